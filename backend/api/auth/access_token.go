@@ -0,0 +1,90 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+const (
+	// AccessTokenPrefix is the prefix of the access tokens, distinguishing them from the JWT tokens.
+	AccessTokenPrefix = "bbp_"
+	accessTokenLength = 40
+	// lastUsedUpdateInterval throttles the last used time updates of the access tokens and the sessions.
+	lastUsedUpdateInterval = 1 * time.Minute
+)
+
+// GenerateAccessTokenSecret generates the secret of an access token and returns it with its hash.
+func GenerateAccessTokenSecret() (string, string, error) {
+	s, err := common.RandomString(accessTokenLength)
+	if err != nil {
+		return "", "", err
+	}
+	token := AccessTokenPrefix + s
+	return token, HashAccessTokenSecret(token), nil
+}
+
+// HashAccessTokenSecret returns the hash of the access token secret stored in the database.
+func HashAccessTokenSecret(token string) string {
+	h := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(h[:])
+}
+
+// IsAccessTokenSecret returns true if the token is an access token secret instead of a JWT token.
+func IsAccessTokenSecret(token string) bool {
+	return strings.HasPrefix(token, AccessTokenPrefix)
+}
+
+// GetAccessTokenScope returns the scope of the access token, or nil if the token is not scoped.
+func GetAccessTokenScope(token *store.AccessTokenMessage) *common.AccessTokenScope {
+	if len(token.Payload.GetPermissions()) == 0 && len(token.Payload.GetProjects()) == 0 {
+		return nil
+	}
+	return &common.AccessTokenScope{
+		Permissions: token.Payload.GetPermissions(),
+		Projects:    token.Payload.GetProjects(),
+	}
+}
+
+func (in *APIAuthInterceptor) authenticateAccessToken(ctx context.Context, tokenStr string) (int, *common.AccessTokenScope, error) {
+	tokenHash := HashAccessTokenSecret(tokenStr)
+	token, err := in.store.GetAccessToken(ctx, &store.FindAccessTokenMessage{TokenHash: &tokenHash, ShowRevoked: true})
+	if err != nil {
+		return 0, nil, status.Errorf(codes.Internal, "failed to find access token, error: %v", err)
+	}
+	if token == nil {
+		return 0, nil, status.Errorf(codes.Unauthenticated, "access token not found")
+	}
+	if token.RevokeTime != nil {
+		return 0, nil, status.Errorf(codes.Unauthenticated, "access token revoked")
+	}
+	now := time.Now()
+	if token.ExpireTime != nil && !now.Before(*token.ExpireTime) {
+		return 0, nil, status.Errorf(codes.Unauthenticated, "access token expired")
+	}
+	user, err := in.store.GetUserByID(ctx, token.PrincipalUID)
+	if err != nil {
+		return 0, nil, status.Errorf(codes.Unauthenticated, "failed to find user ID %q in the access token", token.PrincipalUID)
+	}
+	if user == nil {
+		return 0, nil, status.Errorf(codes.Unauthenticated, "user ID %q not exists in the access token", token.PrincipalUID)
+	}
+	if user.MemberDeleted {
+		return 0, nil, status.Errorf(codes.Unauthenticated, "user ID %q has been deactivated by administrators", token.PrincipalUID)
+	}
+
+	if token.LastUsedTime == nil || now.Sub(*token.LastUsedTime) >= lastUsedUpdateInterval {
+		if _, err := in.store.UpdateAccessToken(ctx, &store.UpdateAccessTokenMessage{UID: token.UID, LastUsedTime: &now}); err != nil {
+			return 0, nil, status.Errorf(codes.Internal, "failed to update access token, error: %v", err)
+		}
+	}
+	return token.PrincipalUID, GetAccessTokenScope(token), nil
+}
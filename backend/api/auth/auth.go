@@ -39,7 +39,8 @@ const (
 	AccessTokenAudienceFmt = "bb.user.access.%s"
 	// MFATempTokenAudienceFmt is the format of the MFA temp token audience.
 	MFATempTokenAudienceFmt = "bb.user.mfa-temp.%s"
-	apiTokenDuration        = 1 * time.Hour
+	// APITokenDuration is the token expiration duration for service accounts.
+	APITokenDuration = 1 * time.Hour
 	// DefaultTokenDuration is the default token expiration duration.
 	DefaultTokenDuration = 7 * 24 * time.Hour
 
@@ -99,13 +100,14 @@ func (in *APIAuthInterceptor) AuthenticationInterceptor(ctx context.Context, req
 	}
 	ctx = context.WithValue(ctx, common.AuthContextKey, authContext)
 
-	principalID, err := in.getPrincipalID(ctx, accessTokenStr)
+	principalID, scope, err := in.getPrincipalID(ctx, accessTokenStr)
 	if err != nil {
 		if IsAuthenticationAllowed(serverInfo.FullMethod, authContext) {
 			return handler(ctx, request)
 		}
 		return nil, err
 	}
+	authContext.AccessTokenScope = scope
 
 	ctx = context.WithValue(ctx, common.PrincipalIDContextKey, principalID)
 	return handler(ctx, request)
@@ -129,13 +131,14 @@ func (in *APIAuthInterceptor) AuthenticationStreamInterceptor(request any, ss gr
 	}
 	ctx = context.WithValue(ctx, common.AuthContextKey, authContext)
 
-	principalID, err := in.getPrincipalID(ctx, accessTokenStr)
+	principalID, scope, err := in.getPrincipalID(ctx, accessTokenStr)
 	if err != nil {
 		if IsAuthenticationAllowed(serverInfo.FullMethod, authContext) {
 			return handler(request, ss)
 		}
 		return err
 	}
+	authContext.AccessTokenScope = scope
 
 	ctx = context.WithValue(ctx, common.PrincipalIDContextKey, principalID)
 	sss := overrideStream{ServerStream: ss, childCtx: ctx}
@@ -197,19 +200,32 @@ func (in *APIAuthInterceptor) authenticate(ctx context.Context, accessTokenStr s
 	if user.MemberDeleted {
 		return 0, status.Errorf(codes.Unauthenticated, "user ID %q has been deactivated by administrators", principalID)
 	}
+	// The tokens issued before the session management have no session ID.
+	if claims.ID != "" {
+		if err := in.authenticateSession(ctx, claims.ID, principalID); err != nil {
+			return 0, err
+		}
+	}
 
 	return principalID, nil
 }
 
-func (in *APIAuthInterceptor) getPrincipalID(ctx context.Context, accessTokenStr string) (int, error) {
-	principalID, err := in.authenticate(ctx, accessTokenStr)
+func (in *APIAuthInterceptor) getPrincipalID(ctx context.Context, accessTokenStr string) (int, *common.AccessTokenScope, error) {
+	var principalID int
+	var scope *common.AccessTokenScope
+	var err error
+	if IsAccessTokenSecret(accessTokenStr) {
+		principalID, scope, err = in.authenticateAccessToken(ctx, accessTokenStr)
+	} else {
+		principalID, err = in.authenticate(ctx, accessTokenStr)
+	}
 	if err != nil {
-		return 0, err
+		return 0, nil, err
 	}
 
 	// Only update for authorized request.
 	in.profile.LastActiveTs = time.Now().Unix()
-	return principalID, nil
+	return principalID, scope, nil
 }
 
 // GetUserIDFromMFATempToken returns the user ID from the MFA temp token.
@@ -275,26 +291,24 @@ type claimsMessage struct {
 	jwt.RegisteredClaims
 }
 
-// GenerateAPIToken generates an API token.
-func GenerateAPIToken(userName string, userID int, mode common.ReleaseMode, secret string) (string, error) {
-	expirationTime := time.Now().Add(apiTokenDuration)
-	return generateToken(userName, userID, fmt.Sprintf(AccessTokenAudienceFmt, mode), expirationTime, []byte(secret))
+// GenerateAPIToken generates an API token of the session expiring at the expiration time.
+func GenerateAPIToken(userName string, userID int, sessionID string, mode common.ReleaseMode, secret string, expirationTime time.Time) (string, error) {
+	return generateToken(userName, userID, sessionID, fmt.Sprintf(AccessTokenAudienceFmt, mode), expirationTime, []byte(secret))
 }
 
-// GenerateAccessToken generates an access token for web.
-func GenerateAccessToken(userName string, userID int, mode common.ReleaseMode, secret string, tokenDuration time.Duration) (string, error) {
-	expirationTime := time.Now().Add(tokenDuration)
-	return generateToken(userName, userID, fmt.Sprintf(AccessTokenAudienceFmt, mode), expirationTime, []byte(secret))
+// GenerateAccessToken generates an access token of the session for web, expiring at the expiration time.
+func GenerateAccessToken(userName string, userID int, sessionID string, mode common.ReleaseMode, secret string, expirationTime time.Time) (string, error) {
+	return generateToken(userName, userID, sessionID, fmt.Sprintf(AccessTokenAudienceFmt, mode), expirationTime, []byte(secret))
 }
 
 // GenerateMFATempToken generates a temporary token for MFA.
 func GenerateMFATempToken(userName string, userID int, mode common.ReleaseMode, secret string, tokenDuration time.Duration) (string, error) {
 	expirationTime := time.Now().Add(tokenDuration)
-	return generateToken(userName, userID, fmt.Sprintf(MFATempTokenAudienceFmt, mode), expirationTime, []byte(secret))
+	return generateToken(userName, userID, "" /* sessionID */, fmt.Sprintf(MFATempTokenAudienceFmt, mode), expirationTime, []byte(secret))
 }
 
 // Pay attention to this function. It holds the main JWT token generation logic.
-func generateToken(userName string, userID int, aud string, expirationTime time.Time, secret []byte) (string, error) {
+func generateToken(userName string, userID int, sessionID string, aud string, expirationTime time.Time, secret []byte) (string, error) {
 	// Create the JWT claims, which includes the username and expiry time.
 	claims := &claimsMessage{
 		Name: userName,
@@ -305,6 +319,8 @@ func generateToken(userName string, userID int, aud string, expirationTime time.
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 			Issuer:    issuer,
 			Subject:   strconv.Itoa(userID),
+			// The JWT ID identifies the session of the token.
+			ID: sessionID,
 		},
 	}
 
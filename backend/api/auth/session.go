@@ -0,0 +1,73 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+// authenticateSession checks the session of the access token against the revocation and the idle timeout.
+func (in *APIAuthInterceptor) authenticateSession(ctx context.Context, sessionID string, principalID int) error {
+	session, err := in.store.GetUserSession(ctx, &store.FindUserSessionMessage{SessionID: &sessionID, ShowInactive: true})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to find session, error: %v", err)
+	}
+	if session == nil || session.PrincipalUID != principalID {
+		return status.Errorf(codes.Unauthenticated, "session not found")
+	}
+	if session.RevokeTime != nil {
+		return status.Errorf(codes.Unauthenticated, "session has been signed out")
+	}
+	now := time.Now()
+	if !now.Before(session.ExpireTime) {
+		return status.Errorf(codes.Unauthenticated, "access token expired")
+	}
+	if idleTimeout := GetSessionIdleTimeout(ctx, in.store); idleTimeout > 0 && now.Sub(session.LastActiveTime) > idleTimeout {
+		if _, err := in.store.UpdateUserSession(ctx, &store.UpdateUserSessionMessage{UID: session.UID, RevokeTime: &now}); err != nil {
+			return status.Errorf(codes.Internal, "failed to revoke idle session, error: %v", err)
+		}
+		return status.Errorf(codes.Unauthenticated, "session has been signed out due to inactivity")
+	}
+
+	if now.Sub(session.LastActiveTime) >= lastUsedUpdateInterval {
+		if _, err := in.store.UpdateUserSession(ctx, &store.UpdateUserSessionMessage{UID: session.UID, LastActiveTime: &now}); err != nil {
+			return status.Errorf(codes.Internal, "failed to update session, error: %v", err)
+		}
+	}
+	return nil
+}
+
+// GetSessionIdleTimeout returns the idle timeout of the sessions, or zero if there is no idle timeout.
+func GetSessionIdleTimeout(ctx context.Context, store *store.Store) time.Duration {
+	workspaceProfile, err := store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return 0
+	}
+	if workspaceProfile.SessionIdleTimeout.GetSeconds() > 0 {
+		return workspaceProfile.SessionIdleTimeout.AsDuration()
+	}
+	return 0
+}
+
+// GetSessionID returns the session ID of the access token signed by the secret, or empty if the token has no session.
+// The expiration is not validated so that the expired tokens can still sign out.
+func GetSessionID(accessTokenStr string, secret string) string {
+	claims := &claimsMessage{}
+	if _, err := jwt.ParseWithClaims(accessTokenStr, claims, func(t *jwt.Token) (any, error) {
+		if t.Method.Alg() != jwt.SigningMethodHS256.Name {
+			return nil, status.Errorf(codes.Unauthenticated, "unexpected access token signing method=%v, expect %v", t.Header["alg"], jwt.SigningMethodHS256)
+		}
+		if kid, ok := t.Header["kid"].(string); ok && kid == keyID {
+			return []byte(secret), nil
+		}
+		return nil, status.Errorf(codes.Unauthenticated, "unexpected access token kid=%v", t.Header["kid"])
+	}, jwt.WithoutClaimsValidation()); err != nil {
+		return ""
+	}
+	return claims.ID
+}
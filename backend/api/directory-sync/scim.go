@@ -0,0 +1,1093 @@
+package directorysync
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/mail"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/bytebase/bytebase/backend/common"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	scimSource      = "SCIM"
+	scimContentType = "application/scim+json"
+
+	scimUserSchema         = "urn:ietf:params:scim:schemas:core:2.0:User"
+	scimGroupSchema        = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	scimListResponseSchema = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	scimErrorSchema        = "urn:ietf:params:scim:api:messages:2.0:Error"
+	scimConfigSchema       = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+
+	scimDefaultCount = 100
+	scimMaxCount     = 1000
+)
+
+var scimGroupEmailLocalPartReplacer = regexp.MustCompile(`[^a-z0-9._-]+`)
+
+// SCIMError is the SCIM error response.
+// Docs: https://datatracker.ietf.org/doc/html/rfc7644#section-3.12
+type SCIMError struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	SCIMType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail,omitempty"`
+
+	status int
+}
+
+func newSCIMError(status int, scimType string, format string, args ...any) *SCIMError {
+	return &SCIMError{
+		Schemas:  []string{scimErrorSchema},
+		Status:   strconv.Itoa(status),
+		SCIMType: scimType,
+		Detail:   fmt.Sprintf(format, args...),
+		status:   status,
+	}
+}
+
+func (e *SCIMError) Error() string {
+	return e.Detail
+}
+
+type SCIMMeta struct {
+	ResourceType string `json:"resourceType"`
+	Created      string `json:"created,omitempty"`
+	Location     string `json:"location,omitempty"`
+	Version      string `json:"version,omitempty"`
+}
+
+type SCIMName struct {
+	Formatted  string `json:"formatted,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+}
+
+type SCIMMultiValuedAttribute struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+	Ref     string `json:"$ref,omitempty"`
+}
+
+// SCIMUser is the SCIM core user schema.
+// Docs: https://datatracker.ietf.org/doc/html/rfc7643#section-4.1
+type SCIMUser struct {
+	Schemas      []string                    `json:"schemas"`
+	ID           string                      `json:"id,omitempty"`
+	ExternalID   string                      `json:"externalId,omitempty"`
+	UserName     string                      `json:"userName"`
+	Name         *SCIMName                   `json:"name,omitempty"`
+	DisplayName  string                      `json:"displayName,omitempty"`
+	Active       *bool                       `json:"active,omitempty"`
+	Emails       []*SCIMMultiValuedAttribute `json:"emails,omitempty"`
+	PhoneNumbers []*SCIMMultiValuedAttribute `json:"phoneNumbers,omitempty"`
+	Meta         *SCIMMeta                   `json:"meta,omitempty"`
+}
+
+// SCIMGroup is the SCIM core group schema.
+// Docs: https://datatracker.ietf.org/doc/html/rfc7643#section-4.2
+type SCIMGroup struct {
+	Schemas     []string                    `json:"schemas"`
+	ID          string                      `json:"id,omitempty"`
+	ExternalID  string                      `json:"externalId,omitempty"`
+	DisplayName string                      `json:"displayName"`
+	Members     []*SCIMMultiValuedAttribute `json:"members"`
+	Meta        *SCIMMeta                   `json:"meta,omitempty"`
+}
+
+type SCIMListResponse struct {
+	Schemas      []string         `json:"schemas"`
+	TotalResults int              `json:"totalResults"`
+	StartIndex   int              `json:"startIndex"`
+	ItemsPerPage int              `json:"itemsPerPage"`
+	Resources    []map[string]any `json:"Resources"`
+}
+
+// RegisterSCIMRoutes registers the spec-compliant SCIM 2.0 service for any identity provider, e.g. Okta.
+// The users are identified by the Bytebase user uid and the groups are identified by the group email.
+// Docs: https://datatracker.ietf.org/doc/html/rfc7644
+func (s *Service) RegisterSCIMRoutes(g *echo.Group) {
+	g.GET("/workspaces/:workspaceID/ServiceProviderConfig", s.scimHandler(s.getSCIMServiceProviderConfig))
+
+	g.POST("/workspaces/:workspaceID/Users", s.scimHandler(s.createSCIMUser))
+	g.GET("/workspaces/:workspaceID/Users", s.scimHandler(s.listSCIMUsers))
+	g.GET("/workspaces/:workspaceID/Users/:userID", s.scimHandler(s.getSCIMUser))
+	g.PUT("/workspaces/:workspaceID/Users/:userID", s.scimHandler(s.replaceSCIMUser))
+	g.PATCH("/workspaces/:workspaceID/Users/:userID", s.scimHandler(s.patchSCIMUser))
+	g.DELETE("/workspaces/:workspaceID/Users/:userID", s.scimHandler(s.deleteSCIMUser))
+
+	g.POST("/workspaces/:workspaceID/Groups", s.scimHandler(s.createSCIMGroup))
+	g.GET("/workspaces/:workspaceID/Groups", s.scimHandler(s.listSCIMGroups))
+	g.GET("/workspaces/:workspaceID/Groups/:groupID", s.scimHandler(s.getSCIMGroup))
+	g.PUT("/workspaces/:workspaceID/Groups/:groupID", s.scimHandler(s.replaceSCIMGroup))
+	g.PATCH("/workspaces/:workspaceID/Groups/:groupID", s.scimHandler(s.patchSCIMGroup))
+	g.DELETE("/workspaces/:workspaceID/Groups/:groupID", s.scimHandler(s.deleteSCIMGroup))
+}
+
+// scimHandler authenticates the request with the SCIM token in the workspace
+// setting, and writes the errors in the SCIM error response format.
+func (s *Service) scimHandler(handler func(ctx context.Context, c echo.Context) error) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		if err := s.validRequestURL(ctx, c); err != nil {
+			return writeSCIMResponse(c, http.StatusUnauthorized, newSCIMError(http.StatusUnauthorized, "", "%v", err))
+		}
+		if err := s.licenseService.IsFeatureEnabled(api.FeatureDirectorySync); err != nil {
+			return writeSCIMResponse(c, http.StatusForbidden, newSCIMError(http.StatusForbidden, "", "%v", err))
+		}
+		if err := handler(ctx, c); err != nil {
+			var scimErr *SCIMError
+			if !errors.As(err, &scimErr) {
+				scimErr = newSCIMError(http.StatusInternalServerError, "", "%v", err)
+			}
+			return writeSCIMResponse(c, scimErr.status, scimErr)
+		}
+		return nil
+	}
+}
+
+func (*Service) getSCIMServiceProviderConfig(_ context.Context, c echo.Context) error {
+	return writeSCIMResponse(c, http.StatusOK, map[string]any{
+		"schemas":        []string{scimConfigSchema},
+		"patch":          map[string]any{"supported": true},
+		"bulk":           map[string]any{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+		"filter":         map[string]any{"supported": true, "maxResults": scimMaxCount},
+		"changePassword": map[string]any{"supported": false},
+		"sort":           map[string]any{"supported": false},
+		"etag":           map[string]any{"supported": true},
+		"authenticationSchemes": []map[string]any{
+			{
+				"type":        "oauthbearertoken",
+				"name":        "OAuth Bearer Token",
+				"description": "Authentication with the SCIM token of the workspace.",
+				"primary":     true,
+			},
+		},
+	})
+}
+
+func (s *Service) createSCIMUser(ctx context.Context, c echo.Context) error {
+	body, err := readSCIMBody(c)
+	if err != nil {
+		return err
+	}
+	scimUser, err := decodeSCIMUser(body)
+	if err != nil {
+		return err
+	}
+	email, err := scimUser.getEmail()
+	if err != nil {
+		return err
+	}
+
+	existing, err := s.store.GetUserByEmail(ctx, email)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get user %s", email)
+	}
+	if existing != nil {
+		return newSCIMError(http.StatusConflict, "uniqueness", "user %s already exists", email)
+	}
+
+	password, err := common.RandomString(20)
+	if err != nil {
+		return errors.Wrap(err, "failed to generate random password")
+	}
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return errors.Wrap(err, "failed to generate password hash")
+	}
+	user, err := s.store.CreateUser(ctx, &store.UserMessage{
+		Name:          scimUser.getName(email),
+		Email:         email,
+		Type:          api.EndUser,
+		MemberDeleted: !scimUser.isActive(),
+		PasswordHash:  string(passwordHash),
+		Phone:         scimUser.getPhone(),
+		Profile: &storepb.UserProfile{
+			Source: scimSource,
+		},
+	}, api.SystemBotID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to create user %s", email)
+	}
+
+	return s.writeSCIMUser(ctx, c, http.StatusCreated, user)
+}
+
+func (s *Service) listSCIMUsers(ctx context.Context, c echo.Context) error {
+	filter, err := getSCIMListFilter(c)
+	if err != nil {
+		return err
+	}
+	baseURL, err := s.getSCIMBaseURL(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	startIndex, count := getSCIMListPage(c)
+
+	// The deprovisioned users are listed as inactive so that the identity provider can reactivate them.
+	endUser := api.EndUser
+	find := &store.FindUserMessage{
+		Type:        &endUser,
+		ShowDeleted: true,
+	}
+	if filter == nil {
+		// Paginate in the store query without the filter.
+		total, err := s.store.CountUsers(ctx, api.EndUser)
+		if err != nil {
+			return errors.Wrap(err, "failed to count users")
+		}
+		offset := startIndex - 1
+		find.Limit, find.Offset = &count, &offset
+		users, err := s.store.ListUsers(ctx, find)
+		if err != nil {
+			return errors.Wrap(err, "failed to list users")
+		}
+		resources := []map[string]any{}
+		for _, user := range users {
+			resource, err := toSCIMResource(convertToSCIMUser(baseURL, user))
+			if err != nil {
+				return err
+			}
+			resources = append(resources, resource)
+		}
+		return writeSCIMListResponse(c, resources, total, startIndex)
+	}
+	// The identity providers look up the users by the email mostly, which is narrowed in the store query.
+	if email, ok := getSCIMFilterEmail(filter); ok {
+		find.Email = &email
+	}
+	users, err := s.store.ListUsers(ctx, find)
+	if err != nil {
+		return errors.Wrap(err, "failed to list users")
+	}
+
+	var resources []map[string]any
+	for _, user := range users {
+		resource, err := toSCIMResource(convertToSCIMUser(baseURL, user))
+		if err != nil {
+			return err
+		}
+		if filter.match(resource) {
+			resources = append(resources, resource)
+		}
+	}
+	return writeSCIMListResponse(c, paginateSCIMResources(resources, startIndex, count), len(resources), startIndex)
+}
+
+func (s *Service) getSCIMUser(ctx context.Context, c echo.Context) error {
+	user, err := s.getSCIMUserByID(ctx, c.Param("userID"))
+	if err != nil {
+		return err
+	}
+	baseURL, err := s.getSCIMBaseURL(ctx, c)
+	if err != nil {
+		return err
+	}
+	scimUser := convertToSCIMUser(baseURL, user)
+	if matchETag(c.Request().Header.Get("If-None-Match"), scimUser.Meta.Version) {
+		c.Response().Header().Set("ETag", scimUser.Meta.Version)
+		return c.NoContent(http.StatusNotModified)
+	}
+	return writeSCIMResource(c, http.StatusOK, scimUser, scimUser.Meta.Version)
+}
+
+func (s *Service) replaceSCIMUser(ctx context.Context, c echo.Context) error {
+	user, err := s.getSCIMProvisionedUserByID(ctx, c.Param("userID"))
+	if err != nil {
+		return err
+	}
+	if err := checkIfMatch(c, convertToSCIMUser("", user).Meta.Version); err != nil {
+		return err
+	}
+	body, err := readSCIMBody(c)
+	if err != nil {
+		return err
+	}
+	scimUser, err := decodeSCIMUser(body)
+	if err != nil {
+		return err
+	}
+	updatedUser, err := s.updateUserWithSCIM(ctx, user, scimUser)
+	if err != nil {
+		return err
+	}
+	return s.writeSCIMUser(ctx, c, http.StatusOK, updatedUser)
+}
+
+func (s *Service) patchSCIMUser(ctx context.Context, c echo.Context) error {
+	user, err := s.getSCIMProvisionedUserByID(ctx, c.Param("userID"))
+	if err != nil {
+		return err
+	}
+	original := convertToSCIMUser("", user)
+	if err := checkIfMatch(c, original.Meta.Version); err != nil {
+		return err
+	}
+	patch, err := readSCIMPatchRequest(c)
+	if err != nil {
+		return err
+	}
+
+	resource, err := toSCIMResource(original)
+	if err != nil {
+		return err
+	}
+	if err := applySCIMPatch(resource, patch.Operations); err != nil {
+		return err
+	}
+	scimUser, err := decodeSCIMUser(resource)
+	if err != nil {
+		return err
+	}
+	// The name sub-attributes take effect only if the display name is not patched in the meantime.
+	if scimUser.DisplayName == original.DisplayName && scimUser.Name != nil && *scimUser.Name != *original.Name {
+		scimUser.DisplayName = ""
+		if scimUser.Name.Formatted == original.Name.Formatted {
+			scimUser.Name.Formatted = ""
+		}
+	}
+
+	updatedUser, err := s.updateUserWithSCIM(ctx, user, scimUser)
+	if err != nil {
+		return err
+	}
+	return s.writeSCIMUser(ctx, c, http.StatusOK, updatedUser)
+}
+
+func (s *Service) deleteSCIMUser(ctx context.Context, c echo.Context) error {
+	user, err := s.getSCIMProvisionedUserByID(ctx, c.Param("userID"))
+	if err != nil {
+		return err
+	}
+	if user.MemberDeleted {
+		return newSCIMError(http.StatusNotFound, "", "user %d not found", user.ID)
+	}
+	if err := checkIfMatch(c, convertToSCIMUser("", user).Meta.Version); err != nil {
+		return err
+	}
+
+	deleteUser := true
+	if _, err := s.store.UpdateUser(ctx, user, &store.UpdateUserMessage{
+		Delete: &deleteUser,
+	}, api.SystemBotID); err != nil {
+		return errors.Wrap(err, "failed to delete user")
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (s *Service) getSCIMUserByID(ctx context.Context, userID string) (*store.UserMessage, error) {
+	uid, err := strconv.Atoi(userID)
+	if err != nil {
+		return nil, newSCIMError(http.StatusNotFound, "", "user %s not found", userID)
+	}
+	user, err := s.store.GetUserByID(ctx, uid)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get user %d", uid)
+	}
+	if user == nil || user.Type != api.EndUser {
+		return nil, newSCIMError(http.StatusNotFound, "", "user %s not found", userID)
+	}
+	return user, nil
+}
+
+// getSCIMProvisionedUserByID returns the user provisioned by SCIM,
+// so that the SCIM token cannot take over the users created in Bytebase, e.g. the workspace admins.
+func (s *Service) getSCIMProvisionedUserByID(ctx context.Context, userID string) (*store.UserMessage, error) {
+	user, err := s.getSCIMUserByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user.Profile.GetSource() != scimSource {
+		return nil, newSCIMError(http.StatusForbidden, "", "user %s is not provisioned by SCIM", userID)
+	}
+	return user, nil
+}
+
+// updateUserWithSCIM updates the user to the SCIM representation.
+func (s *Service) updateUserWithSCIM(ctx context.Context, user *store.UserMessage, scimUser *SCIMUser) (*store.UserMessage, error) {
+	email, err := scimUser.getEmail()
+	if err != nil {
+		return nil, err
+	}
+
+	patch := &store.UpdateUserMessage{}
+	if email != user.Email {
+		existing, err := s.store.GetUserByEmail(ctx, email)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get user %s", email)
+		}
+		if existing != nil {
+			return nil, newSCIMError(http.StatusConflict, "uniqueness", "user %s already exists", email)
+		}
+		patch.Email = &email
+	}
+	if name := scimUser.getName(email); name != user.Name {
+		patch.Name = &name
+	}
+	if deleted := !scimUser.isActive(); deleted != user.MemberDeleted {
+		patch.Delete = &deleted
+	}
+	if phone := scimUser.getPhone(); phone != user.Phone {
+		patch.Phone = &phone
+	}
+
+	updatedUser, err := s.store.UpdateUser(ctx, user, patch, api.SystemBotID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to update user %s", user.Email)
+	}
+	return updatedUser, nil
+}
+
+func (s *Service) writeSCIMUser(ctx context.Context, c echo.Context, status int, user *store.UserMessage) error {
+	baseURL, err := s.getSCIMBaseURL(ctx, c)
+	if err != nil {
+		return err
+	}
+	scimUser := convertToSCIMUser(baseURL, user)
+	return writeSCIMResource(c, status, scimUser, scimUser.Meta.Version)
+}
+
+func (s *Service) createSCIMGroup(ctx context.Context, c echo.Context) error {
+	body, err := readSCIMBody(c)
+	if err != nil {
+		return err
+	}
+	scimGroup, err := decodeSCIMGroup(body)
+	if err != nil {
+		return err
+	}
+	email, err := s.getSCIMGroupEmail(ctx, scimGroup)
+	if err != nil {
+		return err
+	}
+
+	existing, err := s.store.GetGroup(ctx, email)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get group %s", email)
+	}
+	if existing != nil {
+		return newSCIMError(http.StatusConflict, "uniqueness", "group %s already exists", email)
+	}
+	members, err := s.convertToGroupMembers(ctx, scimGroup.Members, nil)
+	if err != nil {
+		return err
+	}
+
+	group, err := s.store.CreateGroup(ctx, &store.GroupMessage{
+		Email: email,
+		Title: scimGroup.DisplayName,
+		Payload: &storepb.GroupPayload{
+			Members: members,
+			Source:  scimSource,
+		},
+	}, api.SystemBotID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to create group %s", email)
+	}
+	if err := s.iamManager.ReloadCache(ctx); err != nil {
+		return errors.Wrap(err, "failed to reload iam cache")
+	}
+
+	return s.writeSCIMGroup(ctx, c, http.StatusCreated, group)
+}
+
+func (s *Service) listSCIMGroups(ctx context.Context, c echo.Context) error {
+	filter, err := getSCIMListFilter(c)
+	if err != nil {
+		return err
+	}
+	baseURL, err := s.getSCIMBaseURL(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	groups, err := s.store.ListGroups(ctx, &store.FindGroupMessage{})
+	if err != nil {
+		return errors.Wrap(err, "failed to list groups")
+	}
+
+	var resources []map[string]any
+	for _, group := range groups {
+		scimGroup, err := s.convertToSCIMGroup(ctx, baseURL, group)
+		if err != nil {
+			return err
+		}
+		resource, err := toSCIMResource(scimGroup)
+		if err != nil {
+			return err
+		}
+		if filter == nil || filter.match(resource) {
+			resources = append(resources, resource)
+		}
+	}
+	startIndex, count := getSCIMListPage(c)
+	return writeSCIMListResponse(c, paginateSCIMResources(resources, startIndex, count), len(resources), startIndex)
+}
+
+func (s *Service) getSCIMGroup(ctx context.Context, c echo.Context) error {
+	group, err := s.getSCIMGroupByID(ctx, c.Param("groupID"))
+	if err != nil {
+		return err
+	}
+	baseURL, err := s.getSCIMBaseURL(ctx, c)
+	if err != nil {
+		return err
+	}
+	scimGroup, err := s.convertToSCIMGroup(ctx, baseURL, group)
+	if err != nil {
+		return err
+	}
+	if matchETag(c.Request().Header.Get("If-None-Match"), scimGroup.Meta.Version) {
+		c.Response().Header().Set("ETag", scimGroup.Meta.Version)
+		return c.NoContent(http.StatusNotModified)
+	}
+	return writeSCIMResource(c, http.StatusOK, scimGroup, scimGroup.Meta.Version)
+}
+
+func (s *Service) replaceSCIMGroup(ctx context.Context, c echo.Context) error {
+	group, err := s.getSCIMGroupByID(ctx, c.Param("groupID"))
+	if err != nil {
+		return err
+	}
+	original, err := s.convertToSCIMGroup(ctx, "", group)
+	if err != nil {
+		return err
+	}
+	if err := checkIfMatch(c, original.Meta.Version); err != nil {
+		return err
+	}
+	body, err := readSCIMBody(c)
+	if err != nil {
+		return err
+	}
+	scimGroup, err := decodeSCIMGroup(body)
+	if err != nil {
+		return err
+	}
+	updatedGroup, err := s.updateGroupWithSCIM(ctx, group, scimGroup)
+	if err != nil {
+		return err
+	}
+	return s.writeSCIMGroup(ctx, c, http.StatusOK, updatedGroup)
+}
+
+func (s *Service) patchSCIMGroup(ctx context.Context, c echo.Context) error {
+	group, err := s.getSCIMGroupByID(ctx, c.Param("groupID"))
+	if err != nil {
+		return err
+	}
+	original, err := s.convertToSCIMGroup(ctx, "", group)
+	if err != nil {
+		return err
+	}
+	if err := checkIfMatch(c, original.Meta.Version); err != nil {
+		return err
+	}
+	patch, err := readSCIMPatchRequest(c)
+	if err != nil {
+		return err
+	}
+
+	resource, err := toSCIMResource(original)
+	if err != nil {
+		return err
+	}
+	if err := applySCIMPatch(resource, patch.Operations); err != nil {
+		return err
+	}
+	scimGroup, err := decodeSCIMGroup(resource)
+	if err != nil {
+		return err
+	}
+
+	updatedGroup, err := s.updateGroupWithSCIM(ctx, group, scimGroup)
+	if err != nil {
+		return err
+	}
+	return s.writeSCIMGroup(ctx, c, http.StatusOK, updatedGroup)
+}
+
+func (s *Service) deleteSCIMGroup(ctx context.Context, c echo.Context) error {
+	group, err := s.getSCIMGroupByID(ctx, c.Param("groupID"))
+	if err != nil {
+		return err
+	}
+	original, err := s.convertToSCIMGroup(ctx, "", group)
+	if err != nil {
+		return err
+	}
+	if err := checkIfMatch(c, original.Meta.Version); err != nil {
+		return err
+	}
+
+	if err := s.store.DeleteGroup(ctx, group.Email); err != nil {
+		return errors.Wrap(err, "failed to delete group")
+	}
+	if err := s.iamManager.ReloadCache(ctx); err != nil {
+		return errors.Wrap(err, "failed to reload iam cache")
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (s *Service) getSCIMGroupByID(ctx context.Context, groupID string) (*store.GroupMessage, error) {
+	email, err := decodeGroupEmail(groupID)
+	if err != nil {
+		return nil, newSCIMError(http.StatusNotFound, "", "group %s not found", groupID)
+	}
+	group, err := s.store.GetGroup(ctx, email)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get group %s", email)
+	}
+	if group == nil {
+		return nil, newSCIMError(http.StatusNotFound, "", "group %s not found", email)
+	}
+	return group, nil
+}
+
+// getSCIMGroupEmail returns the email of the group to create. Okta doesn't
+// send the externalId for groups, so the email is derived from the display
+// name with the workspace domain if neither is an email.
+func (s *Service) getSCIMGroupEmail(ctx context.Context, scimGroup *SCIMGroup) (string, error) {
+	if scimGroup.DisplayName == "" {
+		return "", newSCIMError(http.StatusBadRequest, "invalidValue", "displayName is required")
+	}
+	for _, candidate := range []string{scimGroup.ExternalID, scimGroup.DisplayName} {
+		if email := strings.ToLower(strings.TrimSpace(candidate)); isEmail(email) {
+			return email, nil
+		}
+	}
+
+	setting, err := s.store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return "", err
+	}
+	localPart := strings.Trim(scimGroupEmailLocalPartReplacer.ReplaceAllString(strings.ToLower(scimGroup.DisplayName), "-"), "-.")
+	if len(setting.Domains) == 0 || localPart == "" {
+		return "", newSCIMError(http.StatusBadRequest, "invalidValue", "cannot derive the email of group %q, use the group email as the externalId or configure the workspace domain", scimGroup.DisplayName)
+	}
+	return fmt.Sprintf("%s@%s", localPart, setting.Domains[0]), nil
+}
+
+// updateGroupWithSCIM updates the group to the SCIM representation.
+func (s *Service) updateGroupWithSCIM(ctx context.Context, group *store.GroupMessage, scimGroup *SCIMGroup) (*store.GroupMessage, error) {
+	if scimGroup.DisplayName == "" {
+		return nil, newSCIMError(http.StatusBadRequest, "invalidValue", "displayName is required")
+	}
+	members, err := s.convertToGroupMembers(ctx, scimGroup.Members, group.Payload.GetMembers())
+	if err != nil {
+		return nil, err
+	}
+	payload, ok := proto.Clone(group.Payload).(*storepb.GroupPayload)
+	if !ok || payload == nil {
+		payload = &storepb.GroupPayload{}
+	}
+	payload.Members = members
+	payload.Source = scimSource
+
+	updatedGroup, err := s.store.UpdateGroup(ctx, group.Email, &store.UpdateGroupMessage{
+		Title:   &scimGroup.DisplayName,
+		Payload: payload,
+	}, api.SystemBotID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to update group %s", group.Email)
+	}
+	if err := s.iamManager.ReloadCache(ctx); err != nil {
+		return nil, errors.Wrap(err, "failed to reload iam cache")
+	}
+	return updatedGroup, nil
+}
+
+// convertToGroupMembers converts the SCIM members to the group members, keeping
+// the roles of the existing members.
+func (s *Service) convertToGroupMembers(ctx context.Context, scimMembers []*SCIMMultiValuedAttribute, existing []*storepb.GroupMember) ([]*storepb.GroupMember, error) {
+	var members []*storepb.GroupMember
+	seen := map[string]bool{}
+	for _, scimMember := range scimMembers {
+		// The member value is the Bytebase user uid.
+		uid, err := strconv.Atoi(scimMember.Value)
+		if err != nil {
+			return nil, newSCIMError(http.StatusBadRequest, "invalidValue", "invalid member %q", scimMember.Value)
+		}
+		user, err := s.store.GetUserByID(ctx, uid)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get user %d", uid)
+		}
+		if user == nil {
+			return nil, newSCIMError(http.StatusBadRequest, "invalidValue", "member %q not found", scimMember.Value)
+		}
+		member := common.FormatUserUID(uid)
+		if seen[member] {
+			continue
+		}
+		seen[member] = true
+
+		role := storepb.GroupMember_MEMBER
+		for _, m := range existing {
+			if m.Member == member {
+				role = m.Role
+			}
+		}
+		members = append(members, &storepb.GroupMember{
+			Member: member,
+			Role:   role,
+		})
+	}
+	return members, nil
+}
+
+func (s *Service) writeSCIMGroup(ctx context.Context, c echo.Context, status int, group *store.GroupMessage) error {
+	baseURL, err := s.getSCIMBaseURL(ctx, c)
+	if err != nil {
+		return err
+	}
+	scimGroup, err := s.convertToSCIMGroup(ctx, baseURL, group)
+	if err != nil {
+		return err
+	}
+	return writeSCIMResource(c, status, scimGroup, scimGroup.Meta.Version)
+}
+
+func (s *Service) convertToSCIMGroup(ctx context.Context, baseURL string, group *store.GroupMessage) (*SCIMGroup, error) {
+	scimGroup := &SCIMGroup{
+		Schemas:     []string{scimGroupSchema},
+		ID:          group.Email,
+		ExternalID:  group.Email,
+		DisplayName: group.Title,
+		Members:     []*SCIMMultiValuedAttribute{},
+	}
+	for _, member := range group.Payload.GetMembers() {
+		uid, err := common.GetUserID(member.Member)
+		if err != nil {
+			continue
+		}
+		user, err := s.store.GetUserByID(ctx, uid)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get user %d", uid)
+		}
+		if user == nil {
+			continue
+		}
+		scimGroup.Members = append(scimGroup.Members, &SCIMMultiValuedAttribute{
+			Value:   strconv.Itoa(uid),
+			Display: user.Email,
+		})
+	}
+	version, err := getSCIMVersion(scimGroup)
+	if err != nil {
+		return nil, err
+	}
+	// The member references are set after the version as they vary with the external URL.
+	for _, member := range scimGroup.Members {
+		member.Ref = fmt.Sprintf("%s/Users/%s", baseURL, member.Value)
+	}
+	scimGroup.Meta = &SCIMMeta{
+		ResourceType: "Group",
+		Created:      group.CreatedTime.UTC().Format(time.RFC3339),
+		Location:     fmt.Sprintf("%s/Groups/%s", baseURL, group.Email),
+		Version:      version,
+	}
+	return scimGroup, nil
+}
+
+func convertToSCIMUser(baseURL string, user *store.UserMessage) *SCIMUser {
+	active := !user.MemberDeleted
+	scimUser := &SCIMUser{
+		Schemas:     []string{scimUserSchema},
+		ID:          strconv.Itoa(user.ID),
+		UserName:    user.Email,
+		Name:        &SCIMName{Formatted: user.Name},
+		DisplayName: user.Name,
+		Active:      &active,
+		Emails: []*SCIMMultiValuedAttribute{
+			{
+				Value:   user.Email,
+				Type:    "work",
+				Primary: true,
+			},
+		},
+	}
+	if user.Phone != "" {
+		scimUser.PhoneNumbers = []*SCIMMultiValuedAttribute{
+			{
+				Value:   user.Phone,
+				Type:    "work",
+				Primary: true,
+			},
+		}
+	}
+	// The version is derived from the JSON encoding of the user which cannot fail.
+	version, _ := getSCIMVersion(scimUser)
+	scimUser.Meta = &SCIMMeta{
+		ResourceType: "User",
+		Created:      user.CreatedTime.UTC().Format(time.RFC3339),
+		Location:     fmt.Sprintf("%s/Users/%d", baseURL, user.ID),
+		Version:      version,
+	}
+	return scimUser
+}
+
+// getSCIMBaseURL returns the URL of the SCIM service of the workspace, e.g.
+// https://bytebase.example.com/hook/scim/v2/workspaces/{workspace}.
+func (s *Service) getSCIMBaseURL(ctx context.Context, c echo.Context) (string, error) {
+	setting, err := s.store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return "", err
+	}
+	prefix, _, _ := strings.Cut(c.Path(), "/workspaces/")
+	return fmt.Sprintf("%s%s/workspaces/%s", strings.TrimSuffix(setting.ExternalUrl, "/"), prefix, c.Param("workspaceID")), nil
+}
+
+// getSCIMVersion returns the weak ETag of the resource without the meta.
+func getSCIMVersion(resource any) (string, error) {
+	b, err := json.Marshal(resource)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal resource")
+	}
+	sum := sha256.Sum256(b)
+	return fmt.Sprintf(`W/"%s"`, hex.EncodeToString(sum[:8])), nil
+}
+
+// matchETag reports whether the If-Match or If-None-Match header matches the version.
+func matchETag(header, version string) bool {
+	for _, etag := range strings.Split(header, ",") {
+		etag = strings.TrimSpace(etag)
+		if etag == "*" || strings.TrimPrefix(etag, "W/") == strings.TrimPrefix(version, "W/") {
+			return true
+		}
+	}
+	return false
+}
+
+func checkIfMatch(c echo.Context, version string) error {
+	header := c.Request().Header.Get("If-Match")
+	if header == "" || matchETag(header, version) {
+		return nil
+	}
+	return newSCIMError(http.StatusPreconditionFailed, "", "the resource version %s doesn't match %s", version, header)
+}
+
+// getSCIMListFilter returns the parsed filter query, or nil if absent.
+func getSCIMListFilter(c echo.Context) (scimFilter, error) {
+	filter := c.QueryParam("filter")
+	if filter == "" {
+		return nil, nil
+	}
+	f, err := parseSCIMFilter(filter)
+	if err != nil {
+		return nil, newSCIMError(http.StatusBadRequest, "invalidFilter", "%v", err)
+	}
+	return f, nil
+}
+
+// getSCIMListPage returns the 1-based startIndex and the count of the list request.
+func getSCIMListPage(c echo.Context) (int, int) {
+	startIndex, err := strconv.Atoi(c.QueryParam("startIndex"))
+	if err != nil || startIndex < 1 {
+		startIndex = 1
+	}
+	count, err := strconv.Atoi(c.QueryParam("count"))
+	if err != nil {
+		count = scimDefaultCount
+	}
+	return startIndex, max(0, min(count, scimMaxCount))
+}
+
+// paginateSCIMResources returns the page of the resources with the 1-based startIndex and the count.
+func paginateSCIMResources(resources []map[string]any, startIndex, count int) []map[string]any {
+	if startIndex > len(resources) {
+		return []map[string]any{}
+	}
+	return resources[startIndex-1 : min(startIndex-1+count, len(resources))]
+}
+
+// writeSCIMListResponse writes the page of the total results, and removes the excludedAttributes, e.g. the members of the groups.
+func writeSCIMListResponse(c echo.Context, page []map[string]any, totalResults int, startIndex int) error {
+	if excludedAttributes := c.QueryParam("excludedAttributes"); excludedAttributes != "" {
+		for _, resource := range page {
+			for _, attribute := range strings.Split(excludedAttributes, ",") {
+				path := parseAttributePath(strings.TrimSpace(attribute))
+				if len(path) != 1 || strings.EqualFold(path[0], "id") || strings.EqualFold(path[0], "schemas") {
+					continue
+				}
+				if key, ok := lookupAttributeKey(resource, path[0]); ok {
+					delete(resource, key)
+				}
+			}
+		}
+	}
+
+	return writeSCIMResponse(c, http.StatusOK, &SCIMListResponse{
+		Schemas:      []string{scimListResponseSchema},
+		TotalResults: totalResults,
+		StartIndex:   startIndex,
+		ItemsPerPage: len(page),
+		Resources:    page,
+	})
+}
+
+func writeSCIMResource(c echo.Context, status int, resource any, version string) error {
+	c.Response().Header().Set("ETag", version)
+	return writeSCIMResponse(c, status, resource)
+}
+
+func writeSCIMResponse(c echo.Context, status int, response any) error {
+	b, err := json.Marshal(response)
+	if err != nil {
+		return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to marshal response, error %v", err))
+	}
+	return c.Blob(status, scimContentType, b)
+}
+
+func readSCIMBody(c echo.Context) (map[string]any, error) {
+	body, err := io.ReadAll(c.Request().Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read body")
+	}
+	var resource map[string]any
+	if err := json.Unmarshal(body, &resource); err != nil {
+		return nil, newSCIMError(http.StatusBadRequest, "invalidSyntax", "failed to unmarshal body, error %v", err)
+	}
+	return resource, nil
+}
+
+func readSCIMPatchRequest(c echo.Context) (*PatchRequest, error) {
+	body, err := io.ReadAll(c.Request().Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read body")
+	}
+	var patch PatchRequest
+	if err := json.Unmarshal(body, &patch); err != nil {
+		return nil, newSCIMError(http.StatusBadRequest, "invalidSyntax", "failed to unmarshal body, error %v", err)
+	}
+	return &patch, nil
+}
+
+// toSCIMResource converts the resource to the JSON representation for filtering and patching.
+func toSCIMResource(resource any) (map[string]any, error) {
+	b, err := json.Marshal(resource)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal resource")
+	}
+	var m map[string]any
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal resource")
+	}
+	return m, nil
+}
+
+func decodeSCIMUser(resource map[string]any) (*SCIMUser, error) {
+	// Entra ID sends the active attribute as "True" or "False" in PATCH.
+	if key, ok := lookupAttributeKey(resource, "active"); ok {
+		if v, ok := resource[key].(string); ok {
+			active, err := strconv.ParseBool(v)
+			if err != nil {
+				return nil, newSCIMError(http.StatusBadRequest, "invalidValue", "invalid active %q", v)
+			}
+			resource[key] = active
+		}
+	}
+	var scimUser SCIMUser
+	if err := decodeSCIMResource(resource, &scimUser); err != nil {
+		return nil, err
+	}
+	return &scimUser, nil
+}
+
+func decodeSCIMGroup(resource map[string]any) (*SCIMGroup, error) {
+	var scimGroup SCIMGroup
+	if err := decodeSCIMResource(resource, &scimGroup); err != nil {
+		return nil, err
+	}
+	return &scimGroup, nil
+}
+
+func decodeSCIMResource(resource map[string]any, v any) error {
+	b, err := json.Marshal(resource)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal resource")
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		return newSCIMError(http.StatusBadRequest, "invalidValue", "invalid resource, error %v", err)
+	}
+	return nil
+}
+
+func isEmail(email string) bool {
+	address, err := mail.ParseAddress(email)
+	return err == nil && address.Address == email
+}
+
+func (u *SCIMUser) isActive() bool {
+	return u.Active == nil || *u.Active
+}
+
+// getEmail returns the email of the user from the userName, or the primary
+// email if the userName is not an email.
+func (u *SCIMUser) getEmail() (string, error) {
+	if email := strings.ToLower(strings.TrimSpace(u.UserName)); isEmail(email) {
+		return email, nil
+	}
+	var emails []string
+	for _, email := range u.Emails {
+		if email.Primary {
+			emails = append([]string{email.Value}, emails...)
+		} else {
+			emails = append(emails, email.Value)
+		}
+	}
+	for _, email := range emails {
+		if email = strings.ToLower(strings.TrimSpace(email)); isEmail(email) {
+			return email, nil
+		}
+	}
+	return "", newSCIMError(http.StatusBadRequest, "invalidValue", "userName or emails must contain a valid email")
+}
+
+func (u *SCIMUser) getName(email string) string {
+	if u.DisplayName != "" {
+		return u.DisplayName
+	}
+	if u.Name != nil {
+		if u.Name.Formatted != "" {
+			return u.Name.Formatted
+		}
+		if name := strings.TrimSpace(u.Name.GivenName + " " + u.Name.FamilyName); name != "" {
+			return name
+		}
+	}
+	return email
+}
+
+// getPhone returns the primary phone number, or empty if it doesn't conform E.164 format.
+func (u *SCIMUser) getPhone() string {
+	var phone string
+	for _, phoneNumber := range u.PhoneNumbers {
+		if phone == "" || phoneNumber.Primary {
+			phone = phoneNumber.Value
+		}
+	}
+	if err := common.ValidatePhone(phone); err != nil {
+		return ""
+	}
+	return phone
+}
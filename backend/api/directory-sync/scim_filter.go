@@ -0,0 +1,490 @@
+package directorysync
+
+import (
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// scimFilter is a parsed SCIM filter evaluated against the JSON representation
+// of a resource.
+// Docs: https://datatracker.ietf.org/doc/html/rfc7644#section-3.4.2.2
+type scimFilter interface {
+	match(resource map[string]any) bool
+}
+
+type logicalFilter struct {
+	and         bool
+	left, right scimFilter
+}
+
+func (f *logicalFilter) match(resource map[string]any) bool {
+	if f.and {
+		return f.left.match(resource) && f.right.match(resource)
+	}
+	return f.left.match(resource) || f.right.match(resource)
+}
+
+type notFilter struct {
+	filter scimFilter
+}
+
+func (f *notFilter) match(resource map[string]any) bool {
+	return !f.filter.match(resource)
+}
+
+// attributeFilter is the attribute expression, e.g. `userName eq "alice"`.
+type attributeFilter struct {
+	path     []string
+	operator string
+	value    any
+}
+
+func (f *attributeFilter) match(resource map[string]any) bool {
+	values := getAttributeValues(resource, f.path)
+	switch f.operator {
+	case "pr":
+		for _, v := range values {
+			if isPresent(v) {
+				return true
+			}
+		}
+		return false
+	case "ne":
+		for _, v := range values {
+			if compareValue("eq", v, f.value) {
+				return false
+			}
+		}
+		return true
+	default:
+		if f.operator == "eq" && f.value == nil {
+			return len(values) == 0
+		}
+		for _, v := range values {
+			if compareValue(f.operator, v, f.value) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// valuePathFilter filters the elements of a multi-valued complex attribute,
+// e.g. `emails[type eq "work"]`.
+type valuePathFilter struct {
+	path   []string
+	filter scimFilter
+}
+
+func (f *valuePathFilter) match(resource map[string]any) bool {
+	for _, v := range getAttributeValues(resource, f.path) {
+		if element, ok := v.(map[string]any); ok && f.filter.match(element) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseSCIMFilter parses the filter with the grammar defined in RFC 7644.
+// The attribute names are case-insensitive and the schema URN prefix is ignored.
+func parseSCIMFilter(filter string) (scimFilter, error) {
+	tokens, err := tokenizeSCIMFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+	p := &scimFilterParser{tokens: tokens}
+	f, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.tokens) {
+		return nil, errors.Errorf("unexpected token %q in filter", p.tokens[p.pos].text)
+	}
+	return f, nil
+}
+
+type scimFilterToken struct {
+	text string
+	// quoted is true for string literals.
+	quoted bool
+}
+
+func tokenizeSCIMFilter(filter string) ([]scimFilterToken, error) {
+	var tokens []scimFilterToken
+	for i := 0; i < len(filter); {
+		ch := filter[i]
+		switch {
+		case ch == ' ' || ch == '\t' || ch == '\n' || ch == '\r':
+			i++
+		case ch == '(' || ch == ')' || ch == '[' || ch == ']':
+			tokens = append(tokens, scimFilterToken{text: string(ch)})
+			i++
+		case ch == '"':
+			j := i + 1
+			for ; j < len(filter); j++ {
+				if filter[j] == '\\' {
+					j++
+					continue
+				}
+				if filter[j] == '"' {
+					break
+				}
+			}
+			if j >= len(filter) {
+				return nil, errors.Errorf("unterminated string in filter %q", filter)
+			}
+			var s string
+			if err := json.Unmarshal([]byte(filter[i:j+1]), &s); err != nil {
+				return nil, errors.Wrapf(err, "invalid string %s in filter", filter[i:j+1])
+			}
+			tokens = append(tokens, scimFilterToken{text: s, quoted: true})
+			i = j + 1
+		default:
+			j := i
+			for ; j < len(filter); j++ {
+				if strings.ContainsRune(" \t\n\r()[]\"", rune(filter[j])) {
+					break
+				}
+			}
+			tokens = append(tokens, scimFilterToken{text: filter[i:j]})
+			i = j
+		}
+	}
+	return tokens, nil
+}
+
+type scimFilterParser struct {
+	tokens []scimFilterToken
+	pos    int
+}
+
+func (p *scimFilterParser) peekKeyword(keyword string) bool {
+	if p.pos >= len(p.tokens) {
+		return false
+	}
+	token := p.tokens[p.pos]
+	return !token.quoted && strings.EqualFold(token.text, keyword)
+}
+
+func (p *scimFilterParser) expect(text string) error {
+	if !p.peekKeyword(text) {
+		return errors.Errorf("expect %q in filter", text)
+	}
+	p.pos++
+	return nil
+}
+
+func (p *scimFilterParser) parseOr() (scimFilter, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peekKeyword("or") {
+		p.pos++
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &logicalFilter{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *scimFilterParser) parseAnd() (scimFilter, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.peekKeyword("and") {
+		p.pos++
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &logicalFilter{and: true, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *scimFilterParser) parseUnary() (scimFilter, error) {
+	if p.peekKeyword("not") {
+		p.pos++
+		if err := p.expect("("); err != nil {
+			return nil, err
+		}
+		f, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+		return &notFilter{filter: f}, nil
+	}
+	if p.peekKeyword("(") {
+		p.pos++
+		f, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+		return f, nil
+	}
+	return p.parseAttributeExpression()
+}
+
+func (p *scimFilterParser) parseAttributeExpression() (scimFilter, error) {
+	if p.pos >= len(p.tokens) || p.tokens[p.pos].quoted {
+		return nil, errors.New("expect attribute path in filter")
+	}
+	path := parseAttributePath(p.tokens[p.pos].text)
+	p.pos++
+
+	if p.peekKeyword("[") {
+		p.pos++
+		f, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect("]"); err != nil {
+			return nil, err
+		}
+		return &valuePathFilter{path: path, filter: f}, nil
+	}
+
+	if p.pos >= len(p.tokens) || p.tokens[p.pos].quoted {
+		return nil, errors.Errorf("expect operator after %q in filter", strings.Join(path, "."))
+	}
+	operator := strings.ToLower(p.tokens[p.pos].text)
+	p.pos++
+	switch operator {
+	case "pr":
+		return &attributeFilter{path: path, operator: operator}, nil
+	case "eq", "ne", "co", "sw", "ew", "gt", "ge", "lt", "le":
+	default:
+		return nil, errors.Errorf("unsupported operator %q in filter", operator)
+	}
+
+	if p.pos >= len(p.tokens) {
+		return nil, errors.Errorf("expect value after %q in filter", operator)
+	}
+	token := p.tokens[p.pos]
+	p.pos++
+	value, err := parseFilterValue(token)
+	if err != nil {
+		return nil, err
+	}
+	return &attributeFilter{path: path, operator: operator, value: value}, nil
+}
+
+func parseFilterValue(token scimFilterToken) (any, error) {
+	if token.quoted {
+		return token.text, nil
+	}
+	switch strings.ToLower(token.text) {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "null":
+		return nil, nil
+	}
+	v, err := strconv.ParseFloat(token.text, 64)
+	if err != nil {
+		return nil, errors.Errorf("invalid value %q in filter", token.text)
+	}
+	return v, nil
+}
+
+// parseAttributePath splits the attribute path into the attribute and the
+// sub-attribute, stripping the schema URN prefix if any.
+func parseAttributePath(path string) []string {
+	if strings.HasPrefix(strings.ToLower(path), "urn:") {
+		path = path[strings.LastIndex(path, ":")+1:]
+	}
+	return strings.Split(path, ".")
+}
+
+// getAttributeValues returns the values referenced by the attribute path,
+// flattening the multi-valued attributes.
+func getAttributeValues(resource map[string]any, path []string) []any {
+	current := []any{resource}
+	for _, name := range path {
+		var next []any
+		for _, v := range current {
+			m, ok := v.(map[string]any)
+			if !ok {
+				continue
+			}
+			key, ok := lookupAttributeKey(m, name)
+			if !ok {
+				continue
+			}
+			if values, ok := m[key].([]any); ok {
+				next = append(next, values...)
+			} else {
+				next = append(next, m[key])
+			}
+		}
+		current = next
+	}
+	return current
+}
+
+// lookupAttributeKey returns the key of the attribute in the case-insensitive way.
+func lookupAttributeKey(m map[string]any, name string) (string, bool) {
+	if _, ok := m[name]; ok {
+		return name, true
+	}
+	for key := range m {
+		if strings.EqualFold(key, name) {
+			return key, true
+		}
+	}
+	return name, false
+}
+
+func isPresent(v any) bool {
+	switch v := v.(type) {
+	case nil:
+		return false
+	case string:
+		return v != ""
+	case []any:
+		return len(v) > 0
+	case map[string]any:
+		return len(v) > 0
+	default:
+		return true
+	}
+}
+
+// compareValue compares the attribute value with the filter value. The string
+// comparisons are case-insensitive, and the complex value is compared by its
+// "value" sub-attribute.
+func compareValue(operator string, attribute, value any) bool {
+	if m, ok := attribute.(map[string]any); ok {
+		key, ok := lookupAttributeKey(m, "value")
+		if !ok {
+			return false
+		}
+		attribute = m[key]
+	}
+	switch a := attribute.(type) {
+	case string:
+		b, ok := value.(string)
+		if !ok {
+			return false
+		}
+		a, b = strings.ToLower(a), strings.ToLower(b)
+		switch operator {
+		case "eq":
+			return a == b
+		case "co":
+			return strings.Contains(a, b)
+		case "sw":
+			return strings.HasPrefix(a, b)
+		case "ew":
+			return strings.HasSuffix(a, b)
+		case "gt":
+			return a > b
+		case "ge":
+			return a >= b
+		case "lt":
+			return a < b
+		case "le":
+			return a <= b
+		}
+	case float64:
+		b, ok := value.(float64)
+		if !ok {
+			return false
+		}
+		switch operator {
+		case "eq":
+			return a == b
+		case "gt":
+			return a > b
+		case "ge":
+			return a >= b
+		case "lt":
+			return a < b
+		case "le":
+			return a <= b
+		}
+	default:
+		if operator == "eq" {
+			return reflect.DeepEqual(attribute, value)
+		}
+	}
+	return false
+}
+
+// getSCIMFilterEmail returns the email that the users must have to match the filter,
+// e.g. alice@example.com for `userName eq "alice@example.com" and active eq true`,
+// so that the users can be looked up by the email instead of being listed and matched one by one.
+// The userName and the emails of the users are both the email.
+func getSCIMFilterEmail(f scimFilter) (string, bool) {
+	switch f := f.(type) {
+	case *attributeFilter:
+		return getSCIMFilterEqualValue(f, isSCIMEmailPath)
+	case *valuePathFilter:
+		if len(f.path) != 1 || !strings.EqualFold(f.path[0], "emails") {
+			return "", false
+		}
+		// e.g. `emails[type eq "work" and value eq "alice@example.com"]`.
+		return getSCIMFilterEqualValue(f.filter, func(path []string) bool {
+			return len(path) == 1 && strings.EqualFold(path[0], "value")
+		})
+	case *logicalFilter:
+		if !f.and {
+			return "", false
+		}
+		if email, ok := getSCIMFilterEmail(f.left); ok {
+			return email, true
+		}
+		return getSCIMFilterEmail(f.right)
+	default:
+		return "", false
+	}
+}
+
+// getSCIMFilterEqualValue returns the string value that the attribute must equal to match the filter.
+func getSCIMFilterEqualValue(f scimFilter, matchPath func([]string) bool) (string, bool) {
+	switch f := f.(type) {
+	case *attributeFilter:
+		if f.operator != "eq" || !matchPath(f.path) {
+			return "", false
+		}
+		value, ok := f.value.(string)
+		return value, ok
+	case *logicalFilter:
+		if !f.and {
+			return "", false
+		}
+		if value, ok := getSCIMFilterEqualValue(f.left, matchPath); ok {
+			return value, true
+		}
+		return getSCIMFilterEqualValue(f.right, matchPath)
+	default:
+		return "", false
+	}
+}
+
+// isSCIMEmailPath reports whether the attribute path refers to the email of the users, i.e. userName, emails or emails.value.
+func isSCIMEmailPath(path []string) bool {
+	switch len(path) {
+	case 1:
+		return strings.EqualFold(path[0], "userName") || strings.EqualFold(path[0], "emails")
+	case 2:
+		return strings.EqualFold(path[0], "emails") && strings.EqualFold(path[1], "value")
+	default:
+		return false
+	}
+}
@@ -0,0 +1,235 @@
+package directorysync
+
+import (
+	"net/http"
+	"reflect"
+	"strings"
+)
+
+// scimPatchPath is the parsed path of the PATCH operation, e.g. `displayName`,
+// `name.givenName` or `members[value eq "101"]`.
+// Docs: https://datatracker.ietf.org/doc/html/rfc7644#section-3.5.2
+type scimPatchPath struct {
+	attribute string
+	filter    scimFilter
+	// subAttribute is optional.
+	subAttribute string
+}
+
+func parseSCIMPatchPath(path string) (*scimPatchPath, error) {
+	attributePath, rest, hasFilter := strings.Cut(path, "[")
+	segments := parseAttributePath(strings.TrimSpace(attributePath))
+	if len(segments) > 2 || segments[0] == "" {
+		return nil, newSCIMError(http.StatusBadRequest, "invalidPath", "invalid path %q", path)
+	}
+	patchPath := &scimPatchPath{attribute: segments[0]}
+	if len(segments) == 2 {
+		patchPath.subAttribute = segments[1]
+	}
+	if !hasFilter {
+		return patchPath, nil
+	}
+
+	end := strings.LastIndex(rest, "]")
+	if end < 0 || patchPath.subAttribute != "" {
+		return nil, newSCIMError(http.StatusBadRequest, "invalidPath", "invalid path %q", path)
+	}
+	filter, err := parseSCIMFilter(rest[:end])
+	if err != nil {
+		return nil, newSCIMError(http.StatusBadRequest, "invalidFilter", "invalid filter in path %q, error %v", path, err)
+	}
+	patchPath.filter = filter
+	if suffix := rest[end+1:]; suffix != "" {
+		if !strings.HasPrefix(suffix, ".") || len(suffix) == 1 {
+			return nil, newSCIMError(http.StatusBadRequest, "invalidPath", "invalid path %q", path)
+		}
+		patchPath.subAttribute = suffix[1:]
+	}
+	return patchPath, nil
+}
+
+// applySCIMPatch applies the PATCH operations to the JSON representation of
+// the resource in order. The operation names are case-insensitive as Entra ID
+// sends "Add", "Replace" and "Remove".
+func applySCIMPatch(resource map[string]any, operations []*PatchOperation) error {
+	for _, operation := range operations {
+		op := strings.ToLower(operation.OP)
+		switch op {
+		case "add", "replace", "remove":
+		default:
+			return newSCIMError(http.StatusBadRequest, "invalidSyntax", "unsupported operation %q", operation.OP)
+		}
+
+		if operation.Path == "" {
+			if op == "remove" {
+				return newSCIMError(http.StatusBadRequest, "noTarget", "path is required for remove operation")
+			}
+			values, ok := operation.Value.(map[string]any)
+			if !ok {
+				return newSCIMError(http.StatusBadRequest, "invalidValue", "value must be an object if path is not specified")
+			}
+			for name, value := range values {
+				if err := applySCIMPatchOperation(resource, op, &scimPatchPath{attribute: name}, value); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		path, err := parseSCIMPatchPath(operation.Path)
+		if err != nil {
+			return err
+		}
+		if err := applySCIMPatchOperation(resource, op, path, operation.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func applySCIMPatchOperation(resource map[string]any, op string, path *scimPatchPath, value any) error {
+	// The name of the path-less value may carry the schema URN prefix as well.
+	if segments := parseAttributePath(path.attribute); len(segments) == 2 && path.filter == nil && path.subAttribute == "" {
+		path = &scimPatchPath{attribute: segments[0], subAttribute: segments[1]}
+	}
+	key, _ := lookupAttributeKey(resource, path.attribute)
+
+	if path.filter != nil {
+		elements, _ := resource[key].([]any)
+		var result []any
+		matched := false
+		for _, element := range elements {
+			m, ok := element.(map[string]any)
+			if !ok || !path.filter.match(m) {
+				result = append(result, element)
+				continue
+			}
+			matched = true
+			switch {
+			case op == "remove" && path.subAttribute == "":
+				// Drop the element.
+			case op == "remove":
+				subKey, _ := lookupAttributeKey(m, path.subAttribute)
+				delete(m, subKey)
+				result = append(result, m)
+			case path.subAttribute == "":
+				if v, ok := value.(map[string]any); ok {
+					mergeAttributes(m, v)
+					result = append(result, m)
+				} else {
+					result = append(result, value)
+				}
+			default:
+				subKey, _ := lookupAttributeKey(m, path.subAttribute)
+				m[subKey] = value
+				result = append(result, m)
+			}
+		}
+		if !matched {
+			// Removing the absent values is a no-op so that the deprovisioning is idempotent.
+			if op == "remove" {
+				return nil
+			}
+			return newSCIMError(http.StatusBadRequest, "noTarget", "no value matches the filter of %q", path.attribute)
+		}
+		resource[key] = result
+		return nil
+	}
+
+	if path.subAttribute != "" {
+		complexValue, ok := resource[key].(map[string]any)
+		if !ok {
+			if op == "remove" {
+				return nil
+			}
+			complexValue = map[string]any{}
+			resource[key] = complexValue
+		}
+		subKey, _ := lookupAttributeKey(complexValue, path.subAttribute)
+		if op == "remove" {
+			delete(complexValue, subKey)
+		} else {
+			complexValue[subKey] = value
+		}
+		return nil
+	}
+
+	switch op {
+	case "remove":
+		// Entra ID removes the group members with the values instead of the filter.
+		if elements, ok := resource[key].([]any); ok && value != nil {
+			var result []any
+			for _, element := range elements {
+				if !containsSCIMValue(toSCIMValues(value), element) {
+					result = append(result, element)
+				}
+			}
+			resource[key] = result
+			return nil
+		}
+		delete(resource, key)
+	case "add":
+		switch existing := resource[key].(type) {
+		case []any:
+			for _, v := range toSCIMValues(value) {
+				if !containsSCIMValue(existing, v) {
+					existing = append(existing, v)
+				}
+			}
+			resource[key] = existing
+		case map[string]any:
+			if v, ok := value.(map[string]any); ok {
+				mergeAttributes(existing, v)
+			} else {
+				resource[key] = value
+			}
+		default:
+			resource[key] = value
+		}
+	case "replace":
+		if existing, ok := resource[key].(map[string]any); ok {
+			if v, ok := value.(map[string]any); ok {
+				mergeAttributes(existing, v)
+				return nil
+			}
+		}
+		resource[key] = value
+	}
+	return nil
+}
+
+// mergeAttributes sets the sub-attributes in src to dst, leaving the other
+// sub-attributes unchanged.
+func mergeAttributes(dst, src map[string]any) {
+	for name, value := range src {
+		key, _ := lookupAttributeKey(dst, name)
+		dst[key] = value
+	}
+}
+
+func toSCIMValues(value any) []any {
+	if values, ok := value.([]any); ok {
+		return values
+	}
+	return []any{value}
+}
+
+// containsSCIMValue reports whether the value is in the values. The complex
+// values are identified by the "value" sub-attribute.
+func containsSCIMValue(values []any, value any) bool {
+	for _, v := range values {
+		if reflect.DeepEqual(scimValueIdentity(v), scimValueIdentity(value)) {
+			return true
+		}
+	}
+	return false
+}
+
+func scimValueIdentity(value any) any {
+	if m, ok := value.(map[string]any); ok {
+		if key, ok := lookupAttributeKey(m, "value"); ok {
+			return m[key]
+		}
+	}
+	return value
+}
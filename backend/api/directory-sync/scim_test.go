@@ -0,0 +1,218 @@
+package directorysync
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSCIMUser = `{
+	"schemas": ["urn:ietf:params:scim:schemas:core:2.0:User"],
+	"id": "101",
+	"userName": "alice@example.com",
+	"name": {"formatted": "Alice Smith", "givenName": "Alice", "familyName": "Smith"},
+	"displayName": "Alice",
+	"active": true,
+	"emails": [
+		{"value": "alice@example.com", "type": "work", "primary": true},
+		{"value": "alice@home.example.com", "type": "home"}
+	],
+	"meta": {"resourceType": "User", "created": "2024-01-01T00:00:00Z"}
+}`
+
+func newTestSCIMResource(t *testing.T, resource string) map[string]any {
+	var m map[string]any
+	require.NoError(t, json.Unmarshal([]byte(resource), &m))
+	return m
+}
+
+func TestSCIMFilter(t *testing.T) {
+	tests := []struct {
+		filter string
+		want   bool
+	}{
+		{`userName eq "Alice@Example.com"`, true},
+		{`USERNAME Eq "alice@example.com"`, true},
+		{`urn:ietf:params:scim:schemas:core:2.0:User:userName eq "alice@example.com"`, true},
+		{`userName ne "alice@example.com"`, false},
+		{`userName sw "alice"`, true},
+		{`userName ew "@example.com"`, true},
+		{`userName co "bob"`, false},
+		{`name.familyName eq "Smith"`, true},
+		{`title pr`, false},
+		{`displayName pr and active eq true`, true},
+		{`active eq false or userName sw "bob"`, false},
+		{`not (userName sw "bob")`, true},
+		{`(userName sw "bob" or displayName eq "alice") and active eq true`, true},
+		{`emails eq "alice@home.example.com"`, true},
+		{`emails[type eq "work" and value co "home"]`, false},
+		{`emails[type eq "home" and value co "home"]`, true},
+		{`emails.type eq "home"`, true},
+		{`meta.created gt "2023-12-31T00:00:00Z"`, true},
+		{`meta.created lt "2023-12-31T00:00:00Z"`, false},
+		{`title eq null`, true},
+	}
+	resource := newTestSCIMResource(t, testSCIMUser)
+	for _, test := range tests {
+		filter, err := parseSCIMFilter(test.filter)
+		require.NoError(t, err, test.filter)
+		assert.Equal(t, test.want, filter.match(resource), test.filter)
+	}
+
+	for _, filter := range []string{
+		`userName`,
+		`userName eq`,
+		`userName like "alice"`,
+		`userName eq "alice`,
+		`(userName eq "alice"`,
+		`emails[type eq "work"`,
+		`userName eq "alice" displayName eq "alice"`,
+	} {
+		_, err := parseSCIMFilter(filter)
+		assert.Error(t, err, filter)
+	}
+}
+
+func TestGetSCIMFilterEmail(t *testing.T) {
+	tests := []struct {
+		filter string
+		want   string
+	}{
+		{`userName eq "alice@example.com"`, "alice@example.com"},
+		{`emails.value eq "alice@example.com"`, "alice@example.com"},
+		{`emails eq "alice@example.com"`, "alice@example.com"},
+		{`emails[type eq "work" and value eq "alice@example.com"]`, "alice@example.com"},
+		{`active eq true and userName eq "alice@example.com"`, "alice@example.com"},
+		{`userName eq "alice@example.com" or userName eq "bob@example.com"`, ""},
+		{`not (userName eq "alice@example.com")`, ""},
+		{`userName sw "alice"`, ""},
+		{`emails.type eq "work"`, ""},
+		{`displayName eq "alice@example.com"`, ""},
+	}
+	for _, test := range tests {
+		filter, err := parseSCIMFilter(test.filter)
+		require.NoError(t, err, test.filter)
+		email, ok := getSCIMFilterEmail(filter)
+		assert.Equal(t, test.want != "", ok, test.filter)
+		assert.Equal(t, test.want, email, test.filter)
+	}
+}
+
+func TestApplySCIMPatch(t *testing.T) {
+	tests := []struct {
+		name       string
+		resource   string
+		operations string
+		want       string
+		wantErr    string
+	}{
+		{
+			name:       "replace without path",
+			resource:   `{"displayName": "Alice", "active": true, "name": {"givenName": "Alice", "familyName": "Smith"}}`,
+			operations: `[{"op": "Replace", "value": {"active": "False", "name": {"familyName": "Jones"}}}]`,
+			want:       `{"displayName": "Alice", "active": "False", "name": {"givenName": "Alice", "familyName": "Jones"}}`,
+		},
+		{
+			name:       "replace sub-attribute",
+			resource:   `{"name": {"givenName": "Alice"}}`,
+			operations: `[{"op": "replace", "path": "name.familyName", "value": "Smith"}, {"op": "add", "path": "nickName", "value": "Al"}]`,
+			want:       `{"name": {"givenName": "Alice", "familyName": "Smith"}, "nickName": "Al"}`,
+		},
+		{
+			name:       "add members",
+			resource:   `{"members": [{"value": "101"}]}`,
+			operations: `[{"op": "add", "path": "members", "value": [{"value": "101"}, {"value": "102"}]}]`,
+			want:       `{"members": [{"value": "101"}, {"value": "102"}]}`,
+		},
+		{
+			name:       "remove members with filter",
+			resource:   `{"members": [{"value": "101"}, {"value": "102"}, {"value": "103"}]}`,
+			operations: `[{"op": "remove", "path": "members[value eq \"102\" or value eq \"103\"]"}, {"op": "remove", "path": "members[value eq \"104\"]"}]`,
+			want:       `{"members": [{"value": "101"}]}`,
+		},
+		{
+			name:       "remove members with value",
+			resource:   `{"members": [{"value": "101"}, {"value": "102"}]}`,
+			operations: `[{"op": "Remove", "path": "members", "value": [{"value": "101"}]}]`,
+			want:       `{"members": [{"value": "102"}]}`,
+		},
+		{
+			name:       "replace all members",
+			resource:   `{"members": [{"value": "101"}]}`,
+			operations: `[{"op": "replace", "path": "members", "value": [{"value": "102"}]}]`,
+			want:       `{"members": [{"value": "102"}]}`,
+		},
+		{
+			name:       "replace with filter and sub-attribute",
+			resource:   `{"emails": [{"type": "work", "value": "a@example.com"}, {"type": "home", "value": "b@example.com"}]}`,
+			operations: `[{"op": "replace", "path": "emails[type eq \"work\"].value", "value": "c@example.com"}]`,
+			want:       `{"emails": [{"type": "work", "value": "c@example.com"}, {"type": "home", "value": "b@example.com"}]}`,
+		},
+		{
+			name:       "replace with unmatched filter",
+			resource:   `{"emails": [{"type": "work", "value": "a@example.com"}]}`,
+			operations: `[{"op": "replace", "path": "emails[type eq \"home\"].value", "value": "c@example.com"}]`,
+			wantErr:    "no value matches",
+		},
+		{
+			name:       "remove without path",
+			resource:   `{"displayName": "Alice"}`,
+			operations: `[{"op": "remove"}]`,
+			wantErr:    "path is required",
+		},
+		{
+			name:       "unsupported operation",
+			resource:   `{"displayName": "Alice"}`,
+			operations: `[{"op": "move", "path": "displayName"}]`,
+			wantErr:    "unsupported operation",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resource := newTestSCIMResource(t, test.resource)
+			var operations []*PatchOperation
+			require.NoError(t, json.Unmarshal([]byte(test.operations), &operations))
+			err := applySCIMPatch(resource, operations)
+			if test.wantErr != "" {
+				assert.ErrorContains(t, err, test.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, newTestSCIMResource(t, test.want), resource)
+		})
+	}
+}
+
+func TestDecodeSCIMUser(t *testing.T) {
+	scimUser, err := decodeSCIMUser(newTestSCIMResource(t, `{
+		"userName": "alice",
+		"name": {"givenName": "Alice", "familyName": "Smith"},
+		"active": "False",
+		"emails": [{"value": "alice@home.example.com"}, {"value": "Alice@Example.com", "primary": true}],
+		"phoneNumbers": [{"value": "+14155552671", "primary": true}]
+	}`))
+	require.NoError(t, err)
+	email, err := scimUser.getEmail()
+	require.NoError(t, err)
+	assert.Equal(t, "alice@example.com", email)
+	assert.Equal(t, "Alice Smith", scimUser.getName(email))
+	assert.False(t, scimUser.isActive())
+	assert.Equal(t, "+14155552671", scimUser.getPhone())
+
+	scimUser, err = decodeSCIMUser(newTestSCIMResource(t, `{"userName": "alice"}`))
+	require.NoError(t, err)
+	assert.True(t, scimUser.isActive())
+	_, err = scimUser.getEmail()
+	assert.ErrorContains(t, err, "must contain a valid email")
+}
+
+func TestMatchETag(t *testing.T) {
+	assert.True(t, matchETag(`W/"abc"`, `W/"abc"`))
+	assert.True(t, matchETag(`"abc"`, `W/"abc"`))
+	assert.True(t, matchETag(`W/"xyz", W/"abc"`, `W/"abc"`))
+	assert.True(t, matchETag(`*`, `W/"abc"`))
+	assert.False(t, matchETag(`W/"xyz"`, `W/"abc"`))
+	assert.False(t, matchETag(``, `W/"abc"`))
+}
@@ -84,6 +84,7 @@ func (s *Service) RegisterDirectorySyncRoutes(g *echo.Group) {
 					Source:                 entraIDSource,
 					LastLoginTime:          user.Profile.LastLoginTime,
 					LastChangePasswordTime: user.Profile.LastChangePasswordTime,
+					Attributes:             user.Profile.GetAttributes(),
 				},
 			}, api.SystemBotID)
 			if err != nil {
@@ -68,6 +68,7 @@ func getAzurePullRequestInfo(ctx context.Context, vcsProvider *store.VCSProvider
 		url:         pushEvent.Resource.Links.Web.Href,
 		title:       pushEvent.Resource.Title,
 		description: pushEvent.Resource.Description,
+		commitID:    pushEvent.Resource.LastMergeCommit.CommitID,
 		changes:     getChangesByFileList(mrFiles, vcsConnector.Payload.BaseDirectory),
 	}
 
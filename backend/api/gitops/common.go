@@ -5,9 +5,9 @@ import (
 	"fmt"
 	"log/slog"
 	"path/filepath"
-	"regexp"
 	"strings"
 
+	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/plugin/vcs"
 	"github.com/bytebase/bytebase/backend/utils"
@@ -15,10 +15,6 @@ import (
 	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
 )
 
-var (
-	versionRE = regexp.MustCompile(`^[0-9]+`)
-)
-
 type webhookAction int
 
 const (
@@ -33,7 +29,9 @@ type pullRequestInfo struct {
 	title       string
 	description string
 	url         string
-	changes     []*fileChange
+	// commitID is the head commit of the pull request, on which the SQL review status is reported.
+	commitID string
+	changes  []*fileChange
 
 	// all files directly (non-recursive) under the base directory.
 	getAllFiles func(context.Context) ([]*fileChange, error)
@@ -77,11 +75,10 @@ func convertVcsFile(f *vcs.File) (*fileChange, error) {
 		return nil, nil
 	}
 
-	matches := versionRE.FindAllString(f.Name, -1)
-	if len(matches) == 0 {
+	version := common.GetReleaseFileVersion(f.Name)
+	if version == "" {
 		return nil, nil
 	}
-	version := matches[0]
 	description := strings.TrimPrefix(f.Name, version)
 	description = strings.TrimLeft(description, "_")
 	changeType := v1pb.Plan_ChangeDatabaseConfig_MIGRATE
@@ -113,11 +110,10 @@ func getFileChange(file *vcs.PullRequestFile) (*fileChange, error) {
 		return nil, nil
 	}
 
-	matches := versionRE.FindAllString(filename, -1)
-	if len(matches) == 0 {
+	version := common.GetReleaseFileVersion(filename)
+	if version == "" {
 		return nil, nil
 	}
-	version := matches[0]
 	description := strings.TrimPrefix(filename, version)
 	description = strings.TrimLeft(description, "_")
 	changeType := v1pb.Plan_ChangeDatabaseConfig_MIGRATE
@@ -151,6 +147,9 @@ func getPullRequestID(url string) string {
 }
 
 const (
+	// sqlReviewCheckName is the name of the SQL review check on the pull request commit.
+	sqlReviewCheckName = "Bytebase SQL Review"
+
 	commentPrefixBytebaseBot     = "**[Bytebase Bot]**"
 	commentPrefixSQLReview       = "**[Bytebase SQL Review]**"
 	commentPrefixSQLReviewPassed = "SQL Review Check Passed"
@@ -177,6 +176,8 @@ func getFileWebURLInPR(webURL string, line int32, vcsType storepb.VCSType) strin
 		return fmt.Sprintf("%s_0_%d", webURL, line)
 	case storepb.VCSType_BITBUCKET:
 		return fmt.Sprintf("%sT%d", webURL, line)
+	case storepb.VCSType_GITEA:
+		return fmt.Sprintf("%s#L%d", webURL, line)
 	default:
 		return webURL
 	}
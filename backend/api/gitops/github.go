@@ -53,6 +53,7 @@ func getGitHubPullRequestInfo(ctx context.Context, vcsProvider *store.VCSProvide
 		url:         pushEvent.PullRequest.HTMLURL,
 		title:       pushEvent.PullRequest.Title,
 		description: pushEvent.PullRequest.Body,
+		commitID:    pushEvent.PullRequest.Head.SHA,
 		changes:     getChangesByFileList(mrFiles, vcsConnector.Payload.BaseDirectory),
 	}
 
@@ -51,6 +51,7 @@ func getGitLabPullRequestInfo(ctx context.Context, vcsProvider *store.VCSProvide
 		url:         pushEvent.ObjectAttributes.URL,
 		title:       pushEvent.ObjectAttributes.Title,
 		description: pushEvent.ObjectAttributes.Description,
+		commitID:    pushEvent.ObjectAttributes.LastCommit.ID,
 		changes:     getChangesByFileList(mrFiles, vcsConnector.Payload.BaseDirectory),
 	}
 
@@ -116,6 +116,24 @@ func (s *Service) RegisterWebhookRoutes(g *echo.Group) {
 			if err != nil {
 				return c.String(http.StatusOK, fmt.Sprintf("failed to get pr info from pull request, error %v", err))
 			}
+		case storepb.VCSType_GITEA:
+			signature := getGiteaHeader(c.Request().Header, "Signature")
+			// Gitea signs the body in the same way as GitHub, but without the "sha256=" prefix.
+			ok, err := validateGitHubWebhookSignature256(signature, vcsConnector.Payload.WebhookSecretToken, body)
+			if err != nil {
+				return c.String(http.StatusOK, fmt.Sprintf("failed to validate webhook signature %q, error %v", signature, err))
+			}
+			if !ok {
+				return c.String(http.StatusOK, fmt.Sprintf("invalid webhook signature %q", signature))
+			}
+			if eventType := getGiteaHeader(c.Request().Header, "Event"); eventType != "pull_request" {
+				return c.String(http.StatusOK, fmt.Sprintf(`skip webhook event "%v"`, eventType))
+			}
+
+			prInfo, err = getGiteaPullRequestInfo(ctx, vcsProvider, vcsConnector, body, s.profile)
+			if err != nil {
+				return c.String(http.StatusOK, fmt.Sprintf("failed to get pr info from pull request, error %v", err))
+			}
 		case storepb.VCSType_GITLAB:
 			secretToken := c.Request().Header.Get("X-Gitlab-Token")
 			if secretToken != vcsConnector.Payload.WebhookSecretToken {
@@ -157,6 +175,14 @@ func (s *Service) RegisterWebhookRoutes(g *echo.Group) {
 			return nil
 		}
 		if len(prInfo.changes) == 0 {
+			// Report the check as passed so that it doesn't block the pull requests requiring the check.
+			if prInfo.action == webhookActionSQLReview {
+				reportSQLReviewStatus(ctx, vcsProvider, vcsConnector, prInfo, &vcs.CommitStatus{
+					State:       vcs.CommitStatusSuccess,
+					Description: "No SQL changes",
+					TargetURL:   fmt.Sprintf("%s/sql-review", setting.ExternalUrl),
+				})
+			}
 			return c.String(http.StatusOK, fmt.Sprintf("no relevant file change directly under the base directory %q for pull request %q", vcsConnector.Payload.BaseDirectory, prInfo.url))
 		}
 
@@ -197,12 +223,26 @@ func (s *Service) RegisterWebhookRoutes(g *echo.Group) {
 			commentPrefix = commentPrefixBytebaseBot
 			createCommentIfNotExist = true
 		case webhookActionSQLReview:
-			comment, err = s.sqlReviewWithPRInfo(childCtx, project, vcsConnector, vcsProvider.Type, prInfo)
+			sqlReviewURL := fmt.Sprintf("%s/sql-review", setting.ExternalUrl)
+			reportSQLReviewStatus(ctx, vcsProvider, vcsConnector, prInfo, &vcs.CommitStatus{
+				State:       vcs.CommitStatusPending,
+				Description: "SQL review is running",
+				TargetURL:   sqlReviewURL,
+			})
+			result, err := s.sqlReviewWithPRInfo(childCtx, project, vcsConnector, vcsProvider.Type, prInfo)
 			if err != nil {
+				reportSQLReviewStatus(ctx, vcsProvider, vcsConnector, prInfo, &vcs.CommitStatus{
+					State:       vcs.CommitStatusFailure,
+					Description: "Failed to run SQL review",
+					Summary:     err.Error(),
+					TargetURL:   sqlReviewURL,
+				})
 				return c.String(http.StatusOK, fmt.Sprintf("failed to exec sql review for pull request %s, error %v", prInfo.url, err))
 			}
+			reportSQLReviewStatus(ctx, vcsProvider, vcsConnector, prInfo, result.getCommitStatus(sqlReviewURL))
+			comment = result.comment
 			if comment != "" {
-				comment = fmt.Sprintf("%s\n\n---\n\nClick [here](%s) to check the SQL review config", comment, fmt.Sprintf("%s/sql-review", setting.ExternalUrl))
+				comment = fmt.Sprintf("%s\n\n---\n\nClick [here](%s) to check the SQL review config", comment, sqlReviewURL)
 			}
 			commentPrefix = commentPrefixSQLReview
 			// We don't have the "Enable SQL review" option for VCS connection.
@@ -280,16 +320,44 @@ func validateGitHubWebhookSignature256(signature, key string, body []byte) (bool
 	return subtle.ConstantTimeCompare([]byte(signature), []byte(got)) == 1, nil
 }
 
-func (s *Service) sqlReviewWithPRInfo(ctx context.Context, project *store.ProjectMessage, vcsConnector *store.VCSConnectorMessage, vcsType storepb.VCSType, prInfo *pullRequestInfo) (string, error) {
+// sqlReviewResult is the SQL review result of the pull request.
+type sqlReviewResult struct {
+	// comment is the markdown comment of the advices.
+	comment     string
+	errorCount  int
+	warnCount   int
+	annotations []*vcs.CommitStatusAnnotation
+}
+
+// getCommitStatus returns the commit status of the SQL review result.
+// The check fails if there is any error, and passes with the warnings.
+func (r *sqlReviewResult) getCommitStatus(targetURL string) *vcs.CommitStatus {
+	status := &vcs.CommitStatus{
+		State:       vcs.CommitStatusSuccess,
+		Description: fmt.Sprintf("%d errors, %d warnings", r.errorCount, r.warnCount),
+		Summary:     r.comment,
+		TargetURL:   targetURL,
+		Annotations: r.annotations,
+	}
+	if r.errorCount > 0 {
+		status.State = vcs.CommitStatusFailure
+	} else if r.warnCount == 0 {
+		status.Description = commentPrefixSQLReviewPassed
+	}
+	return status
+}
+
+func (s *Service) sqlReviewWithPRInfo(ctx context.Context, project *store.ProjectMessage, vcsConnector *store.VCSConnectorMessage, vcsType storepb.VCSType, prInfo *pullRequestInfo) (*sqlReviewResult, error) {
 	instance, database, err := s.getDatabaseSample(ctx, project, vcsConnector)
 	if err != nil {
-		return "", errors.Wrapf(err, "failed to get database sample")
+		return nil, errors.Wrapf(err, "failed to get database sample")
 	}
 
 	content := []string{}
 	errorCount := 0
 	warnCount := 0
 	maximumCount := 30
+	var annotations []*vcs.CommitStatusAnnotation
 
 	for i, change := range prInfo.changes {
 		changeType := storepb.PlanCheckRunConfig_DDL
@@ -330,6 +398,7 @@ func (s *Service) sqlReviewWithPRInfo(ctx context.Context, project *store.Projec
 			}
 			message := fmt.Sprintf("- **[%s]** %s ([line%d](%s))", advice.Status.String(), advice.Title, advice.Line, getFileWebURLInPR(change.webURL, advice.Line, vcsType))
 			adviceMessage = append(adviceMessage, message)
+			annotations = append(annotations, getSQLReviewAnnotation(change.path, advice))
 		}
 
 		if len(adviceMessage) > 0 {
@@ -350,10 +419,54 @@ func (s *Service) sqlReviewWithPRInfo(ctx context.Context, project *store.Projec
 	}
 
 	if len(content) == 0 {
-		return commentPrefixSQLReviewPassed, nil
+		return &sqlReviewResult{comment: commentPrefixSQLReviewPassed}, nil
+	}
+
+	return &sqlReviewResult{
+		comment:     fmt.Sprintf("\n%d errors, %d warnings\n\n---\n\n%s", errorCount, warnCount, strings.Join(content, "\n")),
+		errorCount:  errorCount,
+		warnCount:   warnCount,
+		annotations: annotations,
+	}, nil
+}
+
+// getSQLReviewAnnotation returns the annotation of the advice on the file lines.
+func getSQLReviewAnnotation(path string, advice *v1pb.Advice) *vcs.CommitStatusAnnotation {
+	startLine := int(advice.Line)
+	if p := advice.GetStartPosition(); p.GetLine() > 0 {
+		startLine = int(p.GetLine())
+	}
+	startLine = max(startLine, 1)
+	endLine := max(int(advice.GetEndPosition().GetLine()), startLine)
+
+	level := vcs.AnnotationLevelWarning
+	if advice.Status == v1pb.Advice_ERROR {
+		level = vcs.AnnotationLevelFailure
+	}
+	message := advice.Content
+	if message == "" {
+		message = advice.Title
 	}
+	return &vcs.CommitStatusAnnotation{
+		Path:      strings.TrimPrefix(path, "/"),
+		StartLine: startLine,
+		EndLine:   endLine,
+		Level:     level,
+		Title:     advice.Title,
+		Message:   message,
+	}
+}
 
-	return fmt.Sprintf("\n%d errors, %d warnings\n\n---\n\n%s", errorCount, warnCount, strings.Join(content, "\n")), nil
+// reportSQLReviewStatus reports the SQL review status on the head commit of the pull request.
+// The failure is only logged because the review result is also posted as the pull request comment.
+func reportSQLReviewStatus(ctx context.Context, vcsProvider *store.VCSProviderMessage, vcsConnector *store.VCSConnectorMessage, prInfo *pullRequestInfo, status *vcs.CommitStatus) {
+	status.Name = sqlReviewCheckName
+	if err := vcs.Get(
+		vcsProvider.Type,
+		vcs.ProviderConfig{InstanceURL: vcsProvider.InstanceURL, AuthToken: vcsProvider.AccessToken},
+	).CreateCommitStatus(ctx, vcsConnector.Payload.ExternalId, getPullRequestID(prInfo.url), prInfo.commitID, status); err != nil {
+		slog.Error("failed to report SQL review status", slog.String("pr", prInfo.url), slog.String("state", string(status.State)), log.BBError(err))
+	}
 }
 
 func (s *Service) createReleaseFromPRInfo(ctx context.Context, project *store.ProjectMessage, vcsProvider *store.VCSProviderMessage, prInfo *pullRequestInfo) (*v1pb.Release, error) {
@@ -1,9 +1,13 @@
 package gitops
 
 import (
+	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/bytebase/bytebase/backend/plugin/vcs"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
 )
 
 func TestValidateGitHubWebhookSignature256(t *testing.T) {
@@ -39,4 +43,68 @@ func TestValidateGitHubWebhookSignature256(t *testing.T) {
 		assert.True(t, got)
 		assert.NoError(t, err)
 	})
+
+	t.Run("success without prefix", func(t *testing.T) {
+		got, err := validateGitHubWebhookSignature256(
+			"6bf313c917fd04a3c6c85270bab6c2a6ae40b7ab37767107bf80ad5c6a0a0deb",
+			"bZovosSKsJ8QKCG9",
+			[]byte(payload),
+		)
+		assert.True(t, got)
+		assert.NoError(t, err)
+	})
+}
+
+func TestGetGiteaHeader(t *testing.T) {
+	header := http.Header{}
+	header.Set("X-Forgejo-Event", "pull_request")
+	assert.Equal(t, "pull_request", getGiteaHeader(header, "Event"))
+	header.Set("X-Gitea-Event", "push")
+	assert.Equal(t, "push", getGiteaHeader(header, "Event"))
+	assert.Equal(t, "", getGiteaHeader(header, "Signature"))
+}
+
+func TestGetSQLReviewAnnotation(t *testing.T) {
+	a := assert.New(t)
+	a.Equal(&vcs.CommitStatusAnnotation{
+		Path:      "migrations/1_ddl.sql",
+		StartLine: 3,
+		EndLine:   5,
+		Level:     vcs.AnnotationLevelFailure,
+		Title:     "column.no-null",
+		Message:   "Column `id` is nullable",
+	}, getSQLReviewAnnotation("/migrations/1_ddl.sql", &v1pb.Advice{
+		Status:        v1pb.Advice_ERROR,
+		Title:         "column.no-null",
+		Content:       "Column `id` is nullable",
+		Line:          2,
+		StartPosition: &v1pb.Position{Line: 3},
+		EndPosition:   &v1pb.Position{Line: 5},
+	}))
+	a.Equal(&vcs.CommitStatusAnnotation{
+		Path:      "migrations/1_ddl.sql",
+		StartLine: 1,
+		EndLine:   1,
+		Level:     vcs.AnnotationLevelWarning,
+		Title:     "statement.where.require",
+		Message:   "statement.where.require",
+	}, getSQLReviewAnnotation("migrations/1_ddl.sql", &v1pb.Advice{
+		Status: v1pb.Advice_WARNING,
+		Title:  "statement.where.require",
+	}))
+}
+
+func TestGetSQLReviewCommitStatus(t *testing.T) {
+	a := assert.New(t)
+	status := (&sqlReviewResult{comment: commentPrefixSQLReviewPassed}).getCommitStatus("https://bytebase.example.com/sql-review")
+	a.Equal(vcs.CommitStatusSuccess, status.State)
+	a.Equal(commentPrefixSQLReviewPassed, status.Description)
+
+	status = (&sqlReviewResult{warnCount: 2}).getCommitStatus("")
+	a.Equal(vcs.CommitStatusSuccess, status.State)
+	a.Equal("0 errors, 2 warnings", status.Description)
+
+	status = (&sqlReviewResult{errorCount: 1, warnCount: 2}).getCommitStatus("")
+	a.Equal(vcs.CommitStatusFailure, status.State)
+	a.Equal("1 errors, 2 warnings", status.Description)
 }
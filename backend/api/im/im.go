@@ -0,0 +1,223 @@
+// Package im is the package for the interactive requests from the IM apps.
+package im
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	apiv1 "github.com/bytebase/bytebase/backend/api/v1"
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/plugin/webhook"
+	"github.com/bytebase/bytebase/backend/plugin/webhook/slack"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+const (
+	// teamsActionStatusHeader is the header of the message shown to the user taking the Teams action.
+	teamsActionStatusHeader = "CARD-ACTION-STATUS"
+	// maxRequestBodySize is the maximum size of the interactive requests.
+	maxRequestBodySize = 1 << 20
+)
+
+// Service is the API endpoint for handling the interactive requests from the IM apps.
+type Service struct {
+	store        *store.Store
+	issueService *apiv1.IssueService
+}
+
+// NewService creates an IM service.
+func NewService(store *store.Store, issueService *apiv1.IssueService) *Service {
+	return &Service{
+		store:        store,
+		issueService: issueService,
+	}
+}
+
+// RegisterIMRoutes registers the routes of the interactive requests.
+func (s *Service) RegisterIMRoutes(g *echo.Group) {
+	g.POST("/slack", s.handleSlackInteraction)
+	// The path must be consistent with webhook.TeamsActionPath.
+	g.POST("/teams", s.handleTeamsAction)
+}
+
+func (s *Service) handleSlackInteraction(c echo.Context) error {
+	ctx := c.Request().Context()
+	body, err := io.ReadAll(io.LimitReader(c.Request().Body, maxRequestBodySize))
+	if err != nil {
+		return c.String(http.StatusBadRequest, "failed to read body")
+	}
+	imSetting, err := s.store.GetAppIMSetting(ctx)
+	if err != nil {
+		return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to get app im setting, error %v", err))
+	}
+	signingSecret := imSetting.GetSlack().GetSigningSecret()
+	if !imSetting.GetSlack().GetEnabled() || signingSecret == "" {
+		return c.String(http.StatusNotFound, "Slack interactivity is not enabled")
+	}
+	if err := slack.VerifyRequest(signingSecret, c.Request().Header, body, time.Now()); err != nil {
+		return c.String(http.StatusUnauthorized, err.Error())
+	}
+	payload, err := slack.ParseInteractionPayload(body)
+	if err != nil {
+		return c.String(http.StatusBadRequest, err.Error())
+	}
+	if payload.Type != "block_actions" || len(payload.Actions) != 1 {
+		return c.NoContent(http.StatusOK)
+	}
+	action := payload.Actions[0]
+	if action.ActionID != webhook.ActionApproveIssue && action.ActionID != webhook.ActionRejectIssue {
+		return c.NoContent(http.StatusOK)
+	}
+
+	// Slack expects the response in 3 seconds, so take the action in the background and reply with the response URL.
+	go func() {
+		ctx := context.WithoutCancel(ctx)
+		text := func() string {
+			email, err := slack.GetUserEmail(ctx, imSetting.GetSlack().GetToken(), payload.User.ID)
+			if err != nil {
+				return fmt.Sprintf("Failed to get your Slack profile: %v", err)
+			}
+			return s.takeIssueAction(ctx, email, action.Value, action.ActionID, "Slack")
+		}()
+		if err := slack.Respond(ctx, payload.ResponseURL, text); err != nil {
+			slog.Warn("failed to respond to Slack interaction", log.BBError(err))
+		}
+	}()
+	return c.NoContent(http.StatusOK)
+}
+
+func (s *Service) handleTeamsAction(c echo.Context) error {
+	ctx := c.Request().Context()
+	body, err := io.ReadAll(io.LimitReader(c.Request().Body, maxRequestBodySize))
+	if err != nil {
+		return c.String(http.StatusBadRequest, "failed to read body")
+	}
+	imSetting, err := s.store.GetAppIMSetting(ctx)
+	if err != nil {
+		return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to get app im setting, error %v", err))
+	}
+	if !imSetting.GetTeams().GetEnabled() {
+		return c.String(http.StatusNotFound, "Teams interactivity is not enabled")
+	}
+	setting, err := s.store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to get workspace setting, error %v", err))
+	}
+	audience, err := getBaseURL(setting.ExternalUrl)
+	if err != nil {
+		return c.String(http.StatusInternalServerError, err.Error())
+	}
+	token, ok := strings.CutPrefix(c.Request().Header.Get(echo.HeaderAuthorization), "Bearer ")
+	if !ok {
+		return c.String(http.StatusUnauthorized, "bearer token is required")
+	}
+	email, err := webhook.VerifyTeamsActionToken(ctx, token, audience, imSetting.GetTeams().GetSender())
+	if err != nil {
+		return c.String(http.StatusUnauthorized, err.Error())
+	}
+	var actionBody webhook.TeamsActionBody
+	if err := json.Unmarshal(body, &actionBody); err != nil {
+		return c.String(http.StatusBadRequest, "invalid action body")
+	}
+
+	c.Response().Header().Set(teamsActionStatusHeader, s.takeIssueAction(ctx, email, actionBody.Issue, actionBody.Action, "Teams"))
+	return c.NoContent(http.StatusOK)
+}
+
+// takeIssueAction approves or rejects the issue as the Bytebase user with the email,
+// and returns the message shown to the user.
+// It goes through the same approval flow and permission checks as the approval in the web UI.
+func (s *Service) takeIssueAction(ctx context.Context, email string, issueName string, action string, app string) string {
+	user, err := s.store.GetUserByEmail(ctx, email)
+	if err != nil {
+		return fmt.Sprintf("Failed to find the Bytebase user: %v", err)
+	}
+	if user == nil || user.MemberDeleted {
+		return fmt.Sprintf("No active Bytebase user with email %q.", email)
+	}
+	ctx = context.WithValue(ctx, common.PrincipalIDContextKey, user.ID)
+	ctx = context.WithValue(ctx, common.UserContextKey, user)
+
+	comment := fmt.Sprintf("Via %s.", app)
+	switch action {
+	case webhook.ActionApproveIssue:
+		request := &v1pb.ApproveIssueRequest{Name: issueName, Comment: comment}
+		issue, err := s.issueService.ApproveIssue(ctx, request)
+		s.createIssueActionAuditLog(ctx, store.AuditLogMethodIMIssueApprove, user, issueName, request, err)
+		if err != nil {
+			return fmt.Sprintf("Failed to approve the issue: %s", getErrorMessage(err))
+		}
+		return fmt.Sprintf("Approved issue %q.", issue.Title)
+	case webhook.ActionRejectIssue:
+		request := &v1pb.RejectIssueRequest{Name: issueName, Comment: comment}
+		issue, err := s.issueService.RejectIssue(ctx, request)
+		s.createIssueActionAuditLog(ctx, store.AuditLogMethodIMIssueReject, user, issueName, request, err)
+		if err != nil {
+			return fmt.Sprintf("Failed to reject the issue: %s", getErrorMessage(err))
+		}
+		return fmt.Sprintf("Rejected issue %q.", issue.Title)
+	default:
+		return fmt.Sprintf("Unsupported action %q.", action)
+	}
+}
+
+// createIssueActionAuditLog records the issue action taken from the IM apps.
+// These requests bypass the gRPC audit interceptor, so they are logged here.
+func (s *Service) createIssueActionAuditLog(ctx context.Context, method store.AuditLogMethod, user *store.UserMessage, issueName string, request proto.Message, actionErr error) {
+	projectID, issueUID, err := common.GetProjectIDIssueUID(issueName)
+	if err != nil {
+		// The issue action fails on the invalid issue name, nothing to audit.
+		return
+	}
+	requestString, err := protojson.Marshal(request)
+	if err != nil {
+		slog.Warn("failed to marshal the IM issue action request", log.BBError(err))
+	}
+	if err := s.store.CreateAuditLog(ctx, &storepb.AuditLog{
+		Parent:   common.FormatProject(projectID),
+		Method:   method.String(),
+		Resource: issueName,
+		User:     common.FormatUserUID(user.ID),
+		Severity: storepb.AuditLog_INFO,
+		Request:  string(requestString),
+		Response: "",
+		Status:   status.Convert(actionErr).Proto(),
+	}); err != nil {
+		slog.Error("failed to create audit log for the IM issue action", slog.Int("issue", issueUID), log.BBError(err))
+	}
+}
+
+func getErrorMessage(err error) string {
+	if st, ok := status.FromError(err); ok {
+		return st.Message()
+	}
+	return err.Error()
+}
+
+// getBaseURL returns the scheme and the host of the URL, e.g. https://bytebase.example.com.
+func getBaseURL(externalURL string) (string, error) {
+	if externalURL == "" {
+		return "", errors.New("external URL is empty")
+	}
+	u, err := url.Parse(externalURL)
+	if err != nil {
+		return "", errors.Wrapf(err, "invalid external URL %q", externalURL)
+	}
+	return fmt.Sprintf("%s://%s", u.Scheme, u.Host), nil
+}
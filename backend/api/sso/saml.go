@@ -0,0 +1,144 @@
+package sso
+
+import (
+	"context"
+	"fmt"
+	"html/template"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/plugin/idp/saml"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// samlLoginTemplate exchanges the SAML response posted by the identity provider
+// for the Bytebase session with the login API, just like the frontend does for
+// the OAuth2 and OIDC callbacks.
+var samlLoginTemplate = template.Must(template.New("saml").Parse(`<!DOCTYPE html>
+<html>
+<head><meta charset="utf-8"><title>Bytebase</title></head>
+<body>
+<p id="message">Signing in...</p>
+<script>
+(async () => {
+  const redirect = {{.Redirect}};
+  try {
+    const response = await fetch("/v1/auth/login", {
+      method: "POST",
+      headers: { "Content-Type": "application/json" },
+      credentials: "same-origin",
+      body: JSON.stringify({
+        web: true,
+        idpName: {{.IdpName}},
+        idpContext: { samlContext: { samlResponse: {{.SAMLResponse}} } },
+      }),
+    });
+    const data = await response.json();
+    if (!response.ok) {
+      throw new Error(data.message || response.statusText);
+    }
+    if (data.mfaTempToken) {
+      window.location.replace("/auth/mfa?" + new URLSearchParams({ mfaTempToken: data.mfaTempToken, redirect }));
+      return;
+    }
+    window.location.replace(redirect);
+  } catch (e) {
+    document.getElementById("message").textContent = "Failed to sign in: " + e.message;
+  }
+})();
+</script>
+</body>
+</html>
+`))
+
+// RegisterSAMLRoutes registers the routes of Bytebase as the SAML service provider.
+func (s *Service) RegisterSAMLRoutes(g *echo.Group) {
+	// The service provider metadata to be imported into the identity provider.
+	g.GET("/:idp/metadata", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		samlIDP, err := s.getSAMLIdentityProvider(ctx, c.Param("idp"))
+		if err != nil {
+			return c.String(http.StatusBadRequest, err.Error())
+		}
+		metadata, err := samlIDP.Metadata()
+		if err != nil {
+			return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to build metadata, error %v", err))
+		}
+		return c.Blob(http.StatusOK, "application/samlmetadata+xml", metadata)
+	})
+
+	// Start the service provider initiated sign-in by redirecting to the identity provider with the signed AuthnRequest.
+	g.GET("/:idp/sso", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		samlIDP, err := s.getSAMLIdentityProvider(ctx, c.Param("idp"))
+		if err != nil {
+			return c.String(http.StatusBadRequest, err.Error())
+		}
+		authURL, err := samlIDP.AuthURL(getRedirectPath(c.QueryParam("redirect")))
+		if err != nil {
+			return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to build auth URL, error %v", err))
+		}
+		return c.Redirect(http.StatusFound, authURL)
+	})
+
+	// The assertion consumer service receiving the SAML response with HTTP-POST binding.
+	// The SAML response is validated by the login API.
+	g.POST("/:idp/acs", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		idpID := c.Param("idp")
+		if _, err := s.getSAMLIdentityProvider(ctx, idpID); err != nil {
+			return c.String(http.StatusBadRequest, err.Error())
+		}
+		samlResponse := c.FormValue("SAMLResponse")
+		if samlResponse == "" {
+			return c.String(http.StatusBadRequest, "missing SAMLResponse")
+		}
+
+		var html strings.Builder
+		if err := samlLoginTemplate.Execute(&html, map[string]string{
+			"IdpName":      fmt.Sprintf("%s%s", common.IdentityProviderNamePrefix, idpID),
+			"SAMLResponse": samlResponse,
+			"Redirect":     getRedirectPath(c.FormValue("RelayState")),
+		}); err != nil {
+			return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to render page, error %v", err))
+		}
+		return c.HTML(http.StatusOK, html.String())
+	})
+}
+
+func (s *Service) getSAMLIdentityProvider(ctx context.Context, idpID string) (*saml.IdentityProvider, error) {
+	idp, err := s.store.GetIdentityProvider(ctx, &store.FindIdentityProviderMessage{
+		ResourceID: &idpID,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get identity provider %q", idpID)
+	}
+	if idp == nil || idp.Deleted {
+		return nil, errors.Errorf("identity provider %q not found", idpID)
+	}
+	if idp.Type != storepb.IdentityProviderType_SAML {
+		return nil, errors.Errorf("identity provider %q is not a SAML identity provider", idpID)
+	}
+
+	setting, err := s.store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get workspace setting")
+	}
+	if setting.ExternalUrl == "" {
+		return nil, errors.Errorf("external URL is empty")
+	}
+	return saml.NewIdentityProviderWithExternalURL(setting.ExternalUrl, idp.ResourceID, idp.Config.GetSamlConfig())
+}
+
+// getRedirectPath only allows redirecting to the paths of Bytebase to avoid the open redirect.
+func getRedirectPath(redirect string) string {
+	if !strings.HasPrefix(redirect, "/") || strings.HasPrefix(redirect, "//") || strings.HasPrefix(redirect, "/\\") {
+		return "/"
+	}
+	return redirect
+}
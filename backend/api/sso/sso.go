@@ -0,0 +1,19 @@
+// Package sso is the API endpoint for the browser based single sign-on flows
+// which cannot be served by the gRPC APIs, e.g. SAML 2.0.
+package sso
+
+import (
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+// Service is the API endpoint for handling single sign-on requests.
+type Service struct {
+	store *store.Store
+}
+
+// NewService creates a single sign-on service.
+func NewService(store *store.Store) *Service {
+	return &Service{
+		store: store,
+	}
+}
@@ -0,0 +1,322 @@
+package v1
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bytebase/bytebase/backend/api/auth"
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/component/iam"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// maximumAccessTokenLifetime is the longest lifetime of an access token.
+const maximumAccessTokenLifetime = 365 * 24 * time.Hour
+
+// CreateAccessToken creates an access token for the user.
+func (s *AuthService) CreateAccessToken(ctx context.Context, request *v1pb.CreateAccessTokenRequest) (*v1pb.AccessToken, error) {
+	userID, err := common.GetUserID(request.Parent)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	user, err := s.getCredentialOwner(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	callerUser, ok := ctx.Value(common.UserContextKey).(*store.UserMessage)
+	if !ok {
+		return nil, status.Errorf(codes.PermissionDenied, "failed to get caller user")
+	}
+	if err := checkAccessTokenIssuer(callerUser, user); err != nil {
+		return nil, err
+	}
+
+	accessToken := request.AccessToken
+	if accessToken == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "access token must be set")
+	}
+	if accessToken.Title == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "access token title is required")
+	}
+	if !iam.PermissionsExist(accessToken.Permissions...) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid permissions %v", accessToken.Permissions)
+	}
+	var projectIDs []string
+	for _, name := range accessToken.Projects {
+		projectID, err := common.GetProjectID(name)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{ResourceID: &projectID})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get project, error: %v", err)
+		}
+		if project == nil {
+			return nil, status.Errorf(codes.NotFound, "project %q not found", name)
+		}
+		projectIDs = append(projectIDs, projectID)
+	}
+	if err := s.checkAccessTokenScopeWithinCaller(ctx, callerUser, user, accessToken.Permissions, projectIDs); err != nil {
+		return nil, err
+	}
+	expireTime, err := getAccessTokenExpireTime(accessToken.ExpireTime, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	secret, tokenHash, err := auth.GenerateAccessTokenSecret()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate access token, error: %v", err)
+	}
+	created, err := s.store.CreateAccessToken(ctx, &store.AccessTokenMessage{
+		PrincipalUID: user.ID,
+		TokenHash:    tokenHash,
+		ExpireTime:   expireTime,
+		Payload: &storepb.AccessTokenPayload{
+			Title:       accessToken.Title,
+			Permissions: accessToken.Permissions,
+			Projects:    projectIDs,
+		},
+	}, callerUser.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create access token, error: %v", err)
+	}
+	return convertToAccessToken(created, secret), nil
+}
+
+// ListAccessTokens lists the access tokens of the user.
+func (s *AuthService) ListAccessTokens(ctx context.Context, request *v1pb.ListAccessTokensRequest) (*v1pb.ListAccessTokensResponse, error) {
+	userID, err := common.GetUserID(request.Parent)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if _, err := s.getCredentialOwner(ctx, userID); err != nil {
+		return nil, err
+	}
+	tokens, err := s.store.ListAccessTokens(ctx, &store.FindAccessTokenMessage{
+		PrincipalUID: &userID,
+		ShowRevoked:  request.ShowRevoked,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list access tokens, error: %v", err)
+	}
+	response := &v1pb.ListAccessTokensResponse{}
+	for _, token := range tokens {
+		response.AccessTokens = append(response.AccessTokens, convertToAccessToken(token, ""))
+	}
+	return response, nil
+}
+
+// RevokeAccessToken revokes the access token.
+func (s *AuthService) RevokeAccessToken(ctx context.Context, request *v1pb.RevokeAccessTokenRequest) (*v1pb.AccessToken, error) {
+	token, err := s.getAccessToken(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	updated, err := s.store.UpdateAccessToken(ctx, &store.UpdateAccessTokenMessage{
+		UID:        token.UID,
+		RevokeTime: &now,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to revoke access token, error: %v", err)
+	}
+	return convertToAccessToken(updated, ""), nil
+}
+
+// RotateAccessToken replaces the secret of the access token.
+func (s *AuthService) RotateAccessToken(ctx context.Context, request *v1pb.RotateAccessTokenRequest) (*v1pb.AccessToken, error) {
+	token, err := s.getAccessToken(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if token.ExpireTime != nil && !time.Now().Before(*token.ExpireTime) {
+		return nil, status.Errorf(codes.FailedPrecondition, "access token %q has expired", request.Name)
+	}
+	callerUser, ok := ctx.Value(common.UserContextKey).(*store.UserMessage)
+	if !ok {
+		return nil, status.Errorf(codes.PermissionDenied, "failed to get caller user")
+	}
+	user, err := s.store.GetUserByID(ctx, token.PrincipalUID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user, error: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.NotFound, "user %d not found", token.PrincipalUID)
+	}
+	// Rotating returns a new secret, so it is subject to the same restrictions as creating the token.
+	if err := checkAccessTokenIssuer(callerUser, user); err != nil {
+		return nil, err
+	}
+	if err := s.checkAccessTokenScopeWithinCaller(ctx, callerUser, user, token.Payload.GetPermissions(), token.Payload.GetProjects()); err != nil {
+		return nil, err
+	}
+	secret, tokenHash, err := auth.GenerateAccessTokenSecret()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate access token, error: %v", err)
+	}
+	updated, err := s.store.UpdateAccessToken(ctx, &store.UpdateAccessTokenMessage{
+		UID:       token.UID,
+		TokenHash: &tokenHash,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to rotate access token, error: %v", err)
+	}
+	return convertToAccessToken(updated, secret), nil
+}
+
+// getAccessToken returns the active access token if the caller can manage it.
+func (s *AuthService) getAccessToken(ctx context.Context, name string) (*store.AccessTokenMessage, error) {
+	userID, tokenID, err := common.GetUserIDAccessTokenID(name)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if _, err := s.getCredentialOwner(ctx, userID); err != nil {
+		return nil, err
+	}
+	token, err := s.store.GetAccessToken(ctx, &store.FindAccessTokenMessage{
+		UID:          &tokenID,
+		PrincipalUID: &userID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get access token, error: %v", err)
+	}
+	if token == nil {
+		return nil, status.Errorf(codes.NotFound, "access token %q not found", name)
+	}
+	return token, nil
+}
+
+// getCredentialOwner returns the user owning the access tokens and the sessions.
+// Only the user itself and the user with bb.users.update permission can manage the access tokens and the sessions of the user.
+func (s *AuthService) getCredentialOwner(ctx context.Context, userID int) (*store.UserMessage, error) {
+	callerUser, ok := ctx.Value(common.UserContextKey).(*store.UserMessage)
+	if !ok {
+		return nil, status.Errorf(codes.PermissionDenied, "failed to get caller user")
+	}
+	user, err := s.store.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user, error: %v", err)
+	}
+	if user == nil || user.MemberDeleted {
+		return nil, status.Errorf(codes.NotFound, "user %d not found", userID)
+	}
+	if user.Type != api.EndUser && user.Type != api.ServiceAccount {
+		return nil, status.Errorf(codes.InvalidArgument, "credentials are not supported for user type %s", user.Type)
+	}
+	if callerUser.ID != userID {
+		ok, err := s.iamManager.CheckPermission(ctx, iam.PermissionUsersUpdate, callerUser)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to check permission, error: %v", err)
+		}
+		if !ok {
+			return nil, status.Errorf(codes.PermissionDenied, "user does not have permission %q", iam.PermissionUsersUpdate)
+		}
+	}
+	return user, nil
+}
+
+// checkAccessTokenIssuer checks if the caller can get the secret of an access token of the user.
+// The users can only issue the access tokens of themselves, or of the service accounts to avoid impersonating other users.
+func checkAccessTokenIssuer(callerUser, user *store.UserMessage) error {
+	if callerUser.ID == user.ID || user.Type == api.ServiceAccount {
+		return nil
+	}
+	return status.Errorf(codes.PermissionDenied, "cannot issue access tokens for user %q", user.Email)
+}
+
+// checkAccessTokenScopeWithinCaller checks that the scope of the access token doesn't exceed the permissions of the caller.
+func (s *AuthService) checkAccessTokenScopeWithinCaller(ctx context.Context, callerUser, user *store.UserMessage, permissions []string, projectIDs []string) error {
+	// The token scoped to all permissions of another user could grant more than the caller has.
+	if callerUser.ID != user.ID {
+		if len(permissions) == 0 {
+			return status.Errorf(codes.InvalidArgument, "permissions are required for the access tokens of other users")
+		}
+		for _, permission := range permissions {
+			ok, err := s.iamManager.CheckPermission(ctx, iam.Permission(permission), callerUser, projectIDs...)
+			if err != nil {
+				return status.Errorf(codes.Internal, "failed to check permission, error: %v", err)
+			}
+			if !ok {
+				return status.Errorf(codes.PermissionDenied, "user does not have permission %q", permission)
+			}
+		}
+	}
+	// The scoped access token cannot issue a token with a wider scope.
+	authContext, ok := common.GetAuthContextFromContext(ctx)
+	if !ok || authContext.AccessTokenScope == nil {
+		return nil
+	}
+	if err := checkScopeWithin(authContext.AccessTokenScope.Permissions, permissions); err != nil {
+		return status.Errorf(codes.PermissionDenied, "permissions exceed the scope of the access token, error: %v", err)
+	}
+	if err := checkScopeWithin(authContext.AccessTokenScope.Projects, projectIDs); err != nil {
+		return status.Errorf(codes.PermissionDenied, "projects exceed the scope of the access token, error: %v", err)
+	}
+	return nil
+}
+
+// checkScopeWithin checks that the requested values are within the allowed values. Empty values mean no restriction.
+func checkScopeWithin(allowed []string, requested []string) error {
+	if len(allowed) == 0 {
+		return nil
+	}
+	if len(requested) == 0 {
+		return errors.New("unrestricted scope is not allowed")
+	}
+	for _, v := range requested {
+		if !slices.Contains(allowed, v) {
+			return errors.Errorf("%q is not allowed", v)
+		}
+	}
+	return nil
+}
+
+// getAccessTokenExpireTime returns the expire time of the access token, defaulting to the maximum lifetime.
+func getAccessTokenExpireTime(expireTime *timestamppb.Timestamp, now time.Time) (*time.Time, error) {
+	maximum := now.Add(maximumAccessTokenLifetime)
+	if expireTime == nil {
+		return &maximum, nil
+	}
+	t := expireTime.AsTime()
+	if !t.After(now) {
+		return nil, status.Errorf(codes.InvalidArgument, "expire time must be in the future")
+	}
+	if t.After(maximum) {
+		return nil, status.Errorf(codes.InvalidArgument, "expire time cannot be more than %d days later", int(maximumAccessTokenLifetime.Hours()/24))
+	}
+	return &t, nil
+}
+
+func convertToAccessToken(token *store.AccessTokenMessage, secret string) *v1pb.AccessToken {
+	accessToken := &v1pb.AccessToken{
+		Name:        common.FormatAccessToken(token.PrincipalUID, token.UID),
+		Title:       token.Payload.GetTitle(),
+		Permissions: token.Payload.GetPermissions(),
+		CreateTime:  timestamppb.New(token.CreatedTime),
+		Token:       secret,
+	}
+	for _, projectID := range token.Payload.GetProjects() {
+		accessToken.Projects = append(accessToken.Projects, common.FormatProject(projectID))
+	}
+	if token.ExpireTime != nil {
+		accessToken.ExpireTime = timestamppb.New(*token.ExpireTime)
+	}
+	if token.LastUsedTime != nil {
+		accessToken.LastUsedTime = timestamppb.New(*token.LastUsedTime)
+	}
+	if token.RevokeTime != nil {
+		accessToken.RevokeTime = timestamppb.New(*token.RevokeTime)
+	}
+	return accessToken
+}
@@ -0,0 +1,47 @@
+package v1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+func TestCheckAccessTokenIssuer(t *testing.T) {
+	a := require.New(t)
+	caller := &store.UserMessage{ID: 101, Type: api.EndUser}
+	a.NoError(checkAccessTokenIssuer(caller, caller))
+	a.NoError(checkAccessTokenIssuer(caller, &store.UserMessage{ID: 102, Type: api.ServiceAccount}))
+	a.Error(checkAccessTokenIssuer(caller, &store.UserMessage{ID: 103, Type: api.EndUser}))
+}
+
+func TestCheckScopeWithin(t *testing.T) {
+	a := require.New(t)
+	a.NoError(checkScopeWithin(nil, nil))
+	a.NoError(checkScopeWithin(nil, []string{"bb.releases.create"}))
+	a.NoError(checkScopeWithin([]string{"bb.releases.create", "bb.plans.create"}, []string{"bb.plans.create"}))
+	a.Error(checkScopeWithin([]string{"bb.releases.create"}, nil))
+	a.Error(checkScopeWithin([]string{"bb.releases.create"}, []string{"bb.users.update"}))
+}
+
+func TestGetAccessTokenExpireTime(t *testing.T) {
+	a := require.New(t)
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	got, err := getAccessTokenExpireTime(nil, now)
+	a.NoError(err)
+	a.Equal(now.Add(maximumAccessTokenLifetime), *got)
+
+	got, err = getAccessTokenExpireTime(timestamppb.New(now.Add(time.Hour)), now)
+	a.NoError(err)
+	a.Equal(now.Add(time.Hour), *got)
+
+	_, err = getAccessTokenExpireTime(timestamppb.New(now), now)
+	a.Error(err)
+	_, err = getAccessTokenExpireTime(timestamppb.New(now.Add(maximumAccessTokenLifetime+time.Hour)), now)
+	a.Error(err)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"log/slog"
 	"regexp"
+	"slices"
 	"strings"
 
 	annotationsproto "google.golang.org/genproto/googleapis/api/annotations"
@@ -80,6 +81,9 @@ func (in *ACLInterceptor) ACLInterceptor(ctx context.Context, request any, serve
 	if user == nil {
 		return nil, status.Errorf(codes.Unauthenticated, "unauthenticated for method %q", serverInfo.FullMethod)
 	}
+	if err := checkAccessTokenScope(serverInfo.FullMethod, authContext); err != nil {
+		return nil, err
+	}
 
 	ok, extra, err := doIAMPermissionCheck(ctx, in.iamManager, serverInfo.FullMethod, user, authContext)
 	if err != nil {
@@ -150,6 +154,9 @@ func (o *overrideStream) RecvMsg(request any) error {
 	if o.user == nil {
 		return status.Errorf(codes.Unauthenticated, "unauthenticated for method %q", o.fullMethod)
 	}
+	if err := checkAccessTokenScope(o.fullMethod, authContext); err != nil {
+		return err
+	}
 
 	ok, extra, err := doIAMPermissionCheck(o.childCtx, o.iamManager, o.fullMethod, o.user, authContext)
 	if err != nil {
@@ -230,6 +237,33 @@ func doIAMPermissionCheck(ctx context.Context, iamManager *iam.Manager, fullMeth
 	return true, nil, nil
 }
 
+// checkAccessTokenScope checks the request against the scope of the access token authenticating the request.
+// The scoped tokens can only call the methods guarded by an IAM permission in the scope, on the projects in the scope.
+func checkAccessTokenScope(fullMethod string, authContext *common.AuthContext) error {
+	scope := authContext.AccessTokenScope
+	if scope == nil {
+		return nil
+	}
+	if authContext.AuthMethod != common.AuthMethodIAM || authContext.Permission == "" {
+		return status.Errorf(codes.PermissionDenied, "method %q is not allowed for the scoped access token", fullMethod)
+	}
+	if len(scope.Permissions) > 0 && !slices.Contains(scope.Permissions, authContext.Permission) {
+		return status.Errorf(codes.PermissionDenied, "permission %q is not granted to the access token", authContext.Permission)
+	}
+	if len(scope.Projects) > 0 {
+		projectIDs := authContext.GetProjectResources()
+		if authContext.HasWorkspaceResource() || len(projectIDs) == 0 {
+			return status.Errorf(codes.PermissionDenied, "method %q on the workspace is not allowed for the project-scoped access token", fullMethod)
+		}
+		for _, projectID := range projectIDs {
+			if !slices.Contains(scope.Projects, projectID) {
+				return status.Errorf(codes.PermissionDenied, "project %q is not granted to the access token", projectID)
+			}
+		}
+	}
+	return nil
+}
+
 var projectRegex = regexp.MustCompile(`^projects/[^/]+`)
 var databaseRegex = regexp.MustCompile(`^instances/[^/]+/databases/[^/]+`)
 
@@ -259,6 +293,10 @@ func populateRawResources(ctx context.Context, stores *store.Store, authContext
 					return errors.Wrapf(err, "failed to get database %q", match)
 				}
 				resource.ProjectID = database.ProjectID
+				resource.Database = match
+				if database.EffectiveEnvironmentID != "" {
+					resource.Environment = common.FormatEnvironment(database.EffectiveEnvironmentID)
+				}
 			}
 		default:
 			resource.Workspace = true
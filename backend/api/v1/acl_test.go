@@ -218,3 +218,71 @@ func TestToSnakeCase(t *testing.T) {
 		require.Equal(t, tt.want, got, tt.input)
 	}
 }
+
+func TestCheckAccessTokenScope(t *testing.T) {
+	const method = "/bytebase.v1.ReleaseService/CreateRelease"
+	projectResource := []*common.Resource{{Name: "projects/hello", ProjectID: "hello"}}
+	tests := []struct {
+		authContext *common.AuthContext
+		wantErr     bool
+	}{
+		{
+			authContext: &common.AuthContext{AuthMethod: common.AuthMethodIAM, Permission: "bb.releases.create", Resources: projectResource},
+		},
+		{
+			authContext: &common.AuthContext{AuthMethod: common.AuthMethodCustom, Resources: projectResource},
+		},
+		{
+			authContext: &common.AuthContext{
+				AuthMethod:       common.AuthMethodIAM,
+				Permission:       "bb.releases.create",
+				Resources:        projectResource,
+				AccessTokenScope: &common.AccessTokenScope{Permissions: []string{"bb.releases.create"}, Projects: []string{"hello"}},
+			},
+		},
+		{
+			authContext: &common.AuthContext{
+				AuthMethod:       common.AuthMethodIAM,
+				Permission:       "bb.releases.create",
+				Resources:        projectResource,
+				AccessTokenScope: &common.AccessTokenScope{Permissions: []string{"bb.releases.get"}},
+			},
+			wantErr: true,
+		},
+		{
+			authContext: &common.AuthContext{
+				AuthMethod:       common.AuthMethodIAM,
+				Permission:       "bb.releases.create",
+				Resources:        projectResource,
+				AccessTokenScope: &common.AccessTokenScope{Projects: []string{"world"}},
+			},
+			wantErr: true,
+		},
+		{
+			authContext: &common.AuthContext{
+				AuthMethod:       common.AuthMethodIAM,
+				Permission:       "bb.projects.list",
+				Resources:        []*common.Resource{{Workspace: true}},
+				AccessTokenScope: &common.AccessTokenScope{Projects: []string{"hello"}},
+			},
+			wantErr: true,
+		},
+		{
+			authContext: &common.AuthContext{
+				AuthMethod:       common.AuthMethodCustom,
+				Resources:        projectResource,
+				AccessTokenScope: &common.AccessTokenScope{Permissions: []string{"bb.releases.create"}},
+			},
+			wantErr: true,
+		},
+	}
+
+	for i, tt := range tests {
+		err := checkAccessTokenScope(method, tt.authContext)
+		if tt.wantErr {
+			require.Error(t, err, i)
+		} else {
+			require.NoError(t, err, i)
+		}
+	}
+}
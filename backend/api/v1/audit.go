@@ -195,6 +195,8 @@ func getRequestResource(request any) string {
 		return r.Parent
 	case *v1pb.UpdateDatabaseCatalogRequest:
 		return r.GetCatalog().GetName()
+	case *v1pb.ReviewClassificationProposalsRequest:
+		return r.Name
 	case *v1pb.UpdateSecretRequest:
 		return r.GetSecret().GetName()
 	case *v1pb.DeleteSecretRequest:
@@ -237,6 +239,20 @@ func getRequestResource(request any) string {
 		return r.GetName()
 	case *v1pb.UpdateSettingRequest:
 		return r.GetSetting().GetName()
+	case *v1pb.RevealJITAccessCredentialRequest:
+		return r.Name
+	case *v1pb.CreateAccessTokenRequest:
+		return r.Parent
+	case *v1pb.RevokeAccessTokenRequest:
+		return r.Name
+	case *v1pb.RotateAccessTokenRequest:
+		return r.Name
+	case *v1pb.RevokeSessionRequest:
+		return r.Name
+	case *v1pb.RevokeAllSessionsRequest:
+		return r.Parent
+	case *v1pb.RedeliverWebhookDeliveryRequest:
+		return r.Name
 	default:
 	}
 	return ""
@@ -338,6 +354,10 @@ func getResponseString(response any) (string, error) {
 			return redactInstance(r)
 		case *v1pb.Secret:
 			return redactSecret(r)
+		case *v1pb.RevealJITAccessCredentialResponse:
+			return redactRevealJITAccessCredentialResponse(r)
+		case *v1pb.AccessToken:
+			return redactAccessToken(r)
 		default:
 			if p, ok := r.(protoreflect.ProtoMessage); ok {
 				return p
@@ -413,6 +433,32 @@ func redactUser(r *v1pb.User) *v1pb.User {
 	}
 }
 
+func redactRevealJITAccessCredentialResponse(r *v1pb.RevealJITAccessCredentialResponse) *v1pb.RevealJITAccessCredentialResponse {
+	if r == nil {
+		return nil
+	}
+	return &v1pb.RevealJITAccessCredentialResponse{
+		Host:       r.Host,
+		Port:       r.Port,
+		Database:   r.Database,
+		Username:   r.Username,
+		Password:   maskedString,
+		ExpireTime: r.ExpireTime,
+	}
+}
+
+func redactAccessToken(r *v1pb.AccessToken) *v1pb.AccessToken {
+	if r == nil {
+		return nil
+	}
+	//nolint:revive
+	r = proto.Clone(r).(*v1pb.AccessToken)
+	if r.Token != "" {
+		r.Token = maskedString
+	}
+	return r
+}
+
 func redactInstance(i *v1pb.Instance) *v1pb.Instance {
 	if i == nil {
 		return nil
@@ -18,6 +18,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -33,6 +34,7 @@ import (
 	"github.com/bytebase/bytebase/backend/plugin/idp/ldap"
 	"github.com/bytebase/bytebase/backend/plugin/idp/oauth2"
 	"github.com/bytebase/bytebase/backend/plugin/idp/oidc"
+	"github.com/bytebase/bytebase/backend/plugin/idp/saml"
 	"github.com/bytebase/bytebase/backend/plugin/metric"
 	"github.com/bytebase/bytebase/backend/runner/metricreport"
 	"github.com/bytebase/bytebase/backend/store"
@@ -405,6 +407,26 @@ func (s *AuthService) UpdateUser(ctx context.Context, request *v1pb.UpdateUserRe
 				}
 			}
 			patch.Phone = &request.User.Phone
+		case "profile.attributes":
+			// The attributes are referenced by the row filter policies, users cannot change their own attributes.
+			ok, err := s.iamManager.CheckPermission(ctx, iam.PermissionUsersUpdate, callerUser)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				return nil, status.Errorf(codes.PermissionDenied, "user does not have permission %q", iam.PermissionUsersUpdate)
+			}
+			for key := range request.User.GetProfile().GetAttributes() {
+				if !userAttributeKeyRegexp.MatchString(key) {
+					return nil, status.Errorf(codes.InvalidArgument, "invalid attribute key %q", key)
+				}
+			}
+			profile, ok := proto.Clone(user.Profile).(*storepb.UserProfile)
+			if !ok || profile == nil {
+				profile = &storepb.UserProfile{}
+			}
+			profile.Attributes = request.User.GetProfile().GetAttributes()
+			patch.Profile = profile
 		}
 	}
 	if passwordPatch != nil {
@@ -517,6 +539,9 @@ func (s *AuthService) DeleteUser(ctx context.Context, request *v1pb.DeleteUserRe
 	if _, err := s.store.UpdateUser(ctx, user, &store.UpdateUserMessage{Delete: &deletePatch}, callerUser.ID); err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
+	if err := s.store.RevokeUserCredentials(ctx, user.ID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to revoke user credentials, error: %v", err)
+	}
 	return &emptypb.Empty{}, nil
 }
 
@@ -599,6 +624,7 @@ func convertToUser(user *store.UserMessage) *v1pb.User {
 			LastLoginTime:          user.Profile.LastLoginTime,
 			LastChangePasswordTime: user.Profile.LastChangePasswordTime,
 			Source:                 user.Profile.Source,
+			Attributes:             user.Profile.Attributes,
 		},
 	}
 
@@ -759,13 +785,21 @@ func (s *AuthService) Login(ctx context.Context, request *v1pb.LoginRequest) (*v
 	}
 
 	if loginUser.Type == api.EndUser {
-		token, err := auth.GenerateAccessToken(loginUser.Name, loginUser.ID, s.profile.Mode, s.secret, tokenDuration)
+		session, err := s.createSession(ctx, loginUser, time.Now().Add(tokenDuration), setting.MaximumSessionsPerUser)
+		if err != nil {
+			return nil, err
+		}
+		token, err := auth.GenerateAccessToken(loginUser.Name, loginUser.ID, session.SessionID, s.profile.Mode, s.secret, session.ExpireTime)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to generate API access token")
 		}
 		response.Token = token
 	} else if loginUser.Type == api.ServiceAccount {
-		token, err := auth.GenerateAPIToken(loginUser.Name, loginUser.ID, s.profile.Mode, s.secret)
+		session, err := s.createSession(ctx, loginUser, time.Now().Add(auth.APITokenDuration), setting.MaximumSessionsPerUser)
+		if err != nil {
+			return nil, err
+		}
+		token, err := auth.GenerateAPIToken(loginUser.Name, loginUser.ID, session.SessionID, s.profile.Mode, s.secret, session.ExpireTime)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to generate API access token")
 		}
@@ -797,6 +831,7 @@ func (s *AuthService) Login(ctx context.Context, request *v1pb.LoginRequest) (*v
 		Profile: &storepb.UserProfile{
 			LastLoginTime:          timestamppb.Now(),
 			LastChangePasswordTime: loginUser.Profile.GetLastChangePasswordTime(),
+			Attributes:             loginUser.Profile.GetAttributes(),
 		},
 	}, api.SystemBotID); err != nil {
 		slog.Error("failed to update user profile", log.BBError(err), slog.String("user", loginUser.Email))
@@ -824,7 +859,14 @@ func (s *AuthService) Logout(ctx context.Context, _ *v1pb.LogoutRequest) (*empty
 	if err != nil {
 		return nil, status.Error(codes.Unauthenticated, err.Error())
 	}
-	s.stateCfg.ExpireCache.Add(accessTokenStr, true)
+	if sessionID := auth.GetSessionID(accessTokenStr, s.secret); sessionID != "" {
+		if err := s.revokeSession(ctx, sessionID); err != nil {
+			return nil, err
+		}
+	} else {
+		// The tokens issued before the session management are revoked in memory.
+		s.stateCfg.ExpireCache.Add(accessTokenStr, true)
+	}
 
 	if err := grpc.SetHeader(ctx, metadata.New(map[string]string{
 		auth.GatewayMetadataAccessTokenKey: "",
@@ -945,6 +987,19 @@ func (s *AuthService) getOrCreateUserWithIDP(ctx context.Context, request *v1pb.
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to get user info: %v", err)
 		}
+	} else if idp.Type == storepb.IdentityProviderType_SAML {
+		samlContext := request.IdpContext.GetSamlContext()
+		if samlContext == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "missing SAML context")
+		}
+		samlIDP, err := saml.NewIdentityProviderWithExternalURL(setting.ExternalUrl, idp.ResourceID, idp.Config.GetSamlConfig())
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to create new SAML identity provider: %v", err)
+		}
+		userInfo, err = samlIDP.UserInfo(samlContext.SamlResponse)
+		if err != nil {
+			return nil, status.Errorf(codes.Unauthenticated, "failed to get user info: %v", err)
+		}
 	} else {
 		return nil, status.Errorf(codes.InvalidArgument, "identity provider type %s not supported", idp.Type.String())
 	}
@@ -984,6 +1039,9 @@ func (s *AuthService) getOrCreateUserWithIDP(ctx context.Context, request *v1pb.
 				return nil, status.Errorf(codes.Internal, "failed to undelete user: %v", err)
 			}
 		}
+		if err := s.syncUserGroupsWithIDP(ctx, idp, user, userInfo.Groups); err != nil {
+			return nil, err
+		}
 		return user, nil
 	}
 
@@ -1009,9 +1067,70 @@ func (s *AuthService) getOrCreateUserWithIDP(ctx context.Context, request *v1pb.
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create user, error: %v", err)
 	}
+	if err := s.syncUserGroupsWithIDP(ctx, idp, newUser, userInfo.Groups); err != nil {
+		return nil, err
+	}
 	return newUser, nil
 }
 
+// syncUserGroupsWithIDP syncs the group memberships of the user with the groups asserted by the identity provider.
+// It only applies if the identity provider maps the groups. The user joins the groups whose email equals an asserted group,
+// and leaves the groups where the user is a member but which are no longer asserted. Group owners are managed in Bytebase only.
+func (s *AuthService) syncUserGroupsWithIDP(ctx context.Context, idp *store.IdentityProviderMessage, user *store.UserMessage, idpGroups []string) error {
+	if idp.Config.GetSamlConfig().GetFieldMapping().GetGroups() == "" {
+		return nil
+	}
+	groups, err := s.store.ListGroups(ctx, &store.FindGroupMessage{})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to list groups, error: %v", err)
+	}
+	member := common.FormatUserUID(user.ID)
+	updated := false
+	for _, group := range groups {
+		payload, ok := syncGroupMember(group.Payload, member, slices.Contains(idpGroups, group.Email))
+		if !ok {
+			continue
+		}
+		if _, err := s.store.UpdateGroup(ctx, group.Email, &store.UpdateGroupMessage{Payload: payload}, api.SystemBotID); err != nil {
+			return status.Errorf(codes.Internal, "failed to sync user with group %q, error: %v", group.Email, err)
+		}
+		updated = true
+	}
+	if updated {
+		if err := s.iamManager.ReloadCache(ctx); err != nil {
+			return status.Errorf(codes.Internal, "failed to reload iam cache, error: %v", err)
+		}
+	}
+	return nil
+}
+
+// syncGroupMember returns the group payload with the member added if asserted, or removed if not asserted.
+// It returns false if the payload doesn't change.
+func syncGroupMember(groupPayload *storepb.GroupPayload, member string, asserted bool) (*storepb.GroupPayload, bool) {
+	index := slices.IndexFunc(groupPayload.GetMembers(), func(m *storepb.GroupMember) bool {
+		return m.Member == member
+	})
+	if asserted == (index >= 0) {
+		return nil, false
+	}
+	if !asserted && groupPayload.Members[index].Role != storepb.GroupMember_MEMBER {
+		return nil, false
+	}
+	payload, ok := proto.Clone(groupPayload).(*storepb.GroupPayload)
+	if !ok || payload == nil {
+		payload = &storepb.GroupPayload{}
+	}
+	if asserted {
+		payload.Members = append(payload.Members, &storepb.GroupMember{
+			Member: member,
+			Role:   storepb.GroupMember_MEMBER,
+		})
+	} else {
+		payload.Members = slices.Delete(payload.Members, index, index+1)
+	}
+	return payload, true
+}
+
 func challengeMFACode(user *store.UserMessage, mfaCode string) error {
 	if !validateWithCodeAndSecret(mfaCode, user.MFAConfig.OtpSecret) {
 		return status.Errorf(codes.Unauthenticated, "invalid MFA code")
@@ -90,3 +90,35 @@ func TestHasWorkspaceAdmin(t *testing.T) {
 		}
 	}
 }
+
+func TestSyncGroupMember(t *testing.T) {
+	a := require.New(t)
+	payload := &storepb.GroupPayload{
+		Members: []*storepb.GroupMember{
+			{Member: "users/101", Role: storepb.GroupMember_OWNER},
+			{Member: "users/102", Role: storepb.GroupMember_MEMBER},
+		},
+	}
+
+	// Join the asserted group.
+	got, ok := syncGroupMember(payload, "users/103", true)
+	a.True(ok)
+	a.Len(got.Members, 3)
+	a.Len(payload.Members, 2)
+	// Already a member.
+	_, ok = syncGroupMember(payload, "users/102", true)
+	a.False(ok)
+	// Leave the group no longer asserted.
+	got, ok = syncGroupMember(payload, "users/102", false)
+	a.True(ok)
+	a.Len(got.Members, 1)
+	a.Equal("users/101", got.Members[0].Member)
+	// Owners are managed in Bytebase.
+	_, ok = syncGroupMember(payload, "users/101", false)
+	a.False(ok)
+	// Not a member.
+	_, ok = syncGroupMember(payload, "users/103", false)
+	a.False(ok)
+	_, ok = syncGroupMember(nil, "users/103", false)
+	a.False(ok)
+}
@@ -11,6 +11,7 @@ import (
 	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/store/model"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
 )
@@ -102,6 +103,7 @@ func (s *DatabaseCatalogService) UpdateDatabaseCatalog(ctx context.Context, requ
 	}
 
 	databaseConfig := convertDatabaseCatalog(request.GetCatalog())
+	keepClassificationProposals(databaseConfig, dbSchema.GetInternalConfig())
 	if err := s.store.UpdateDBSchema(ctx, database.UID, &store.UpdateDBSchemaMessage{Config: databaseConfig}, api.SystemBotID); err != nil {
 		return nil, err
 	}
@@ -109,6 +111,133 @@ func (s *DatabaseCatalogService) UpdateDatabaseCatalog(ctx context.Context, requ
 	return request.GetCatalog(), nil
 }
 
+// ReviewClassificationProposals accepts or rejects the classification proposals of the sensitive data discovery.
+func (s *DatabaseCatalogService) ReviewClassificationProposals(ctx context.Context, request *v1pb.ReviewClassificationProposalsRequest) (*v1pb.DatabaseCatalog, error) {
+	if err := s.licenseService.IsFeatureEnabled(api.FeatureSensitiveData); err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+	if request.Action == v1pb.ReviewClassificationProposalsRequest_ACTION_UNSPECIFIED {
+		return nil, status.Errorf(codes.InvalidArgument, "action is required")
+	}
+	if len(request.Columns) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "columns are required")
+	}
+	instanceID, databaseName, err := common.TrimSuffixAndGetInstanceDatabaseID(request.Name, common.CatalogSuffix)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &instanceID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get instance %s", instanceID)
+	}
+	if instance == nil {
+		return nil, status.Errorf(codes.NotFound, "instance %q not found", instanceID)
+	}
+	database, err := s.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
+		InstanceID:          &instanceID,
+		DatabaseName:        &databaseName,
+		IgnoreCaseSensitive: store.IgnoreDatabaseAndTableCaseSensitive(instance),
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if database == nil {
+		return nil, status.Errorf(codes.NotFound, "database %q not found", databaseName)
+	}
+	dbSchema, err := s.store.GetDBSchema(ctx, database.UID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if dbSchema == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "database schema metadata not found")
+	}
+
+	config := dbSchema.GetInternalConfig()
+	for _, column := range request.Columns {
+		columnConfig := config.CreateOrGetSchemaConfig(column.Schema).CreateOrGetTableConfig(column.Table).CreateOrGetColumnConfig(column.Column)
+		proposal := columnConfig.ClassificationProposal
+		if proposal == nil || proposal.State != storepb.ClassificationProposal_PENDING {
+			return nil, status.Errorf(codes.FailedPrecondition, "no pending classification proposal for column %q in table %q schema %q", column.Column, column.Table, column.Schema)
+		}
+		switch request.Action {
+		case v1pb.ReviewClassificationProposalsRequest_ACCEPT:
+			columnConfig.Classification = proposal.Classification
+			if proposal.SemanticType != "" {
+				columnConfig.SemanticType = proposal.SemanticType
+			}
+			columnConfig.ClassificationProposal = nil
+		case v1pb.ReviewClassificationProposalsRequest_REJECT:
+			proposal.State = storepb.ClassificationProposal_REJECTED
+		}
+	}
+
+	databaseConfig := config.BuildDatabaseConfig()
+	if err := s.store.UpdateDBSchema(ctx, database.UID, &store.UpdateDBSchemaMessage{Config: databaseConfig}, api.SystemBotID); err != nil {
+		return nil, err
+	}
+	return convertDatabaseConfig(database, databaseConfig), nil
+}
+
+// keepClassificationProposals keeps the classification proposals of the unclassified columns,
+// because the proposals are output only in the API.
+// The proposals of the columns missing in the update are kept as well.
+func keepClassificationProposals(databaseConfig *storepb.DatabaseConfig, oldConfig *model.DatabaseConfig) {
+	for _, schema := range databaseConfig.Schemas {
+		for _, table := range schema.Tables {
+			for _, column := range table.Columns {
+				column.ClassificationProposal = nil
+			}
+		}
+	}
+	for _, oldSchema := range oldConfig.BuildDatabaseConfig().Schemas {
+		for _, oldTable := range oldSchema.Tables {
+			for _, oldColumn := range oldTable.Columns {
+				if oldColumn.ClassificationProposal == nil {
+					continue
+				}
+				column := getOrCreateColumnCatalog(databaseConfig, oldSchema.Name, oldTable.Name, oldColumn.Name)
+				if column.Classification != "" {
+					continue
+				}
+				column.ClassificationProposal = oldColumn.ClassificationProposal
+			}
+		}
+	}
+}
+
+func getOrCreateColumnCatalog(databaseConfig *storepb.DatabaseConfig, schemaName, tableName, columnName string) *storepb.ColumnCatalog {
+	var schema *storepb.SchemaCatalog
+	for _, s := range databaseConfig.Schemas {
+		if s.Name == schemaName {
+			schema = s
+			break
+		}
+	}
+	if schema == nil {
+		schema = &storepb.SchemaCatalog{Name: schemaName}
+		databaseConfig.Schemas = append(databaseConfig.Schemas, schema)
+	}
+	var table *storepb.TableCatalog
+	for _, t := range schema.Tables {
+		if t.Name == tableName {
+			table = t
+			break
+		}
+	}
+	if table == nil {
+		table = &storepb.TableCatalog{Name: tableName}
+		schema.Tables = append(schema.Tables, table)
+	}
+	for _, c := range table.Columns {
+		if c.Name == columnName {
+			return c
+		}
+	}
+	column := &storepb.ColumnCatalog{Name: columnName}
+	table.Columns = append(table.Columns, column)
+	return column
+}
+
 func convertDatabaseConfig(database *store.DatabaseMessage, config *storepb.DatabaseConfig) *v1pb.DatabaseCatalog {
 	c := &v1pb.DatabaseCatalog{
 		Name: fmt.Sprintf("%s%s/%s%s%s", common.InstanceNamePrefix, database.InstanceID, common.DatabaseIDPrefix, database.DatabaseName, common.CatalogSuffix),
@@ -144,12 +273,34 @@ func convertTableCatalog(t *storepb.TableCatalog) *v1pb.TableCatalog {
 
 func convertColumnCatalog(c *storepb.ColumnCatalog) *v1pb.ColumnCatalog {
 	return &v1pb.ColumnCatalog{
-		Name:           c.Name,
-		SemanticType:   c.SemanticType,
-		Labels:         c.Labels,
-		Classification: c.Classification,
-		ObjectSchema:   convertStoreObjectSchema(c.ObjectSchema),
+		Name:                   c.Name,
+		SemanticType:           c.SemanticType,
+		Labels:                 c.Labels,
+		Classification:         c.Classification,
+		ObjectSchema:           convertStoreObjectSchema(c.ObjectSchema),
+		ClassificationProposal: convertToV1ClassificationProposal(c.ClassificationProposal),
+	}
+}
+
+func convertToV1ClassificationProposal(proposal *storepb.ClassificationProposal) *v1pb.ClassificationProposal {
+	if proposal == nil {
+		return nil
+	}
+	p := &v1pb.ClassificationProposal{
+		Classification: proposal.Classification,
+		SemanticType:   proposal.SemanticType,
+		Detector:       proposal.Detector,
+		MatchRatio:     proposal.MatchRatio,
+		SampleSize:     proposal.SampleSize,
+		ScanTime:       proposal.ScanTime,
+	}
+	switch proposal.State {
+	case storepb.ClassificationProposal_PENDING:
+		p.State = v1pb.ClassificationProposal_PENDING
+	case storepb.ClassificationProposal_REJECTED:
+		p.State = v1pb.ClassificationProposal_REJECTED
 	}
+	return p
 }
 
 func convertStoreObjectSchema(objectSchema *storepb.ObjectSchema) *v1pb.ObjectSchema {
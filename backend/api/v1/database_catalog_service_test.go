@@ -0,0 +1,74 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/bytebase/bytebase/backend/store/model"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestKeepClassificationProposals(t *testing.T) {
+	a := require.New(t)
+	proposal := &storepb.ClassificationProposal{Classification: "1-1", State: storepb.ClassificationProposal_PENDING}
+	oldConfig := model.NewDatabaseConfig(&storepb.DatabaseConfig{
+		Schemas: []*storepb.SchemaCatalog{
+			{
+				Name: "public",
+				Tables: []*storepb.TableCatalog{
+					{
+						Name: "user",
+						Columns: []*storepb.ColumnCatalog{
+							{Name: "email", ClassificationProposal: proposal},
+							{Name: "phone", ClassificationProposal: proposal},
+							{Name: "name", ClassificationProposal: proposal},
+						},
+					},
+				},
+			},
+		},
+	})
+	databaseConfig := &storepb.DatabaseConfig{
+		Schemas: []*storepb.SchemaCatalog{
+			{
+				Name: "public",
+				Tables: []*storepb.TableCatalog{
+					{
+						Name: "user",
+						Columns: []*storepb.ColumnCatalog{
+							{Name: "email", SemanticType: "default"},
+							{Name: "phone", Classification: "1-2"},
+							{Name: "address", ClassificationProposal: proposal},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	keepClassificationProposals(databaseConfig, oldConfig)
+	want := &storepb.DatabaseConfig{
+		Schemas: []*storepb.SchemaCatalog{
+			{
+				Name: "public",
+				Tables: []*storepb.TableCatalog{
+					{
+						Name: "user",
+						Columns: []*storepb.ColumnCatalog{
+							{Name: "email", SemanticType: "default", ClassificationProposal: proposal},
+							{Name: "phone", Classification: "1-2"},
+							// The proposals are output only in the API.
+							{Name: "address"},
+							// The proposal of the column missing in the update is kept.
+							{Name: "name", ClassificationProposal: proposal},
+						},
+					},
+				},
+			},
+		},
+	}
+	a.Empty(cmp.Diff(want, databaseConfig, protocmp.Transform()))
+}
@@ -79,9 +79,13 @@ func (s *DatabaseGroupService) CreateDatabaseGroup(ctx context.Context, request
 		Placeholder: request.DatabaseGroup.DatabasePlaceholder,
 		Expression:  request.DatabaseGroup.DatabaseExpr,
 	}
+	if request.DatabaseGroup.AtomicRollout && !request.DatabaseGroup.Multitenancy {
+		return nil, status.Errorf(codes.InvalidArgument, "atomic rollout is only applicable to multitenancy database groups")
+	}
 	if request.DatabaseGroup.Multitenancy {
 		storeDatabaseGroup.Payload = &storepb.DatabaseGroupPayload{
-			Multitenancy: true,
+			Multitenancy:  true,
+			AtomicRollout: request.DatabaseGroup.AtomicRollout,
 		}
 	}
 	if request.ValidateOnly {
@@ -148,13 +152,28 @@ func (s *DatabaseGroupService) UpdateDatabaseGroup(ctx context.Context, request
 			}
 			updateDatabaseGroup.Expression = request.DatabaseGroup.DatabaseExpr
 		case "multitenancy":
-			updateDatabaseGroup.Payload = &storepb.DatabaseGroupPayload{
-				Multitenancy: request.DatabaseGroup.Multitenancy,
+			if updateDatabaseGroup.Payload == nil {
+				updateDatabaseGroup.Payload = &storepb.DatabaseGroupPayload{
+					Multitenancy:  existedDatabaseGroup.Payload.GetMultitenancy(),
+					AtomicRollout: existedDatabaseGroup.Payload.GetAtomicRollout(),
+				}
+			}
+			updateDatabaseGroup.Payload.Multitenancy = request.DatabaseGroup.Multitenancy
+		case "atomic_rollout":
+			if updateDatabaseGroup.Payload == nil {
+				updateDatabaseGroup.Payload = &storepb.DatabaseGroupPayload{
+					Multitenancy:  existedDatabaseGroup.Payload.GetMultitenancy(),
+					AtomicRollout: existedDatabaseGroup.Payload.GetAtomicRollout(),
+				}
 			}
+			updateDatabaseGroup.Payload.AtomicRollout = request.DatabaseGroup.AtomicRollout
 		default:
 			return nil, status.Errorf(codes.InvalidArgument, "unsupported path: %q", path)
 		}
 	}
+	if p := updateDatabaseGroup.Payload; p != nil && p.AtomicRollout && !p.Multitenancy {
+		return nil, status.Errorf(codes.InvalidArgument, "atomic rollout is only applicable to multitenancy database groups")
+	}
 	principalID, ok := ctx.Value(common.PrincipalIDContextKey).(int)
 	if !ok {
 		return nil, status.Errorf(codes.Internal, "principal ID not found")
@@ -300,6 +319,7 @@ func convertStoreToAPIDatabaseGroupBasic(databaseGroup *store.DatabaseGroupMessa
 	}
 	if databaseGroup.Payload != nil {
 		databaseGroupV1.Multitenancy = databaseGroup.Payload.Multitenancy
+		databaseGroupV1.AtomicRollout = databaseGroup.Payload.AtomicRollout
 	}
 	return databaseGroupV1
 }
@@ -22,6 +22,7 @@ import (
 
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/component/config"
+	"github.com/bytebase/bytebase/backend/component/dbfactory"
 	"github.com/bytebase/bytebase/backend/component/iam"
 	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
@@ -63,16 +64,18 @@ type DatabaseService struct {
 	licenseService enterprise.LicenseService
 	profile        *config.Profile
 	iamManager     *iam.Manager
+	dbFactory      *dbfactory.DBFactory
 }
 
 // NewDatabaseService creates a new DatabaseService.
-func NewDatabaseService(store *store.Store, schemaSyncer *schemasync.Syncer, licenseService enterprise.LicenseService, profile *config.Profile, iamManager *iam.Manager) *DatabaseService {
+func NewDatabaseService(store *store.Store, schemaSyncer *schemasync.Syncer, licenseService enterprise.LicenseService, profile *config.Profile, iamManager *iam.Manager, dbFactory *dbfactory.DBFactory) *DatabaseService {
 	return &DatabaseService{
 		store:          store,
 		schemaSyncer:   schemaSyncer,
 		licenseService: licenseService,
 		profile:        profile,
 		iamManager:     iamManager,
+		dbFactory:      dbFactory,
 	}
 }
 
@@ -243,16 +243,20 @@ func convertToRevision(ctx context.Context, s *store.Store, parent string, revis
 	if creator == nil {
 		return nil, errors.Errorf("creator %v not found", revision.CreatorUID)
 	}
-	_, sheetUID, err := common.GetProjectResourceIDSheetUID(revision.Payload.Sheet)
-	if err != nil {
-		return nil, errors.Wrapf(err, "failed to get sheetUID from %q", revision.Payload.Sheet)
-	}
-	sheet, err := s.GetSheet(ctx, &store.FindSheetMessage{UID: &sheetUID})
-	if err != nil {
-		return nil, errors.Wrapf(err, "failed to get sheet %q", revision.Payload.Sheet)
-	}
-	if sheet == nil {
-		return nil, errors.Errorf("sheet %q not found", revision.Payload.Sheet)
+	// The revisions imported from the migration tools have no sheet.
+	sheet := &store.SheetMessage{}
+	if revision.Payload.Sheet != "" {
+		_, sheetUID, err := common.GetProjectResourceIDSheetUID(revision.Payload.Sheet)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get sheetUID from %q", revision.Payload.Sheet)
+		}
+		sheet, err = s.GetSheet(ctx, &store.FindSheetMessage{UID: &sheetUID})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get sheet %q", revision.Payload.Sheet)
+		}
+		if sheet == nil {
+			return nil, errors.Errorf("sheet %q not found", revision.Payload.Sheet)
+		}
 	}
 
 	taskRunName, issueName := revision.Payload.TaskRun, ""
@@ -283,6 +287,8 @@ func convertToRevision(ctx context.Context, s *store.Store, parent string, revis
 		File:          revision.Payload.File,
 		Issue:         issueName,
 		TaskRun:       taskRunName,
+		Checksum:      revision.Payload.Checksum,
+		AppliedTime:   revision.Payload.AppliedTime,
 	}
 
 	if revision.DeleterUID != nil {
@@ -0,0 +1,365 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+const (
+	defaultFlywayHistoryTable    = "flyway_schema_history"
+	defaultLiquibaseHistoryTable = "DATABASECHANGELOG"
+)
+
+var (
+	// historyTableRE matches the optionally schema-qualified and quoted history table names.
+	historyTableRE = regexp.MustCompile(`^[A-Za-z0-9_."\x60\[\]]+$`)
+)
+
+// importedRevision is a migration applied by Flyway or Liquibase.
+type importedRevision struct {
+	version     string
+	fileType    storepb.ReleaseFileType
+	checksum    string
+	appliedTime time.Time
+}
+
+// flywayHistoryRow is a row of the Flyway schema history table.
+type flywayHistoryRow struct {
+	version     sql.NullString
+	typ         string
+	script      string
+	checksum    sql.NullInt64
+	installedOn time.Time
+	success     bool
+}
+
+// liquibaseHistoryRow is a row of the Liquibase DATABASECHANGELOG table.
+type liquibaseHistoryRow struct {
+	id           string
+	filename     string
+	md5sum       sql.NullString
+	dateExecuted time.Time
+	execType     string
+}
+
+func (s *DatabaseService) ImportRevisions(ctx context.Context, request *v1pb.ImportRevisionsRequest) (*v1pb.ImportRevisionsResponse, error) {
+	user, ok := ctx.Value(common.UserContextKey).(*store.UserMessage)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "user not found")
+	}
+	instanceID, databaseName, err := common.GetInstanceDatabaseID(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to get instance and database from %v, err: %v", request.Parent, err)
+	}
+	historyTable := request.HistoryTable
+	switch request.Source {
+	case v1pb.ImportRevisionsRequest_FLYWAY:
+		if historyTable == "" {
+			historyTable = defaultFlywayHistoryTable
+		}
+	case v1pb.ImportRevisionsRequest_LIQUIBASE:
+		if historyTable == "" {
+			historyTable = defaultLiquibaseHistoryTable
+		}
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported source %v", request.Source)
+	}
+	if !historyTableRE.MatchString(historyTable) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid history table %q", historyTable)
+	}
+
+	instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &instanceID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to find instance %v, err: %v", instanceID, err)
+	}
+	if instance == nil {
+		return nil, status.Errorf(codes.NotFound, "instance %v not found", instanceID)
+	}
+	database, err := s.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
+		InstanceID:   &instanceID,
+		DatabaseName: &databaseName,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to find database %v, err: %v", request.Parent, err)
+	}
+	if database == nil {
+		return nil, status.Errorf(codes.NotFound, "database %v not found", request.Parent)
+	}
+
+	driver, err := s.dbFactory.GetAdminDatabaseDriver(ctx, instance, database, db.ConnectionContext{})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get database driver, err: %v", err)
+	}
+	defer driver.Close(ctx)
+	sqlDB := driver.GetDB()
+	if sqlDB == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "importing revisions is not supported for engine %v", instance.Engine)
+	}
+
+	var importedRevisions []*importedRevision
+	var importSource storepb.RevisionPayload_ImportSource
+	switch request.Source {
+	case v1pb.ImportRevisionsRequest_FLYWAY:
+		rows, err := readFlywayHistory(ctx, sqlDB, historyTable)
+		if err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "failed to read flyway history table %q, err: %v", historyTable, err)
+		}
+		importedRevisions, err = convertFlywayHistory(rows)
+		if err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "failed to convert flyway history, err: %v", err)
+		}
+		importSource = storepb.RevisionPayload_FLYWAY
+	case v1pb.ImportRevisionsRequest_LIQUIBASE:
+		rows, err := readLiquibaseHistory(ctx, sqlDB, historyTable)
+		if err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "failed to read liquibase history table %q, err: %v", historyTable, err)
+		}
+		importedRevisions = convertLiquibaseHistory(rows)
+		importSource = storepb.RevisionPayload_LIQUIBASE
+	}
+
+	revisions, err := s.store.ListRevisions(ctx, &store.FindRevisionMessage{DatabaseUID: &database.UID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list revisions, err: %v", err)
+	}
+	existingVersions := map[string]bool{}
+	for _, revision := range revisions {
+		existingVersions[revision.Version] = true
+	}
+
+	response := &v1pb.ImportRevisionsResponse{}
+	for _, r := range importedRevisions {
+		if existingVersions[r.version] {
+			response.SkippedVersions = append(response.SkippedVersions, r.version)
+			continue
+		}
+		payload := &storepb.RevisionPayload{
+			Type:         r.fileType,
+			Checksum:     r.checksum,
+			ImportSource: importSource,
+		}
+		if !r.appliedTime.IsZero() {
+			payload.AppliedTime = timestamppb.New(r.appliedTime)
+		}
+		revision, err := s.store.CreateRevision(ctx, &store.RevisionMessage{
+			DatabaseUID: database.UID,
+			Version:     r.version,
+			Payload:     payload,
+		}, user.ID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to create revision %q, err: %v", r.version, err)
+		}
+		converted, err := convertToRevision(ctx, s.store, request.Parent, revision)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to convert to revision, err: %v", err)
+		}
+		response.Revisions = append(response.Revisions, converted)
+	}
+	return response, nil
+}
+
+func readFlywayHistory(ctx context.Context, sqlDB *sql.DB, table string) ([]*flywayHistoryRow, error) {
+	rows, err := sqlDB.QueryContext(ctx, fmt.Sprintf(`SELECT version, type, script, checksum, installed_on, success FROM %s ORDER BY installed_rank`, table))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var history []*flywayHistoryRow
+	for rows.Next() {
+		row := &flywayHistoryRow{}
+		var installedOn any
+		if err := rows.Scan(&row.version, &row.typ, &row.script, &row.checksum, &installedOn, &row.success); err != nil {
+			return nil, err
+		}
+		if row.installedOn, err = parseHistoryTime(installedOn); err != nil {
+			return nil, err
+		}
+		history = append(history, row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return history, nil
+}
+
+func readLiquibaseHistory(ctx context.Context, sqlDB *sql.DB, table string) ([]*liquibaseHistoryRow, error) {
+	rows, err := sqlDB.QueryContext(ctx, fmt.Sprintf(`SELECT ID, FILENAME, MD5SUM, DATEEXECUTED, EXECTYPE FROM %s ORDER BY ORDEREXECUTED`, table))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var history []*liquibaseHistoryRow
+	for rows.Next() {
+		row := &liquibaseHistoryRow{}
+		var dateExecuted any
+		if err := rows.Scan(&row.id, &row.filename, &row.md5sum, &dateExecuted, &row.execType); err != nil {
+			return nil, err
+		}
+		if row.dateExecuted, err = parseHistoryTime(dateExecuted); err != nil {
+			return nil, err
+		}
+		history = append(history, row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return history, nil
+}
+
+// parseHistoryTime parses the timestamp columns, which some drivers return as text.
+func parseHistoryTime(v any) (time.Time, error) {
+	var text string
+	switch t := v.(type) {
+	case time.Time:
+		return t, nil
+	case nil:
+		return time.Time{}, nil
+	case []byte:
+		text = string(t)
+	case string:
+		text = t
+	default:
+		return time.Time{}, errors.Errorf("unexpected time type %T", v)
+	}
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02 15:04:05.999999999", "2006-01-02T15:04:05.999999999"} {
+		if parsed, err := time.Parse(layout, text); err == nil {
+			return parsed, nil
+		}
+	}
+	return time.Time{}, errors.Errorf("failed to parse time %q", text)
+}
+
+// convertFlywayHistory converts the successful Flyway migrations to the revisions.
+// The versioned migrations are keyed by getFlywayReleaseVersion, and the repeatable
+// migrations are keyed by the script name, e.g. R__views for R__views.sql, and keep the latest run.
+func convertFlywayHistory(rows []*flywayHistoryRow) ([]*importedRevision, error) {
+	var revisions []*importedRevision
+	indexes := map[string]int{}
+	// The Flyway versions of the release versions, to reject the Flyway versions mapped to the same release version.
+	flywayVersions := map[string]string{}
+	for _, row := range rows {
+		if !row.success {
+			continue
+		}
+		switch row.typ {
+		case "SCHEMA", "DELETE":
+			// The schema creation and the deletion markers aren't migrations.
+			continue
+		}
+		r := &importedRevision{
+			fileType:    storepb.ReleaseFileType_VERSIONED,
+			appliedTime: row.installedOn,
+		}
+		if row.checksum.Valid {
+			r.checksum = strconv.FormatInt(row.checksum.Int64, 10)
+		}
+		if row.version.Valid && row.version.String != "" {
+			r.version = getFlywayReleaseVersion(row.script)
+			if r.version == "" {
+				return nil, errors.Errorf("cannot get the version of script %q", row.script)
+			}
+			if v, ok := flywayVersions[r.version]; ok && v != row.version.String {
+				return nil, errors.Errorf("flyway versions %q and %q have the same version %q", v, row.version.String, r.version)
+			}
+			flywayVersions[r.version] = row.version.String
+		} else {
+			r.version = strings.TrimSuffix(path.Base(row.script), path.Ext(row.script))
+			r.fileType = storepb.ReleaseFileType_REPEATABLE
+		}
+		if strings.HasPrefix(row.typ, "UNDO_") {
+			// The undo migration reverts the version.
+			if i, ok := indexes[r.version]; ok {
+				revisions[i] = nil
+				delete(indexes, r.version)
+			}
+			continue
+		}
+		if i, ok := indexes[r.version]; ok {
+			revisions[i] = r
+			continue
+		}
+		indexes[r.version] = len(revisions)
+		revisions = append(revisions, r)
+	}
+	return compactImportedRevisions(revisions), nil
+}
+
+// convertLiquibaseHistory converts the executed Liquibase changesets to the revisions.
+// The changesets are grouped by the version of their changelog files, see common.GetReleaseFileVersion.
+// The changesets without a version are ignored.
+func convertLiquibaseHistory(rows []*liquibaseHistoryRow) []*importedRevision {
+	var revisions []*importedRevision
+	indexes := map[string]int{}
+	for _, row := range rows {
+		switch row.execType {
+		case "FAILED", "SKIPPED":
+			continue
+		}
+		version := common.GetReleaseFileVersion(row.filename)
+		if version == "" {
+			continue
+		}
+		r := &importedRevision{
+			version:     version,
+			fileType:    storepb.ReleaseFileType_VERSIONED,
+			checksum:    row.md5sum.String,
+			appliedTime: row.dateExecuted,
+		}
+		if i, ok := indexes[version]; ok {
+			// The file is applied when its last changeset is applied.
+			revisions[i] = r
+			continue
+		}
+		indexes[version] = len(revisions)
+		revisions = append(revisions, r)
+	}
+	return compactImportedRevisions(revisions)
+}
+
+func compactImportedRevisions(revisions []*importedRevision) []*importedRevision {
+	var result []*importedRevision
+	for _, r := range revisions {
+		if r != nil {
+			result = append(result, r)
+		}
+	}
+	// Order the versioned revisions by version, followed by the repeatable revisions in the order they are applied.
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].fileType != result[j].fileType {
+			return result[i].fileType == storepb.ReleaseFileType_VERSIONED
+		}
+		return result[i].fileType == storepb.ReleaseFileType_VERSIONED && result[i].version < result[j].version
+	})
+	return result
+}
+
+// getFlywayReleaseVersion returns the version of the Flyway versioned migration script.
+// The release file of the script is named without the Flyway prefix,
+// e.g. 3__add_table.sql for V3__add_table.sql or U3__add_table.sql, whose version is 3.
+func getFlywayReleaseVersion(script string) string {
+	name := path.Base(script)
+	if strings.HasPrefix(name, "V") || strings.HasPrefix(name, "U") {
+		name = name[1:]
+	}
+	return common.GetReleaseFileVersion(name)
+}
@@ -0,0 +1,116 @@
+package v1
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestGetFlywayReleaseVersion(t *testing.T) {
+	a := require.New(t)
+	tests := map[string]string{
+		"V3__add_table.sql":             "3",
+		"U3__add_table.sql":             "3",
+		"V1_2__add_table.sql":           "1",
+		"db/migration/V0012__users.sql": "0012",
+		"R__views.sql":                  "",
+	}
+	for script, want := range tests {
+		a.Equal(want, getFlywayReleaseVersion(script), script)
+	}
+}
+
+// TestImportedRevisionMatchesReleaseFile checks that the imported revision has the version of the release file,
+// so that the release file is recognized as applied.
+func TestImportedRevisionMatchesReleaseFile(t *testing.T) {
+	a := require.New(t)
+	t1 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		releaseFile     string
+		flywayVersion   string
+		flywayScript    string
+		liquibaseChange string
+	}{
+		{releaseFile: "3_add_table.sql", flywayVersion: "3", flywayScript: "V3_add_table.sql", liquibaseChange: "changelog/3_add_table.sql"},
+		{releaseFile: "0012__users.sql", flywayVersion: "0012", flywayScript: "V0012__users.sql", liquibaseChange: "changelog/0012__users.sql"},
+		{releaseFile: "20240102_orders.sql", flywayVersion: "20240102", flywayScript: "V20240102_orders.sql", liquibaseChange: "db/20240102_orders.sql"},
+	}
+	for _, tc := range tests {
+		// The release and GitOps paths derive the version from the file name.
+		releaseVersion := common.GetReleaseFileVersion(tc.releaseFile)
+		a.NotEmpty(releaseVersion, tc.releaseFile)
+
+		flyway, err := convertFlywayHistory([]*flywayHistoryRow{
+			{version: sql.NullString{String: tc.flywayVersion, Valid: true}, typ: "SQL", script: tc.flywayScript, success: true, installedOn: t1},
+		})
+		a.NoError(err)
+		a.Len(flyway, 1)
+		a.Equal(releaseVersion, flyway[0].version, tc.releaseFile)
+
+		liquibase := convertLiquibaseHistory([]*liquibaseHistoryRow{
+			{id: "1", filename: tc.liquibaseChange, dateExecuted: t1, execType: "EXECUTED"},
+		})
+		a.Len(liquibase, 1)
+		a.Equal(releaseVersion, liquibase[0].version, tc.releaseFile)
+	}
+}
+
+func TestConvertFlywayHistory(t *testing.T) {
+	a := require.New(t)
+	t1 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	t2 := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	version := func(v string) sql.NullString {
+		return sql.NullString{String: v, Valid: true}
+	}
+	checksum := func(v int64) sql.NullInt64 {
+		return sql.NullInt64{Int64: v, Valid: true}
+	}
+	rows := []*flywayHistoryRow{
+		{version: version("0"), typ: "SCHEMA", script: "<< Flyway Schema Creation >>", success: true, installedOn: t1},
+		{version: version("10"), typ: "SQL", script: "V10__orders.sql", checksum: checksum(10), success: true, installedOn: t1},
+		{version: version("9"), typ: "SQL", script: "V9__users.sql", checksum: checksum(9), success: true, installedOn: t1},
+		{typ: "SQL", script: "R__views.sql", checksum: checksum(1), success: true, installedOn: t1},
+		{version: version("11"), typ: "SQL", script: "V11__failed.sql", success: false, installedOn: t1},
+		{version: version("10"), typ: "UNDO_SQL", script: "U10__orders.sql", success: true, installedOn: t2},
+		{typ: "SQL", script: "R__views.sql", checksum: checksum(2), success: true, installedOn: t2},
+	}
+	got, err := convertFlywayHistory(rows)
+	a.NoError(err)
+	a.Equal([]*importedRevision{
+		{version: "9", fileType: storepb.ReleaseFileType_VERSIONED, checksum: "9", appliedTime: t1},
+		{version: "R__views", fileType: storepb.ReleaseFileType_REPEATABLE, checksum: "2", appliedTime: t2},
+	}, got)
+
+	// The Flyway versions with the same leading number are ambiguous.
+	_, err = convertFlywayHistory([]*flywayHistoryRow{
+		{version: version("1.1"), typ: "SQL", script: "V1_1__users.sql", success: true, installedOn: t1},
+		{version: version("1.2"), typ: "SQL", script: "V1_2__orders.sql", success: true, installedOn: t1},
+	})
+	a.Error(err)
+}
+
+func TestConvertLiquibaseHistory(t *testing.T) {
+	a := require.New(t)
+	t1 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	t2 := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	md5sum := func(v string) sql.NullString {
+		return sql.NullString{String: v, Valid: true}
+	}
+	rows := []*liquibaseHistoryRow{
+		{id: "1", filename: "changelog/0002_orders.sql", md5sum: md5sum("9:b1"), dateExecuted: t1, execType: "EXECUTED"},
+		{id: "2", filename: "changelog/0002_orders.sql", md5sum: md5sum("9:b2"), dateExecuted: t2, execType: "EXECUTED"},
+		{id: "1", filename: "changelog/0001_users.sql", md5sum: md5sum("9:a1"), dateExecuted: t1, execType: "MARK_RAN"},
+		{id: "seed", filename: "changelog/seed.xml", dateExecuted: t1, execType: "EXECUTED"},
+		{id: "3", filename: "changelog/0003_index.sql", dateExecuted: t2, execType: "FAILED"},
+	}
+	got := convertLiquibaseHistory(rows)
+	a.Equal([]*importedRevision{
+		{version: "0001", fileType: storepb.ReleaseFileType_VERSIONED, checksum: "9:a1", appliedTime: t1},
+		{version: "0002", fileType: storepb.ReleaseFileType_VERSIONED, checksum: "9:b2", appliedTime: t2},
+	}, got)
+}
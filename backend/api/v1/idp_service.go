@@ -18,6 +18,7 @@ import (
 	"github.com/bytebase/bytebase/backend/plugin/idp/ldap"
 	"github.com/bytebase/bytebase/backend/plugin/idp/oauth2"
 	"github.com/bytebase/bytebase/backend/plugin/idp/oidc"
+	"github.com/bytebase/bytebase/backend/plugin/idp/saml"
 	"github.com/bytebase/bytebase/backend/store"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
@@ -102,6 +103,18 @@ func (s *IdentityProviderService) CreateIdentityProvider(ctx context.Context, re
 		Type:       storepb.IdentityProviderType(request.IdentityProvider.Type),
 		Config:     convertIdentityProviderConfigToStore(request.IdentityProvider.GetConfig()),
 	}
+	if samlConfig := identityProviderMessage.Config.GetSamlConfig(); samlConfig != nil {
+		// Generate the key pair for Bytebase as the service provider to sign the AuthnRequest.
+		spCertificate, spPrivateKey, err := saml.GenerateServiceProviderKeyPair(saml.GetEntityID(setting.ExternalUrl, request.IdentityProviderId))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to generate service provider key pair: %v", err)
+		}
+		samlConfig.SpCertificate = spCertificate
+		samlConfig.SpPrivateKey = spPrivateKey
+		if _, err := saml.NewIdentityProviderWithExternalURL(setting.ExternalUrl, request.IdentityProviderId, samlConfig); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid SAML identity provider config: %v", err)
+		}
+	}
 	if request.ValidateOnly {
 		identityProvider, err := convertToIdentityProvider(identityProviderMessage)
 		if err != nil {
@@ -174,6 +187,10 @@ func (s *IdentityProviderService) UpdateIdentityProvider(ctx context.Context, re
 			if request.IdentityProvider.Config.GetLdapConfig().BindPassword == "" {
 				patch.Config.GetLdapConfig().BindPassword = identityProviderMessage.Config.GetLdapConfig().BindPassword
 			}
+		} else if identityProviderMessage.Type == storepb.IdentityProviderType_SAML {
+			// The service provider key pair is generated on creation and never updated.
+			patch.Config.GetSamlConfig().SpCertificate = identityProviderMessage.Config.GetSamlConfig().SpCertificate
+			patch.Config.GetSamlConfig().SpPrivateKey = identityProviderMessage.Config.GetSamlConfig().SpPrivateKey
 		}
 	}
 
@@ -373,6 +390,22 @@ func (s *IdentityProviderService) TestIdentityProvider(ctx context.Context, requ
 			return nil, status.Errorf(codes.InvalidArgument, "failed to test connection, error: %s", err.Error())
 		}
 		_ = conn.Close()
+	} else if identityProvider.Type == v1pb.IdentityProviderType_SAML {
+		// SAML sign-in requires the browser redirection, so we only validate the config here.
+		identityProviderConfig := convertIdentityProviderConfigToStore(identityProvider.Config).GetSamlConfig()
+		identityProviderID, err := common.GetIdentityProviderID(identityProvider.Name)
+		if err != nil {
+			identityProviderID = "test"
+		}
+		spCertificate, spPrivateKey, err := saml.GenerateServiceProviderKeyPair(saml.GetEntityID(setting.ExternalUrl, identityProviderID))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to generate service provider key pair: %v", err)
+		}
+		identityProviderConfig.SpCertificate = spCertificate
+		identityProviderConfig.SpPrivateKey = spPrivateKey
+		if _, err := saml.NewIdentityProviderWithExternalURL(setting.ExternalUrl, identityProviderID, identityProviderConfig); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid SAML identity provider config, error: %s", err.Error())
+		}
 	} else {
 		return nil, status.Errorf(codes.InvalidArgument, "identity provider type %s not supported", identityProvider.Type.String())
 	}
@@ -492,6 +525,26 @@ func convertIdentityProviderConfigFromStore(identityProviderConfig *storepb.Iden
 				},
 			},
 		}, nil
+	} else if v := identityProviderConfig.GetSamlConfig(); v != nil {
+		fieldMapping := v1pb.FieldMapping{
+			Identifier:  v.FieldMapping.Identifier,
+			DisplayName: v.FieldMapping.DisplayName,
+			Email:       v.FieldMapping.Email,
+			Phone:       v.FieldMapping.Phone,
+			Groups:      v.FieldMapping.Groups,
+		}
+		return &v1pb.IdentityProviderConfig{
+			Config: &v1pb.IdentityProviderConfig_SamlConfig{
+				SamlConfig: &v1pb.SAMLIdentityProviderConfig{
+					IdpEntityId:    v.IdpEntityId,
+					SsoUrl:         v.SsoUrl,
+					IdpCertificate: v.IdpCertificate,
+					NameIdFormat:   v.NameIdFormat,
+					FieldMapping:   &fieldMapping,
+					SpCertificate:  v.SpCertificate, // SECURITY: We only expose the certificate but the private key.
+				},
+			},
+		}, nil
 	}
 	return nil, nil
 }
@@ -560,6 +613,25 @@ func convertIdentityProviderConfigToStore(identityProviderConfig *v1pb.IdentityP
 				},
 			},
 		}
+	} else if v := identityProviderConfig.GetSamlConfig(); v != nil {
+		fieldMapping := storepb.FieldMapping{
+			Identifier:  v.FieldMapping.Identifier,
+			DisplayName: v.FieldMapping.DisplayName,
+			Email:       v.FieldMapping.Email,
+			Phone:       v.FieldMapping.Phone,
+			Groups:      v.FieldMapping.Groups,
+		}
+		return &storepb.IdentityProviderConfig{
+			Config: &storepb.IdentityProviderConfig_SamlConfig{
+				SamlConfig: &storepb.SAMLIdentityProviderConfig{
+					IdpEntityId:    v.IdpEntityId,
+					SsoUrl:         v.SsoUrl,
+					IdpCertificate: v.IdpCertificate,
+					NameIdFormat:   v.NameIdFormat,
+					FieldMapping:   &fieldMapping,
+				},
+			},
+		}
 	}
 	return nil
 }
@@ -578,6 +650,10 @@ func validIdentityProviderConfig(identityProviderType v1pb.IdentityProviderType,
 		if identityProviderConfig.GetLdapConfig() == nil {
 			return errors.Errorf("unexpected provider config value")
 		}
+	} else if identityProviderType == v1pb.IdentityProviderType_SAML {
+		if identityProviderConfig.GetSamlConfig() == nil {
+			return errors.Errorf("unexpected provider config value")
+		}
 	} else {
 		return errors.Errorf("unexpected provider type %s", identityProviderType)
 	}
@@ -332,6 +332,14 @@ func (s *InstanceService) UpdateInstance(ctx context.Context, request *v1pb.Upda
 				patch.OptionsUpsert = instance.Options
 			}
 			patch.OptionsUpsert.MaximumConnections = request.Instance.Options.GetMaximumConnections()
+		case "options.maximum_concurrent_task_runs":
+			if request.Instance.Options.GetMaximumConcurrentTaskRuns() < 0 {
+				return nil, status.Errorf(codes.InvalidArgument, "maximum concurrent task runs must not be negative")
+			}
+			if patch.OptionsUpsert == nil {
+				patch.OptionsUpsert = instance.Options
+			}
+			patch.OptionsUpsert.MaximumConcurrentTaskRuns = request.Instance.Options.GetMaximumConcurrentTaskRuns()
 		case "options.sync_databases":
 			if err := s.licenseService.IsFeatureEnabledForInstance(api.FeatureCustomInstanceSynchronization, instance); err != nil {
 				return nil, status.Error(codes.PermissionDenied, err.Error())
@@ -1465,9 +1473,10 @@ func convertToInstanceOptions(options *storepb.InstanceOptions) *v1pb.InstanceOp
 	}
 
 	return &v1pb.InstanceOptions{
-		SyncInterval:       options.SyncInterval,
-		MaximumConnections: options.MaximumConnections,
-		SyncDatabases:      options.GetSyncDatabases(),
+		SyncInterval:              options.SyncInterval,
+		MaximumConnections:        options.MaximumConnections,
+		SyncDatabases:             options.GetSyncDatabases(),
+		MaximumConcurrentTaskRuns: options.GetMaximumConcurrentTaskRuns(),
 	}
 }
 
@@ -1477,8 +1486,9 @@ func convertInstanceOptions(options *v1pb.InstanceOptions) *storepb.InstanceOpti
 	}
 
 	return &storepb.InstanceOptions{
-		SyncInterval:       options.SyncInterval,
-		MaximumConnections: options.MaximumConnections,
-		SyncDatabases:      options.GetSyncDatabases(),
+		SyncInterval:              options.SyncInterval,
+		MaximumConnections:        options.MaximumConnections,
+		SyncDatabases:             options.GetSyncDatabases(),
+		MaximumConcurrentTaskRuns: options.GetMaximumConcurrentTaskRuns(),
 	}
 }
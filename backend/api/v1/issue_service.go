@@ -31,6 +31,11 @@ import (
 	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
 )
 
+const (
+	minJITAccessDuration = 5 * time.Minute
+	maxJITAccessDuration = 24 * time.Hour
+)
+
 // IssueService implements the issue service.
 type IssueService struct {
 	v1pb.UnimplementedIssueServiceServer
@@ -42,6 +47,7 @@ type IssueService struct {
 	profile        *config.Profile
 	iamManager     *iam.Manager
 	metricReporter *metricreport.Reporter
+	secret         string
 }
 
 // NewIssueService creates a new IssueService.
@@ -54,6 +60,7 @@ func NewIssueService(
 	profile *config.Profile,
 	iamManager *iam.Manager,
 	metricReporter *metricreport.Reporter,
+	secret string,
 ) *IssueService {
 	return &IssueService{
 		store:          store,
@@ -64,6 +71,7 @@ func NewIssueService(
 		profile:        profile,
 		iamManager:     iamManager,
 		metricReporter: metricReporter,
+		secret:         secret,
 	}
 }
 
@@ -401,6 +409,8 @@ func (s *IssueService) CreateIssue(ctx context.Context, request *v1pb.CreateIssu
 		return s.createIssueDatabaseChange(ctx, request)
 	case v1pb.Issue_DATABASE_DATA_EXPORT:
 		return s.createIssueDatabaseDataExport(ctx, request)
+	case v1pb.Issue_JIT_ACCESS:
+		return s.createIssueJITAccess(ctx, request)
 	default:
 		return nil, status.Errorf(codes.InvalidArgument, "unknown issue type %q", request.Issue.Type)
 	}
@@ -683,6 +693,134 @@ func (s *IssueService) createIssueDatabaseDataExport(ctx context.Context, reques
 	return converted, nil
 }
 
+func (s *IssueService) createIssueJITAccess(ctx context.Context, request *v1pb.CreateIssueRequest) (*v1pb.Issue, error) {
+	user, ok := ctx.Value(common.UserContextKey).(*store.UserMessage)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "user not found")
+	}
+	projectID, err := common.GetProjectID(request.Parent)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{
+		ResourceID: &projectID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get project, error: %v", err)
+	}
+	if project == nil {
+		return nil, status.Errorf(codes.NotFound, "project not found for id: %v", projectID)
+	}
+
+	jitAccessRequest := request.Issue.JitAccessRequest
+	if jitAccessRequest == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "expect JIT access request")
+	}
+	// The credential is only revealed to the requester, so the users cannot request the access for others.
+	if jitAccessRequest.User == "" {
+		jitAccessRequest.User = common.FormatUserEmail(user.Email)
+	}
+	if jitAccessRequest.User != common.FormatUserEmail(user.Email) {
+		return nil, status.Errorf(codes.InvalidArgument, "JIT access can only be requested for yourself")
+	}
+	if len(jitAccessRequest.Privileges) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "expect JIT access privileges")
+	}
+	for _, privilege := range jitAccessRequest.Privileges {
+		if privilege == v1pb.JITAccessRequest_PRIVILEGE_UNSPECIFIED {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid JIT access privilege %v", privilege)
+		}
+	}
+	if jitAccessRequest.Duration == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "expect JIT access duration")
+	}
+	if d := jitAccessRequest.Duration.AsDuration(); d < minJITAccessDuration || d > maxJITAccessDuration {
+		return nil, status.Errorf(codes.InvalidArgument, "JIT access duration must be between %v and %v", minJITAccessDuration, maxJITAccessDuration)
+	}
+
+	instanceID, databaseName, err := common.GetInstanceDatabaseID(jitAccessRequest.Database)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &instanceID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get instance, error: %v", err)
+	}
+	if instance == nil {
+		return nil, status.Errorf(codes.NotFound, "instance %q not found", instanceID)
+	}
+	if instance.Engine != storepb.Engine_MYSQL && instance.Engine != storepb.Engine_MARIADB && instance.Engine != storepb.Engine_POSTGRES {
+		return nil, status.Errorf(codes.InvalidArgument, "JIT access is not supported for engine %v", instance.Engine)
+	}
+	database, err := s.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
+		InstanceID:   &instanceID,
+		DatabaseName: &databaseName,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get database, error: %v", err)
+	}
+	if database == nil {
+		return nil, status.Errorf(codes.NotFound, "database %q not found", jitAccessRequest.Database)
+	}
+	if database.ProjectID != project.ResourceID {
+		return nil, status.Errorf(codes.InvalidArgument, "database %q does not belong to project %q", jitAccessRequest.Database, project.ResourceID)
+	}
+
+	issueCreateMessage := &store.IssueMessage{
+		Project:     project,
+		PlanUID:     nil,
+		PipelineUID: nil,
+		Title:       request.Issue.Title,
+		Status:      api.IssueOpen,
+		Type:        api.IssueDatabaseJITAccess,
+		Description: request.Issue.Description,
+	}
+
+	convertedJITAccessRequest, err := convertJITAccessRequest(ctx, s.store, jitAccessRequest)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert JITAccessRequest, error: %v", err)
+	}
+
+	issueCreateMessage.Payload = &storepb.IssuePayload{
+		JitAccessRequest: convertedJITAccessRequest,
+		Approval: &storepb.IssuePayloadApproval{
+			ApprovalFindingDone: false,
+			ApprovalTemplates:   nil,
+			Approvers:           nil,
+		},
+		Labels: request.Issue.Labels,
+	}
+
+	issue, err := s.store.CreateIssueV2(ctx, issueCreateMessage, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create issue, error: %v", err)
+	}
+	s.stateCfg.ApprovalFinding.Store(issue.UID, issue)
+
+	s.webhookManager.CreateEvent(ctx, &webhook.Event{
+		Actor:   user,
+		Type:    webhook.EventTypeIssueCreate,
+		Comment: "",
+		Issue:   webhook.NewIssue(issue),
+		Project: webhook.NewProject(issue.Project),
+	})
+
+	converted, err := s.convertToIssue(ctx, issue)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert to issue, error: %v", err)
+	}
+
+	s.metricReporter.Report(ctx, &metric.Metric{
+		Name:  metricapi.IssueCreateMetricName,
+		Value: 1,
+		Labels: map[string]any{
+			"type": issue.Type,
+		},
+	})
+
+	return converted, nil
+}
+
 // ApproveIssue approves the approval flow of the issue.
 func (s *IssueService) ApproveIssue(ctx context.Context, request *v1pb.ApproveIssueRequest) (*v1pb.Issue, error) {
 	issue, err := s.getIssueMessage(ctx, request.Name)
@@ -1126,6 +1264,71 @@ func (s *IssueService) RequestIssue(ctx context.Context, request *v1pb.RequestIs
 	return issueV1, nil
 }
 
+// RevealJITAccessCredential reveals the database credential of the JIT access issue to the requester.
+// The obfuscated password is removed from the issue once revealed.
+func (s *IssueService) RevealJITAccessCredential(ctx context.Context, request *v1pb.RevealJITAccessCredentialRequest) (*v1pb.RevealJITAccessCredentialResponse, error) {
+	user, ok := ctx.Value(common.UserContextKey).(*store.UserMessage)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "user not found")
+	}
+	issue, err := s.getIssueMessage(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if issue.Type != api.IssueDatabaseJITAccess {
+		return nil, status.Errorf(codes.InvalidArgument, "issue %q is not a JIT access issue", request.Name)
+	}
+	jitAccessRequest := issue.Payload.JitAccessRequest
+	if jitAccessRequest.GetUser() != common.FormatUserUID(user.ID) {
+		return nil, status.Errorf(codes.PermissionDenied, "only the requester can reveal the JIT access credential")
+	}
+	credential := jitAccessRequest.GetCredential()
+	if credential.GetState() != storepb.JITAccessCredential_ACTIVE {
+		return nil, status.Errorf(codes.FailedPrecondition, "JIT access credential is not active")
+	}
+	if credential.PasswordRevealed {
+		return nil, status.Errorf(codes.FailedPrecondition, "JIT access credential has already been revealed")
+	}
+
+	instanceID, databaseName, err := common.GetInstanceDatabaseID(jitAccessRequest.Database)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &instanceID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get instance, error: %v", err)
+	}
+	if instance == nil {
+		return nil, status.Errorf(codes.NotFound, "instance %q not found", instanceID)
+	}
+	dataSource := utils.DataSourceFromInstanceWithType(instance, api.Admin)
+	if dataSource == nil {
+		return nil, status.Errorf(codes.Internal, "admin data source not found for instance %q", instanceID)
+	}
+
+	// The concurrent requests race on the conditional update so that only one of them gets the password.
+	obfuscatedPassword, ok, err := s.store.RevealJITAccessCredential(ctx, issue.UID, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update issue, error: %v", err)
+	}
+	if !ok {
+		return nil, status.Errorf(codes.FailedPrecondition, "JIT access credential has already been revealed or is not active")
+	}
+	password, err := common.Unobfuscate(obfuscatedPassword, s.secret)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to unobfuscate the credential password, error: %v", err)
+	}
+
+	return &v1pb.RevealJITAccessCredentialResponse{
+		Host:       dataSource.Host,
+		Port:       dataSource.Port,
+		Database:   databaseName,
+		Username:   credential.Username,
+		Password:   password,
+		ExpireTime: credential.ExpireTime,
+	}, nil
+}
+
 // UpdateIssue updates the issue.
 func (s *IssueService) UpdateIssue(ctx context.Context, request *v1pb.UpdateIssueRequest) (*v1pb.Issue, error) {
 	user, ok := ctx.Value(common.UserContextKey).(*store.UserMessage)
@@ -1328,6 +1531,11 @@ func (s *IssueService) BatchUpdateIssuesStatus(ctx context.Context, request *v1p
 		issueIDs = append(issueIDs, issue.UID)
 		issues = append(issues, issue)
 
+		// The active JIT access credential is revoked by the runner when the issue is canceled.
+		if issue.Type == api.IssueDatabaseJITAccess && request.Status == v1pb.IssueStatus_DONE && issue.Payload.GetJitAccessRequest().GetCredential().GetState() == storepb.JITAccessCredential_ACTIVE {
+			return nil, status.Errorf(codes.FailedPrecondition, "cannot resolve issue %q with an active JIT access credential, cancel the issue to revoke the credential", issueName)
+		}
+
 		// Check if there is any running/pending task runs.
 		if issue.PipelineUID != nil {
 			taskRunStatusList := []api.TaskRunStatus{api.TaskRunRunning, api.TaskRunPending}
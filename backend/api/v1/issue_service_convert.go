@@ -34,6 +34,10 @@ func (s *IssueService) convertToIssue(ctx context.Context, issue *store.IssueMes
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to convert GrantRequest")
 	}
+	convertedJITAccessRequest, err := convertToJITAccessRequest(ctx, s.store, issuePayload.JitAccessRequest)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to convert JITAccessRequest")
+	}
 
 	releasers, err := s.convertToIssueReleasers(ctx, issue)
 	if err != nil {
@@ -61,6 +65,7 @@ func (s *IssueService) convertToIssue(ctx context.Context, issue *store.IssueMes
 		RiskLevel:            v1pb.Issue_RISK_LEVEL_UNSPECIFIED,
 		TaskStatusCount:      issue.TaskStatusCount,
 		Labels:               issuePayload.Labels,
+		JitAccessRequest:     convertedJITAccessRequest,
 	}
 
 	if issue.PlanUID != nil {
@@ -161,6 +166,8 @@ func convertToIssueType(t api.IssueType) v1pb.Issue_Type {
 		return v1pb.Issue_GRANT_REQUEST
 	case api.IssueDatabaseDataExport:
 		return v1pb.Issue_DATABASE_DATA_EXPORT
+	case api.IssueDatabaseJITAccess:
+		return v1pb.Issue_JIT_ACCESS
 	default:
 		return v1pb.Issue_TYPE_UNSPECIFIED
 	}
@@ -174,6 +181,8 @@ func convertToAPIIssueType(t v1pb.Issue_Type) (api.IssueType, error) {
 		return api.IssueGrantRequest, nil
 	case v1pb.Issue_DATABASE_DATA_EXPORT:
 		return api.IssueDatabaseDataExport, nil
+	case v1pb.Issue_JIT_ACCESS:
+		return api.IssueDatabaseJITAccess, nil
 	default:
 		return api.IssueType(""), errors.Errorf("invalid issue type %v", t)
 	}
@@ -329,6 +338,69 @@ func convertGrantRequest(ctx context.Context, s *store.Store, v *v1pb.GrantReque
 	}, nil
 }
 
+func convertToJITAccessRequest(ctx context.Context, s *store.Store, v *storepb.JITAccessRequest) (*v1pb.JITAccessRequest, error) {
+	if v == nil {
+		return nil, nil
+	}
+	uid, err := common.GetUserID(v.User)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get user uid from %q", v.User)
+	}
+	user, err := s.GetUserByID(ctx, uid)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get user by uid %q", uid)
+	}
+	if user == nil {
+		return nil, errors.Errorf("user %q not found", v.User)
+	}
+	request := &v1pb.JITAccessRequest{
+		Database: v.Database,
+		Duration: v.Duration,
+		User:     common.FormatUserEmail(user.Email),
+	}
+	for _, privilege := range v.Privileges {
+		request.Privileges = append(request.Privileges, v1pb.JITAccessRequest_Privilege(privilege))
+	}
+	if c := v.Credential; c != nil {
+		request.Credential = &v1pb.JITAccessCredential{
+			State:            v1pb.JITAccessCredential_State(c.State),
+			Username:         c.Username,
+			PasswordRevealed: c.PasswordRevealed,
+			CreateTime:       c.CreateTime,
+			ExpireTime:       c.ExpireTime,
+			RevokeTime:       c.RevokeTime,
+			Error:            c.Error,
+		}
+	}
+	return request, nil
+}
+
+func convertJITAccessRequest(ctx context.Context, s *store.Store, v *v1pb.JITAccessRequest) (*storepb.JITAccessRequest, error) {
+	if v == nil {
+		return nil, nil
+	}
+	email, err := common.GetUserEmail(v.User)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get user email from %q", v.User)
+	}
+	user, err := s.GetUserByEmail(ctx, email)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get user by email %q", email)
+	}
+	if user == nil {
+		return nil, errors.Errorf("user %q not found", v.User)
+	}
+	request := &storepb.JITAccessRequest{
+		Database: v.Database,
+		Duration: v.Duration,
+		User:     common.FormatUserUID(user.ID),
+	}
+	for _, privilege := range v.Privileges {
+		request.Privileges = append(request.Privileges, storepb.JITAccessRequest_Privilege(privilege))
+	}
+	return request, nil
+}
+
 func convertToIssueComments(issueName string, issueComments []*store.IssueCommentMessage) []*v1pb.IssueComment {
 	var res []*v1pb.IssueComment
 	for _, ic := range issueComments {
@@ -357,6 +429,8 @@ func convertToIssueComment(issueName string, ic *store.IssueCommentMessage) *v1p
 		r.Event = convertToIssueCommentEventTaskUpdate(e)
 	case *storepb.IssueCommentPayload_TaskPriorBackup_:
 		r.Event = convertToIssueCommentEventTaskPriorBackup(e)
+	case *storepb.IssueCommentPayload_AtomicRolloutRevert_:
+		r.Event = convertToIssueCommentEventAtomicRolloutRevert(e)
 	}
 
 	return r
@@ -506,3 +580,22 @@ func convertToIssueCommentEventTaskPriorBackupTables(tables []*storepb.IssueComm
 	}
 	return r
 }
+
+func convertToIssueCommentEventAtomicRolloutRevert(a *storepb.IssueCommentPayload_AtomicRolloutRevert_) *v1pb.IssueComment_AtomicRolloutRevert_ {
+	var tenants []*v1pb.IssueComment_AtomicRolloutRevert_Tenant
+	for _, t := range a.AtomicRolloutRevert.Tenants {
+		tenants = append(tenants, &v1pb.IssueComment_AtomicRolloutRevert_Tenant{
+			Task:      t.Task,
+			Status:    v1pb.IssueComment_AtomicRolloutRevert_Tenant_Status(t.Status),
+			Statement: t.Statement,
+			Error:     t.Error,
+		})
+	}
+	return &v1pb.IssueComment_AtomicRolloutRevert_{
+		AtomicRolloutRevert: &v1pb.IssueComment_AtomicRolloutRevert{
+			DatabaseGroup: a.AtomicRolloutRevert.DatabaseGroup,
+			FailedTask:    a.AtomicRolloutRevert.FailedTask,
+			Tenants:       tenants,
+		},
+	}
+}
@@ -1,6 +1,8 @@
 package v1
 
 import (
+	"encoding/hex"
+	"log/slog"
 	"reflect"
 	"time"
 
@@ -9,6 +11,7 @@ import (
 	"google.golang.org/genproto/googleapis/type/expr"
 
 	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/store"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 )
@@ -17,12 +20,15 @@ type maskingLevelEvaluator struct {
 	maskingRules            []*storepb.MaskingRulePolicy_MaskingRule
 	dataClassificationIDMap map[string]*storepb.DataClassificationSetting_DataClassificationConfig
 	semanticTypesMap        map[string]*storepb.SemanticTypeSetting_SemanticType
+	// maskingKeys is the map from the masking key id to the key material.
+	maskingKeys map[string][]byte
 }
 
 func newEmptyMaskingLevelEvaluator() *maskingLevelEvaluator {
 	return &maskingLevelEvaluator{
 		dataClassificationIDMap: make(map[string]*storepb.DataClassificationSetting_DataClassificationConfig),
 		semanticTypesMap:        make(map[string]*storepb.SemanticTypeSetting_SemanticType),
+		maskingKeys:             make(map[string][]byte),
 	}
 }
 
@@ -59,6 +65,26 @@ func (m *maskingLevelEvaluator) withSemanticTypeSetting(semanticTypeSetting *sto
 	return m
 }
 
+func (m *maskingLevelEvaluator) withMaskingKeySetting(maskingKeySetting *storepb.MaskingKeySetting, secret string) *maskingLevelEvaluator {
+	if maskingKeySetting == nil {
+		return m
+	}
+	for _, key := range maskingKeySetting.Keys {
+		encoded, err := common.Unobfuscate(key.ObfuscatedKey, secret)
+		if err != nil {
+			slog.Warn("failed to unobfuscate masking key", slog.String("id", key.Id), log.BBError(err))
+			continue
+		}
+		material, err := hex.DecodeString(encoded)
+		if err != nil {
+			slog.Warn("failed to decode masking key", slog.String("id", key.Id), log.BBError(err))
+			continue
+		}
+		m.maskingKeys[key.Id] = material
+	}
+	return m
+}
+
 func (m *maskingLevelEvaluator) getDataClassificationConfig(classificationID string) *storepb.DataClassificationSetting_DataClassificationConfig {
 	return m.dataClassificationIDMap[classificationID]
 }
@@ -513,6 +513,33 @@ func validatePolicyPayload(policyType api.PolicyType, policy *v1pb.Policy) error
 				return err
 			}
 		}
+	case api.PolicyTypeRowFilter:
+		rowFilterPolicy, ok := policy.Policy.(*v1pb.Policy_RowFilterPolicy)
+		if !ok {
+			return status.Errorf(codes.InvalidArgument, "unmatched policy type %v and policy %v", policyType, policy.Policy)
+		}
+		if rowFilterPolicy.RowFilterPolicy == nil {
+			return status.Errorf(codes.InvalidArgument, "row filter policy must be set")
+		}
+		for _, rule := range rowFilterPolicy.RowFilterPolicy.Rules {
+			if _, _, err := common.GetInstanceDatabaseID(rule.Database); err != nil {
+				return status.Errorf(codes.InvalidArgument, "invalid database %q in row filter rule: %v", rule.Database, err)
+			}
+			if rule.Table == "" {
+				return status.Errorf(codes.InvalidArgument, "row filter rule must have table set")
+			}
+			if err := validateRowFilterPredicate(rule.Predicate); err != nil {
+				return status.Errorf(codes.InvalidArgument, "invalid row filter predicate %q: %v", rule.Predicate, err)
+			}
+			if len(rule.Members) == 0 {
+				return status.Errorf(codes.InvalidArgument, "row filter rule must have members set")
+			}
+			for _, member := range rule.Members {
+				if err := validateMember(member); err != nil {
+					return err
+				}
+			}
+		}
 	default:
 	}
 	return nil
@@ -626,6 +653,39 @@ func (s *OrgPolicyService) convertPolicyPayloadToString(ctx context.Context, pol
 			return "", errors.Wrap(err, "failed to marshal data source query policy")
 		}
 		return string(payloadBytes), nil
+	case v1pb.PolicyType_TASK_RUN_CONCURRENCY:
+		payload, err := convertToTaskRunConcurrencyPayload(policy.GetTaskRunConcurrencyPolicy())
+		if err != nil {
+			return "", status.Error(codes.InvalidArgument, err.Error())
+		}
+		payloadBytes, err := protojson.Marshal(payload)
+		if err != nil {
+			return "", errors.Wrap(err, "failed to marshal task run concurrency policy")
+		}
+		return string(payloadBytes), nil
+	case v1pb.PolicyType_ROW_FILTER:
+		if err := s.licenseService.IsFeatureEnabled(api.FeatureSensitiveData); err != nil {
+			return "", status.Error(codes.PermissionDenied, err.Error())
+		}
+		payload, err := s.convertToStorePBRowFilterPolicyPayload(ctx, policy.GetRowFilterPolicy())
+		if err != nil {
+			return "", err
+		}
+		payloadBytes, err := protojson.Marshal(payload)
+		if err != nil {
+			return "", errors.Wrap(err, "failed to marshal row filter policy")
+		}
+		return string(payloadBytes), nil
+	case v1pb.PolicyType_DRY_RUN:
+		payload, err := s.convertToStorePBDryRunPolicyPayload(ctx, policy.GetDryRunPolicy())
+		if err != nil {
+			return "", err
+		}
+		payloadBytes, err := protojson.Marshal(payload)
+		if err != nil {
+			return "", errors.Wrap(err, "failed to marshal dry run policy")
+		}
+		return string(payloadBytes), nil
 	}
 
 	return "", status.Errorf(codes.InvalidArgument, "invalid policy %v", policy.Type)
@@ -730,6 +790,29 @@ func (s *OrgPolicyService) convertToPolicy(ctx context.Context, parentPath strin
 			return nil, err
 		}
 		policy.Policy = payload
+	case api.PolicyTypeTaskRunConcurrency:
+		pType = v1pb.PolicyType_TASK_RUN_CONCURRENCY
+		payload, err := convertToV1PBTaskRunConcurrencyPolicy(policyMessage.Payload)
+		if err != nil {
+			return nil, err
+		}
+		policy.Policy = payload
+	case api.PolicyTypeRowFilter:
+		pType = v1pb.PolicyType_ROW_FILTER
+		rowFilterPolicy := &storepb.RowFilterPolicy{}
+		if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(policyMessage.Payload), rowFilterPolicy); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal row filter policy")
+		}
+		policy.Policy = &v1pb.Policy_RowFilterPolicy{
+			RowFilterPolicy: s.convertToV1PBRowFilterPolicyPayload(ctx, rowFilterPolicy),
+		}
+	case api.PolicyTypeDryRun:
+		pType = v1pb.PolicyType_DRY_RUN
+		payload, err := convertToV1PBDryRunPolicy(policyMessage.Payload)
+		if err != nil {
+			return nil, err
+		}
+		policy.Policy = payload
 	}
 
 	policy.Type = pType
@@ -1018,6 +1101,115 @@ func convertToDataSourceQueryPayload(policy *v1pb.DataSourceQueryPolicy) (*store
 	}, nil
 }
 
+func convertToV1PBTaskRunConcurrencyPolicy(payloadStr string) (*v1pb.Policy_TaskRunConcurrencyPolicy, error) {
+	payload := &storepb.TaskRunConcurrencyPolicy{}
+	if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(payloadStr), payload); err != nil {
+		return nil, err
+	}
+
+	return &v1pb.Policy_TaskRunConcurrencyPolicy{
+		TaskRunConcurrencyPolicy: &v1pb.TaskRunConcurrencyPolicy{
+			MaximumConcurrentTaskRuns: payload.MaximumConcurrentTaskRuns,
+		},
+	}, nil
+}
+
+func convertToV1PBDryRunPolicy(payloadStr string) (*v1pb.Policy_DryRunPolicy, error) {
+	payload := &storepb.DryRunPolicy{}
+	if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(payloadStr), payload); err != nil {
+		return nil, err
+	}
+
+	policy := &v1pb.DryRunPolicy{
+		Enabled: payload.Enabled,
+	}
+	if payload.SandboxInstance != "" {
+		policy.SandboxInstance = common.FormatInstance(payload.SandboxInstance)
+	}
+	return &v1pb.Policy_DryRunPolicy{
+		DryRunPolicy: policy,
+	}, nil
+}
+
+// convertToStorePBDryRunPolicyPayload converts the dry run policy, checking the sandbox instance exists and supports the schema-only clones.
+func (s *OrgPolicyService) convertToStorePBDryRunPolicyPayload(ctx context.Context, policy *v1pb.DryRunPolicy) (*storepb.DryRunPolicy, error) {
+	payload := &storepb.DryRunPolicy{
+		Enabled: policy.GetEnabled(),
+	}
+	if policy.GetSandboxInstance() != "" {
+		instanceID, err := common.GetInstanceID(policy.GetSandboxInstance())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid sandbox instance %q, error: %v", policy.GetSandboxInstance(), err)
+		}
+		instance, err := s.findActiveInstance(ctx, &store.FindInstanceMessage{ResourceID: &instanceID})
+		if err != nil {
+			return nil, err
+		}
+		if !common.DryRunCloneEngines[instance.Engine] {
+			return nil, status.Errorf(codes.InvalidArgument, "sandbox instance %q of engine %v is not supported", policy.GetSandboxInstance(), instance.Engine)
+		}
+		payload.SandboxInstance = instanceID
+	}
+	return payload, nil
+}
+
+func convertToTaskRunConcurrencyPayload(policy *v1pb.TaskRunConcurrencyPolicy) (*storepb.TaskRunConcurrencyPolicy, error) {
+	if policy.GetMaximumConcurrentTaskRuns() < 0 {
+		return nil, errors.Errorf("maximum concurrent task runs must not be negative")
+	}
+	return &storepb.TaskRunConcurrencyPolicy{
+		MaximumConcurrentTaskRuns: policy.GetMaximumConcurrentTaskRuns(),
+	}, nil
+}
+
+func (s *OrgPolicyService) convertToStorePBRowFilterPolicyPayload(ctx context.Context, policy *v1pb.RowFilterPolicy) (*storepb.RowFilterPolicy, error) {
+	var rules []*storepb.RowFilterPolicy_Rule
+	for _, rule := range policy.GetRules() {
+		var members []string
+		for _, member := range rule.Members {
+			storeMember, err := convertToStoreIamPolicyMember(ctx, s.store, member)
+			if err != nil {
+				return nil, err
+			}
+			members = append(members, storeMember)
+		}
+		rules = append(rules, &storepb.RowFilterPolicy_Rule{
+			Database:  rule.Database,
+			Schema:    rule.Schema,
+			Table:     rule.Table,
+			Predicate: rule.Predicate,
+			Members:   members,
+		})
+	}
+	return &storepb.RowFilterPolicy{
+		Rules: rules,
+	}, nil
+}
+
+func (s *OrgPolicyService) convertToV1PBRowFilterPolicyPayload(ctx context.Context, policy *storepb.RowFilterPolicy) *v1pb.RowFilterPolicy {
+	var rules []*v1pb.RowFilterPolicy_Rule
+	for _, rule := range policy.Rules {
+		var members []string
+		for _, member := range rule.Members {
+			memberInBinding := convertToV1MemberInBinding(ctx, s.store, member)
+			if memberInBinding == "" {
+				continue
+			}
+			members = append(members, memberInBinding)
+		}
+		rules = append(rules, &v1pb.RowFilterPolicy_Rule{
+			Database:  rule.Database,
+			Schema:    rule.Schema,
+			Table:     rule.Table,
+			Predicate: rule.Predicate,
+			Members:   members,
+		})
+	}
+	return &v1pb.RowFilterPolicy{
+		Rules: rules,
+	}
+}
+
 func convertPolicyType(pType string) (api.PolicyType, error) {
 	var policyType api.PolicyType
 	switch strings.ToUpper(pType) {
@@ -1039,6 +1231,12 @@ func convertPolicyType(pType string) (api.PolicyType, error) {
 		return api.PolicyTypeRestrictIssueCreationForSQLReview, nil
 	case v1pb.PolicyType_DATA_SOURCE_QUERY.String():
 		return api.PolicyTypeDataSourceQuery, nil
+	case v1pb.PolicyType_TASK_RUN_CONCURRENCY.String():
+		return api.PolicyTypeTaskRunConcurrency, nil
+	case v1pb.PolicyType_ROW_FILTER.String():
+		return api.PolicyTypeRowFilter, nil
+	case v1pb.PolicyType_DRY_RUN.String():
+		return api.PolicyTypeDryRun, nil
 	}
 	return policyType, errors.Errorf("invalid policy type %v", pType)
 }
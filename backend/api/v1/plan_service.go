@@ -15,6 +15,7 @@ import (
 	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/testing/protocmp"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -28,6 +29,7 @@ import (
 	"github.com/bytebase/bytebase/backend/component/state"
 	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
 	"github.com/bytebase/bytebase/backend/store"
 	"github.com/bytebase/bytebase/backend/utils"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
@@ -457,6 +459,52 @@ func (s *PlanService) UpdatePlan(ctx context.Context, request *v1pb.UpdatePlanRe
 						return nil, err
 					}
 
+					// DMLBatchConfig
+					if err := func() error {
+						if task.Type != api.TaskDatabaseDataUpdate {
+							return nil
+						}
+						payload := &storepb.TaskDatabaseUpdatePayload{}
+						if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(task.Payload), payload); err != nil {
+							return status.Errorf(codes.Internal, "failed to unmarshal task payload: %v", err)
+						}
+						if err := validateDMLBatchConfig(spec.GetChangeDatabaseConfig()); err != nil {
+							return status.Errorf(codes.InvalidArgument, "invalid dml batch config, error %v", err)
+						}
+						if spec.GetChangeDatabaseConfig().GetDmlBatchConfig() != nil {
+							instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &task.InstanceID})
+							if err != nil {
+								return status.Errorf(codes.Internal, "failed to get instance %d: %v", task.InstanceID, err)
+							}
+							if instance == nil {
+								return status.Errorf(codes.NotFound, "instance %d not found", task.InstanceID)
+							}
+							_, sheetUID, err := common.GetProjectResourceIDSheetUID(spec.GetChangeDatabaseConfig().GetSheet())
+							if err != nil {
+								return status.Errorf(codes.InvalidArgument, "failed to get sheet id from %q: %v", spec.GetChangeDatabaseConfig().GetSheet(), err)
+							}
+							statement, err := s.store.GetSheetStatementByID(ctx, sheetUID)
+							if err != nil {
+								return status.Errorf(codes.Internal, "failed to get sheet statement %d: %v", sheetUID, err)
+							}
+							if err := validateDMLBatchTarget(instance.Engine, statement); err != nil {
+								return status.Errorf(codes.InvalidArgument, "invalid dml batch config, error %v", err)
+							}
+						}
+						newConfig := convertPlanSpecDMLBatchConfig(spec.GetChangeDatabaseConfig().GetDmlBatchConfig())
+						if proto.Equal(payload.DmlBatchConfig, newConfig) {
+							return nil
+						}
+						if newConfig == nil {
+							newConfig = &storepb.DMLBatchConfig{}
+						}
+						taskPatch.DMLBatchConfig = newConfig
+						doUpdate = true
+						return nil
+					}(); err != nil {
+						return nil, err
+					}
+
 					// EarliestAllowedTs
 					if spec.EarliestAllowedTime.GetSeconds() != task.EarliestAllowedTs {
 						seconds := spec.EarliestAllowedTime.GetSeconds()
@@ -1172,12 +1220,16 @@ func getSpecs(database *store.DatabaseMessage, revisions []*store.RevisionMessag
 	revisionByVersion := map[string]*store.RevisionMessage{}
 
 	for _, r := range revisions {
+		revisionByVersion[r.Version] = r
+		// The repeatable and declarative revisions don't take part in the version ordering.
+		if t := r.Payload.GetType(); t == storepb.ReleaseFileType_REPEATABLE || t == storepb.ReleaseFileType_DECLARATIVE {
+			continue
+		}
 		if lastVersion == "" {
 			lastVersion = r.Version
 		} else if lastVersion < r.Version {
 			lastVersion = r.Version
 		}
-		revisionByVersion[r.Version] = r
 	}
 
 	slices.SortFunc(release.Payload.Files, func(a, b *storepb.ReleasePayload_File) int {
@@ -1190,7 +1242,23 @@ func getSpecs(database *store.DatabaseMessage, revisions []*store.RevisionMessag
 		return 0
 	})
 
+	// The declarative and repeatable files are applied after the versioned files.
+	var declarativeSpecs, repeatableSpecs []*v1pb.Plan_Spec
 	for _, file := range release.Payload.Files {
+		switch file.Type {
+		case storepb.ReleaseFileType_DECLARATIVE:
+			// The SDL task computes the diff between the database schema and the file.
+			if !isReleaseFileApplied(revisionByVersion, file) {
+				declarativeSpecs = append(declarativeSpecs, getReleaseFileSpec(database, releaseName, file, v1pb.Plan_ChangeDatabaseConfig_MIGRATE_SDL))
+			}
+			continue
+		case storepb.ReleaseFileType_REPEATABLE:
+			if !isReleaseFileApplied(revisionByVersion, file) {
+				repeatableSpecs = append(repeatableSpecs, getReleaseFileSpec(database, releaseName, file, v1pb.Plan_ChangeDatabaseConfig_MIGRATE))
+			}
+			continue
+		}
+
 		r, ok := revisionByVersion[file.Version]
 		if ok {
 			// applied, so we will not deploy it.
@@ -1212,22 +1280,10 @@ func getSpecs(database *store.DatabaseMessage, revisions []*store.RevisionMessag
 			}
 		}
 
-		spec := &v1pb.Plan_Spec{
-			Id: uuid.NewString(),
-			SpecReleaseSource: &v1pb.Plan_SpecReleaseSource{
-				File: common.FormatReleaseFile(releaseName, file.Id),
-			},
-			Config: &v1pb.Plan_Spec_ChangeDatabaseConfig{
-				ChangeDatabaseConfig: &v1pb.Plan_ChangeDatabaseConfig{
-					Type:          v1pb.Plan_ChangeDatabaseConfig_MIGRATE,
-					Target:        common.FormatDatabase(database.InstanceID, database.DatabaseName),
-					Sheet:         file.Sheet,
-					SchemaVersion: file.Version,
-				},
-			},
-		}
-		specs = append(specs, spec)
+		specs = append(specs, getReleaseFileSpec(database, releaseName, file, v1pb.Plan_ChangeDatabaseConfig_MIGRATE))
 	}
+	specs = append(specs, declarativeSpecs...)
+	specs = append(specs, repeatableSpecs...)
 
 	return specs,
 		&v1pb.PreviewPlanResponse_DatabaseFiles{
@@ -1241,6 +1297,29 @@ func getSpecs(database *store.DatabaseMessage, revisions []*store.RevisionMessag
 		nil
 }
 
+func getReleaseFileSpec(database *store.DatabaseMessage, releaseName string, file *storepb.ReleasePayload_File, changeType v1pb.Plan_ChangeDatabaseConfig_Type) *v1pb.Plan_Spec {
+	return &v1pb.Plan_Spec{
+		Id: uuid.NewString(),
+		SpecReleaseSource: &v1pb.Plan_SpecReleaseSource{
+			File: common.FormatReleaseFile(releaseName, file.Id),
+		},
+		Config: &v1pb.Plan_Spec_ChangeDatabaseConfig{
+			ChangeDatabaseConfig: &v1pb.Plan_ChangeDatabaseConfig{
+				Type:          changeType,
+				Target:        common.FormatDatabase(database.InstanceID, database.DatabaseName),
+				Sheet:         file.Sheet,
+				SchemaVersion: file.Version,
+			},
+		},
+	}
+}
+
+// isReleaseFileApplied returns whether the repeatable or declarative file has been applied with the same content.
+func isReleaseFileApplied(revisionByVersion map[string]*store.RevisionMessage, file *storepb.ReleasePayload_File) bool {
+	r, ok := revisionByVersion[file.Version]
+	return ok && r.Payload.SheetSha256 == file.SheetSha256
+}
+
 // diffSpecs check if there are any specs removed, added or updated in the new plan.
 // Only updating sheet is taken into account.
 func diffSpecs(oldSteps []*v1pb.Plan_Step, newSteps []*v1pb.Plan_Step) ([]*v1pb.Plan_Spec, []*v1pb.Plan_Spec, []*v1pb.Plan_Spec) {
@@ -1283,6 +1362,36 @@ func diffSpecs(oldSteps []*v1pb.Plan_Step, newSteps []*v1pb.Plan_Step) ([]*v1pb.
 	return removed, added, updated
 }
 
+func validateDMLBatchConfig(c *v1pb.Plan_ChangeDatabaseConfig) error {
+	batch := c.GetDmlBatchConfig()
+	if batch == nil {
+		return nil
+	}
+	if c.Type != v1pb.Plan_ChangeDatabaseConfig_DATA {
+		return errors.Errorf("dml batch config is only applicable to DATA type, but got %v", c.Type)
+	}
+	if batch.ChunkSize <= 0 {
+		return errors.Errorf("dml batch chunk size must be positive, but got %d", batch.ChunkSize)
+	}
+	if batch.Sleep.AsDuration() < 0 || batch.MaxReplicaLag.AsDuration() < 0 {
+		return errors.Errorf("dml batch sleep and max replica lag must not be negative")
+	}
+	return nil
+}
+
+// validateDMLBatchTarget validates that the statement can be executed in batches on the engine.
+func validateDMLBatchTarget(engine storepb.Engine, statement string) error {
+	switch engine {
+	case storepb.Engine_MYSQL, storepb.Engine_MARIADB, storepb.Engine_OCEANBASE, storepb.Engine_POSTGRES:
+	default:
+		return errors.Errorf("dml batch execution is not supported for %v", engine)
+	}
+	if _, err := base.ParseBatchDML(engine, statement); err != nil {
+		return errors.Wrapf(err, "the statement cannot be executed in batches")
+	}
+	return nil
+}
+
 func validateSteps(steps []*v1pb.Plan_Step) error {
 	if len(steps) == 0 {
 		return errors.Errorf("the plan has zero step")
@@ -1316,6 +1425,12 @@ func validateSteps(steps []*v1pb.Plan_Step) error {
 				} else {
 					return errors.Errorf("unknown target %q", c.Target)
 				}
+				if err := validateDMLBatchConfig(c); err != nil {
+					return err
+				}
+				if c.DryRun && c.Type != v1pb.Plan_ChangeDatabaseConfig_MIGRATE && c.Type != v1pb.Plan_ChangeDatabaseConfig_DATA {
+					return errors.Errorf("dry run is only applicable to MIGRATE and DATA types, but got %v", c.Type)
+				}
 			case *v1pb.Plan_Spec_CreateDatabaseConfig:
 				configTypeCount["CreateDatabaseConfig"]++
 			case *v1pb.Plan_Spec_ExportDataConfig:
@@ -283,10 +283,48 @@ func getPlanCheckRunsFromChangeDatabaseConfigForDatabase(ctx context.Context, s
 			},
 		})
 	}
+	dryRun, err := isDryRunEnabled(ctx, s, config, database)
+	if err != nil {
+		return nil, err
+	}
+	if dryRun {
+		planCheckRuns = append(planCheckRuns, &store.PlanCheckRunMessage{
+			CreatorUID: api.SystemBotID,
+			UpdaterUID: api.SystemBotID,
+			PlanUID:    plan.UID,
+			Status:     store.PlanCheckRunStatusRunning,
+			Type:       store.PlanCheckDatabaseStatementDryRun,
+			Config: &storepb.PlanCheckRunConfig{
+				SheetUid:           int32(sheetUID),
+				ChangeDatabaseType: convertToChangeDatabaseType(config.Type),
+				InstanceUid:        int32(instance.UID),
+				DatabaseName:       database.DatabaseName,
+			},
+		})
+	}
 
 	return planCheckRuns, nil
 }
 
+// isDryRunEnabled returns true if the spec requests the dry run and the environment of the database allows it.
+func isDryRunEnabled(ctx context.Context, s *store.Store, config *storepb.PlanConfig_ChangeDatabaseConfig, database *store.DatabaseMessage) (bool, error) {
+	if !config.DryRun {
+		return false, nil
+	}
+	environment, err := s.GetEnvironmentV2(ctx, &store.FindEnvironmentMessage{ResourceID: &database.EffectiveEnvironmentID})
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to get environment %q", database.EffectiveEnvironmentID)
+	}
+	if environment == nil {
+		return false, nil
+	}
+	policy, err := s.GetDryRunPolicy(ctx, environment.UID)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to get dry run policy")
+	}
+	return policy.Enabled, nil
+}
+
 func getPlanCheckRunsFromExportDataConfigDatabaseTarget(ctx context.Context, s *store.Store, plan *store.PlanMessage, config *storepb.PlanConfig_ExportDataConfig) ([]*store.PlanCheckRunMessage, error) {
 	instanceID, databaseName, err := common.GetInstanceDatabaseID(config.Target)
 	if err != nil {
@@ -0,0 +1,104 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+func TestGetSpecs(t *testing.T) {
+	a := require.New(t)
+	database := &store.DatabaseMessage{InstanceID: "mysql", DatabaseName: "db"}
+	releaseName := "projects/p/releases/1"
+	release := &store.ReleaseMessage{
+		Payload: &storepb.ReleasePayload{
+			Files: []*storepb.ReleasePayload_File{
+				{Id: "views", Version: "R__views", Type: storepb.ReleaseFileType_REPEATABLE, Sheet: "projects/p/sheets/4", SheetSha256: "changed"},
+				{Id: "procs", Version: "R__procs", Type: storepb.ReleaseFileType_REPEATABLE, Sheet: "projects/p/sheets/5", SheetSha256: "same"},
+				{Id: "schema", Version: "schema", Type: storepb.ReleaseFileType_DECLARATIVE, Sheet: "projects/p/sheets/3", SheetSha256: "new"},
+				{Id: "v2", Version: "0002", Type: storepb.ReleaseFileType_VERSIONED, Sheet: "projects/p/sheets/2", SheetSha256: "2"},
+				{Id: "v1", Version: "0001", Type: storepb.ReleaseFileType_VERSIONED, Sheet: "projects/p/sheets/1", SheetSha256: "1"},
+			},
+		},
+	}
+	revisions := []*store.RevisionMessage{
+		{Version: "0001", Payload: &storepb.RevisionPayload{SheetSha256: "1", Type: storepb.ReleaseFileType_VERSIONED}},
+		// The repeatable revisions don't take part in the version ordering, otherwise 0002 would be out of order.
+		{Version: "R__views", Payload: &storepb.RevisionPayload{SheetSha256: "old", Type: storepb.ReleaseFileType_REPEATABLE}},
+		{Version: "R__procs", Payload: &storepb.RevisionPayload{SheetSha256: "same", Type: storepb.ReleaseFileType_REPEATABLE}},
+	}
+
+	specs, outOfOrder, modified, err := getSpecs(database, revisions, release, releaseName, false /* allowOoo */)
+	a.NoError(err)
+	a.Empty(outOfOrder.Files)
+	a.Empty(modified.Files)
+
+	type spec struct {
+		file       string
+		changeType v1pb.Plan_ChangeDatabaseConfig_Type
+	}
+	var got []spec
+	for _, s := range specs {
+		got = append(got, spec{
+			file:       s.GetSpecReleaseSource().GetFile(),
+			changeType: s.GetChangeDatabaseConfig().GetType(),
+		})
+	}
+	a.Equal([]spec{
+		{file: "projects/p/releases/1/files/v2", changeType: v1pb.Plan_ChangeDatabaseConfig_MIGRATE},
+		{file: "projects/p/releases/1/files/schema", changeType: v1pb.Plan_ChangeDatabaseConfig_MIGRATE_SDL},
+		{file: "projects/p/releases/1/files/views", changeType: v1pb.Plan_ChangeDatabaseConfig_MIGRATE},
+	}, got)
+}
+
+func TestValidateAndSanitizeReleaseFiles(t *testing.T) {
+	a := require.New(t)
+
+	files, err := validateAndSanitizeReleaseFiles([]*v1pb.Release_File{
+		{Version: "R__views", Type: v1pb.ReleaseFileType_REPEATABLE},
+		{Version: "0001", Type: v1pb.ReleaseFileType_VERSIONED},
+		{Version: "schema", Type: v1pb.ReleaseFileType_DECLARATIVE},
+	})
+	a.NoError(err)
+	a.Len(files, 3)
+
+	_, err = validateAndSanitizeReleaseFiles([]*v1pb.Release_File{
+		{Version: "schema1", Type: v1pb.ReleaseFileType_DECLARATIVE},
+		{Version: "schema2", Type: v1pb.ReleaseFileType_DECLARATIVE},
+	})
+	a.Error(err)
+
+	_, err = validateAndSanitizeReleaseFiles([]*v1pb.Release_File{
+		{Version: "0001", Type: v1pb.ReleaseFileType_TYPE_UNSPECIFIED},
+	})
+	a.Error(err)
+}
+
+func TestValidateDMLBatchTarget(t *testing.T) {
+	a := require.New(t)
+	tests := []struct {
+		engine    storepb.Engine
+		statement string
+		valid     bool
+	}{
+		{storepb.Engine_MYSQL, "UPDATE t SET a = 1 WHERE b = 2", true},
+		{storepb.Engine_OCEANBASE, "DELETE FROM t WHERE b = 2", true},
+		{storepb.Engine_POSTGRES, "UPDATE public.t SET a = 1", true},
+		{storepb.Engine_POSTGRES, "UPDATE t SET a = t2.a FROM t2 WHERE t.id = t2.id", false},
+		{storepb.Engine_POSTGRES, "DELETE FROM t USING t2 WHERE t.id = t2.id", false},
+		{storepb.Engine_TIDB, "UPDATE t SET a = 1", false},
+		{storepb.Engine_MSSQL, "UPDATE t SET a = 1", false},
+	}
+	for _, test := range tests {
+		err := validateDMLBatchTarget(test.engine, test.statement)
+		if test.valid {
+			a.NoError(err, test.statement)
+		} else {
+			a.Error(err, test.statement)
+		}
+	}
+}
@@ -18,14 +18,17 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/component/config"
 	"github.com/bytebase/bytebase/backend/component/iam"
+	"github.com/bytebase/bytebase/backend/component/webhook"
 	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	webhookplugin "github.com/bytebase/bytebase/backend/plugin/webhook"
@@ -42,15 +45,17 @@ type ProjectService struct {
 	profile        *config.Profile
 	iamManager     *iam.Manager
 	licenseService enterprise.LicenseService
+	webhookManager *webhook.Manager
 }
 
 // NewProjectService creates a new ProjectService.
-func NewProjectService(store *store.Store, profile *config.Profile, iamManager *iam.Manager, licenseService enterprise.LicenseService) *ProjectService {
+func NewProjectService(store *store.Store, profile *config.Profile, iamManager *iam.Manager, licenseService enterprise.LicenseService, webhookManager *webhook.Manager) *ProjectService {
 	return &ProjectService{
 		store:          store,
 		profile:        profile,
 		iamManager:     iamManager,
 		licenseService: licenseService,
+		webhookManager: webhookManager,
 	}
 }
 
@@ -257,6 +262,22 @@ func (s *ProjectService) UpdateProject(ctx context.Context, request *v1pb.Update
 			projectSettings := project.Setting
 			projectSettings.AllowSelfApproval = request.Project.AllowSelfApproval
 			patch.Setting = projectSettings
+		case "migration_hooks":
+			migrationHooks, err := convertToStoreMigrationHooks(request.Project.MigrationHooks)
+			if err != nil {
+				return nil, status.Error(codes.InvalidArgument, err.Error())
+			}
+			projectSettings := project.Setting
+			projectSettings.MigrationHooks = migrationHooks
+			patch.Setting = projectSettings
+		case "release_pins":
+			releasePins, err := s.convertToStoreReleasePins(ctx, project, request.Project.ReleasePins)
+			if err != nil {
+				return nil, err
+			}
+			projectSettings := project.Setting
+			projectSettings.ReleasePins = releasePins
+			patch.Setting = projectSettings
 		default:
 			return nil, status.Errorf(codes.InvalidArgument, `unsupport update_mask "%s"`, path)
 		}
@@ -758,13 +779,50 @@ func (s *ProjectService) UpdateWebhook(ctx context.Context, request *v1pb.Update
 			}
 			update.ActivityList = types
 		case "direct_message":
-			update.Payload = &storepb.ProjectWebhookPayload{
-				DirectMessage: request.Webhook.DirectMessage,
+			if update.Payload == nil {
+				update.Payload = proto.Clone(webhook.Payload).(*storepb.ProjectWebhookPayload)
+			}
+			update.Payload.DirectMessage = request.Webhook.DirectMessage
+		case "signing_secret":
+			if update.Payload == nil {
+				update.Payload = proto.Clone(webhook.Payload).(*storepb.ProjectWebhookPayload)
+			}
+			update.Payload.SigningSecret = request.Webhook.SigningSecret
+		case "generic_config":
+			if update.Payload == nil {
+				update.Payload = proto.Clone(webhook.Payload).(*storepb.ProjectWebhookPayload)
 			}
+			genericConfig := convertToStoreGenericWebhookConfig(request.Webhook.GenericConfig)
+			// The headers are input only, so they are kept unless given or updated by "generic_config.headers".
+			if genericConfig != nil && len(genericConfig.Headers) == 0 {
+				genericConfig.Headers = update.Payload.GetGenericConfig().GetHeaders()
+			}
+			update.Payload.GenericConfig = genericConfig
+		case "generic_config.headers":
+			if update.Payload == nil {
+				update.Payload = proto.Clone(webhook.Payload).(*storepb.ProjectWebhookPayload)
+			}
+			if update.Payload.GenericConfig == nil {
+				update.Payload.GenericConfig = &storepb.GenericWebhookConfig{}
+			}
+			update.Payload.GenericConfig.Headers = request.Webhook.GetGenericConfig().GetHeaders()
 		default:
 			return nil, status.Errorf(codes.InvalidArgument, "invalid field %q", path)
 		}
 	}
+	if webhook.Type == webhookplugin.GenericWebhookType && (update.Payload != nil || update.ActivityList != nil) {
+		genericConfig := webhook.Payload.GetGenericConfig()
+		if update.Payload != nil {
+			genericConfig = update.Payload.GetGenericConfig()
+		}
+		activityList := webhook.ActivityList
+		if update.ActivityList != nil {
+			activityList = update.ActivityList
+		}
+		if err := webhookplugin.ValidateGenericConfig(genericConfig, activityList); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid generic webhook config: %v", err)
+		}
+	}
 
 	principalID, ok := ctx.Value(common.PrincipalIDContextKey).(int)
 	if !ok {
@@ -864,35 +922,7 @@ func (s *ProjectService) TestWebhook(ctx context.Context, request *v1pb.TestWebh
 	}
 
 	resp := &v1pb.TestWebhookResponse{}
-	err = webhookplugin.Post(
-		webhook.Type,
-		webhookplugin.Context{
-			URL:          webhook.URL,
-			Level:        webhookplugin.WebhookInfo,
-			ActivityType: string(api.ActivityIssueCreate),
-			Title:        fmt.Sprintf("Test webhook %q", webhook.Title),
-			TitleZh:      fmt.Sprintf("测试 webhook %q", webhook.Title),
-			Description:  "This is a test",
-			Link:         fmt.Sprintf("%s/project/%s/webhook/%s", setting.ExternalUrl, fmt.Sprintf("%s-%d", slug.Make(project.Title), project.UID), fmt.Sprintf("%s-%d", slug.Make(webhook.Title), webhook.ID)),
-			ActorID:      api.SystemBotID,
-			ActorName:    "Bytebase",
-			ActorEmail:   s.store.GetSystemBotUser(ctx).Email,
-			CreatedTs:    time.Now().Unix(),
-			Issue: &webhookplugin.Issue{
-				ID:          1,
-				Name:        "Test issue",
-				Status:      "OPEN",
-				Type:        "bb.issue.database.create",
-				Description: "This is a test issue",
-				Creator:     s.store.GetSystemBotUser(ctx),
-			},
-
-			Project: &webhookplugin.Project{
-				Name:  common.FormatProject(project.ResourceID),
-				Title: project.Title,
-			},
-		},
-	)
+	err = webhookplugin.Post(webhook.Type, s.getTestWebhookContext(ctx, setting.ExternalUrl, project, webhook))
 	if err != nil {
 		resp.Error = err.Error()
 	}
@@ -900,6 +930,227 @@ func (s *ProjectService) TestWebhook(ctx context.Context, request *v1pb.TestWebh
 	return resp, nil
 }
 
+// RenderWebhookPayload renders the request of a generic webhook for a test event without sending it.
+func (s *ProjectService) RenderWebhookPayload(ctx context.Context, request *v1pb.RenderWebhookPayloadRequest) (*v1pb.RenderWebhookPayloadResponse, error) {
+	setting, err := s.store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+	}
+	if setting.ExternalUrl == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, setupExternalURLError)
+	}
+
+	projectID, err := common.GetProjectID(request.Project)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{
+		ResourceID: &projectID,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if project == nil {
+		return nil, status.Errorf(codes.NotFound, "project %q not found", request.Project)
+	}
+	if project.Deleted {
+		return nil, status.Errorf(codes.NotFound, "project %q has been deleted", request.Project)
+	}
+
+	if request.Webhook.GetType() != v1pb.Webhook_TYPE_GENERIC {
+		return nil, status.Errorf(codes.InvalidArgument, "only generic webhooks can be rendered")
+	}
+	webhook, err := convertToStoreProjectWebhookMessage(request.Webhook)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	webhookCtx := s.getTestWebhookContext(ctx, setting.ExternalUrl, project, webhook)
+	webhookCtx.GenericConfig = webhook.Payload.GetGenericConfig()
+	payload, headers, err := webhookplugin.RenderGenericPayload(webhookCtx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &v1pb.RenderWebhookPayloadResponse{
+		Payload: string(payload),
+		Headers: headers,
+	}, nil
+}
+
+// getTestWebhookContext returns the context of the sample event to test the webhook.
+func (s *ProjectService) getTestWebhookContext(ctx context.Context, externalURL string, project *store.ProjectMessage, webhook *store.ProjectWebhookMessage) webhookplugin.Context {
+	return webhookplugin.Context{
+		URL:          webhook.URL,
+		Level:        webhookplugin.WebhookInfo,
+		ActivityType: string(api.ActivityIssueCreate),
+		Title:        fmt.Sprintf("Test webhook %q", webhook.Title),
+		TitleZh:      fmt.Sprintf("测试 webhook %q", webhook.Title),
+		Description:  "This is a test",
+		Link:         fmt.Sprintf("%s/project/%s/webhook/%s", externalURL, fmt.Sprintf("%s-%d", slug.Make(project.Title), project.UID), fmt.Sprintf("%s-%d", slug.Make(webhook.Title), webhook.ID)),
+		ActorID:      api.SystemBotID,
+		ActorName:    "Bytebase",
+		ActorEmail:   s.store.GetSystemBotUser(ctx).Email,
+		CreatedTs:    time.Now().Unix(),
+		// Sign the test request so that the receiver can verify the signature.
+		SigningSecret: webhook.Payload.GetSigningSecret(),
+		GenericConfig: webhook.Payload.GetGenericConfig(),
+		Issue: &webhookplugin.Issue{
+			ID:          1,
+			Name:        "Test issue",
+			Status:      "OPEN",
+			Type:        "bb.issue.database.create",
+			Description: "This is a test issue",
+			Creator:     s.store.GetSystemBotUser(ctx),
+		},
+
+		Project: &webhookplugin.Project{
+			Name:  common.FormatProject(project.ResourceID),
+			Title: project.Title,
+		},
+	}
+}
+
+// ListWebhookDeliveries lists the deliveries of a webhook.
+func (s *ProjectService) ListWebhookDeliveries(ctx context.Context, request *v1pb.ListWebhookDeliveriesRequest) (*v1pb.ListWebhookDeliveriesResponse, error) {
+	projectID, webhookID, err := common.GetProjectIDWebhookID(request.Parent)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	webhookUID, err := strconv.Atoi(webhookID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid webhook id %q", webhookID)
+	}
+	webhook, err := s.getProjectWebhook(ctx, projectID, webhookUID)
+	if err != nil {
+		return nil, err
+	}
+
+	offset, err := parseLimitAndOffset(&pageSize{
+		token:   request.PageToken,
+		limit:   int(request.PageSize),
+		maximum: 1000,
+	})
+	if err != nil {
+		return nil, err
+	}
+	limitPlusOne := offset.limit + 1
+	deliveries, err := s.store.ListWebhookDeliveries(ctx, &store.FindWebhookDeliveryMessage{
+		ProjectWebhookUID: &webhook.ID,
+		Limit:             &limitPlusOne,
+		Offset:            &offset.offset,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list webhook deliveries, error: %v", err)
+	}
+
+	var nextPageToken string
+	if len(deliveries) == limitPlusOne {
+		deliveries = deliveries[:offset.limit]
+		if nextPageToken, err = offset.getNextPageToken(); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get next page token, error: %v", err)
+		}
+	}
+
+	response := &v1pb.ListWebhookDeliveriesResponse{
+		NextPageToken: nextPageToken,
+	}
+	for _, delivery := range deliveries {
+		response.Deliveries = append(response.Deliveries, convertToWebhookDelivery(projectID, delivery))
+	}
+	return response, nil
+}
+
+// RedeliverWebhookDelivery redelivers a webhook delivery.
+func (s *ProjectService) RedeliverWebhookDelivery(ctx context.Context, request *v1pb.RedeliverWebhookDeliveryRequest) (*v1pb.WebhookDelivery, error) {
+	projectID, webhookUID, deliveryUID, err := common.GetProjectIDWebhookIDDeliveryID(request.Name)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	projectWebhook, err := s.getProjectWebhook(ctx, projectID, webhookUID)
+	if err != nil {
+		return nil, err
+	}
+	delivery, err := s.store.GetWebhookDelivery(ctx, &store.FindWebhookDeliveryMessage{
+		UID:               &deliveryUID,
+		ProjectWebhookUID: &projectWebhook.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get webhook delivery, error: %v", err)
+	}
+	if delivery == nil {
+		return nil, status.Errorf(codes.NotFound, "webhook delivery %q not found", request.Name)
+	}
+
+	delivery, err = s.webhookManager.RedeliverWebhookDelivery(ctx, delivery.UID)
+	if errors.Is(err, webhook.ErrWebhookDeliveryInProgress) {
+		return nil, status.Errorf(codes.FailedPrecondition, "webhook delivery %q is being posted", request.Name)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to redeliver webhook delivery, error: %v", err)
+	}
+	return convertToWebhookDelivery(projectID, delivery), nil
+}
+
+func (s *ProjectService) getProjectWebhook(ctx context.Context, projectID string, webhookUID int) (*store.ProjectWebhookMessage, error) {
+	project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{
+		ResourceID: &projectID,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if project == nil {
+		return nil, status.Errorf(codes.NotFound, "project %q not found", projectID)
+	}
+	if project.Deleted {
+		return nil, status.Errorf(codes.NotFound, "project %q has been deleted", projectID)
+	}
+	webhook, err := s.store.GetProjectWebhookV2(ctx, &store.FindProjectWebhookMessage{
+		ProjectID: &project.UID,
+		ID:        &webhookUID,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if webhook == nil {
+		return nil, status.Errorf(codes.NotFound, "webhook %q not found", common.FormatWebhook(projectID, webhookUID))
+	}
+	return webhook, nil
+}
+
+func convertToWebhookDelivery(projectID string, delivery *store.WebhookDeliveryMessage) *v1pb.WebhookDelivery {
+	event := delivery.Payload.GetEvent()
+	v := &v1pb.WebhookDelivery{
+		Name:         common.FormatWebhookDelivery(projectID, delivery.ProjectWebhookUID, delivery.UID),
+		Title:        event.GetTitle(),
+		AttemptCount: int32(delivery.AttemptCount),
+		CreateTime:   timestamppb.New(delivery.CreatedTime),
+	}
+	if types := convertNotificationTypeStrings([]string{event.GetActivityType()}); len(types) > 0 {
+		v.ActivityType = types[0]
+	}
+	switch delivery.Status {
+	case store.WebhookDeliveryPending:
+		v.Status = v1pb.WebhookDelivery_PENDING
+		v.NextAttemptTime = timestamppb.New(delivery.NextAttemptTime)
+	case store.WebhookDeliverySucceeded:
+		v.Status = v1pb.WebhookDelivery_SUCCEEDED
+	case store.WebhookDeliveryDead:
+		v.Status = v1pb.WebhookDelivery_DEAD
+	}
+	attempts := delivery.Payload.GetAttempts()
+	for i := len(attempts) - 1; i >= 0; i-- {
+		attempt := attempts[i]
+		v.Attempts = append(v.Attempts, &v1pb.WebhookDelivery_Attempt{
+			CreateTime:   attempt.CreateTime,
+			RequestBody:  attempt.RequestBody,
+			ResponseCode: attempt.ResponseCode,
+			ResponseBody: attempt.ResponseBody,
+			Latency:      attempt.Latency,
+			Error:        attempt.Error,
+		})
+	}
+	return v
+}
+
 func convertToStoreProjectWebhookMessage(webhook *v1pb.Webhook) (*store.ProjectWebhookMessage, error) {
 	tp, err := convertToAPIWebhookTypeString(webhook.Type)
 	if err != nil {
@@ -909,17 +1160,47 @@ func convertToStoreProjectWebhookMessage(webhook *v1pb.Webhook) (*store.ProjectW
 	if err != nil {
 		return nil, err
 	}
+	payload := &storepb.ProjectWebhookPayload{
+		DirectMessage: webhook.DirectMessage,
+		SigningSecret: webhook.SigningSecret,
+	}
+	if tp == webhookplugin.GenericWebhookType {
+		payload.GenericConfig = convertToStoreGenericWebhookConfig(webhook.GenericConfig)
+		if err := webhookplugin.ValidateGenericConfig(payload.GenericConfig, activityTypes); err != nil {
+			return nil, common.Errorf(common.Invalid, "invalid generic webhook config: %v", err)
+		}
+	}
 	return &store.ProjectWebhookMessage{
 		Type:         tp,
 		URL:          webhook.Url,
 		Title:        webhook.Title,
 		ActivityList: activityTypes,
-		Payload: &storepb.ProjectWebhookPayload{
-			DirectMessage: webhook.DirectMessage,
-		},
+		Payload:      payload,
 	}, nil
 }
 
+func convertToStoreGenericWebhookConfig(config *v1pb.GenericWebhookConfig) *storepb.GenericWebhookConfig {
+	if config == nil {
+		return nil
+	}
+	return &storepb.GenericWebhookConfig{
+		PayloadTemplate: config.PayloadTemplate,
+		CloudEvents:     config.CloudEvents,
+		Headers:         config.Headers,
+	}
+}
+
+func convertToGenericWebhookConfig(config *storepb.GenericWebhookConfig) *v1pb.GenericWebhookConfig {
+	if config == nil {
+		return nil
+	}
+	// The headers are input only as they usually carry the credentials of the receivers.
+	return &v1pb.GenericWebhookConfig{
+		PayloadTemplate: config.PayloadTemplate,
+		CloudEvents:     config.CloudEvents,
+	}
+}
+
 func convertToActivityTypeStrings(types []v1pb.Activity_Type) ([]string, error) {
 	var result []string
 	for _, tp := range types {
@@ -968,6 +1249,12 @@ func convertToActivityTypeStrings(types []v1pb.Activity_Type) ([]string, error)
 			result = append(result, string(api.ActivityNotifyIssueApproved))
 		case v1pb.Activity_TYPE_NOTIFY_PIPELINE_ROLLOUT:
 			result = append(result, string(api.ActivityNotifyPipelineRollout))
+		case v1pb.Activity_TYPE_DATABASE_SCHEMA_DRIFT:
+			result = append(result, string(api.ActivityDatabaseSchemaDrift))
+		case v1pb.Activity_TYPE_DATABASE_CONNECTION_ANOMALY:
+			result = append(result, string(api.ActivityDatabaseConnectionAnomaly))
+		case v1pb.Activity_TYPE_DATABASE_SLOW_QUERY:
+			result = append(result, string(api.ActivityDatabaseSlowQuery))
 		default:
 			return nil, common.Errorf(common.Invalid, "unsupported activity type: %v", tp)
 		}
@@ -1021,6 +1308,12 @@ func convertNotificationTypeStrings(types []string) []v1pb.Activity_Type {
 			result = append(result, v1pb.Activity_TYPE_NOTIFY_ISSUE_APPROVED)
 		case string(api.ActivityNotifyPipelineRollout):
 			result = append(result, v1pb.Activity_TYPE_NOTIFY_PIPELINE_ROLLOUT)
+		case string(api.ActivityDatabaseSchemaDrift):
+			result = append(result, v1pb.Activity_TYPE_DATABASE_SCHEMA_DRIFT)
+		case string(api.ActivityDatabaseConnectionAnomaly):
+			result = append(result, v1pb.Activity_TYPE_DATABASE_CONNECTION_ANOMALY)
+		case string(api.ActivityDatabaseSlowQuery):
+			result = append(result, v1pb.Activity_TYPE_DATABASE_SLOW_QUERY)
 		default:
 			result = append(result, v1pb.Activity_TYPE_UNSPECIFIED)
 		}
@@ -1049,6 +1342,8 @@ func convertToAPIWebhookTypeString(tp v1pb.Webhook_Type) (string, error) {
 		return "bb.plugin.webhook.custom", nil
 	case v1pb.Webhook_TYPE_LARK:
 		return "bb.plugin.webhook.lark", nil
+	case v1pb.Webhook_TYPE_GENERIC:
+		return webhookplugin.GenericWebhookType, nil
 	default:
 		return "", common.Errorf(common.Invalid, "webhook type %q is not supported", tp)
 	}
@@ -1072,6 +1367,8 @@ func convertWebhookTypeString(tp string) v1pb.Webhook_Type {
 		return v1pb.Webhook_TYPE_CUSTOM
 	case "bb.plugin.webhook.lark":
 		return v1pb.Webhook_TYPE_LARK
+	case webhookplugin.GenericWebhookType:
+		return v1pb.Webhook_TYPE_GENERIC
 	default:
 		return v1pb.Webhook_TYPE_UNSPECIFIED
 	}
@@ -1275,6 +1572,7 @@ func convertToProject(projectMessage *store.ProjectMessage) *v1pb.Project {
 			Url:               webhook.URL,
 			NotificationTypes: convertNotificationTypeStrings(webhook.ActivityList),
 			DirectMessage:     webhook.Payload.GetDirectMessage(),
+			GenericConfig:     convertToGenericWebhookConfig(webhook.Payload.GetGenericConfig()),
 		})
 	}
 
@@ -1304,10 +1602,112 @@ func convertToProject(projectMessage *store.ProjectMessage) *v1pb.Project {
 		SkipBackupErrors:           projectMessage.Setting.SkipBackupErrors,
 		PostgresDatabaseTenantMode: projectMessage.Setting.PostgresDatabaseTenantMode,
 		AllowSelfApproval:          projectMessage.Setting.AllowSelfApproval,
+		MigrationHooks:             convertToMigrationHooks(projectMessage.Setting.MigrationHooks),
+		ReleasePins:                convertToReleasePins(projectMessage.ResourceID, projectMessage.Setting.ReleasePins),
+	}
+}
+
+func convertToReleasePins(projectID string, pins []*storepb.ReleasePin) []*v1pb.ReleasePin {
+	var releasePins []*v1pb.ReleasePin
+	for _, pin := range pins {
+		releasePins = append(releasePins, &v1pb.ReleasePin{
+			Environment: common.FormatEnvironment(pin.Environment),
+			Release:     common.FormatReleaseName(projectID, pin.ReleaseUid),
+		})
 	}
+	return releasePins
+}
+
+func (s *ProjectService) convertToStoreReleasePins(ctx context.Context, project *store.ProjectMessage, pins []*v1pb.ReleasePin) ([]*storepb.ReleasePin, error) {
+	var releasePins []*storepb.ReleasePin
+	pinnedEnvironments := map[string]bool{}
+	for _, pin := range pins {
+		environmentID, err := common.GetEnvironmentID(pin.Environment)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if pinnedEnvironments[environmentID] {
+			return nil, status.Errorf(codes.InvalidArgument, "environment %q is pinned more than once", pin.Environment)
+		}
+		pinnedEnvironments[environmentID] = true
+		environment, err := s.store.GetEnvironmentV2(ctx, &store.FindEnvironmentMessage{ResourceID: &environmentID})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get environment %q, err: %v", environmentID, err)
+		}
+		if environment == nil {
+			return nil, status.Errorf(codes.NotFound, "environment %q not found", environmentID)
+		}
+		projectID, releaseUID, err := common.GetProjectReleaseUID(pin.Release)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if projectID != project.ResourceID {
+			return nil, status.Errorf(codes.InvalidArgument, "release %q is not in project %q", pin.Release, project.ResourceID)
+		}
+		release, err := s.store.GetRelease(ctx, releaseUID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get release %q, err: %v", pin.Release, err)
+		}
+		if release == nil || release.Deleted || release.ProjectUID != project.UID {
+			return nil, status.Errorf(codes.NotFound, "release %q not found", pin.Release)
+		}
+		releasePin := &storepb.ReleasePin{
+			Environment: environmentID,
+			ReleaseUid:  releaseUID,
+		}
+		// Keep the ongoing promotion unless the environment is pinned to another release.
+		for _, oldPin := range project.Setting.GetReleasePins() {
+			if oldPin.Environment == environmentID && oldPin.ReleaseUid == releaseUID {
+				releasePin.PromotingReleaseUid = oldPin.PromotingReleaseUid
+				releasePin.PromotingPlanUids = oldPin.PromotingPlanUids
+			}
+		}
+		releasePins = append(releasePins, releasePin)
+	}
+	return releasePins, nil
+}
+
+func convertToMigrationHooks(hooks []*storepb.MigrationHook) []*v1pb.MigrationHook {
+	var migrationHooks []*v1pb.MigrationHook
+	for _, hook := range hooks {
+		var environments []string
+		for _, environment := range hook.Environments {
+			environments = append(environments, common.FormatEnvironment(environment))
+		}
+		migrationHooks = append(migrationHooks, &v1pb.MigrationHook{
+			Environments:  environments,
+			PreStatement:  hook.PreStatement,
+			PostStatement: hook.PostStatement,
+		})
+	}
+	return migrationHooks
+}
+
+func convertToStoreMigrationHooks(hooks []*v1pb.MigrationHook) ([]*storepb.MigrationHook, error) {
+	var migrationHooks []*storepb.MigrationHook
+	for _, hook := range hooks {
+		var environments []string
+		for _, environment := range hook.Environments {
+			environmentID, err := common.GetEnvironmentID(environment)
+			if err != nil {
+				return nil, err
+			}
+			environments = append(environments, environmentID)
+		}
+		migrationHooks = append(migrationHooks, &storepb.MigrationHook{
+			Environments:  environments,
+			PreStatement:  hook.PreStatement,
+			PostStatement: hook.PostStatement,
+		})
+	}
+	return migrationHooks, nil
 }
 
 func convertToProjectMessage(resourceID string, project *v1pb.Project) (*store.ProjectMessage, error) {
+	migrationHooks, err := convertToStoreMigrationHooks(project.MigrationHooks)
+	if err != nil {
+		return nil, err
+	}
 	setting := &storepb.Project{
 		AllowModifyStatement:       project.AllowModifyStatement,
 		AutoResolveIssue:           project.AutoResolveIssue,
@@ -1316,6 +1716,7 @@ func convertToProjectMessage(resourceID string, project *v1pb.Project) (*store.P
 		SkipBackupErrors:           project.SkipBackupErrors,
 		PostgresDatabaseTenantMode: project.PostgresDatabaseTenantMode,
 		AllowSelfApproval:          project.AllowSelfApproval,
+		MigrationHooks:             migrationHooks,
 	}
 	return &store.ProjectMessage{
 		ResourceID: resourceID,
@@ -181,7 +181,7 @@ func TestValidateBindings(t *testing.T) {
 
 	a := require.New(t)
 	// Mock an empty project service to test the validateBindings function.
-	projectService := NewProjectService(nil, nil, nil, nil)
+	projectService := NewProjectService(nil, nil, nil, nil, nil)
 	for _, tt := range tests {
 		err := projectService.validateBindings(tt.bindings, tt.roles, nil)
 		if tt.wantErr {
@@ -2,6 +2,8 @@ package v1
 
 import (
 	"context"
+	"encoding/hex"
+	"log/slog"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -9,7 +11,9 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/component/masker"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/plugin/parser/base"
 	"github.com/bytebase/bytebase/backend/store"
 	"github.com/bytebase/bytebase/backend/utils"
@@ -29,26 +33,11 @@ func NewQueryResultMasker(store *store.Store) *QueryResultMasker {
 
 // MaskResults masks the result in-place based on the dynamic masking policy, query-span, instance and action.
 func (s *QueryResultMasker) MaskResults(ctx context.Context, spans []*base.QuerySpan, results []*v1pb.QueryResult, instance *store.InstanceMessage, action storepb.MaskingExceptionPolicy_MaskingException_Action) error {
-	classificationSetting, err := s.store.GetDataClassificationSetting(ctx)
-	if err != nil {
-		return errors.Wrapf(err, "failed to find classification setting")
-	}
-
-	maskingRulePolicy, err := s.store.GetMaskingRulePolicy(ctx)
+	m, err := s.newMaskingLevelEvaluator(ctx)
 	if err != nil {
-		return errors.Wrapf(err, "failed to find masking rule policy")
+		return err
 	}
 
-	semanticTypesSetting, err := s.store.GetSemanticTypesSetting(ctx)
-	if err != nil {
-		return errors.Wrapf(err, "failed to find semantic types setting")
-	}
-
-	m := newEmptyMaskingLevelEvaluator().
-		withMaskingRulePolicy(maskingRulePolicy).
-		withDataClassificationSetting(classificationSetting).
-		withSemanticTypeSetting(semanticTypesSetting)
-
 	// We expect the len(spans) == len(results), but to avoid NPE, we use the min(len(spans), len(results)) here.
 	loopBoundary := min(len(spans), len(results))
 	for i := 0; i < loopBoundary; i++ {
@@ -72,6 +61,42 @@ func (s *QueryResultMasker) MaskResults(ctx context.Context, spans []*base.Query
 	return nil
 }
 
+func (s *QueryResultMasker) newMaskingLevelEvaluator(ctx context.Context) (*maskingLevelEvaluator, error) {
+	classificationSetting, err := s.store.GetDataClassificationSetting(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to find classification setting")
+	}
+
+	maskingRulePolicy, err := s.store.GetMaskingRulePolicy(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to find masking rule policy")
+	}
+
+	semanticTypesSetting, err := s.store.GetSemanticTypesSetting(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to find semantic types setting")
+	}
+
+	maskingKeySetting, err := s.store.GetMaskingKeySetting(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to find masking key setting")
+	}
+	// The masking keys are obfuscated with the auth secret.
+	secretSetting, err := s.store.GetSettingV2(ctx, api.SettingAuthSecret)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to find auth secret setting")
+	}
+	if secretSetting == nil {
+		return nil, errors.Errorf("auth secret setting not found")
+	}
+
+	return newEmptyMaskingLevelEvaluator().
+		withMaskingRulePolicy(maskingRulePolicy).
+		withDataClassificationSetting(classificationSetting).
+		withSemanticTypeSetting(semanticTypesSetting).
+		withMaskingKeySetting(maskingKeySetting, secretSetting.Value), nil
+}
+
 // getMaskersForQuerySpan returns the maskers for the query span.
 func (s *QueryResultMasker) getMaskersForQuerySpan(ctx context.Context, m *maskingLevelEvaluator, instance *store.InstanceMessage, span *base.QuerySpan, action storepb.MaskingExceptionPolicy_MaskingException_Action) ([]masker.Masker, error) {
 	if span == nil {
@@ -148,34 +173,64 @@ func (s *QueryResultMasker) getMaskerForColumnResource(
 	if instance != nil && !isMaskingSupported(instance.Engine) {
 		return masker.NewNoneMasker(), nil
 	}
+	semanticTypeID, err := s.getSemanticTypeForColumnResource(ctx, m, instance, sourceColumn, maskingExceptionPolicyMap, action, currentPrincipal)
+	if err != nil {
+		return nil, err
+	}
+
+	// Built-in algorithm.
+	switch semanticTypeID {
+	case "":
+		return masker.NewNoneMasker(), nil
+	case "bb.default":
+		return masker.NewDefaultFullMasker(), nil
+	case "bb.default-partial":
+		return masker.NewDefaultRangeMasker(), nil
+	}
+
+	semanticType := m.semanticTypesMap[semanticTypeID]
+	return getMaskerByMaskingAlgorithmAndLevel(semanticType.GetAlgorithm(), m.maskingKeys), nil
+}
+
+// getSemanticTypeForColumnResource returns the semantic type masking the column for the principal.
+// It returns empty if the column is not masked.
+func (s *QueryResultMasker) getSemanticTypeForColumnResource(
+	ctx context.Context,
+	m *maskingLevelEvaluator,
+	instance *store.InstanceMessage,
+	sourceColumn base.ColumnResource,
+	maskingExceptionPolicyMap map[string]*storepb.MaskingExceptionPolicy,
+	action storepb.MaskingExceptionPolicy_MaskingException_Action,
+	currentPrincipal *store.UserMessage,
+) (string, error) {
 	database, err := s.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
 		InstanceID:   &instance.ResourceID,
 		DatabaseName: &sourceColumn.Database,
 	})
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to find database: %q", sourceColumn.Database)
+		return "", errors.Wrapf(err, "failed to find database: %q", sourceColumn.Database)
 	}
 	if database == nil {
-		return masker.NewNoneMasker(), nil
+		return "", nil
 	}
 
 	project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{
 		ResourceID: &database.ProjectID,
 	})
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to find project: %q", database.ProjectID)
+		return "", errors.Wrapf(err, "failed to find project: %q", database.ProjectID)
 	}
 	if project == nil {
-		return masker.NewNoneMasker(), nil
+		return "", nil
 	}
 
 	meta, config, err := s.getColumnForColumnResource(ctx, instance.ResourceID, &sourceColumn)
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to get database metadata for column resource: %q", sourceColumn.String())
+		return "", errors.Wrapf(err, "failed to get database metadata for column resource: %q", sourceColumn.String())
 	}
 	// Span and metadata are not the same in real time, so we fall back to none masker.
 	if meta == nil {
-		return masker.NewNoneMasker(), nil
+		return "", nil
 	}
 
 	var maskingExceptionPolicy *storepb.MaskingExceptionPolicy
@@ -184,7 +239,7 @@ func (s *QueryResultMasker) getMaskerForColumnResource(
 	if _, ok := maskingExceptionPolicyMap[database.ProjectID]; !ok {
 		policy, err := s.store.GetMaskingExceptionPolicyByProjectUID(ctx, project.UID)
 		if err != nil {
-			return nil, errors.Wrapf(err, "failed to find masking exception policy for project %q", project.ResourceID)
+			return "", errors.Wrapf(err, "failed to find masking exception policy for project %q", project.ResourceID)
 		}
 		// It is safe if policy is nil.
 		maskingExceptionPolicyMap[database.ProjectID] = policy
@@ -211,19 +266,9 @@ func (s *QueryResultMasker) getMaskerForColumnResource(
 
 	semanticTypeID, err := m.evaluateSemanticTypeOfColumn(database, sourceColumn.Schema, sourceColumn.Table, sourceColumn.Column, project.DataClassificationConfigID, config, maskingExceptionContainsCurrentPrincipal)
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to evaluate masking level of database %q, schema %q, table %q, column %q", sourceColumn.Database, sourceColumn.Schema, sourceColumn.Table, sourceColumn.Column)
-	}
-
-	// Built-in algorithm.
-	switch semanticTypeID {
-	case "bb.default":
-		return masker.NewDefaultFullMasker(), nil
-	case "bb.default-partial":
-		return masker.NewDefaultRangeMasker(), nil
+		return "", errors.Wrapf(err, "failed to evaluate masking level of database %q, schema %q, table %q, column %q", sourceColumn.Database, sourceColumn.Schema, sourceColumn.Table, sourceColumn.Column)
 	}
-
-	semanticType := m.semanticTypesMap[semanticTypeID]
-	return getMaskerByMaskingAlgorithmAndLevel(semanticType.GetAlgorithm()), nil
+	return semanticTypeID, nil
 }
 
 func (s *QueryResultMasker) getColumnForColumnResource(ctx context.Context, instanceID string, sourceColumn *base.ColumnResource) (*storepb.ColumnMetadata, *storepb.ColumnCatalog, error) {
@@ -278,7 +323,7 @@ func (s *QueryResultMasker) getColumnForColumnResource(ctx context.Context, inst
 	return columnMetadata, columnConfig, nil
 }
 
-func getMaskerByMaskingAlgorithmAndLevel(algorithm *storepb.Algorithm) masker.Masker {
+func getMaskerByMaskingAlgorithmAndLevel(algorithm *storepb.Algorithm, maskingKeys map[string][]byte) masker.Masker {
 	if algorithm == nil {
 		return masker.NewNoneMasker()
 	}
@@ -292,6 +337,31 @@ func getMaskerByMaskingAlgorithmAndLevel(algorithm *storepb.Algorithm) masker.Ma
 		return masker.NewMD5Masker(m.Md5Mask.Salt)
 	case *storepb.Algorithm_InnerOuterMask_:
 		return masker.NewInnerOuterMasker(m.InnerOuterMask.Type, m.InnerOuterMask.PrefixLen, m.InnerOuterMask.SuffixLen, m.InnerOuterMask.Substitution)
+	case *storepb.Algorithm_FormatPreservingEncryptionMask_:
+		// Fall back to the full mask if the key or tweak is unavailable, so that the data is never exposed.
+		key, ok := maskingKeys[m.FormatPreservingEncryptionMask.KeyId]
+		if !ok {
+			slog.Warn("masking key not found", slog.String("id", m.FormatPreservingEncryptionMask.KeyId))
+			return masker.NewDefaultFullMasker()
+		}
+		tweak, err := hex.DecodeString(m.FormatPreservingEncryptionMask.Tweak)
+		if err != nil {
+			slog.Warn("failed to decode format-preserving encryption tweak", log.BBError(err))
+			return masker.NewDefaultFullMasker()
+		}
+		fpeMasker, err := masker.NewFormatPreservingEncryptionMasker(m.FormatPreservingEncryptionMask.Mode, key, tweak, m.FormatPreservingEncryptionMask.Format)
+		if err != nil {
+			slog.Warn("failed to create format-preserving encryption masker", log.BBError(err))
+			return masker.NewDefaultFullMasker()
+		}
+		return fpeMasker
+	case *storepb.Algorithm_TokenizationMask_:
+		key, ok := maskingKeys[m.TokenizationMask.KeyId]
+		if !ok {
+			slog.Warn("masking key not found", slog.String("id", m.TokenizationMask.KeyId))
+			return masker.NewDefaultFullMasker()
+		}
+		return masker.NewTokenizationMasker(key, m.TokenizationMask.Prefix, m.TokenizationMask.Length)
 	}
 	return masker.NewNoneMasker()
 }
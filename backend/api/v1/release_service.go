@@ -2,6 +2,8 @@ package v1
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"slices"
 
 	"github.com/google/uuid"
@@ -13,11 +15,13 @@ import (
 
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/component/dbfactory"
+	"github.com/bytebase/bytebase/backend/component/iam"
 	"github.com/bytebase/bytebase/backend/component/sheet"
 	"github.com/bytebase/bytebase/backend/plugin/advisor"
 	"github.com/bytebase/bytebase/backend/plugin/advisor/catalog"
 	"github.com/bytebase/bytebase/backend/plugin/db"
 	"github.com/bytebase/bytebase/backend/runner/schemasync"
+	runnerutils "github.com/bytebase/bytebase/backend/runner/utils"
 	"github.com/bytebase/bytebase/backend/store"
 	"github.com/bytebase/bytebase/backend/utils"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
@@ -30,6 +34,12 @@ type ReleaseService struct {
 	sheetManager *sheet.Manager
 	schemaSyncer *schemasync.Syncer
 	dbFactory    *dbfactory.DBFactory
+	iamManager   *iam.Manager
+
+	// The services used to promote the releases.
+	planService    *PlanService
+	issueService   *IssueService
+	rolloutService *RolloutService
 }
 
 func NewReleaseService(
@@ -37,12 +47,20 @@ func NewReleaseService(
 	sheetManager *sheet.Manager,
 	schemaSyncer *schemasync.Syncer,
 	dbFactory *dbfactory.DBFactory,
+	iamManager *iam.Manager,
+	planService *PlanService,
+	issueService *IssueService,
+	rolloutService *RolloutService,
 ) *ReleaseService {
 	return &ReleaseService{
-		store:        store,
-		sheetManager: sheetManager,
-		schemaSyncer: schemaSyncer,
-		dbFactory:    dbFactory,
+		store:          store,
+		sheetManager:   sheetManager,
+		schemaSyncer:   schemaSyncer,
+		dbFactory:      dbFactory,
+		iamManager:     iamManager,
+		planService:    planService,
+		issueService:   issueService,
+		rolloutService: rolloutService,
 	}
 }
 
@@ -354,12 +372,43 @@ func (s *ReleaseService) CheckRelease(ctx context.Context, request *v1pb.CheckRe
 				if err != nil {
 					return nil, status.Errorf(codes.Internal, "failed to list revisions: %v", err)
 				}
-				if len(revisions) > 0 {
-					// Skip the file if it has been applied to the database.
-					continue
+				switch file.Type {
+				case v1pb.ReleaseFileType_REPEATABLE, v1pb.ReleaseFileType_DECLARATIVE:
+					// Skip the file if the same content has been applied to the database.
+					if len(revisions) > 0 && revisions[0].Payload.SheetSha256 == getStatementSha256Hex(file.Statement) {
+						continue
+					}
+				default:
+					if len(revisions) > 0 {
+						// Skip the file if it has been applied to the database.
+						continue
+					}
+				}
+
+				statement := file.Statement
+				if file.Type == v1pb.ReleaseFileType_DECLARATIVE {
+					// Review the diff to apply rather than the desired schema.
+					diff, err := runnerutils.ComputeDatabaseSchemaDiff(ctx, instance, database, s.dbFactory, file.Statement)
+					if err != nil {
+						response.Results = append(response.Results, &v1pb.CheckReleaseResponse_CheckResult{
+							File: file.Path,
+							Advices: []*v1pb.Advice{
+								{
+									Status:  v1pb.Advice_ERROR,
+									Title:   "Invalid declarative schema",
+									Content: err.Error(),
+								},
+							},
+						})
+						continue
+					}
+					if diff == "" {
+						continue
+					}
+					statement = diff
 				}
 
-				adviceStatus, advices, err := s.runSQLReviewCheckForFile(ctx, catalog, instance, database, file)
+				adviceStatus, advices, err := s.runSQLReviewCheckForFile(ctx, catalog, instance, database, file.ChangeType, statement)
 				if err != nil {
 					return nil, status.Errorf(codes.Internal, "failed to check SQL review: %v", err)
 				}
@@ -381,7 +430,8 @@ func (s *ReleaseService) runSQLReviewCheckForFile(
 	catalog *catalog.Catalog,
 	instance *store.InstanceMessage,
 	database *store.DatabaseMessage,
-	file *v1pb.Release_File,
+	fileChangeType v1pb.Release_File_ChangeType,
+	statement string,
 ) (storepb.Advice_Status, []*v1pb.Advice, error) {
 	if !isSQLReviewSupported(instance.Engine) || database == nil {
 		return storepb.Advice_SUCCESS, nil, nil
@@ -406,7 +456,7 @@ func (s *ReleaseService) runSQLReviewCheckForFile(
 
 	dbMetadata := dbSchema.GetMetadata()
 	changeType := storepb.PlanCheckRunConfig_DDL
-	switch file.ChangeType {
+	switch fileChangeType {
 	case v1pb.Release_File_DDL_GHOST:
 		changeType = storepb.PlanCheckRunConfig_DDL_GHOST
 	case v1pb.Release_File_DML:
@@ -451,7 +501,7 @@ func (s *ReleaseService) runSQLReviewCheckForFile(
 		}
 	}
 
-	res, err := advisor.SQLReviewCheck(s.sheetManager, file.Statement, reviewConfig.SqlReviewRules, context)
+	res, err := advisor.SQLReviewCheck(s.sheetManager, statement, reviewConfig.SqlReviewRules, context)
 	if err != nil {
 		return storepb.Advice_ERROR, nil, status.Errorf(codes.Internal, "failed to exec SQL review with error: %v", err)
 	}
@@ -474,6 +524,12 @@ func (s *ReleaseService) runSQLReviewCheckForFile(
 	return adviceLevel, advices, nil
 }
 
+// getStatementSha256Hex returns the SHA256 hash of the statement in the same way as the sheet.
+func getStatementSha256Hex(statement string) string {
+	h := sha256.Sum256([]byte(statement))
+	return hex.EncodeToString(h[:])
+}
+
 func convertToReleases(ctx context.Context, s *store.Store, releases []*store.ReleaseMessage) ([]*v1pb.Release, error) {
 	var rs []*v1pb.Release
 	for _, release := range releases {
@@ -604,6 +660,7 @@ func convertReleaseVcsSource(vs *v1pb.Release_VCSSource) *storepb.ReleasePayload
 
 func validateAndSanitizeReleaseFiles(files []*v1pb.Release_File) ([]*v1pb.Release_File, error) {
 	versionSet := map[string]struct{}{}
+	hasDeclarativeFile := false
 
 	for _, f := range files {
 		f.Id = uuid.NewString()
@@ -612,7 +669,12 @@ func validateAndSanitizeReleaseFiles(files []*v1pb.Release_File) ([]*v1pb.Releas
 			return nil, errors.Errorf("file version cannot be empty")
 		}
 		switch f.Type {
-		case v1pb.ReleaseFileType_VERSIONED:
+		case v1pb.ReleaseFileType_VERSIONED, v1pb.ReleaseFileType_REPEATABLE:
+		case v1pb.ReleaseFileType_DECLARATIVE:
+			if hasDeclarativeFile {
+				return nil, errors.Errorf("found more than one declarative file")
+			}
+			hasDeclarativeFile = true
 		case v1pb.ReleaseFileType_TYPE_UNSPECIFIED:
 			return nil, errors.Errorf("unexpected file type %q", f.Type.String())
 		default:
@@ -0,0 +1,388 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/component/iam"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// GetReleaseMatrix gets the release deployed to each environment and the pending files of the databases.
+func (s *ReleaseService) GetReleaseMatrix(ctx context.Context, request *v1pb.GetReleaseMatrixRequest) (*v1pb.ReleaseMatrix, error) {
+	user, ok := ctx.Value(common.UserContextKey).(*store.UserMessage)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "user not found")
+	}
+	projectID, err := common.GetProjectID(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to get project id, err: %v", err)
+	}
+	project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{ResourceID: &projectID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to find project, err: %v", err)
+	}
+	if project == nil {
+		return nil, status.Errorf(codes.NotFound, "project %q not found", projectID)
+	}
+	projects, err := s.getPromotionProjects(ctx, user, project, request.Projects, iam.PermissionDatabasesList, iam.PermissionRevisionsList)
+	if err != nil {
+		return nil, err
+	}
+
+	environments, err := s.store.ListEnvironmentV2(ctx, &store.FindEnvironmentMessage{})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list environments, err: %v", err)
+	}
+	databasesByEnvironment, err := s.listDatabasesByEnvironment(ctx, projects)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list databases, err: %v", err)
+	}
+	latestRelease, err := s.getLatestRelease(ctx, project)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get the latest release, err: %v", err)
+	}
+	pins := map[string]*storepb.ReleasePin{}
+	for _, pin := range project.Setting.GetReleasePins() {
+		pins[pin.Environment] = pin
+	}
+
+	matrix := &v1pb.ReleaseMatrix{}
+	for _, environment := range environments {
+		e := &v1pb.ReleaseMatrix_Environment{
+			Name: common.FormatEnvironment(environment.ResourceID),
+		}
+		release := latestRelease
+		if pin, ok := pins[environment.ResourceID]; ok {
+			e.Pinned = true
+			release, err = s.store.GetRelease(ctx, pin.ReleaseUid)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get release %d, err: %v", pin.ReleaseUid, err)
+			}
+			if pin.PromotingReleaseUid != 0 {
+				e.PromotingRelease = common.FormatReleaseName(project.ResourceID, pin.PromotingReleaseUid)
+			}
+		}
+		var releaseName string
+		if release != nil {
+			releaseName = common.FormatReleaseName(project.ResourceID, release.UID)
+			e.Release = releaseName
+		}
+		for _, database := range databasesByEnvironment[environment.ResourceID] {
+			revisions, err := s.store.ListRevisions(ctx, &store.FindRevisionMessage{DatabaseUID: &database.UID})
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to list revisions, err: %v", err)
+			}
+			d := &v1pb.ReleaseMatrix_Database{
+				Name:    common.FormatDatabase(database.InstanceID, database.DatabaseName),
+				Version: getLatestRevisionVersion(revisions),
+				Project: common.FormatProject(database.ProjectID),
+			}
+			if release != nil {
+				// The out of order files are pending as well.
+				specs, _, _, err := getSpecs(database, revisions, release, releaseName, true /* allowOoo */)
+				if err != nil {
+					return nil, status.Errorf(codes.Internal, "failed to get pending files, err: %v", err)
+				}
+				for _, spec := range specs {
+					d.PendingFiles = append(d.PendingFiles, spec.GetSpecReleaseSource().GetFile())
+				}
+			}
+			e.Databases = append(e.Databases, d)
+		}
+		matrix.Environments = append(matrix.Environments, e)
+	}
+	return matrix, nil
+}
+
+// PromoteRelease creates the plan, issue and rollout applying the pending files of the release to the databases of an environment.
+func (s *ReleaseService) PromoteRelease(ctx context.Context, request *v1pb.PromoteReleaseRequest) (*v1pb.PromoteReleaseResponse, error) {
+	user, ok := ctx.Value(common.UserContextKey).(*store.UserMessage)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "user not found")
+	}
+	projectID, releaseUID, err := common.GetProjectReleaseUID(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to get release uid, err: %v", err)
+	}
+	project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{ResourceID: &projectID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to find project, err: %v", err)
+	}
+	if project == nil {
+		return nil, status.Errorf(codes.NotFound, "project %q not found", projectID)
+	}
+	release, err := s.store.GetRelease(ctx, releaseUID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get release, err: %v", err)
+	}
+	if release == nil || release.Deleted || release.ProjectUID != project.UID {
+		return nil, status.Errorf(codes.NotFound, "release %q not found", request.Name)
+	}
+	// The promotion creates the plans, issues and rollouts in every project, so the caller needs all the permissions.
+	projects, err := s.getPromotionProjects(ctx, user, project, request.Projects, iam.PermissionPlansCreate, iam.PermissionIssuesCreate, iam.PermissionRolloutsCreate)
+	if err != nil {
+		return nil, err
+	}
+
+	environments, err := s.store.ListEnvironmentV2(ctx, &store.FindEnvironmentMessage{})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list environments, err: %v", err)
+	}
+	pinnedEnvironments := map[string]bool{}
+	for _, pin := range project.Setting.GetReleasePins() {
+		pinnedEnvironments[pin.Environment] = true
+	}
+	if request.Environment != "" {
+		environmentID, err := common.GetEnvironmentID(request.Environment)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		var found *store.EnvironmentMessage
+		for _, environment := range environments {
+			if environment.ResourceID == environmentID {
+				found = environment
+				break
+			}
+		}
+		if found == nil {
+			return nil, status.Errorf(codes.NotFound, "environment %q not found", request.Environment)
+		}
+		environments = []*store.EnvironmentMessage{found}
+	} else {
+		// The pinned environments are only promoted to explicitly.
+		var unpinned []*store.EnvironmentMessage
+		for _, environment := range environments {
+			if !pinnedEnvironments[environment.ResourceID] {
+				unpinned = append(unpinned, environment)
+			}
+		}
+		environments = unpinned
+	}
+	databasesByEnvironment, err := s.listDatabasesByEnvironment(ctx, projects)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list databases, err: %v", err)
+	}
+
+	// Promote to the requested environment, or the first unpinned environment in the pipeline order having pending files.
+	var environment *store.EnvironmentMessage
+	specsByProject := map[string][]*v1pb.Plan_Spec{}
+	for _, e := range environments {
+		for _, database := range databasesByEnvironment[e.ResourceID] {
+			revisions, err := s.store.ListRevisions(ctx, &store.FindRevisionMessage{DatabaseUID: &database.UID})
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to list revisions, err: %v", err)
+			}
+			databaseSpecs, outOfOrder, _, err := getSpecs(database, revisions, release, request.Name, false /* allowOoo */)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get pending files, err: %v", err)
+			}
+			if len(outOfOrder.Files) > 0 {
+				return nil, status.Errorf(codes.FailedPrecondition, "files %s are out of order for database %q", strings.Join(outOfOrder.Files, ", "), outOfOrder.Database)
+			}
+			specsByProject[database.ProjectID] = append(specsByProject[database.ProjectID], databaseSpecs...)
+		}
+		if len(specsByProject) > 0 {
+			environment = e
+			break
+		}
+	}
+	if environment == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "release %q has no pending files to promote", request.Name)
+	}
+	// The pin of the environment is moved to the release after the promotion.
+	movePin := false
+	for _, pin := range project.Setting.GetReleasePins() {
+		if pin.Environment == environment.ResourceID && pin.ReleaseUid != release.UID {
+			movePin = true
+		}
+	}
+	if movePin {
+		ok, err := s.iamManager.CheckPermission(ctx, iam.PermissionProjectsUpdate, user, project.ResourceID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to check permission, error: %v", err)
+		}
+		if !ok {
+			return nil, status.Errorf(codes.PermissionDenied, "user does not have permission %q in project %q to move the release pin", iam.PermissionProjectsUpdate, project.ResourceID)
+		}
+	}
+
+	response := &v1pb.PromoteReleaseResponse{
+		Environment: common.FormatEnvironment(environment.ResourceID),
+	}
+	var planUIDs []int64
+	title := fmt.Sprintf("Promote release %q to %s", release.Payload.GetTitle(), environment.Title)
+	for _, p := range projects {
+		specs := specsByProject[p.ResourceID]
+		if len(specs) == 0 {
+			continue
+		}
+		plan, err := s.planService.CreatePlan(ctx, &v1pb.CreatePlanRequest{
+			Parent: common.FormatProject(p.ResourceID),
+			Plan: &v1pb.Plan{
+				Title: title,
+				Steps: []*v1pb.Plan_Step{
+					{
+						Title: environment.Title,
+						Specs: specs,
+					},
+				},
+				ReleaseSource: &v1pb.Plan_ReleaseSource{
+					Release: request.Name,
+				},
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		_, planUID, err := common.GetProjectIDPlanID(plan.Name)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get plan uid, err: %v", err)
+		}
+		planUIDs = append(planUIDs, planUID)
+		issue, err := s.issueService.CreateIssue(ctx, &v1pb.CreateIssueRequest{
+			Parent: common.FormatProject(p.ResourceID),
+			Issue: &v1pb.Issue{
+				Title: title,
+				Type:  v1pb.Issue_DATABASE_CHANGE,
+				Plan:  plan.Name,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		rollout, err := s.rolloutService.CreateRollout(ctx, &v1pb.CreateRolloutRequest{
+			Parent: common.FormatProject(p.ResourceID),
+			Rollout: &v1pb.Rollout{
+				Plan: plan.Name,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		response.Promotions = append(response.Promotions, &v1pb.PromoteReleaseResponse_Promotion{
+			Project: common.FormatProject(p.ResourceID),
+			Plan:    plan.Name,
+			Issue:   issue.Name,
+			Rollout: rollout.Name,
+		})
+	}
+
+	// The pin is moved by the scheduler when the rollouts of the plans complete.
+	if movePin {
+		projectSettings := project.Setting
+		projectSettings.ReleasePins = startReleasePromotion(project.Setting.GetReleasePins(), environment.ResourceID, release.UID, planUIDs)
+		if _, err := s.store.UpdateProjectV2(ctx, &store.UpdateProjectMessage{
+			UpdaterID:  user.ID,
+			ResourceID: project.ResourceID,
+			Setting:    projectSettings,
+		}); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to update release pins, err: %v", err)
+		}
+	}
+
+	return response, nil
+}
+
+// getPromotionProjects returns the project of the release and the other requested projects,
+// after checking the permissions of the user in the other projects.
+func (s *ReleaseService) getPromotionProjects(ctx context.Context, user *store.UserMessage, project *store.ProjectMessage, names []string, permissions ...iam.Permission) ([]*store.ProjectMessage, error) {
+	projects := []*store.ProjectMessage{project}
+	seen := map[string]bool{project.ResourceID: true}
+	for _, name := range names {
+		projectID, err := common.GetProjectID(name)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if seen[projectID] {
+			continue
+		}
+		seen[projectID] = true
+		p, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{ResourceID: &projectID})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to find project, err: %v", err)
+		}
+		if p == nil || p.Deleted {
+			return nil, status.Errorf(codes.NotFound, "project %q not found", projectID)
+		}
+		projects = append(projects, p)
+	}
+	for _, p := range projects {
+		for _, permission := range permissions {
+			ok, err := s.iamManager.CheckPermission(ctx, permission, user, p.ResourceID)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to check permission, error: %v", err)
+			}
+			if !ok {
+				return nil, status.Errorf(codes.PermissionDenied, "user does not have permission %q in project %q", permission, p.ResourceID)
+			}
+		}
+	}
+	return projects, nil
+}
+
+func (s *ReleaseService) listDatabasesByEnvironment(ctx context.Context, projects []*store.ProjectMessage) (map[string][]*store.DatabaseMessage, error) {
+	databasesByEnvironment := map[string][]*store.DatabaseMessage{}
+	for _, project := range projects {
+		databases, err := s.store.ListDatabases(ctx, &store.FindDatabaseMessage{ProjectID: &project.ResourceID})
+		if err != nil {
+			return nil, err
+		}
+		for _, database := range databases {
+			databasesByEnvironment[database.EffectiveEnvironmentID] = append(databasesByEnvironment[database.EffectiveEnvironmentID], database)
+		}
+	}
+	return databasesByEnvironment, nil
+}
+
+func (s *ReleaseService) getLatestRelease(ctx context.Context, project *store.ProjectMessage) (*store.ReleaseMessage, error) {
+	limit := 1
+	releases, err := s.store.ListReleases(ctx, &store.FindReleaseMessage{ProjectUID: &project.UID, Limit: &limit})
+	if err != nil {
+		return nil, err
+	}
+	if len(releases) == 0 {
+		return nil, nil
+	}
+	return releases[0], nil
+}
+
+// getLatestRevisionVersion returns the latest version of the versioned revisions.
+func getLatestRevisionVersion(revisions []*store.RevisionMessage) string {
+	var version string
+	for _, r := range revisions {
+		if t := r.Payload.GetType(); t == storepb.ReleaseFileType_REPEATABLE || t == storepb.ReleaseFileType_DECLARATIVE {
+			continue
+		}
+		if r.Version > version {
+			version = r.Version
+		}
+	}
+	return version
+}
+
+// startReleasePromotion records the promotion of the release to the pinned environment.
+// The pin is moved to the release after the rollouts of the promotion plans complete.
+func startReleasePromotion(pins []*storepb.ReleasePin, environment string, releaseUID int64, planUIDs []int64) []*storepb.ReleasePin {
+	var releasePins []*storepb.ReleasePin
+	for _, pin := range pins {
+		if pin.Environment == environment && pin.ReleaseUid != releaseUID {
+			releasePins = append(releasePins, &storepb.ReleasePin{
+				Environment:         environment,
+				ReleaseUid:          pin.ReleaseUid,
+				PromotingReleaseUid: releaseUID,
+				PromotingPlanUids:   planUIDs,
+			})
+			continue
+		}
+		releasePins = append(releasePins, pin)
+	}
+	return releasePins
+}
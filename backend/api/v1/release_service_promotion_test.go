@@ -0,0 +1,42 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestGetLatestRevisionVersion(t *testing.T) {
+	a := require.New(t)
+	a.Equal("", getLatestRevisionVersion(nil))
+	a.Equal("0002", getLatestRevisionVersion([]*store.RevisionMessage{
+		{Version: "0001", Payload: &storepb.RevisionPayload{Type: storepb.ReleaseFileType_VERSIONED}},
+		{Version: "0002", Payload: &storepb.RevisionPayload{}},
+		{Version: "R__views", Payload: &storepb.RevisionPayload{Type: storepb.ReleaseFileType_REPEATABLE}},
+		{Version: "schema", Payload: &storepb.RevisionPayload{Type: storepb.ReleaseFileType_DECLARATIVE}},
+	}))
+}
+
+func TestStartReleasePromotion(t *testing.T) {
+	a := require.New(t)
+	pins := []*storepb.ReleasePin{
+		{Environment: "staging", ReleaseUid: 41},
+		{Environment: "prod", ReleaseUid: 42},
+	}
+
+	// The pin stays at the release until the rollouts complete.
+	got := startReleasePromotion(pins, "prod", 43, []int64{101, 102})
+	a.Equal([]*storepb.ReleasePin{
+		{Environment: "staging", ReleaseUid: 41},
+		{Environment: "prod", ReleaseUid: 42, PromotingReleaseUid: 43, PromotingPlanUids: []int64{101, 102}},
+	}, got)
+	// The original pins are not modified.
+	a.Zero(pins[1].PromotingReleaseUid)
+
+	a.Equal(pins, startReleasePromotion(pins, "prod", 42, []int64{101}))
+	// The environment tracking the latest release is not pinned by the promotion.
+	a.Equal(pins, startReleasePromotion(pins, "test", 43, []int64{101}))
+}
@@ -183,6 +183,8 @@ func convertToSource(source store.RiskSource) v1pb.Risk_Source {
 		return v1pb.Risk_REQUEST_QUERY
 	case store.RiskRequestExport:
 		return v1pb.Risk_REQUEST_EXPORT
+	case store.RiskRequestJITAccess:
+		return v1pb.Risk_REQUEST_JIT_ACCESS
 	case store.RiskSourceDatabaseDataExport:
 		return v1pb.Risk_DATA_EXPORT
 	}
@@ -201,6 +203,8 @@ func convertSource(source v1pb.Risk_Source) store.RiskSource {
 		return store.RiskRequestQuery
 	case v1pb.Risk_REQUEST_EXPORT:
 		return store.RiskRequestExport
+	case v1pb.Risk_REQUEST_JIT_ACCESS:
+		return store.RiskRequestJITAccess
 	case v1pb.Risk_DATA_EXPORT:
 		return store.RiskSourceDatabaseDataExport
 	}
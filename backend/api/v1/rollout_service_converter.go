@@ -143,10 +143,23 @@ func convertToPlanSpecChangeDatabaseConfig(config *storepb.PlanConfig_Spec_Chang
 			PreUpdateBackupDetail: &v1pb.Plan_ChangeDatabaseConfig_PreUpdateBackupDetail{
 				Database: c.PreUpdateBackupDetail.GetDatabase(),
 			},
+			DmlBatchConfig: convertToPlanSpecDMLBatchConfig(c.DmlBatchConfig),
+			DryRun:         c.DryRun,
 		},
 	}
 }
 
+func convertToPlanSpecDMLBatchConfig(c *storepb.DMLBatchConfig) *v1pb.Plan_ChangeDatabaseConfig_DMLBatchConfig {
+	if c == nil {
+		return nil
+	}
+	return &v1pb.Plan_ChangeDatabaseConfig_DMLBatchConfig{
+		ChunkSize:     c.ChunkSize,
+		Sleep:         c.Sleep,
+		MaxReplicaLag: c.MaxReplicaLag,
+	}
+}
+
 func convertToPlanSpecChangeDatabaseConfigType(t storepb.PlanConfig_ChangeDatabaseConfig_Type) v1pb.Plan_ChangeDatabaseConfig_Type {
 	switch t {
 	case storepb.PlanConfig_ChangeDatabaseConfig_TYPE_UNSPECIFIED:
@@ -301,10 +314,23 @@ func convertPlanSpecChangeDatabaseConfig(config *v1pb.Plan_Spec_ChangeDatabaseCo
 			SchemaVersion:         c.SchemaVersion,
 			GhostFlags:            c.GhostFlags,
 			PreUpdateBackupDetail: preUpdateBackupDetail,
+			DmlBatchConfig:        convertPlanSpecDMLBatchConfig(c.DmlBatchConfig),
+			DryRun:                c.DryRun,
 		},
 	}
 }
 
+func convertPlanSpecDMLBatchConfig(c *v1pb.Plan_ChangeDatabaseConfig_DMLBatchConfig) *storepb.DMLBatchConfig {
+	if c == nil {
+		return nil
+	}
+	return &storepb.DMLBatchConfig{
+		ChunkSize:     c.ChunkSize,
+		Sleep:         c.Sleep,
+		MaxReplicaLag: c.MaxReplicaLag,
+	}
+}
+
 func convertPlanSpecExportDataConfig(config *v1pb.Plan_Spec_ExportDataConfig) *storepb.PlanConfig_Spec_ExportDataConfig {
 	c := config.ExportDataConfig
 	return &storepb.PlanConfig_Spec_ExportDataConfig{
@@ -398,6 +424,8 @@ func convertToPlanCheckRunType(t store.PlanCheckRunType) v1pb.PlanCheckRun_Type
 		return v1pb.PlanCheckRun_DATABASE_CONNECT
 	case store.PlanCheckDatabaseGhostSync:
 		return v1pb.PlanCheckRun_DATABASE_GHOST_SYNC
+	case store.PlanCheckDatabaseStatementDryRun:
+		return v1pb.PlanCheckRun_DATABASE_STATEMENT_DRY_RUN
 	}
 	return v1pb.PlanCheckRun_TYPE_UNSPECIFIED
 }
@@ -450,6 +478,14 @@ func convertToPlanCheckRunResult(result *storepb.PlanCheckRunResult_Result) *v1p
 				EndPosition:   convertToPosition(report.SqlReviewReport.EndPosition),
 			},
 		}
+	case *storepb.PlanCheckRunResult_Result_DryRunReport_:
+		resultV1.Report = &v1pb.PlanCheckRun_Result_DryRunReport_{
+			DryRunReport: &v1pb.PlanCheckRun_Result_DryRunReport{
+				ExecutionDuration: report.DryRunReport.ExecutionDuration,
+				LockWaitDuration:  report.DryRunReport.LockWaitDuration,
+				SandboxClone:      report.DryRunReport.SandboxClone,
+			},
+		}
 	}
 	return resultV1
 }
@@ -595,6 +631,37 @@ func convertToSchedulerInfoWaitingCause(ctx context.Context, s *store.Store, c *
 				},
 			},
 		}, nil
+	case *storepb.SchedulerInfo_WaitingCause_TaskRunQuota:
+		resourceUID := int(cause.TaskRunQuota.ResourceUid)
+		var resource string
+		switch cause.TaskRunQuota.Scope {
+		case storepb.SchedulerInfo_TaskRunQuota_INSTANCE:
+			instance, err := s.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &resourceUID})
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to get instance %v", resourceUID)
+			}
+			if instance == nil {
+				return nil, errors.Errorf("instance %v not found", resourceUID)
+			}
+			resource = common.FormatInstance(instance.ResourceID)
+		case storepb.SchedulerInfo_TaskRunQuota_ENVIRONMENT:
+			environment, err := s.GetEnvironmentV2(ctx, &store.FindEnvironmentMessage{UID: &resourceUID})
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to get environment %v", resourceUID)
+			}
+			if environment == nil {
+				return nil, errors.Errorf("environment %v not found", resourceUID)
+			}
+			resource = common.FormatEnvironment(environment.ResourceID)
+		}
+		return &v1pb.TaskRun_SchedulerInfo_WaitingCause{
+			Cause: &v1pb.TaskRun_SchedulerInfo_WaitingCause_TaskRunQuota_{
+				TaskRunQuota: &v1pb.TaskRun_SchedulerInfo_WaitingCause_TaskRunQuota{
+					Resource:      resource,
+					QueuePosition: cause.TaskRunQuota.QueuePosition,
+				},
+			},
+		}, nil
 	default:
 		return nil, nil
 	}
@@ -1142,6 +1209,30 @@ func convertToTaskRunLogEntries(logs []*store.TaskRunLog) []*v1pb.TaskRunLogEntr
 			prev.PriorBackup.EndTime = timestamppb.New(l.T)
 			prev.PriorBackup.Error = l.Payload.PriorBackupEnd.Error
 			prev.PriorBackup.PriorBackupDetail = convertToTaskRunPriorBackupDetail(l.Payload.PriorBackupEnd.PriorBackupDetail)
+
+		case storepb.TaskRunLog_HOOK_EXECUTE_START:
+			e := &v1pb.TaskRunLogEntry{
+				Type:     v1pb.TaskRunLogEntry_HOOK_EXECUTE,
+				LogTime:  timestamppb.New(l.T),
+				DeployId: l.Payload.DeployId,
+				HookExecute: &v1pb.TaskRunLogEntry_HookExecute{
+					Phase:     v1pb.TaskRunLogEntry_HookExecute_Phase(l.Payload.HookExecuteStart.Phase),
+					Statement: l.Payload.HookExecuteStart.Statement,
+					StartTime: timestamppb.New(l.T),
+				},
+			}
+			entries = append(entries, e)
+
+		case storepb.TaskRunLog_HOOK_EXECUTE_END:
+			if len(entries) == 0 {
+				continue
+			}
+			prev := entries[len(entries)-1]
+			if prev == nil || prev.Type != v1pb.TaskRunLogEntry_HOOK_EXECUTE {
+				continue
+			}
+			prev.HookExecute.EndTime = timestamppb.New(l.T)
+			prev.HookExecute.Error = l.Payload.HookExecuteEnd.Error
 		}
 	}
 
@@ -46,6 +46,9 @@ func transformDatabaseGroupSpecs(ctx context.Context, s *store.Store, project *s
 }
 
 func transformDatabaseGroupTargetToSpecs(ctx context.Context, s *store.Store, spec *storepb.PlanConfig_Spec, c *storepb.PlanConfig_ChangeDatabaseConfig, project *store.ProjectMessage, snapshot *storepb.PlanConfig_DeploymentSnapshot) ([]*storepb.PlanConfig_Spec, error) {
+	if err := validateAtomicRolloutBackup(ctx, s, c, project); err != nil {
+		return nil, err
+	}
 	// Use snapshot result if it's present.
 	for _, s := range snapshot.GetDatabaseGroupSnapshots() {
 		if s.DatabaseGroup == c.Target {
@@ -272,6 +275,29 @@ func getTaskCreatesFromCreateDatabaseConfig(ctx context.Context, s *store.Store,
 	return taskCreates, nil, nil
 }
 
+// validateAtomicRolloutBackup rejects the data changes to the atomic rollout database groups without the prior backup,
+// because the succeeded tenants are reverted from the prior backup once any tenant fails.
+func validateAtomicRolloutBackup(ctx context.Context, s *store.Store, c *storepb.PlanConfig_ChangeDatabaseConfig, project *store.ProjectMessage) error {
+	if c.Type != storepb.PlanConfig_ChangeDatabaseConfig_DATA || c.PreUpdateBackupDetail != nil {
+		return nil
+	}
+	_, databaseGroupID, err := common.GetProjectIDDatabaseGroupID(c.Target)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get project and deployment id from target %q", c.Target)
+	}
+	databaseGroup, err := s.GetDatabaseGroup(ctx, &store.FindDatabaseGroupMessage{ProjectUID: &project.UID, ResourceID: &databaseGroupID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get database group %q", databaseGroupID)
+	}
+	if databaseGroup == nil {
+		return errors.Errorf("database group %q not found", databaseGroupID)
+	}
+	if databaseGroup.Payload.GetMultitenancy() && databaseGroup.Payload.GetAtomicRollout() {
+		return errors.Errorf("the data change to the atomic rollout database group %q must enable the prior backup", c.Target)
+	}
+	return nil
+}
+
 func getTaskCreatesFromChangeDatabaseConfig(ctx context.Context, s *store.Store, spec *storepb.PlanConfig_Spec, c *storepb.PlanConfig_ChangeDatabaseConfig, project *store.ProjectMessage, registerEnvironmentID func(string) error) ([]*store.TaskMessage, []store.TaskIndexDAG, error) {
 	// possible target:
 	// 1. instances/{instance}/databases/{database}
@@ -517,6 +543,15 @@ func getTaskCreatesFromChangeDatabaseConfigDatabaseTarget(ctx context.Context, s
 		if err != nil {
 			return nil, nil, errors.Wrapf(err, "failed to get sheet id from sheet %q", c.Sheet)
 		}
+		if c.DmlBatchConfig != nil {
+			statement, err := s.GetSheetStatementByID(ctx, sheetUID)
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "failed to get sheet statement %d", sheetUID)
+			}
+			if err := validateDMLBatchTarget(instance.Engine, statement); err != nil {
+				return nil, nil, errors.Wrapf(err, "invalid dml batch config for database %q", database.DatabaseName)
+			}
+		}
 		preUpdateBackupDetail := &storepb.PreUpdateBackupDetail{}
 		if c.GetPreUpdateBackupDetail().GetDatabase() != "" {
 			preUpdateBackupDetail.Database = c.GetPreUpdateBackupDetail().GetDatabase()
@@ -526,6 +561,7 @@ func getTaskCreatesFromChangeDatabaseConfigDatabaseTarget(ctx context.Context, s
 			SheetId:               int32(sheetUID),
 			SchemaVersion:         getOrDefaultSchemaVersion(c.SchemaVersion),
 			PreUpdateBackupDetail: preUpdateBackupDetail,
+			DmlBatchConfig:        c.DmlBatchConfig,
 			TaskReleaseSource: &storepb.TaskReleaseSource{
 				File: spec.SpecReleaseSource.GetFile(),
 			},
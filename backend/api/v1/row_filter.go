@@ -0,0 +1,253 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/utils"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const userAttributePlaceholderPrefix = "user.attribute."
+
+var (
+	rowFilterPlaceholderRegexp = regexp.MustCompile(`\{\{\s*([^{}]*?)\s*\}\}`)
+	userAttributeKeyRegexp     = regexp.MustCompile(`^[A-Za-z0-9_\-.]+$`)
+)
+
+// validateRowFilterPredicate validates the placeholders of the row filter predicate.
+func validateRowFilterPredicate(predicate string) error {
+	if strings.TrimSpace(predicate) == "" {
+		return errors.New("predicate must not be empty")
+	}
+	for _, match := range rowFilterPlaceholderRegexp.FindAllStringSubmatch(predicate, -1) {
+		name := match[1]
+		if name == "user.email" || name == "user.id" {
+			continue
+		}
+		if key, ok := strings.CutPrefix(name, userAttributePlaceholderPrefix); ok && userAttributeKeyRegexp.MatchString(key) {
+			continue
+		}
+		return errors.Errorf("unsupported placeholder %q", match[0])
+	}
+	rest := rowFilterPlaceholderRegexp.ReplaceAllString(predicate, "")
+	if strings.Contains(rest, "{{") || strings.Contains(rest, "}}") {
+		return errors.New("unclosed placeholder")
+	}
+	return nil
+}
+
+// resolveRowFilterPredicate substitutes the placeholders in the predicate with the quoted string literals of the user values.
+func resolveRowFilterPredicate(engine storepb.Engine, predicate string, user *store.UserMessage) (string, error) {
+	var err error
+	resolved := rowFilterPlaceholderRegexp.ReplaceAllStringFunc(predicate, func(placeholder string) string {
+		name := rowFilterPlaceholderRegexp.FindStringSubmatch(placeholder)[1]
+		var value string
+		switch {
+		case name == "user.email":
+			value = user.Email
+		case name == "user.id":
+			value = strconv.Itoa(user.ID)
+		case strings.HasPrefix(name, userAttributePlaceholderPrefix):
+			key := strings.TrimPrefix(name, userAttributePlaceholderPrefix)
+			v, ok := user.Profile.GetAttributes()[key]
+			if !ok {
+				err = errors.Errorf("user %q has no attribute %q", user.Email, key)
+				return placeholder
+			}
+			value = v
+		default:
+			err = errors.Errorf("unsupported placeholder %q", placeholder)
+			return placeholder
+		}
+		return quoteRowFilterValue(engine, value)
+	})
+	if err != nil {
+		return "", err
+	}
+	return resolved, nil
+}
+
+func quoteRowFilterValue(engine storepb.Engine, value string) string {
+	switch engine {
+	case storepb.Engine_POSTGRES:
+		if strings.Contains(value, `\`) {
+			return fmt.Sprintf("E'%s'", strings.ReplaceAll(strings.ReplaceAll(value, `\`, `\\`), "'", "''"))
+		}
+		return fmt.Sprintf("'%s'", strings.ReplaceAll(value, "'", "''"))
+	default:
+		return fmt.Sprintf("'%s'", strings.ReplaceAll(strings.ReplaceAll(value, `\`, `\\`), "'", "''"))
+	}
+}
+
+// getRowFilters returns the row filters of the user for the tables in the instance.
+// The predicates of the rules matching the same table are combined with OR.
+func getRowFilters(ctx context.Context, stores *store.Store, instance *store.InstanceMessage, database *store.DatabaseMessage, user *store.UserMessage) ([]*base.RowFilter, error) {
+	project, err := stores.GetProjectV2(ctx, &store.FindProjectMessage{ResourceID: &database.ProjectID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get project %q", database.ProjectID)
+	}
+	if project == nil {
+		return nil, nil
+	}
+	policy, err := stores.GetRowFilterPolicyByProjectUID(ctx, project.UID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get row filter policy for project %q", project.ResourceID)
+	}
+
+	var filters []*base.RowFilter
+	predicates := make(map[base.SchemaResource][]string)
+	for _, rule := range policy.Rules {
+		instanceID, databaseName, err := common.GetInstanceDatabaseID(rule.Database)
+		if err != nil || instanceID != instance.ResourceID {
+			continue
+		}
+		if !isRowFilterMember(ctx, stores, rule.Members, user) {
+			continue
+		}
+		predicate, err := resolveRowFilterPredicate(instance.Engine, rule.Predicate, user)
+		if err != nil {
+			return nil, err
+		}
+		schema := rule.Schema
+		if schema == "" && instance.Engine == storepb.Engine_POSTGRES {
+			schema = "public"
+		}
+		key := base.SchemaResource{Database: databaseName, Schema: schema, Table: rule.Table}
+		if _, ok := predicates[key]; !ok {
+			filters = append(filters, &base.RowFilter{Database: databaseName, Schema: schema, Table: rule.Table})
+		}
+		predicates[key] = append(predicates[key], fmt.Sprintf("(%s)", predicate))
+	}
+	for _, filter := range filters {
+		filter.Predicate = strings.Join(predicates[base.SchemaResource{Database: filter.Database, Schema: filter.Schema, Table: filter.Table}], " OR ")
+	}
+	return filters, nil
+}
+
+func isRowFilterMember(ctx context.Context, stores *store.Store, members []string, user *store.UserMessage) bool {
+	for _, member := range members {
+		for _, u := range utils.GetUsersByMember(ctx, stores, member) {
+			if u.ID == user.ID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// applyRowFilters rewrites the statement so that the filtered tables only return the rows visible to the user.
+// The statement is rejected if the row filters cannot be proven to cover every access to the filtered tables,
+// including the backup tables, the views and the function calls which read the filtered tables without referencing them.
+// The spans are nil for the EXPLAIN queries, which return no table rows.
+func applyRowFilters(ctx context.Context, stores *store.Store, instance *store.InstanceMessage, database *store.DatabaseMessage, user *store.UserMessage, statement, schema string, spans []*base.QuerySpan) (string, error) {
+	filters, err := getRowFilters(ctx, stores, instance, database, user)
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to get row filters: %v", err)
+	}
+	return rewriteRowFilters(ctx, instance, database, filters, statement, schema, spans, BuildGetDatabaseMetadataFunc(stores))
+}
+
+// rewriteRowFilters rewrites the statement with the row filters, and rejects the statement reading the filtered tables in the ways the filters cannot cover.
+func rewriteRowFilters(ctx context.Context, instance *store.InstanceMessage, database *store.DatabaseMessage, filters []*base.RowFilter, statement, schema string, spans []*base.QuerySpan, getDatabaseMetadata base.GetDatabaseMetadataFunc) (string, error) {
+	if len(filters) == 0 {
+		return statement, nil
+	}
+	if instance.Engine != storepb.Engine_MYSQL && instance.Engine != storepb.Engine_MARIADB && instance.Engine != storepb.Engine_POSTGRES {
+		return "", status.Errorf(codes.PermissionDenied, "row filters are not supported for engine %s", instance.Engine)
+	}
+
+	ignoreCaseSensitive := store.IgnoreDatabaseAndTableCaseSensitive(instance)
+	result, err := base.RewriteRowFilter(instance.Engine, statement, database.DatabaseName, schema, filters, ignoreCaseSensitive)
+	if err != nil {
+		return "", status.Errorf(codes.PermissionDenied, "cannot apply row filters: %v", err)
+	}
+
+	// The backup tables are mapped to their source tables in the spans, but they are not rewritten.
+	resources, err := base.ExtractResourceList(instance.Engine, database.DatabaseName, schema, statement)
+	if err != nil {
+		return "", status.Errorf(codes.PermissionDenied, "cannot apply row filters: %v", err)
+	}
+	for _, resource := range resources {
+		if isBackupTable(instance.Engine, base.ColumnResource{Database: resource.Database, Schema: resource.Schema, Table: resource.Table}) {
+			return "", status.Errorf(codes.PermissionDenied, "cannot apply row filters to the query reading backup table %q", resource.Table)
+		}
+	}
+
+	filtered := make(map[base.SchemaResource]bool)
+	for _, filter := range filters {
+		filtered[normalizeRowFilterResource(filter.Database, filter.Schema, filter.Table, ignoreCaseSensitive)] = true
+	}
+	applied := make(map[base.SchemaResource]bool)
+	for _, filter := range result.Applied {
+		applied[normalizeRowFilterResource(filter.Database, filter.Schema, filter.Table, ignoreCaseSensitive)] = true
+	}
+	readsFilteredTable := false
+	for _, span := range spans {
+		if span.NotFoundError != nil {
+			return "", status.Errorf(codes.PermissionDenied, "cannot apply row filters to the query with unknown resources: %v", span.NotFoundError)
+		}
+		if span.Type != base.Select && span.Type != base.SelectInfoSchema {
+			return "", status.Errorf(codes.PermissionDenied, "row filters only support SELECT statements")
+		}
+		sourceColumns := span.SourceColumns
+		for _, spanResult := range span.Results {
+			sourceColumns, _ = base.MergeSourceColumnSet(sourceColumns, spanResult.SourceColumns)
+		}
+		for column := range sourceColumns {
+			resource := normalizeRowFilterResource(column.Database, column.Schema, column.Table, ignoreCaseSensitive)
+			if !filtered[resource] {
+				continue
+			}
+			// Fail closed if the query reads a filtered table which isn't rewritten.
+			if !applied[resource] {
+				return "", status.Errorf(codes.PermissionDenied, "cannot apply row filters to table %q read indirectly", column.Table)
+			}
+			readsFilteredTable = true
+		}
+	}
+	if !readsFilteredTable {
+		return result.Statement, nil
+	}
+
+	// The direct references to the filtered tables are rewritten, but the views are expanded to their base tables in the spans.
+	// We cannot rewrite the view definitions, so the queries reading the filtered tables along with any view are rejected.
+	for _, resource := range resources {
+		_, metadata, err := getDatabaseMetadata(ctx, instance.ResourceID, resource.Database)
+		if err != nil {
+			return "", status.Errorf(codes.Internal, "failed to get database metadata for %q: %v", resource.Database, err)
+		}
+		if metadata == nil {
+			continue
+		}
+		resourceSchema := resource.Schema
+		if resourceSchema == "" && instance.Engine == storepb.Engine_POSTGRES {
+			resourceSchema = "public"
+		}
+		schemaMetadata := metadata.GetSchema(resourceSchema)
+		if schemaMetadata == nil {
+			continue
+		}
+		if schemaMetadata.GetView(resource.Table) != nil || schemaMetadata.GetMaterializedView(resource.Table) != nil {
+			return "", status.Errorf(codes.PermissionDenied, "cannot apply row filters to the query reading view %q", resource.Table)
+		}
+	}
+	return result.Statement, nil
+}
+
+func normalizeRowFilterResource(database, schema, table string, ignoreCaseSensitive bool) base.SchemaResource {
+	if ignoreCaseSensitive {
+		return base.SchemaResource{Database: strings.ToLower(database), Schema: strings.ToLower(schema), Table: strings.ToLower(table)}
+	}
+	return base.SchemaResource{Database: database, Schema: schema, Table: table}
+}
@@ -0,0 +1,180 @@
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/store/model"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestValidateRowFilterPredicate(t *testing.T) {
+	a := require.New(t)
+	a.NoError(validateRowFilterPredicate("region = {{user.attribute.region}}"))
+	a.NoError(validateRowFilterPredicate("owner = {{ user.email }} OR owner_id = {{user.id}}"))
+	a.NoError(validateRowFilterPredicate("deleted = 0"))
+	a.Error(validateRowFilterPredicate(""))
+	a.Error(validateRowFilterPredicate("region = {{user.name}}"))
+	a.Error(validateRowFilterPredicate("region = {{user.attribute.}}"))
+	a.Error(validateRowFilterPredicate("region = {{user.attribute.region"))
+}
+
+func TestResolveRowFilterPredicate(t *testing.T) {
+	a := require.New(t)
+	user := &store.UserMessage{
+		ID:    101,
+		Email: "alice@example.com",
+		Profile: &storepb.UserProfile{
+			Attributes: map[string]string{
+				"region": "us",
+				"team":   `o'brien\`,
+			},
+		},
+	}
+
+	got, err := resolveRowFilterPredicate(storepb.Engine_MYSQL, "region = {{user.attribute.region}} AND owner = {{ user.email }} AND uid = {{user.id}}", user)
+	a.NoError(err)
+	a.Equal("region = 'us' AND owner = 'alice@example.com' AND uid = '101'", got)
+
+	got, err = resolveRowFilterPredicate(storepb.Engine_MYSQL, "team = {{user.attribute.team}}", user)
+	a.NoError(err)
+	a.Equal(`team = 'o''brien\\'`, got)
+
+	got, err = resolveRowFilterPredicate(storepb.Engine_POSTGRES, "team = {{user.attribute.team}}", user)
+	a.NoError(err)
+	a.Equal(`team = E'o''brien\\'`, got)
+
+	got, err = resolveRowFilterPredicate(storepb.Engine_POSTGRES, "region = {{user.attribute.region}}", user)
+	a.NoError(err)
+	a.Equal("region = 'us'", got)
+
+	_, err = resolveRowFilterPredicate(storepb.Engine_POSTGRES, "dept = {{user.attribute.dept}}", user)
+	a.Error(err)
+}
+
+func TestRewriteRowFilters(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	database := &store.DatabaseMessage{InstanceID: "mysql", DatabaseName: "db"}
+	filters := []*base.RowFilter{{Database: "db", Table: "t", Predicate: "(region = 'us')"}}
+	getDatabaseMetadata := func(_ context.Context, _, databaseName string) (string, *model.DatabaseMetadata, error) {
+		return databaseName, model.NewDatabaseMetadata(&storepb.DatabaseSchemaMetadata{
+			Name: databaseName,
+			Schemas: []*storepb.SchemaMetadata{
+				{
+					Tables: []*storepb.TableMetadata{{Name: "t"}, {Name: "u"}},
+					Views:  []*storepb.ViewMetadata{{Name: "v"}},
+				},
+			},
+		}), nil
+	}
+	readTable := func(table string) []*base.QuerySpan {
+		return []*base.QuerySpan{{
+			Type:          base.Select,
+			SourceColumns: base.SourceColumnSet{{Database: "db", Table: table, Column: "id"}: true},
+		}}
+	}
+
+	tests := []struct {
+		description string
+		engine      storepb.Engine
+		filters     []*base.RowFilter
+		statement   string
+		spans       []*base.QuerySpan
+		// wantError is the part of the error message, and the statement is rejected with PermissionDenied.
+		wantError string
+	}{
+		{
+			description: "no row filter",
+			engine:      storepb.Engine_MSSQL,
+			statement:   "SELECT * FROM t",
+			spans:       readTable("t"),
+		},
+		{
+			description: "direct read",
+			engine:      storepb.Engine_MYSQL,
+			filters:     filters,
+			statement:   "SELECT * FROM t",
+			spans:       readTable("t"),
+		},
+		{
+			description: "unsupported engine",
+			engine:      storepb.Engine_MSSQL,
+			filters:     filters,
+			statement:   "SELECT * FROM t",
+			spans:       readTable("t"),
+			wantError:   "row filters are not supported for engine MSSQL",
+		},
+		{
+			description: "unsupported engine without schema",
+			engine:      storepb.Engine_ORACLE,
+			filters:     filters,
+			statement:   "SELECT * FROM t",
+			spans:       readTable("t"),
+			wantError:   "row filters are not supported for engine ORACLE",
+		},
+		{
+			description: "backup table",
+			engine:      storepb.Engine_MYSQL,
+			filters:     filters,
+			statement:   "SELECT * FROM bbdataarchive.t",
+			spans:       readTable("t"),
+			wantError:   `reading backup table "t"`,
+		},
+		{
+			description: "indirect read through a view without rewriting",
+			engine:      storepb.Engine_MYSQL,
+			filters:     filters,
+			statement:   "SELECT * FROM v",
+			spans:       readTable("t"),
+			wantError:   `table "t" read indirectly`,
+		},
+		{
+			description: "view along with the filtered table",
+			engine:      storepb.Engine_MYSQL,
+			filters:     filters,
+			statement:   "SELECT * FROM t JOIN v ON t.id = v.id",
+			spans:       readTable("t"),
+			wantError:   `reading view "v"`,
+		},
+		{
+			description: "unknown resource",
+			engine:      storepb.Engine_MYSQL,
+			filters:     filters,
+			statement:   "SELECT * FROM t",
+			spans:       []*base.QuerySpan{{Type: base.Select, NotFoundError: errors.New("table x not found")}},
+			wantError:   "unknown resources",
+		},
+		{
+			description: "not a SELECT statement",
+			engine:      storepb.Engine_MYSQL,
+			filters:     filters,
+			statement:   "SELECT * FROM t",
+			spans:       []*base.QuerySpan{{Type: base.DML}},
+			wantError:   "only support SELECT statements",
+		},
+	}
+	for _, test := range tests {
+		instance := &store.InstanceMessage{ResourceID: "mysql", Engine: test.engine}
+		got, err := rewriteRowFilters(ctx, instance, database, test.filters, test.statement, "", test.spans, getDatabaseMetadata)
+		if test.wantError != "" {
+			a.Error(err, test.description)
+			a.Equal(codes.PermissionDenied, status.Code(err), test.description)
+			a.Contains(err.Error(), test.wantError, test.description)
+			continue
+		}
+		a.NoError(err, test.description)
+		if len(test.filters) == 0 {
+			a.Equal(test.statement, got, test.description)
+		} else {
+			a.Contains(got, "region = 'us'", test.description)
+		}
+	}
+}
@@ -0,0 +1,236 @@
+package v1
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bytebase/bytebase/backend/api/auth"
+	"github.com/bytebase/bytebase/backend/common"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// ListSessions lists the active sessions of the user.
+func (s *AuthService) ListSessions(ctx context.Context, request *v1pb.ListSessionsRequest) (*v1pb.ListSessionsResponse, error) {
+	userID, err := common.GetUserID(request.Parent)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if _, err := s.getCredentialOwner(ctx, userID); err != nil {
+		return nil, err
+	}
+	sessions, err := s.store.ListUserSessions(ctx, &store.FindUserSessionMessage{PrincipalUID: &userID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list sessions, error: %v", err)
+	}
+
+	var currentSessionID string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if accessTokenStr, err := auth.GetTokenFromMetadata(md); err == nil {
+			currentSessionID = auth.GetSessionID(accessTokenStr, s.secret)
+		}
+	}
+	response := &v1pb.ListSessionsResponse{}
+	for _, session := range sessions {
+		response.Sessions = append(response.Sessions, convertToSession(session, currentSessionID))
+	}
+	return response, nil
+}
+
+// RevokeSession signs out the session.
+func (s *AuthService) RevokeSession(ctx context.Context, request *v1pb.RevokeSessionRequest) (*v1pb.Session, error) {
+	userID, sessionID, err := common.GetUserIDSessionID(request.Name)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if _, err := s.getCredentialOwner(ctx, userID); err != nil {
+		return nil, err
+	}
+	session, err := s.store.GetUserSession(ctx, &store.FindUserSessionMessage{
+		PrincipalUID: &userID,
+		SessionID:    &sessionID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get session, error: %v", err)
+	}
+	if session == nil {
+		return nil, status.Errorf(codes.NotFound, "session %q not found", request.Name)
+	}
+	now := time.Now()
+	updated, err := s.store.UpdateUserSession(ctx, &store.UpdateUserSessionMessage{
+		UID:        session.UID,
+		RevokeTime: &now,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to revoke session, error: %v", err)
+	}
+	return convertToSession(updated, ""), nil
+}
+
+// RevokeAllSessions signs out all sessions and revokes all access tokens of the user.
+func (s *AuthService) RevokeAllSessions(ctx context.Context, request *v1pb.RevokeAllSessionsRequest) (*emptypb.Empty, error) {
+	userID, err := common.GetUserID(request.Parent)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if _, err := s.getCredentialOwner(ctx, userID); err != nil {
+		return nil, err
+	}
+	if err := s.store.RevokeUserCredentials(ctx, userID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to revoke sessions, error: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// createSession creates the session for the user signing in. The least recently active sessions are
+// revoked if the end user has more than maximumSessions active sessions.
+func (s *AuthService) createSession(ctx context.Context, user *store.UserMessage, expireTime time.Time, maximumSessions int32) (*store.UserSessionMessage, error) {
+	if err := s.store.DeleteInactiveUserSessions(ctx, user.ID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete inactive sessions, error: %v", err)
+	}
+	session, err := s.store.CreateUserSession(ctx, &store.UserSessionMessage{
+		PrincipalUID: user.ID,
+		SessionID:    uuid.NewString(),
+		ExpireTime:   expireTime,
+		Payload:      getSessionPayload(ctx, s.profile.TrustedProxies),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create session, error: %v", err)
+	}
+	// The service accounts sign in from many automation clients concurrently.
+	if maximumSessions <= 0 || user.Type == api.ServiceAccount {
+		return session, nil
+	}
+
+	sessions, err := s.store.ListUserSessions(ctx, &store.FindUserSessionMessage{PrincipalUID: &user.ID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list sessions, error: %v", err)
+	}
+	now := time.Now()
+	count := int32(0)
+	for _, v := range sessions {
+		if v.UID == session.UID {
+			continue
+		}
+		// Reserve a place for the new session.
+		if count++; count < maximumSessions {
+			continue
+		}
+		if _, err := s.store.UpdateUserSession(ctx, &store.UpdateUserSessionMessage{UID: v.UID, RevokeTime: &now}); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to revoke session, error: %v", err)
+		}
+	}
+	return session, nil
+}
+
+// revokeSession revokes the active session with the session ID if it exists.
+func (s *AuthService) revokeSession(ctx context.Context, sessionID string) error {
+	session, err := s.store.GetUserSession(ctx, &store.FindUserSessionMessage{SessionID: &sessionID})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get session, error: %v", err)
+	}
+	if session == nil {
+		return nil
+	}
+	now := time.Now()
+	if _, err := s.store.UpdateUserSession(ctx, &store.UpdateUserSessionMessage{UID: session.UID, RevokeTime: &now}); err != nil {
+		return status.Errorf(codes.Internal, "failed to revoke session, error: %v", err)
+	}
+	return nil
+}
+
+// getSessionPayload returns the device and the IP address of the client signing in.
+// The headers forwarded by the gRPC gateway take precedence over the ones of the gateway itself.
+func getSessionPayload(ctx context.Context, trustedProxies []string) *storepb.UserSessionPayload {
+	payload := &storepb.UserSessionPayload{}
+	if requestMetadata, err := getRequestMetadataFromCtx(ctx); err == nil {
+		payload.IpAddress = requestMetadata.CallerIp
+		if host, _, err := net.SplitHostPort(payload.IpAddress); err == nil {
+			payload.IpAddress = host
+		}
+		payload.UserAgent = requestMetadata.CallerSuppliedUserAgent
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return payload
+	}
+	payload.IpAddress = getClientIP(payload.IpAddress, md.Get("x-forwarded-for"), trustedProxies)
+	if v := md.Get("grpcgateway-user-agent"); len(v) > 0 {
+		payload.UserAgent = v[0]
+	}
+	return payload
+}
+
+// getClientIP returns the client IP address from the X-Forwarded-For header of the request from the peer.
+// The header is walked from the right, and an address is only taken if the hop appending it is
+// the gRPC gateway on the loopback interface or one of the trusted proxies, so that the clients
+// cannot spoof their addresses.
+func getClientIP(peerIP string, forwardedFor []string, trustedProxies []string) string {
+	var prefixes []netip.Prefix
+	for _, proxy := range trustedProxies {
+		prefix, err := common.ParseIPPrefix(proxy)
+		if err != nil {
+			continue
+		}
+		prefixes = append(prefixes, prefix)
+	}
+	isTrusted := func(ip string) bool {
+		addr, err := netip.ParseAddr(ip)
+		if err != nil {
+			return false
+		}
+		addr = addr.Unmap()
+		if addr.IsLoopback() {
+			return true
+		}
+		for _, prefix := range prefixes {
+			if prefix.Contains(addr) {
+				return true
+			}
+		}
+		return false
+	}
+
+	var hops []string
+	for _, v := range forwardedFor {
+		for _, hop := range strings.Split(v, ",") {
+			hops = append(hops, strings.TrimSpace(hop))
+		}
+	}
+	clientIP := peerIP
+	for i := len(hops) - 1; i >= 0 && isTrusted(clientIP); i-- {
+		if hops[i] == "" {
+			break
+		}
+		clientIP = hops[i]
+	}
+	return clientIP
+}
+
+func convertToSession(session *store.UserSessionMessage, currentSessionID string) *v1pb.Session {
+	v := &v1pb.Session{
+		Name:           common.FormatSession(session.PrincipalUID, session.SessionID),
+		UserAgent:      session.Payload.GetUserAgent(),
+		IpAddress:      session.Payload.GetIpAddress(),
+		CreateTime:     timestamppb.New(session.CreatedTime),
+		LastActiveTime: timestamppb.New(session.LastActiveTime),
+		ExpireTime:     timestamppb.New(session.ExpireTime),
+		Current:        session.SessionID == currentSessionID,
+	}
+	if session.RevokeTime != nil {
+		v.RevokeTime = timestamppb.New(*session.RevokeTime)
+	}
+	return v
+}
@@ -0,0 +1,67 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetClientIP(t *testing.T) {
+	tests := []struct {
+		name           string
+		peerIP         string
+		forwardedFor   []string
+		trustedProxies []string
+		want           string
+	}{
+		{
+			name:   "direct",
+			peerIP: "203.0.113.1",
+			want:   "203.0.113.1",
+		},
+		{
+			name:         "spoofed header from an untrusted peer",
+			peerIP:       "203.0.113.1",
+			forwardedFor: []string{"198.51.100.1"},
+			want:         "203.0.113.1",
+		},
+		{
+			name:         "gRPC gateway",
+			peerIP:       "127.0.0.1",
+			forwardedFor: []string{"203.0.113.1"},
+			want:         "203.0.113.1",
+		},
+		{
+			name:         "spoofed header through the gRPC gateway",
+			peerIP:       "127.0.0.1",
+			forwardedFor: []string{"198.51.100.1, 203.0.113.1"},
+			want:         "203.0.113.1",
+		},
+		{
+			name:           "trusted proxy",
+			peerIP:         "127.0.0.1",
+			forwardedFor:   []string{"198.51.100.1, 203.0.113.1, 10.0.0.2"},
+			trustedProxies: []string{"10.0.0.0/8"},
+			want:           "203.0.113.1",
+		},
+		{
+			name:           "chained trusted proxies",
+			peerIP:         "127.0.0.1",
+			forwardedFor:   []string{"203.0.113.1, 10.0.0.3", "10.0.0.2"},
+			trustedProxies: []string{"10.0.0.0/8"},
+			want:           "203.0.113.1",
+		},
+		{
+			name:           "trusted proxy by IP address",
+			peerIP:         "192.0.2.1",
+			forwardedFor:   []string{"203.0.113.1"},
+			trustedProxies: []string{"192.0.2.1"},
+			want:           "203.0.113.1",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.want, getClientIP(test.peerIP, test.forwardedFor, test.trustedProxies))
+		})
+	}
+}
@@ -3,7 +3,9 @@ package v1
 import (
 	"bytes"
 	"context"
+	"crypto/rand"
 	"embed"
+	"encoding/hex"
 	"fmt"
 	"log/slog"
 	"regexp"
@@ -43,6 +45,7 @@ type SettingService struct {
 	profile        *config.Profile
 	licenseService enterprise.LicenseService
 	stateCfg       *state.State
+	secret         string
 }
 
 // NewSettingService creates a new setting service.
@@ -51,12 +54,14 @@ func NewSettingService(
 	profile *config.Profile,
 	licenseService enterprise.LicenseService,
 	stateCfg *state.State,
+	secret string,
 ) *SettingService {
 	return &SettingService{
 		store:          store,
 		profile:        profile,
 		licenseService: licenseService,
 		stateCfg:       stateCfg,
+		secret:         secret,
 	}
 }
 
@@ -79,6 +84,7 @@ var whitelistSettings = []api.SettingName{
 	api.SettingSQLResultSizeLimit,
 	api.SettingSCIM,
 	api.SettingPasswordRestriction,
+	api.SettingMaskingKeys,
 }
 
 //go:embed mail_templates/testmail/template.html
@@ -235,6 +241,22 @@ func (s *SettingService) UpdateSetting(ctx context.Context, request *v1pb.Update
 					return nil, status.Errorf(codes.InvalidArgument, "refresh token duration should be at least one hour")
 				}
 				oldSetting.TokenDuration = payload.TokenDuration
+			case "value.workspace_profile_setting_value.session_idle_timeout":
+				if err := s.licenseService.IsFeatureEnabled(api.FeatureSecureToken); err != nil {
+					return nil, status.Error(codes.PermissionDenied, err.Error())
+				}
+				if payload.SessionIdleTimeout != nil && payload.SessionIdleTimeout.Seconds > 0 && payload.SessionIdleTimeout.AsDuration() < 5*time.Minute {
+					return nil, status.Errorf(codes.InvalidArgument, "session idle timeout should be at least five minutes")
+				}
+				oldSetting.SessionIdleTimeout = payload.SessionIdleTimeout
+			case "value.workspace_profile_setting_value.maximum_sessions_per_user":
+				if err := s.licenseService.IsFeatureEnabled(api.FeatureSecureToken); err != nil {
+					return nil, status.Error(codes.PermissionDenied, err.Error())
+				}
+				if payload.MaximumSessionsPerUser < 0 {
+					return nil, status.Errorf(codes.InvalidArgument, "maximum sessions per user should not be negative")
+				}
+				oldSetting.MaximumSessionsPerUser = payload.MaximumSessionsPerUser
 			case "value.workspace_profile_setting_value.announcement":
 				if err := s.licenseService.IsFeatureEnabled(api.FeatureAnnouncement); err != nil {
 					return nil, status.Error(codes.PermissionDenied, err.Error())
@@ -437,6 +459,12 @@ func (s *SettingService) UpdateSetting(ctx context.Context, request *v1pb.Update
 				}
 				setting.Lark = payload.Lark
 
+			case "value.app_im_setting_value.teams":
+				if payload.GetTeams().GetEnabled() && (payload.GetTeams().GetOriginator() == "" || payload.GetTeams().GetSender() == "") {
+					return nil, status.Errorf(codes.InvalidArgument, "the originator and the sender are required to enable Teams")
+				}
+				setting.Teams = payload.Teams
+
 			default:
 				return nil, status.Errorf(codes.InvalidArgument, "invalid update mask path %v", path)
 			}
@@ -541,6 +569,16 @@ func (s *SettingService) UpdateSetting(ctx context.Context, request *v1pb.Update
 		if len(payload.Configs[0].Classification) == 0 {
 			return nil, status.Errorf(codes.InvalidArgument, "missing classification map")
 		}
+		for id, classification := range payload.Configs[0].Classification {
+			for _, detector := range classification.Detectors {
+				if detector.Type != storepb.DataClassificationSetting_DataClassificationConfig_DataClassification_Detector_REGEX {
+					continue
+				}
+				if _, err := regexp.Compile(detector.Pattern); err != nil {
+					return nil, status.Errorf(codes.InvalidArgument, "invalid detector pattern %q for classification %s: %v", detector.Pattern, id, err)
+				}
+			}
+		}
 		bytes, err := protojson.Marshal(payload)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to marshal setting for %s with error: %v", apiSettingName, err)
@@ -561,6 +599,9 @@ func (s *SettingService) UpdateSetting(ctx context.Context, request *v1pb.Update
 			}
 			idMap[tp.Id] = struct{}{}
 		}
+		if err := s.validateSemanticTypeAlgorithms(ctx, storeSemanticTypeSetting); err != nil {
+			return nil, err
+		}
 		bytes, err := protojson.Marshal(storeSemanticTypeSetting)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to marshal setting for %s with error: %v", apiSettingName, err)
@@ -617,6 +658,19 @@ func (s *SettingService) UpdateSetting(ctx context.Context, request *v1pb.Update
 			return nil, status.Errorf(codes.Internal, "failed to marshal setting for %s with error: %v", apiSettingName, err)
 		}
 		storeSettingValue = string(bytes)
+	case api.SettingMaskingKeys:
+		if err := s.licenseService.IsFeatureEnabled(api.FeatureSensitiveData); err != nil {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		maskingKeySetting, err := s.convertToStoreMaskingKeySetting(ctx, request.Setting.Value.GetMaskingKeySetting())
+		if err != nil {
+			return nil, err
+		}
+		bytes, err := protojson.Marshal(maskingKeySetting)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to marshal setting for %s with error: %v", apiSettingName, err)
+		}
+		storeSettingValue = string(bytes)
 	default:
 		storeSettingValue = request.Setting.Value.GetStringValue()
 	}
@@ -719,6 +773,11 @@ func (s *SettingService) convertToSettingMessage(ctx context.Context, setting *s
 						Lark: &v1pb.AppIMSetting_Lark{
 							Enabled: storeValue.Lark != nil && storeValue.Lark.Enabled,
 						},
+						Teams: &v1pb.AppIMSetting_Teams{
+							Enabled:    storeValue.Teams != nil && storeValue.Teams.Enabled,
+							Originator: storeValue.Teams.GetOriginator(),
+							Sender:     storeValue.Teams.GetSender(),
+						},
 					},
 				},
 			},
@@ -877,6 +936,19 @@ func (s *SettingService) convertToSettingMessage(ctx context.Context, setting *s
 				},
 			},
 		}, nil
+	case api.SettingMaskingKeys:
+		v1Value := new(v1pb.MaskingKeySetting)
+		if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(setting.Value), v1Value); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to unmarshal setting value for %s with error: %v", setting.Name, err)
+		}
+		return &v1pb.Setting{
+			Name: settingName,
+			Value: &v1pb.Value{
+				Value: &v1pb.Value_MaskingKeySetting{
+					MaskingKeySetting: v1Value,
+				},
+			},
+		}, nil
 	default:
 		return &v1pb.Setting{
 			Name: settingName,
@@ -889,6 +961,127 @@ func (s *SettingService) convertToSettingMessage(ctx context.Context, setting *s
 	}
 }
 
+// convertToStoreMaskingKeySetting validates the masking keys and obfuscates the key material with the workspace secret.
+// A key with empty material keeps the existing material of the same id, or gets a random 256-bit key if it's new.
+func (s *SettingService) convertToStoreMaskingKeySetting(ctx context.Context, setting *v1pb.MaskingKeySetting) (*storepb.MaskingKeySetting, error) {
+	oldSetting, err := s.store.GetMaskingKeySetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get setting %q: %v", api.SettingMaskingKeys, err)
+	}
+	oldKeys := make(map[string]string)
+	for _, key := range oldSetting.Keys {
+		oldKeys[key.Id] = key.ObfuscatedKey
+	}
+
+	storeSetting := &storepb.MaskingKeySetting{}
+	idMap := make(map[string]struct{})
+	for _, key := range setting.GetKeys() {
+		if key.Id == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "masking key id cannot be empty")
+		}
+		if _, ok := idMap[key.Id]; ok {
+			return nil, status.Errorf(codes.InvalidArgument, "duplicate masking key id: %s", key.Id)
+		}
+		idMap[key.Id] = struct{}{}
+
+		storeKey := &storepb.MaskingKeySetting_Key{
+			Id:    key.Id,
+			Title: key.Title,
+		}
+		storeSetting.Keys = append(storeSetting.Keys, storeKey)
+		if key.Key == "" {
+			if oldKey, ok := oldKeys[key.Id]; ok {
+				storeKey.ObfuscatedKey = oldKey
+				continue
+			}
+			material := make([]byte, 32)
+			if _, err := rand.Read(material); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to generate masking key: %v", err)
+			}
+			storeKey.ObfuscatedKey = common.Obfuscate(hex.EncodeToString(material), s.secret)
+			continue
+		}
+		material, err := hex.DecodeString(key.Key)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "masking key %s is not hex-encoded", key.Id)
+		}
+		if l := len(material); l != 16 && l != 24 && l != 32 {
+			return nil, status.Errorf(codes.InvalidArgument, "masking key %s must be 16, 24 or 32 bytes, got %d", key.Id, l)
+		}
+		storeKey.ObfuscatedKey = common.Obfuscate(key.Key, s.secret)
+	}
+
+	// The keys referenced by the semantic types cannot be removed.
+	semanticTypeSetting, err := s.store.GetSemanticTypesSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get setting %q: %v", api.SettingSemanticTypes, err)
+	}
+	for _, tp := range semanticTypeSetting.GetTypes() {
+		keyID := getSemanticTypeAlgorithmKeyID(tp.GetAlgorithm())
+		if keyID == "" {
+			continue
+		}
+		if _, ok := idMap[keyID]; !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "masking key %s is used by the semantic type %s", keyID, tp.Id)
+		}
+	}
+	return storeSetting, nil
+}
+
+// validateSemanticTypeAlgorithms validates the masking algorithms of the semantic types,
+// so that a misconfigured algorithm is rejected instead of falling back to the full mask at query time.
+func (s *SettingService) validateSemanticTypeAlgorithms(ctx context.Context, setting *storepb.SemanticTypeSetting) error {
+	maskingKeySetting, err := s.store.GetMaskingKeySetting(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get setting %q: %v", api.SettingMaskingKeys, err)
+	}
+	keyIDs := make(map[string]struct{})
+	for _, key := range maskingKeySetting.Keys {
+		keyIDs[key.Id] = struct{}{}
+	}
+
+	for _, tp := range setting.Types {
+		switch m := tp.GetAlgorithm().GetMask().(type) {
+		case *storepb.Algorithm_FormatPreservingEncryptionMask_:
+			tweak, err := hex.DecodeString(m.FormatPreservingEncryptionMask.Tweak)
+			if err != nil {
+				return status.Errorf(codes.InvalidArgument, "the tweak of the semantic type %s is not hex-encoded", tp.Id)
+			}
+			switch m.FormatPreservingEncryptionMask.Mode {
+			case storepb.Algorithm_FormatPreservingEncryptionMask_FF1, storepb.Algorithm_FormatPreservingEncryptionMask_MODE_UNSPECIFIED:
+			case storepb.Algorithm_FormatPreservingEncryptionMask_FF3_1:
+				if len(tweak) != 7 {
+					return status.Errorf(codes.InvalidArgument, "the FF3-1 tweak of the semantic type %s must be 7 bytes, got %d", tp.Id, len(tweak))
+				}
+			default:
+				return status.Errorf(codes.InvalidArgument, "unsupported format-preserving encryption mode %v of the semantic type %s", m.FormatPreservingEncryptionMask.Mode, tp.Id)
+			}
+		case *storepb.Algorithm_TokenizationMask_:
+			if l := m.TokenizationMask.Length; l < 0 || l > 64 {
+				return status.Errorf(codes.InvalidArgument, "the token length of the semantic type %s must be between 0 and 64, got %d", tp.Id, l)
+			}
+		default:
+			continue
+		}
+		keyID := getSemanticTypeAlgorithmKeyID(tp.GetAlgorithm())
+		if _, ok := keyIDs[keyID]; !ok {
+			return status.Errorf(codes.InvalidArgument, "masking key %q of the semantic type %s not found", keyID, tp.Id)
+		}
+	}
+	return nil
+}
+
+// getSemanticTypeAlgorithmKeyID returns the id of the masking key used by the algorithm.
+func getSemanticTypeAlgorithmKeyID(algorithm *storepb.Algorithm) string {
+	switch m := algorithm.GetMask().(type) {
+	case *storepb.Algorithm_FormatPreservingEncryptionMask_:
+		return m.FormatPreservingEncryptionMask.KeyId
+	case *storepb.Algorithm_TokenizationMask_:
+		return m.TokenizationMask.KeyId
+	}
+	return ""
+}
+
 func (s *SettingService) validateSchemaTemplate(ctx context.Context, schemaTemplateSetting *v1pb.SchemaTemplateSetting) error {
 	oldStoreSetting, err := s.store.GetSettingV2(ctx, api.SettingSchemaTemplate)
 	if err != nil {
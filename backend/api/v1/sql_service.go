@@ -434,7 +434,13 @@ func queryRetry(
 		}
 	}
 
-	results, duration, queryErr := executeWithTimeout(ctx, driver, conn, statement, timeout, queryContext)
+	// The spans are computed with the original statement, the row filters keep the result columns unchanged.
+	executeStatement, err := applyRowFilters(ctx, stores, instance, database, user, statement, queryContext.Schema, spans)
+	if err != nil {
+		return nil, nil, time.Duration(0), err
+	}
+
+	results, duration, queryErr := executeWithTimeout(ctx, driver, conn, executeStatement, timeout, queryContext)
 	if queryErr != nil {
 		return nil, nil, duration, queryErr
 	}
@@ -676,7 +682,7 @@ func DoExport(
 	}
 	results, spans, duration, queryErr := queryRetry(ctx, storeInstance, user, instance, database, driver, conn, request.Statement, nil /* timeDuration */, queryContext, true, licenseService, optionalAccessCheck, schemaSyncer)
 	if queryErr != nil {
-		return nil, duration, err
+		return nil, duration, queryErr
 	}
 	// only return the last result
 	if len(results) > 1 {
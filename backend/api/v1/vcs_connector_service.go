@@ -20,6 +20,7 @@ import (
 	"github.com/bytebase/bytebase/backend/plugin/vcs"
 	"github.com/bytebase/bytebase/backend/plugin/vcs/azure"
 	"github.com/bytebase/bytebase/backend/plugin/vcs/bitbucket"
+	"github.com/bytebase/bytebase/backend/plugin/vcs/gitea"
 	"github.com/bytebase/bytebase/backend/plugin/vcs/github"
 	"github.com/bytebase/bytebase/backend/plugin/vcs/gitlab"
 	"github.com/bytebase/bytebase/backend/store"
@@ -136,8 +137,17 @@ func (s *VCSConnectorService) CreateVCSConnector(ctx context.Context, request *v
 			ExternalId:         request.GetVcsConnector().ExternalId,
 			WebhookSecretToken: secretToken,
 			DatabaseGroup:      request.GetVcsConnector().DatabaseGroup,
+			WriteBack:          convertV1VCSConnectorWriteBack(request.GetVcsConnector().WriteBack),
 		},
 	}
+	if err := validateVCSConnectorWriteBack(vcsConnectorCreate.Payload.WriteBack); err != nil {
+		return nil, err
+	}
+	if v := vcsConnectorCreate.Payload.WriteBack; v != nil && v.Enabled && v.Branch != "" {
+		if err := checkBranchExistence(ctx, vcsProvider, vcsConnectorCreate.Payload.ExternalId, v.Branch); err != nil {
+			return nil, err
+		}
+	}
 
 	// Create the webhook.
 	bytebaseEndpointURL := setting.GitopsWebhookUrl
@@ -292,6 +302,15 @@ func (s *VCSConnectorService) UpdateVCSConnector(ctx context.Context, request *v
 			update.BaseDirectory = &baseDir
 		case "database_group":
 			update.DatabaseGroup = &request.GetVcsConnector().DatabaseGroup
+		case "write_back":
+			writeBack := convertV1VCSConnectorWriteBack(request.GetVcsConnector().WriteBack)
+			if writeBack == nil {
+				writeBack = &storepb.VCSConnector_WriteBack{}
+			}
+			if err := validateVCSConnectorWriteBack(writeBack); err != nil {
+				return nil, err
+			}
+			update.WriteBack = writeBack
 		}
 	}
 
@@ -306,6 +325,16 @@ func (s *VCSConnectorService) UpdateVCSConnector(ctx context.Context, request *v
 			return nil, err
 		}
 	}
+	if v := update.WriteBack; v != nil && v.Enabled && v.Branch != "" {
+		if err := checkBranchExistence(
+			ctx,
+			vcsProvider,
+			vcsConnector.Payload.ExternalId,
+			v.Branch,
+		); err != nil {
+			return nil, err
+		}
+	}
 
 	if err := s.store.UpdateVCSConnector(ctx, update); err != nil {
 		return nil, err
@@ -408,9 +437,42 @@ func convertStoreVCSConnector(ctx context.Context, stores *store.Store, vcsConne
 		WebUrl:        vcsConnector.Payload.WebUrl,
 		DatabaseGroup: vcsConnector.Payload.DatabaseGroup,
 	}
+	if v := vcsConnector.Payload.WriteBack; v != nil {
+		v1VCSConnector.WriteBack = &v1pb.VCSConnector_WriteBack{
+			Enabled:           v.Enabled,
+			Branch:            v.Branch,
+			Directory:         v.Directory,
+			CreatePullRequest: v.CreatePullRequest,
+		}
+	}
 	return v1VCSConnector, nil
 }
 
+func convertV1VCSConnectorWriteBack(writeBack *v1pb.VCSConnector_WriteBack) *storepb.VCSConnector_WriteBack {
+	if writeBack == nil {
+		return nil
+	}
+	return &storepb.VCSConnector_WriteBack{
+		Enabled:           writeBack.Enabled,
+		Branch:            writeBack.Branch,
+		Directory:         writeBack.Directory,
+		CreatePullRequest: writeBack.CreatePullRequest,
+	}
+}
+
+func validateVCSConnectorWriteBack(writeBack *storepb.VCSConnector_WriteBack) error {
+	if writeBack == nil || !writeBack.Enabled {
+		return nil
+	}
+	if !strings.HasPrefix(writeBack.Directory, "/") {
+		return status.Errorf(codes.InvalidArgument, `write back directory should start with "/"`)
+	}
+	if writeBack.Directory != "/" && strings.HasSuffix(writeBack.Directory, "/") {
+		return status.Errorf(codes.InvalidArgument, `write back directory should not end with "/"`)
+	}
+	return nil
+}
+
 func checkBranchExistence(ctx context.Context, vcsProvider *store.VCSProviderMessage, externalID, branch string) error {
 	if branch == "" {
 		return status.Errorf(codes.InvalidArgument, "branch name is required")
@@ -458,6 +520,24 @@ func createVCSWebhook(ctx context.Context, vcsProvider *store.VCSProviderMessage
 			return "", errors.Wrap(err, "failed to marshal request body for creating webhook")
 		}
 		webhookCreatePayloads = append(webhookCreatePayloads, createPayload)
+	case storepb.VCSType_GITEA:
+		webhookPost := gitea.WebhookCreate{
+			Type: "gitea",
+			Config: gitea.WebhookConfig{
+				URL:         fmt.Sprintf("%s/hook/%s", bytebaseEndpointURL, webhookEndpointID),
+				ContentType: "json",
+				Secret:      webhookSecretToken,
+			},
+			// https://docs.gitea.com/usage/webhooks#event-information
+			Events:       []string{"pull_request"},
+			BranchFilter: "*",
+			Active:       true,
+		}
+		createPayload, err := json.Marshal(webhookPost)
+		if err != nil {
+			return "", errors.Wrap(err, "failed to marshal request body for creating webhook")
+		}
+		webhookCreatePayloads = append(webhookCreatePayloads, createPayload)
 	case storepb.VCSType_BITBUCKET:
 		webhookPost := bitbucket.WebhookCreateOrUpdate{
 			Description: "Bytebase GitOps",
@@ -2,6 +2,10 @@ package v1
 
 import (
 	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -10,8 +14,10 @@ import (
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/component/iam"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
 	"github.com/bytebase/bytebase/backend/store"
 	"github.com/bytebase/bytebase/backend/utils"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
 )
 
@@ -98,3 +104,233 @@ func containsActiveEndUser(users []*store.UserMessage) bool {
 	}
 	return false
 }
+
+// iamAnalysisTarget is the parsed resource of the IAM policy analysis.
+type iamAnalysisTarget struct {
+	projectID  string
+	instance   *store.InstanceMessage
+	database   *store.DatabaseMessage
+	attributes map[string]any
+	// column is set if the resource is a column.
+	column *base.ColumnResource
+}
+
+func (s *WorkspaceService) AnalyzeIamPolicy(ctx context.Context, request *v1pb.AnalyzeIamPolicyRequest) (*v1pb.AnalyzeIamPolicyResponse, error) {
+	if !iam.PermissionsExist(request.Permission) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid permission %q", request.Permission)
+	}
+	target, err := s.getIamAnalysisTarget(ctx, request.FullResourceName)
+	if err != nil {
+		return nil, err
+	}
+	var maskingAction storepb.MaskingExceptionPolicy_MaskingException_Action
+	switch request.Permission {
+	case iam.PermissionSQLSelect:
+		maskingAction = storepb.MaskingExceptionPolicy_MaskingException_QUERY
+	case iam.PermissionSQLExport:
+		maskingAction = storepb.MaskingExceptionPolicy_MaskingException_EXPORT
+	}
+	var evaluator *maskingLevelEvaluator
+	masker := NewQueryResultMasker(s.store)
+	if target.column != nil && maskingAction != storepb.MaskingExceptionPolicy_MaskingException_ACTION_UNSPECIFIED && isMaskingSupported(target.instance.Engine) {
+		evaluator, err = masker.newMaskingLevelEvaluator(ctx)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+	getMaskingSemanticType := func(user *store.UserMessage) (string, error) {
+		if evaluator == nil {
+			return "", nil
+		}
+		return masker.getSemanticTypeForColumnResource(ctx, evaluator, target.instance, *target.column, map[string]*storepb.MaskingExceptionPolicy{}, maskingAction, user)
+	}
+
+	workspaceID, err := s.store.GetWorkspaceID(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace id, error: %v", err)
+	}
+
+	// Forward mode.
+	if request.Principal != "" {
+		email, err := common.GetUserEmail(request.Principal)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		user, err := s.store.GetUserByEmail(ctx, email)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get user %q, error: %v", email, err)
+		}
+		if user == nil {
+			return nil, status.Errorf(codes.NotFound, "user %q not found", email)
+		}
+
+		explanation, err := s.iamManager.ExplainPermission(ctx, request.Permission, user, target.attributes, target.projectID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to explain permission, error: %v", err)
+		}
+		response := &v1pb.AnalyzeIamPolicyResponse{}
+		for _, grant := range explanation.Grants {
+			response.Grants = append(response.Grants, convertToV1IamAnalysisGrant(ctx, s.store, workspaceID, grant))
+		}
+		switch {
+		case user.MemberDeleted:
+			response.DenyReason = "the user has been deactivated"
+		case !s.iamManager.PermissionEnforced():
+			response.Allowed = true
+		case len(explanation.Grants) > 0:
+			response.Allowed = true
+		case len(explanation.ConditionDenied) > 0:
+			var conditions []string
+			for _, grant := range explanation.ConditionDenied {
+				conditions = append(conditions, fmt.Sprintf("%s (%s)", grant.Binding.Role, grant.Binding.GetCondition().GetExpression()))
+			}
+			response.DenyReason = fmt.Sprintf("the conditions of the bindings granting %q are not satisfied: %s", request.Permission, strings.Join(conditions, ", "))
+		default:
+			response.DenyReason = fmt.Sprintf("no role bound to the user grants %q on %q", request.Permission, request.FullResourceName)
+		}
+		if response.Allowed {
+			if response.MaskingSemanticType, err = getMaskingSemanticType(user); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to evaluate masking, error: %v", err)
+			}
+		}
+		return response, nil
+	}
+
+	// Reverse mode.
+	grantsByUser, err := s.iamManager.ListPermissionGrants(ctx, request.Permission, target.attributes, target.projectID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list permission grants, error: %v", err)
+	}
+	users, err := s.store.ListUsers(ctx, &store.FindUserMessage{})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list users, error: %v", err)
+	}
+	response := &v1pb.AnalyzeIamPolicyResponse{}
+	for _, principal := range getIamAnalysisPrincipals(users, grantsByUser, s.iamManager.PermissionEnforced()) {
+		access := &v1pb.AnalyzeIamPolicyResponse_PrincipalAccess{
+			Principal: common.FormatUserEmail(principal.user.Email),
+		}
+		for _, grant := range principal.grants {
+			access.Grants = append(access.Grants, convertToV1IamAnalysisGrant(ctx, s.store, workspaceID, grant))
+		}
+		if access.MaskingSemanticType, err = getMaskingSemanticType(principal.user); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to evaluate masking, error: %v", err)
+		}
+		response.Principals = append(response.Principals, access)
+	}
+	return response, nil
+}
+
+type iamAnalysisPrincipal struct {
+	user   *store.UserMessage
+	grants []*iam.Grant
+}
+
+// getIamAnalysisPrincipals returns the users allowed by the grants, following the same rules as the permission enforcement and the forward mode.
+// The deactivated users are never allowed, and all active users are allowed if the permission is not enforced.
+func getIamAnalysisPrincipals(users []*store.UserMessage, grantsByUser map[string][]*iam.Grant, enforced bool) []*iamAnalysisPrincipal {
+	var principals []*iamAnalysisPrincipal
+	for _, user := range users {
+		if user.Type != api.EndUser && user.Type != api.ServiceAccount {
+			continue
+		}
+		if user.MemberDeleted {
+			continue
+		}
+		grants := slices.Concat(grantsByUser[common.FormatUserUID(user.ID)], grantsByUser[api.AllUsers])
+		if len(grants) == 0 && enforced {
+			continue
+		}
+		principals = append(principals, &iamAnalysisPrincipal{user: user, grants: grants})
+	}
+	return principals
+}
+
+// getIamAnalysisTarget parses the resource name and returns the project and the condition attributes of the resource.
+func (s *WorkspaceService) getIamAnalysisTarget(ctx context.Context, name string) (*iamAnalysisTarget, error) {
+	target := &iamAnalysisTarget{
+		attributes: map[string]any{
+			"request.time": time.Now(),
+		},
+	}
+	switch {
+	case strings.HasPrefix(name, common.WorkspacePrefix):
+		return target, nil
+	case strings.HasPrefix(name, common.ProjectNamePrefix):
+		projectID, err := common.GetProjectID(name)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		target.projectID = projectID
+		return target, nil
+	case strings.HasPrefix(name, common.InstanceNamePrefix):
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported resource %q", name)
+	}
+
+	databaseName := databaseRegex.FindString(name)
+	if databaseName == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid resource %q", name)
+	}
+	database, err := getDatabaseMessage(ctx, s.store, databaseName)
+	if err != nil {
+		return nil, err
+	}
+	instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &database.InstanceID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get instance %q, error: %v", database.InstanceID, err)
+	}
+	if instance == nil {
+		return nil, status.Errorf(codes.NotFound, "instance %q not found", database.InstanceID)
+	}
+	target.projectID = database.ProjectID
+	target.instance = instance
+	target.database = database
+	target.attributes["resource.database"] = databaseName
+	target.attributes["resource.environment_name"] = common.FormatEnvironment(database.EffectiveEnvironmentID)
+
+	// Parse the optional schemas/{schema}/tables/{table}/columns/{column} suffix.
+	suffix := strings.TrimPrefix(strings.TrimPrefix(name, databaseName), "/")
+	if suffix == "" {
+		return target, nil
+	}
+	tokens := strings.Split(suffix, "/")
+	if len(tokens)%2 != 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid resource %q", name)
+	}
+	column := &base.ColumnResource{Database: database.DatabaseName}
+	for i := 0; i < len(tokens); i += 2 {
+		switch tokens[i] {
+		case "schemas":
+			column.Schema = tokens[i+1]
+		case "tables":
+			column.Table = tokens[i+1]
+		case "columns":
+			column.Column = tokens[i+1]
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "invalid resource %q", name)
+		}
+	}
+	if column.Table == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid resource %q, table is required", name)
+	}
+	target.attributes["resource.schema"] = column.Schema
+	target.attributes["resource.table"] = column.Table
+	if column.Column != "" {
+		target.column = column
+	}
+	return target, nil
+}
+
+func convertToV1IamAnalysisGrant(ctx context.Context, stores *store.Store, workspaceID string, grant *iam.Grant) *v1pb.AnalyzeIamPolicyResponse_Grant {
+	policy := common.FormatWorkspace(workspaceID)
+	if grant.ProjectID != "" {
+		policy = common.FormatProject(grant.ProjectID)
+	}
+	return &v1pb.AnalyzeIamPolicyResponse_Grant{
+		Policy:    policy,
+		Role:      grant.Binding.Role,
+		Member:    convertToV1MemberInBinding(ctx, stores, grant.Member),
+		Condition: grant.Binding.Condition,
+	}
+}
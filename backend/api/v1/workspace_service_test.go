@@ -0,0 +1,62 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/component/iam"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestGetIamAnalysisPrincipals(t *testing.T) {
+	users := []*store.UserMessage{
+		{ID: 101, Email: "granted@example.com", Type: api.EndUser},
+		{ID: 102, Email: "none@example.com", Type: api.EndUser},
+		{ID: 103, Email: "deactivated@example.com", Type: api.EndUser, MemberDeleted: true},
+		{ID: 104, Email: "sa@service.bytebase.com", Type: api.ServiceAccount},
+		{ID: api.SystemBotID, Email: api.SystemBotEmail, Type: api.SystemBot},
+	}
+	grant := &iam.Grant{Binding: &storepb.Binding{Role: common.FormatRole(api.WorkspaceDBA.String())}}
+	grantsByUser := map[string][]*iam.Grant{
+		common.FormatUserUID(101): {grant},
+		common.FormatUserUID(103): {grant},
+	}
+	getEmails := func(principals []*iamAnalysisPrincipal) []string {
+		var emails []string
+		for _, principal := range principals {
+			emails = append(emails, principal.user.Email)
+		}
+		return emails
+	}
+
+	tests := []struct {
+		grantsByUser map[string][]*iam.Grant
+		enforced     bool
+		want         []string
+	}{
+		{
+			grantsByUser: grantsByUser,
+			enforced:     true,
+			want:         []string{"granted@example.com"},
+		},
+		{
+			grantsByUser: map[string][]*iam.Grant{api.AllUsers: {grant}},
+			enforced:     true,
+			want:         []string{"granted@example.com", "none@example.com", "sa@service.bytebase.com"},
+		},
+		{
+			// All active users hold the permission if it's not enforced, like the forward mode.
+			grantsByUser: grantsByUser,
+			enforced:     false,
+			want:         []string{"granted@example.com", "none@example.com", "sa@service.bytebase.com"},
+		},
+	}
+	for i, test := range tests {
+		got := getIamAnalysisPrincipals(users, test.grantsByUser, test.enforced)
+		require.Equal(t, test.want, getEmails(got), "test %d", i)
+	}
+}
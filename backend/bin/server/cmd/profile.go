@@ -33,6 +33,7 @@ func getBaseProfile(dataDir string) *config.Profile {
 		Version:              version,
 		GitCommit:            gitcommit,
 		PgURL:                flags.pgURL,
+		TrustedProxies:       flags.trustedProxies,
 		DeployID:             uuid.NewString()[:8],
 		LastActiveTs:         time.Now().Unix(),
 		Lsp:                  flags.lsp,
@@ -251,6 +251,30 @@ func EvalBindingCondition(expr string, requestTime time.Time) (bool, error) {
 	return doEvalBindingCondition(expr, input)
 }
 
+// EvalBindingConditionWithAttributes evaluates the binding condition with the request and resource attributes.
+// The attributes absent from the input are treated as unknown and don't fail the condition.
+func EvalBindingConditionWithAttributes(expr string, attributes map[string]any) (bool, error) {
+	return doEvalBindingCondition(expr, attributes)
+}
+
+// IsBindingConditionExpired returns true if the binding condition can never pass from the request time on,
+// e.g. "request.time < timestamp(...)" in the past.
+func IsBindingConditionExpired(expr string, requestTime time.Time) (bool, error) {
+	if expr == "" {
+		return false, nil
+	}
+	ok, err := EvalBindingCondition(expr, requestTime)
+	if err != nil || ok {
+		return false, err
+	}
+	// A condition that starts in the future is not expired.
+	ok, err = EvalBindingCondition(expr, requestTime.AddDate(100, 0, 0))
+	if err != nil {
+		return false, err
+	}
+	return !ok, nil
+}
+
 func doEvalBindingCondition(expr string, input map[string]any) (bool, error) {
 	if expr == "" {
 		return true, nil
@@ -114,3 +114,46 @@ func TestGetQueryExportFactors(t *testing.T) {
 		a.Equal(tt.want, *factors)
 	}
 }
+
+func TestIsBindingConditionExpired(t *testing.T) {
+	a := require.New(t)
+
+	time20240201, err := time.Parse(time.RFC3339, "2024-02-01T00:00:00Z")
+	a.NoError(err)
+
+	testCases := []struct {
+		expr string
+		want bool
+	}{
+		{
+			expr: "",
+			want: false,
+		},
+		{
+			expr: "request.time < timestamp(\"2024-01-01T00:00:00Z\")",
+			want: true,
+		},
+		{
+			expr: "request.time < timestamp(\"2024-03-01T00:00:00Z\")",
+			want: false,
+		},
+		{
+			expr: "request.time > timestamp(\"2024-03-01T00:00:00Z\")",
+			want: false,
+		},
+		{
+			expr: "request.time < timestamp(\"2024-01-01T00:00:00Z\") && resource.database == \"instances/i1/databases/d1\"",
+			want: true,
+		},
+		{
+			expr: "resource.database == \"instances/i1/databases/d1\"",
+			want: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		res, err := IsBindingConditionExpired(tc.expr, time20240201)
+		a.NoError(err)
+		a.Equal(tc.want, res, tc.expr)
+	}
+}
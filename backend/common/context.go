@@ -40,6 +40,11 @@ type Resource struct {
 	Name      string
 	ProjectID string
 	Workspace bool
+	// Database and Environment are the resource attributes for evaluating the IAM binding conditions.
+	// Database is in instances/{instance}/databases/{database} format.
+	// Environment is in environments/{environment} format.
+	Database    string
+	Environment string
 }
 
 type AuthContext struct {
@@ -48,6 +53,16 @@ type AuthContext struct {
 	Permission             string
 	AuthMethod             AuthMethod
 	Resources              []*Resource
+	// AccessTokenScope is set if the request is authenticated by an access token with a scope.
+	AccessTokenScope *AccessTokenScope
+}
+
+// AccessTokenScope is the scope of an access token.
+// The request is restricted to the permissions and the projects if they are not empty.
+type AccessTokenScope struct {
+	Permissions []string
+	// Projects are the project resource IDs.
+	Projects []string
 }
 
 func GetAuthContextFromContext(ctx context.Context) (*AuthContext, bool) {
@@ -32,6 +32,7 @@ const (
 	RolePrefix                 = "roles/"
 	SecretNamePrefix           = "secrets/"
 	WebhookIDPrefix            = "webhooks/"
+	WebhookDeliveryPrefix      = "deliveries/"
 	SheetIDPrefix              = "sheets/"
 	WorksheetIDPrefix          = "worksheets/"
 	DatabaseGroupNamePrefix    = "databaseGroups/"
@@ -52,6 +53,8 @@ const (
 	ReleaseNamePrefix          = "releases/"
 	FileNamePrefix             = "files/"
 	RevisionNamePrefix         = "revisions/"
+	AccessTokenNamePrefix      = "accessTokens/"
+	SessionNamePrefix          = "sessions/"
 
 	SchemaSuffix     = "/schema"
 	MetadataSuffix   = "/metadata"
@@ -101,6 +104,23 @@ func GetProjectIDWebhookID(name string) (string, string, error) {
 	return tokens[0], tokens[1], nil
 }
 
+// GetProjectIDWebhookIDDeliveryID returns the project ID, webhook ID and delivery ID from a resource name.
+func GetProjectIDWebhookIDDeliveryID(name string) (string, int, int64, error) {
+	tokens, err := GetNameParentTokens(name, ProjectNamePrefix, WebhookIDPrefix, WebhookDeliveryPrefix)
+	if err != nil {
+		return "", 0, 0, err
+	}
+	webhookID, err := strconv.Atoi(tokens[1])
+	if err != nil {
+		return "", 0, 0, errors.Errorf("invalid webhook ID %q", tokens[1])
+	}
+	deliveryID, err := strconv.ParseInt(tokens[2], 10, 64)
+	if err != nil {
+		return "", 0, 0, errors.Errorf("invalid webhook delivery ID %q", tokens[2])
+	}
+	return tokens[0], webhookID, deliveryID, nil
+}
+
 func GetProjectIDDeploymentConfigID(name string) (string, string, error) {
 	tokens, err := GetNameParentTokens(name, ProjectNamePrefix, DeploymentConfigPrefix)
 	if err != nil {
@@ -347,6 +367,36 @@ func GetProjectIDPlanID(name string) (string, int64, error) {
 	return tokens[0], planID, nil
 }
 
+// GetUserIDAccessTokenID returns the user ID and access token ID from a resource name.
+func GetUserIDAccessTokenID(name string) (int, int64, error) {
+	tokens, err := GetNameParentTokens(name, UserNamePrefix, AccessTokenNamePrefix)
+	if err != nil {
+		return 0, 0, err
+	}
+	userID, err := strconv.Atoi(tokens[0])
+	if err != nil {
+		return 0, 0, errors.Errorf("invalid user ID %q", tokens[0])
+	}
+	accessTokenID, err := strconv.ParseInt(tokens[1], 10, 64)
+	if err != nil {
+		return 0, 0, errors.Errorf("invalid access token ID %q", tokens[1])
+	}
+	return userID, accessTokenID, nil
+}
+
+// GetUserIDSessionID returns the user ID and session ID from a resource name.
+func GetUserIDSessionID(name string) (int, string, error) {
+	tokens, err := GetNameParentTokens(name, UserNamePrefix, SessionNamePrefix)
+	if err != nil {
+		return 0, "", err
+	}
+	userID, err := strconv.Atoi(tokens[0])
+	if err != nil {
+		return 0, "", errors.Errorf("invalid user ID %q", tokens[0])
+	}
+	return userID, tokens[1], nil
+}
+
 // GetProjectIDPlanIDPlanCheckRunID returns the project ID, plan ID and plan check run ID from a resource name.
 func GetProjectIDPlanIDPlanCheckRunID(name string) (string, int, int, error) {
 	tokens, err := GetNameParentTokens(name, ProjectNamePrefix, PlanPrefix, PlanCheckRunPrefix)
@@ -722,6 +772,22 @@ func FormatPlan(projectID string, planUID int64) string {
 	return fmt.Sprintf("%s/%s%d", FormatProject(projectID), PlanPrefix, planUID)
 }
 
+func FormatAccessToken(userUID int, accessTokenUID int64) string {
+	return fmt.Sprintf("%s/%s%d", FormatUserUID(userUID), AccessTokenNamePrefix, accessTokenUID)
+}
+
+func FormatSession(userUID int, sessionID string) string {
+	return fmt.Sprintf("%s/%s%s", FormatUserUID(userUID), SessionNamePrefix, sessionID)
+}
+
+func FormatWebhook(projectID string, webhookUID int) string {
+	return fmt.Sprintf("%s/%s%d", FormatProject(projectID), WebhookIDPrefix, webhookUID)
+}
+
+func FormatWebhookDelivery(projectID string, webhookUID int, deliveryUID int64) string {
+	return fmt.Sprintf("%s/%s%d", FormatWebhook(projectID, webhookUID), WebhookDeliveryPrefix, deliveryUID)
+}
+
 func FormatPlanCheckRun(projectID string, planUID, runUID int64) string {
 	return fmt.Sprintf("%s/%s%d", FormatPlan(projectID, planUID), PlanCheckRunPrefix, runUID)
 }
@@ -5,8 +5,10 @@ import (
 	"encoding/base64"
 	"fmt"
 	"math/big"
+	"net/netip"
 	"path"
 	"path/filepath"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -63,8 +65,32 @@ var (
 		storepb.Engine_OCEANBASE_ORACLE: true,
 		storepb.Engine_MSSQL:            true,
 	}
+	// DryRunTransactionEngines are the engines supporting transactional DDL.
+	// The dry run executes the statement in a transaction and rolls it back.
+	DryRunTransactionEngines = map[storepb.Engine]bool{
+		storepb.Engine_POSTGRES: true,
+		storepb.Engine_MSSQL:    true,
+		storepb.Engine_SQLITE:   true,
+	}
+	// DryRunCloneEngines are the engines without transactional DDL.
+	// The dry run executes the statement against a schema-only clone of the database on a sandbox instance.
+	DryRunCloneEngines = map[storepb.Engine]bool{
+		storepb.Engine_MYSQL:     true,
+		storepb.Engine_MARIADB:   true,
+		storepb.Engine_TIDB:      true,
+		storepb.Engine_OCEANBASE: true,
+	}
 )
 
+// releaseFileVersionRE matches the version of the migration files, e.g. 3 for 3_add_table.sql.
+var releaseFileVersionRE = regexp.MustCompile(`^[0-9]+`)
+
+// GetReleaseFileVersion returns the version of the migration file, which is the leading digits of the file name.
+// It returns empty if the file name doesn't start with a digit.
+func GetReleaseFileVersion(filename string) string {
+	return releaseFileVersionRE.FindString(path.Base(filename))
+}
+
 var letters = []rune("0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ")
 
 // ProtojsonMarshaler is a global protojson marshaler with DiscardUnknown set to true.
@@ -194,6 +220,22 @@ func NormalizeExternalURL(url string) (string, error) {
 	return r, nil
 }
 
+// ParseIPPrefix parses the IP address or the CIDR range.
+func ParseIPPrefix(s string) (netip.Prefix, error) {
+	if strings.Contains(s, "/") {
+		prefix, err := netip.ParsePrefix(s)
+		if err != nil {
+			return netip.Prefix{}, errors.Wrapf(err, "invalid CIDR range %q", s)
+		}
+		return prefix.Masked(), nil
+	}
+	addr, err := netip.ParseAddr(s)
+	if err != nil {
+		return netip.Prefix{}, errors.Wrapf(err, "invalid IP address %q", s)
+	}
+	return netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()), nil
+}
+
 // ValidatePhone validates the phone number.
 func ValidatePhone(phone string) error {
 	phoneNumber, err := phonenumbers.Parse(phone, "")
@@ -250,3 +250,17 @@ func TestValidatePhone(t *testing.T) {
 		}
 	}
 }
+
+func TestGetReleaseFileVersion(t *testing.T) {
+	a := require.New(t)
+	tests := map[string]string{
+		"3_add_table.sql":                "3",
+		"0012ddl_users.sql":              "0012",
+		"migrations/20240102_orders.sql": "20240102",
+		"V3__add_table.sql":              "",
+		"add_table.sql":                  "",
+	}
+	for filename, want := range tests {
+		a.Equal(want, GetReleaseFileVersion(filename), filename)
+	}
+}
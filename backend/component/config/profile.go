@@ -57,6 +57,9 @@ type Profile struct {
 	GitCommit string
 	// PgURL is the optional external PostgreSQL instance connection url
 	PgURL string
+	// TrustedProxies are the IP addresses or CIDR ranges of the reverse proxies in front of Bytebase.
+	// The client IP address is only taken from the X-Forwarded-For header appended by the trusted proxies.
+	TrustedProxies []string
 	// MetricConnectionKey is the connection key for metric.
 	MetricConnectionKey string
 	// EnableMetric will enable the metric collector.
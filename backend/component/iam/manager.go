@@ -3,12 +3,15 @@ package iam
 import (
 	"context"
 	_ "embed"
+	"log/slog"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"gopkg.in/yaml.v3"
 
 	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
 	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/store"
@@ -50,7 +53,7 @@ func NewManager(store *store.Store, licenseService enterprise.LicenseService) (*
 }
 
 // Check if the user has permission on the resource hierarchy.
-// CEL on the binding is not considered.
+// The CEL condition on the binding is evaluated with the request time and the resources from the auth context.
 // When multiple projects are specified, the user should have permission on every projects.
 func (m *Manager) CheckPermission(ctx context.Context, p Permission, user *store.UserMessage, projectIDs ...string) (bool, error) {
 	if m.licenseService.IsFeatureEnabled(api.FeatureRBAC) != nil {
@@ -58,11 +61,12 @@ func (m *Manager) CheckPermission(ctx context.Context, p Permission, user *store
 		return true, nil
 	}
 
+	attributes := getConditionAttributes(ctx, time.Now())
 	policyMessage, err := m.store.GetWorkspaceIamPolicy(ctx)
 	if err != nil {
 		return false, err
 	}
-	if ok := check(user.ID, p, policyMessage.Policy, m.rolePermissions, m.groupMembers); ok {
+	if ok := check(user.ID, p, policyMessage.Policy, m.rolePermissions, m.groupMembers, attributes); ok {
 		return true, nil
 	}
 
@@ -83,7 +87,7 @@ func (m *Manager) CheckPermission(ctx context.Context, p Permission, user *store
 			if err != nil {
 				return false, err
 			}
-			if ok := check(user.ID, p, policyMessage.Policy, m.rolePermissions, m.groupMembers); !ok {
+			if ok := check(user.ID, p, policyMessage.Policy, m.rolePermissions, m.groupMembers, attributes); !ok {
 				allOK = false
 				break
 			}
@@ -93,6 +97,11 @@ func (m *Manager) CheckPermission(ctx context.Context, p Permission, user *store
 	return false, nil
 }
 
+// PermissionEnforced returns false if the permissions are not enforced because RBAC is not enabled.
+func (m *Manager) PermissionEnforced() bool {
+	return m.licenseService.IsFeatureEnabled(api.FeatureRBAC) == nil
+}
+
 func (m *Manager) ReloadCache(ctx context.Context) error {
 	roles, err := m.store.ListRoles(ctx)
 	if err != nil {
@@ -133,33 +142,196 @@ func (m *Manager) GetPermissions(role string) (map[Permission]bool, error) {
 	return permissions, nil
 }
 
-func check(userID int, p Permission, policy *storepb.IamPolicy, rolePermissions map[string]map[Permission]bool, groupMembers map[string]map[string]bool) bool {
+// getConditionAttributes returns the condition attributes for each database resource in the auth context.
+// It returns the request time only if there is no database resource.
+func getConditionAttributes(ctx context.Context, requestTime time.Time) []map[string]any {
+	var attributes []map[string]any
+	if authContext, ok := common.GetAuthContextFromContext(ctx); ok {
+		for _, resource := range authContext.Resources {
+			if resource.Database == "" {
+				continue
+			}
+			attributes = append(attributes, map[string]any{
+				"request.time":              requestTime,
+				"resource.database":         resource.Database,
+				"resource.environment_name": resource.Environment,
+			})
+		}
+	}
+	if len(attributes) == 0 {
+		attributes = append(attributes, map[string]any{
+			"request.time": requestTime,
+		})
+	}
+	return attributes
+}
+
+// checkCondition returns true if the binding condition passes for every attribute set.
+func checkCondition(binding *storepb.Binding, attributes []map[string]any) bool {
+	expression := binding.GetCondition().GetExpression()
+	if expression == "" {
+		return true
+	}
+	for _, attribute := range attributes {
+		ok, err := common.EvalBindingConditionWithAttributes(expression, attribute)
+		if err != nil {
+			slog.Error("failed to eval binding condition", slog.String("expression", expression), log.BBError(err))
+			return false
+		}
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func check(userID int, p Permission, policy *storepb.IamPolicy, rolePermissions map[string]map[Permission]bool, groupMembers map[string]map[string]bool, attributes []map[string]any) bool {
 	userName := common.FormatUserUID(userID)
 	for _, binding := range policy.GetBindings() {
-		permissions, ok := rolePermissions[binding.GetRole()]
-		if !ok {
+		if !rolePermissions[binding.GetRole()][p] {
 			continue
 		}
-		if !permissions[p] {
+		if !checkCondition(binding, attributes) {
 			continue
 		}
-		for _, member := range binding.GetMembers() {
-			if member == api.AllUsers {
-				return true
+		if getMatchedMember(userName, binding, groupMembers) != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// getMatchedMember returns the binding member that matches the user, or empty if none matches.
+func getMatchedMember(userName string, binding *storepb.Binding, groupMembers map[string]map[string]bool) string {
+	for _, member := range binding.GetMembers() {
+		if member == api.AllUsers {
+			return member
+		}
+		if member == userName {
+			return member
+		}
+		if strings.HasPrefix(member, common.GroupPrefix) {
+			if groupMembers, ok := groupMembers[member]; ok {
+				if groupMembers[userName] {
+					return member
+				}
+			}
+		}
+	}
+	return ""
+}
+
+// Grant is a binding member granting a permission.
+type Grant struct {
+	// ProjectID is the project of the IAM policy containing the binding.
+	// It's empty for the workspace IAM policy.
+	ProjectID string
+	Binding   *storepb.Binding
+	// Member is the binding member in users/{uid}, groups/{email} or allUsers format.
+	Member string
+}
+
+// PermissionExplanation explains the permission check of a user.
+type PermissionExplanation struct {
+	// Grants are the bindings granting the permission.
+	Grants []*Grant
+	// ConditionDenied are the bindings that would grant the permission but whose conditions are not satisfied.
+	ConditionDenied []*Grant
+}
+
+// ExplainPermission explains the permission check of the user on the workspace, or the project if projectID is not empty.
+// It evaluates the same bindings as CheckPermission with the given condition attributes.
+func (m *Manager) ExplainPermission(ctx context.Context, p Permission, user *store.UserMessage, attributes map[string]any, projectID string) (*PermissionExplanation, error) {
+	policies, err := m.getIamPolicies(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	userName := common.FormatUserUID(user.ID)
+	explanation := &PermissionExplanation{}
+	for _, policy := range policies {
+		for _, binding := range policy.policy.GetBindings() {
+			if !m.rolePermissions[binding.GetRole()][p] {
+				continue
+			}
+			member := getMatchedMember(userName, binding, m.groupMembers)
+			if member == "" {
+				continue
+			}
+			grant := &Grant{ProjectID: policy.projectID, Binding: binding, Member: member}
+			if checkCondition(binding, []map[string]any{attributes}) {
+				explanation.Grants = append(explanation.Grants, grant)
+			} else {
+				explanation.ConditionDenied = append(explanation.ConditionDenied, grant)
+			}
+		}
+	}
+	return explanation, nil
+}
+
+// ListPermissionGrants lists the grants of the permission on the workspace, or the project if projectID is not empty.
+// The result is keyed by users/{uid}, or allUsers for the bindings granting to all users.
+func (m *Manager) ListPermissionGrants(ctx context.Context, p Permission, attributes map[string]any, projectID string) (map[string][]*Grant, error) {
+	policies, err := m.getIamPolicies(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	grants := map[string][]*Grant{}
+	for _, policy := range policies {
+		for _, binding := range policy.policy.GetBindings() {
+			if !m.rolePermissions[binding.GetRole()][p] {
+				continue
 			}
-			if member == userName {
-				return true
+			if !checkCondition(binding, []map[string]any{attributes}) {
+				continue
 			}
-			if strings.HasPrefix(member, common.GroupPrefix) {
-				if groupMembers, ok := groupMembers[member]; ok {
-					if groupMembers[userName] {
-						return true
+			for _, member := range binding.GetMembers() {
+				grant := &Grant{ProjectID: policy.projectID, Binding: binding, Member: member}
+				if strings.HasPrefix(member, common.GroupPrefix) {
+					for userName := range m.groupMembers[member] {
+						grants[userName] = append(grants[userName], grant)
 					}
+					continue
 				}
+				grants[member] = append(grants[member], grant)
 			}
 		}
 	}
-	return false
+	return grants, nil
+}
+
+type iamPolicyWithProject struct {
+	projectID string
+	policy    *storepb.IamPolicy
+}
+
+// getIamPolicies returns the workspace IAM policy, followed by the project IAM policy if projectID is not empty.
+func (m *Manager) getIamPolicies(ctx context.Context, projectID string) ([]iamPolicyWithProject, error) {
+	workspacePolicy, err := m.store.GetWorkspaceIamPolicy(ctx)
+	if err != nil {
+		return nil, err
+	}
+	policies := []iamPolicyWithProject{
+		{policy: workspacePolicy.Policy},
+	}
+	if projectID == "" {
+		return policies, nil
+	}
+	project, err := m.store.GetProjectV2(ctx, &store.FindProjectMessage{
+		ResourceID:  &projectID,
+		ShowDeleted: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if project == nil {
+		return nil, errors.Errorf("project %q not found", projectID)
+	}
+	projectPolicy, err := m.store.GetProjectIamPolicy(ctx, project.UID)
+	if err != nil {
+		return nil, err
+	}
+	policies = append(policies, iamPolicyWithProject{projectID: projectID, policy: projectPolicy.Policy})
+	return policies, nil
 }
 
 func loadPredefinedRoles() ([]*store.RoleMessage, error) {
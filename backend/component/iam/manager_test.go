@@ -2,8 +2,10 @@ package iam
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/type/expr"
 
 	"github.com/bytebase/bytebase/backend/common"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
@@ -20,10 +22,13 @@ func TestCheck(t *testing.T) {
 		rolePermissions[common.FormatRole(role.ResourceID)] = role.Permissions
 	}
 
+	now := time.Now()
+	defaultAttributes := []map[string]any{{"request.time": now}}
 	tests := []struct {
 		permission   Permission
 		policy       *storepb.IamPolicy
 		groupMembers map[string]map[string]bool
+		attributes   []map[string]any
 		want         bool
 	}{
 		{
@@ -94,10 +99,77 @@ func TestCheck(t *testing.T) {
 				},
 			},
 			want: true,
+		},
+		{
+			permission: PermissionInstancesCreate,
+			policy: &storepb.IamPolicy{
+				Bindings: []*storepb.Binding{
+					{
+						Role:      "roles/workspaceAdmin",
+						Members:   []string{"users/123"},
+						Condition: &expr.Expr{Expression: `request.time < timestamp("2024-02-01T00:00:00Z")`},
+					},
+				},
+			},
+			groupMembers: nil,
+			want:         false,
+		},
+		{
+			permission: PermissionInstancesCreate,
+			policy: &storepb.IamPolicy{
+				Bindings: []*storepb.Binding{
+					{
+						Role:      "roles/workspaceAdmin",
+						Members:   []string{"users/123"},
+						Condition: &expr.Expr{Expression: `request.time < timestamp("2999-02-01T00:00:00Z")`},
+					},
+				},
+			},
+			groupMembers: nil,
+			want:         true,
+		},
+		{
+			permission: PermissionInstancesCreate,
+			policy: &storepb.IamPolicy{
+				Bindings: []*storepb.Binding{
+					{
+						Role:      "roles/workspaceAdmin",
+						Members:   []string{"users/123"},
+						Condition: &expr.Expr{Expression: `resource.database == "instances/i1/databases/d1"`},
+					},
+				},
+			},
+			groupMembers: nil,
+			attributes: []map[string]any{
+				{"request.time": now, "resource.database": "instances/i1/databases/d1"},
+				{"request.time": now, "resource.database": "instances/i1/databases/d2"},
+			},
+			want: false,
+		},
+		{
+			permission: PermissionInstancesCreate,
+			policy: &storepb.IamPolicy{
+				Bindings: []*storepb.Binding{
+					{
+						Role:      "roles/workspaceAdmin",
+						Members:   []string{"users/123"},
+						Condition: &expr.Expr{Expression: `resource.database == "instances/i1/databases/d1"`},
+					},
+				},
+			},
+			groupMembers: nil,
+			attributes: []map[string]any{
+				{"request.time": now, "resource.database": "instances/i1/databases/d1"},
+			},
+			want: true,
 		}}
 
 	for i, test := range tests {
-		got := check(userID, test.permission, test.policy, rolePermissions, test.groupMembers)
+		attributes := test.attributes
+		if attributes == nil {
+			attributes = defaultAttributes
+		}
+		got := check(userID, test.permission, test.policy, rolePermissions, test.groupMembers, attributes)
 		if got != test.want {
 			require.Equal(t, test.want, got, i)
 		}
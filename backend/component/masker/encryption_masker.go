@@ -0,0 +1,313 @@
+package masker
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+const (
+	digitAlphabet        = "0123456789"
+	lowerAlphabet        = "abcdefghijklmnopqrstuvwxyz"
+	upperAlphabet        = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	alphanumericAlphabet = digitAlphabet + lowerAlphabet + upperAlphabet
+
+	defaultTokenLength = 32
+	maxTokenLength     = 64
+)
+
+// FormatPreservingEncryptionMasker is the masker that encrypts the data with FF1 or FF3-1,
+// the masked value keeps the length and the character classes of the original value.
+// The value which is too short to be encrypted securely is fully masked.
+type FormatPreservingEncryptionMasker struct {
+	mode   storepb.Algorithm_FormatPreservingEncryptionMask_Mode
+	key    []byte
+	tweak  []byte
+	format storepb.Algorithm_FormatPreservingEncryptionMask_Format
+	cipher fpeCipher
+}
+
+// NewFormatPreservingEncryptionMasker returns a new FormatPreservingEncryptionMasker.
+func NewFormatPreservingEncryptionMasker(mode storepb.Algorithm_FormatPreservingEncryptionMask_Mode, key, tweak []byte, format storepb.Algorithm_FormatPreservingEncryptionMask_Format) (*FormatPreservingEncryptionMasker, error) {
+	var c fpeCipher
+	var err error
+	switch mode {
+	case storepb.Algorithm_FormatPreservingEncryptionMask_FF1, storepb.Algorithm_FormatPreservingEncryptionMask_MODE_UNSPECIFIED:
+		c, err = newFF1Cipher(key, tweak)
+	case storepb.Algorithm_FormatPreservingEncryptionMask_FF3_1:
+		c, err = newFF31Cipher(key, tweak)
+	default:
+		return nil, errors.Errorf("unsupported format-preserving encryption mode %v", mode)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &FormatPreservingEncryptionMasker{
+		mode:   mode,
+		key:    key,
+		tweak:  tweak,
+		format: format,
+		cipher: c,
+	}, nil
+}
+
+// Mask implements Masker.Mask.
+func (m *FormatPreservingEncryptionMasker) Mask(data *MaskData) *v1pb.RowValue {
+	if kind, ok := data.Data.Kind.(*v1pb.RowValue_ValueValue); ok {
+		return &v1pb.RowValue{
+			Kind: &v1pb.RowValue_ValueValue{
+				ValueValue: maskProtoValue(m, kind.ValueValue),
+			},
+		}
+	}
+	s, ok := getMaskDataString(data)
+	if !ok {
+		return defaultMaskedStringValue()
+	}
+	masked, err := m.encrypt(s)
+	if err != nil {
+		slog.Debug("failed to encrypt the value with format-preserving encryption", log.BBError(err))
+		return defaultMaskedStringValue()
+	}
+	return &v1pb.RowValue{
+		Kind: &v1pb.RowValue_StringValue{
+			StringValue: masked,
+		},
+	}
+}
+
+// Equal implements Masker.Equal.
+func (m *FormatPreservingEncryptionMasker) Equal(other Masker) bool {
+	if otherMasker, ok := other.(*FormatPreservingEncryptionMasker); ok {
+		return m.mode == otherMasker.mode &&
+			bytes.Equal(m.key, otherMasker.key) &&
+			bytes.Equal(m.tweak, otherMasker.tweak) &&
+			m.format == otherMasker.format
+	}
+	return false
+}
+
+func (m *FormatPreservingEncryptionMasker) encrypt(s string) (string, error) {
+	switch m.format {
+	case storepb.Algorithm_FormatPreservingEncryptionMask_EMAIL:
+		at := strings.LastIndex(s, "@")
+		if at < 0 {
+			return m.encryptCharacterClasses(s)
+		}
+		local, err := m.encryptCharacterClasses(s[:at])
+		if err != nil {
+			return "", err
+		}
+		return local + s[at:], nil
+	case storepb.Algorithm_FormatPreservingEncryptionMask_CREDIT_CARD:
+		return m.encryptCreditCard(s)
+	default:
+		return m.encryptCharacterClasses(s)
+	}
+}
+
+// encryptCharacterClasses encrypts the digits, the lower and upper case letters separately,
+// so that each character keeps its class. If any class is too short to be encrypted,
+// all the alphanumeric characters are encrypted together instead.
+func (m *FormatPreservingEncryptionMasker) encryptCharacterClasses(s string) (string, error) {
+	runes := []rune(s)
+	var classes [][]int
+	var alphanumeric []int
+	for _, alphabet := range []string{digitAlphabet, lowerAlphabet, upperAlphabet} {
+		var positions []int
+		for i, r := range runes {
+			if r < 128 && strings.IndexByte(alphabet, byte(r)) >= 0 {
+				positions = append(positions, i)
+			}
+		}
+		classes = append(classes, positions)
+		alphanumeric = append(alphanumeric, positions...)
+	}
+
+	perClass := true
+	for i, alphabet := range []string{digitAlphabet, lowerAlphabet, upperAlphabet} {
+		if len(classes[i]) > 0 && len(classes[i]) < fpeMinLength(len(alphabet)) {
+			perClass = false
+			break
+		}
+	}
+	if perClass {
+		for i, alphabet := range []string{digitAlphabet, lowerAlphabet, upperAlphabet} {
+			if len(classes[i]) == 0 {
+				continue
+			}
+			if err := m.encryptPositions(runes, classes[i], alphabet); err != nil {
+				return "", err
+			}
+		}
+		return string(runes), nil
+	}
+
+	if err := m.encryptPositions(runes, alphanumeric, alphanumericAlphabet); err != nil {
+		return "", err
+	}
+	return string(runes), nil
+}
+
+// encryptCreditCard encrypts the digits except the check digit and recomputes the Luhn check digit.
+func (m *FormatPreservingEncryptionMasker) encryptCreditCard(s string) (string, error) {
+	runes := []rune(s)
+	var positions []int
+	for i, r := range runes {
+		if r >= '0' && r <= '9' {
+			positions = append(positions, i)
+		}
+	}
+	if len(positions) < 2 {
+		return "", errors.Errorf("card number is too short")
+	}
+	payload, checkPosition := positions[:len(positions)-1], positions[len(positions)-1]
+	if err := m.encryptPositions(runes, payload, digitAlphabet); err != nil {
+		return "", err
+	}
+	digits := make([]rune, 0, len(payload))
+	for _, position := range payload {
+		digits = append(digits, runes[position])
+	}
+	runes[checkPosition] = luhnCheckDigit(digits)
+	return string(runes), nil
+}
+
+func (m *FormatPreservingEncryptionMasker) encryptPositions(runes []rune, positions []int, alphabet string) error {
+	numerals := make([]uint16, 0, len(positions))
+	for _, position := range positions {
+		numerals = append(numerals, uint16(strings.IndexByte(alphabet, byte(runes[position]))))
+	}
+	encrypted, err := m.cipher.encrypt(numerals, len(alphabet))
+	if err != nil {
+		return err
+	}
+	for i, position := range positions {
+		runes[position] = rune(alphabet[encrypted[i]])
+	}
+	return nil
+}
+
+// luhnCheckDigit returns the check digit to append to the digits.
+func luhnCheckDigit(digits []rune) rune {
+	sum := 0
+	for i := 0; i < len(digits); i++ {
+		d := int(digits[len(digits)-1-i] - '0')
+		// Double every second digit from the right, starting from the rightmost payload digit.
+		if i%2 == 0 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	return rune('0' + (10-sum%10)%10)
+}
+
+// TokenizationMasker is the masker that replaces the data with a deterministic token,
+// the token is the truncated hex-encoded HMAC-SHA256 of the value.
+type TokenizationMasker struct {
+	key    []byte
+	prefix string
+	length int
+}
+
+// NewTokenizationMasker returns a new TokenizationMasker.
+func NewTokenizationMasker(key []byte, prefix string, length int32) *TokenizationMasker {
+	l := int(length)
+	if l <= 0 {
+		l = defaultTokenLength
+	}
+	if l > maxTokenLength {
+		l = maxTokenLength
+	}
+	return &TokenizationMasker{
+		key:    key,
+		prefix: prefix,
+		length: l,
+	}
+}
+
+// Mask implements Masker.Mask.
+func (m *TokenizationMasker) Mask(data *MaskData) *v1pb.RowValue {
+	if kind, ok := data.Data.Kind.(*v1pb.RowValue_ValueValue); ok {
+		return &v1pb.RowValue{
+			Kind: &v1pb.RowValue_ValueValue{
+				ValueValue: maskProtoValue(m, kind.ValueValue),
+			},
+		}
+	}
+	s, ok := getMaskDataString(data)
+	if !ok {
+		return defaultMaskedStringValue()
+	}
+	h := hmac.New(sha256.New, m.key)
+	if _, err := h.Write([]byte(s)); err != nil {
+		slog.Error("Failed to write to hmac hash", log.BBError(err))
+	}
+	return &v1pb.RowValue{
+		Kind: &v1pb.RowValue_StringValue{
+			StringValue: m.prefix + hex.EncodeToString(h.Sum(nil))[:m.length],
+		},
+	}
+}
+
+// Equal implements Masker.Equal.
+func (m *TokenizationMasker) Equal(other Masker) bool {
+	if otherMasker, ok := other.(*TokenizationMasker); ok {
+		return bytes.Equal(m.key, otherMasker.key) && m.prefix == otherMasker.prefix && m.length == otherMasker.length
+	}
+	return false
+}
+
+// getMaskDataString returns the string representation of the data to be encrypted or tokenized.
+// It returns false for the null and boolean values, which have too few values to be hidden.
+func getMaskDataString(data *MaskData) (string, bool) {
+	switch kind := data.Data.Kind.(type) {
+	case *v1pb.RowValue_NullValue, *v1pb.RowValue_BoolValue:
+		return "", false
+	case *v1pb.RowValue_BytesValue:
+		return string(kind.BytesValue), true
+	case *v1pb.RowValue_DoubleValue:
+		return strconv.FormatFloat(kind.DoubleValue, 'f', -1, 64), true
+	case *v1pb.RowValue_FloatValue:
+		return strconv.FormatFloat(float64(kind.FloatValue), 'f', -1, 64), true
+	case *v1pb.RowValue_Int32Value:
+		return strconv.FormatInt(int64(kind.Int32Value), 10), true
+	case *v1pb.RowValue_Int64Value:
+		return strconv.FormatInt(kind.Int64Value, 10), true
+	case *v1pb.RowValue_StringValue:
+		return kind.StringValue, true
+	case *v1pb.RowValue_Uint32Value:
+		return strconv.FormatUint(uint64(kind.Uint32Value), 10), true
+	case *v1pb.RowValue_Uint64Value:
+		return strconv.FormatUint(kind.Uint64Value, 10), true
+	case *v1pb.RowValue_TimestampValue:
+		return kind.TimestampValue.AsTime().Format("2006-01-02 15:04:05.000000"), true
+	case *v1pb.RowValue_TimestampTzValue:
+		t := kind.TimestampTzValue.Timestamp.AsTime()
+		z := time.FixedZone(kind.TimestampTzValue.GetZone(), int(kind.TimestampTzValue.GetOffset()))
+		return t.In(z).Format(time.RFC3339Nano), true
+	}
+	return "", false
+}
+
+func defaultMaskedStringValue() *v1pb.RowValue {
+	return &v1pb.RowValue{
+		Kind: &v1pb.RowValue_StringValue{
+			StringValue: "******",
+		},
+	}
+}
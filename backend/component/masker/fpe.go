@@ -0,0 +1,271 @@
+package masker
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/binary"
+	"math/big"
+	"slices"
+
+	"github.com/pkg/errors"
+)
+
+// fpeMinDomainSize is the minimum domain size radix^minlen required by NIST SP 800-38G Rev. 1.
+const fpeMinDomainSize = 1000000
+
+// fpeCipher is the format-preserving cipher over the numeral strings.
+type fpeCipher interface {
+	encrypt(numerals []uint16, radix int) ([]uint16, error)
+	decrypt(numerals []uint16, radix int) ([]uint16, error)
+}
+
+// ff1Cipher implements the FF1 mode in NIST SP 800-38G.
+type ff1Cipher struct {
+	block cipher.Block
+	tweak []byte
+}
+
+func newFF1Cipher(key, tweak []byte) (*ff1Cipher, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid FF1 key")
+	}
+	return &ff1Cipher{block: block, tweak: tweak}, nil
+}
+
+func (c *ff1Cipher) encrypt(numerals []uint16, radix int) ([]uint16, error) {
+	return c.cipher(numerals, radix, true)
+}
+
+func (c *ff1Cipher) decrypt(numerals []uint16, radix int) ([]uint16, error) {
+	return c.cipher(numerals, radix, false)
+}
+
+func (c *ff1Cipher) cipher(numerals []uint16, radix int, encrypt bool) ([]uint16, error) {
+	n := len(numerals)
+	if err := validateFPEInput(numerals, radix, 2, 1<<32-1); err != nil {
+		return nil, err
+	}
+	t := len(c.tweak)
+	u, v := n/2, n-n/2
+	a, b := slices.Clone(numerals[:u]), slices.Clone(numerals[u:])
+
+	bigRadix := big.NewInt(int64(radix))
+	// b = ceil(ceil(v * log2(radix)) / 8).
+	maxV := new(big.Int).Exp(bigRadix, big.NewInt(int64(v)), nil)
+	byteLen := (new(big.Int).Sub(maxV, big.NewInt(1)).BitLen() + 7) / 8
+	d := 4*((byteLen+3)/4) + 4
+
+	p := []byte{1, 2, 1, byte(radix >> 16), byte(radix >> 8), byte(radix), 10, byte(u), 0, 0, 0, 0, 0, 0, 0, 0}
+	binary.BigEndian.PutUint32(p[8:12], uint32(n))
+	binary.BigEndian.PutUint32(p[12:16], uint32(t))
+
+	padLen := (16 - (t+byteLen+1)%16) % 16
+	q := make([]byte, t+padLen+1+byteLen)
+	copy(q, c.tweak)
+
+	for j := 0; j < 10; j++ {
+		i := j
+		if !encrypt {
+			i = 9 - j
+		}
+		// In the decryption, A is the half feeding the round function.
+		feed := b
+		if !encrypt {
+			feed = a
+		}
+		q[t+padLen] = byte(i)
+		num(feed, radix).FillBytes(q[t+padLen+1:])
+
+		r := c.prf(append(slices.Clone(p), q...))
+		s := make([]byte, 0, d+16)
+		s = append(s, r...)
+		for k := 1; len(s) < d; k++ {
+			x := slices.Clone(r)
+			counter := make([]byte, 16)
+			binary.BigEndian.PutUint64(counter[8:], uint64(k))
+			xorBytes(x, counter)
+			c.block.Encrypt(x, x)
+			s = append(s, x...)
+		}
+		y := new(big.Int).SetBytes(s[:d])
+
+		m := u
+		if i%2 == 1 {
+			m = v
+		}
+		modulus := new(big.Int).Exp(bigRadix, big.NewInt(int64(m)), nil)
+		if encrypt {
+			cNum := new(big.Int).Add(num(a, radix), y)
+			cNum.Mod(cNum, modulus)
+			a, b = b, str(cNum, radix, m)
+		} else {
+			cNum := new(big.Int).Sub(num(b, radix), y)
+			cNum.Mod(cNum, modulus)
+			a, b = str(cNum, radix, m), a
+		}
+	}
+	return append(a, b...), nil
+}
+
+// prf is the CBC-MAC of the input with a zero IV.
+func (c *ff1Cipher) prf(input []byte) []byte {
+	y := make([]byte, 16)
+	for i := 0; i < len(input); i += 16 {
+		xorBytes(y, input[i:i+16])
+		c.block.Encrypt(y, y)
+	}
+	return y
+}
+
+// ff3Cipher implements the FF3-1 mode in NIST SP 800-38G Rev. 1.
+type ff3Cipher struct {
+	block  cipher.Block
+	tweakL []byte
+	tweakR []byte
+}
+
+func newFF31Cipher(key, tweak []byte) (*ff3Cipher, error) {
+	if len(tweak) != 7 {
+		return nil, errors.Errorf("FF3-1 tweak must be 7 bytes, got %d", len(tweak))
+	}
+	tweakL := []byte{tweak[0], tweak[1], tweak[2], tweak[3] & 0xF0}
+	tweakR := []byte{tweak[4], tweak[5], tweak[6], tweak[3] << 4}
+	return newFF3Cipher(key, tweakL, tweakR)
+}
+
+// newFF3Cipher creates the cipher with the 32-bit tweak halves.
+func newFF3Cipher(key, tweakL, tweakR []byte) (*ff3Cipher, error) {
+	reversedKey := slices.Clone(key)
+	slices.Reverse(reversedKey)
+	block, err := aes.NewCipher(reversedKey)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid FF3-1 key")
+	}
+	return &ff3Cipher{block: block, tweakL: tweakL, tweakR: tweakR}, nil
+}
+
+func (c *ff3Cipher) encrypt(numerals []uint16, radix int) ([]uint16, error) {
+	return c.cipher(numerals, radix, true)
+}
+
+func (c *ff3Cipher) decrypt(numerals []uint16, radix int) ([]uint16, error) {
+	return c.cipher(numerals, radix, false)
+}
+
+func (c *ff3Cipher) cipher(numerals []uint16, radix int, encrypt bool) ([]uint16, error) {
+	n := len(numerals)
+	// maxlen = 2 * floor(log_radix(2^96)).
+	maxLen := 0
+	bound := new(big.Int).Lsh(big.NewInt(1), 96)
+	for x := big.NewInt(int64(radix)); x.Cmp(bound) <= 0; x.Mul(x, big.NewInt(int64(radix))) {
+		maxLen += 2
+	}
+	if err := validateFPEInput(numerals, radix, 2, maxLen); err != nil {
+		return nil, err
+	}
+	u, v := (n+1)/2, n-(n+1)/2
+	a, b := slices.Clone(numerals[:u]), slices.Clone(numerals[u:])
+	bigRadix := big.NewInt(int64(radix))
+
+	for j := 0; j < 8; j++ {
+		i := j
+		if !encrypt {
+			i = 7 - j
+		}
+		m, w := u, c.tweakR
+		if i%2 == 1 {
+			m, w = v, c.tweakL
+		}
+		feed := b
+		if !encrypt {
+			feed = a
+		}
+		p := make([]byte, 16)
+		copy(p, w)
+		p[3] ^= byte(i)
+		num(reversed(feed), radix).FillBytes(p[4:])
+
+		slices.Reverse(p)
+		c.block.Encrypt(p, p)
+		slices.Reverse(p)
+		y := new(big.Int).SetBytes(p)
+
+		modulus := new(big.Int).Exp(bigRadix, big.NewInt(int64(m)), nil)
+		if encrypt {
+			cNum := new(big.Int).Add(num(reversed(a), radix), y)
+			cNum.Mod(cNum, modulus)
+			a, b = b, reversed(str(cNum, radix, m))
+		} else {
+			cNum := new(big.Int).Sub(num(reversed(b), radix), y)
+			cNum.Mod(cNum, modulus)
+			a, b = reversed(str(cNum, radix, m)), a
+		}
+	}
+	return append(a, b...), nil
+}
+
+func validateFPEInput(numerals []uint16, radix, minLen, maxLen int) error {
+	if radix < 2 || radix > 1<<16 {
+		return errors.Errorf("invalid radix %d", radix)
+	}
+	n := len(numerals)
+	if n < minLen || n > maxLen {
+		return errors.Errorf("input length %d is out of range [%d, %d]", n, minLen, maxLen)
+	}
+	domain := new(big.Int).Exp(big.NewInt(int64(radix)), big.NewInt(int64(n)), nil)
+	if domain.Cmp(big.NewInt(fpeMinDomainSize)) < 0 {
+		return errors.Errorf("input domain %d^%d is smaller than %d", radix, n, fpeMinDomainSize)
+	}
+	for _, numeral := range numerals {
+		if int(numeral) >= radix {
+			return errors.Errorf("numeral %d is out of radix %d", numeral, radix)
+		}
+	}
+	return nil
+}
+
+// fpeMinLength returns the minimum numeral string length for the radix.
+func fpeMinLength(radix int) int {
+	length := 1
+	for x := radix; x < fpeMinDomainSize; x *= radix {
+		length++
+	}
+	return length
+}
+
+// num returns the number that the numeral string represents in the radix, most significant numeral first.
+func num(numerals []uint16, radix int) *big.Int {
+	x := new(big.Int)
+	bigRadix := big.NewInt(int64(radix))
+	for _, numeral := range numerals {
+		x.Mul(x, bigRadix)
+		x.Add(x, big.NewInt(int64(numeral)))
+	}
+	return x
+}
+
+// str returns the numeral string of length m representing x in the radix.
+func str(x *big.Int, radix, m int) []uint16 {
+	result := make([]uint16, m)
+	x = new(big.Int).Set(x)
+	bigRadix := big.NewInt(int64(radix))
+	remainder := new(big.Int)
+	for i := m - 1; i >= 0; i-- {
+		x.DivMod(x, bigRadix, remainder)
+		result[i] = uint16(remainder.Int64())
+	}
+	return result
+}
+
+func reversed(numerals []uint16) []uint16 {
+	result := slices.Clone(numerals)
+	slices.Reverse(result)
+	return result
+}
+
+func xorBytes(dst, src []byte) {
+	for i := range dst {
+		dst[i] ^= src[i]
+	}
+}
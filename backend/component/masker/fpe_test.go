@@ -0,0 +1,211 @@
+package masker
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+func toNumerals(t *testing.T, s string, alphabet string) []uint16 {
+	var numerals []uint16
+	for _, r := range s {
+		i := strings.IndexRune(alphabet, r)
+		require.GreaterOrEqual(t, i, 0)
+		numerals = append(numerals, uint16(i))
+	}
+	return numerals
+}
+
+func fromNumerals(numerals []uint16, alphabet string) string {
+	var sb strings.Builder
+	for _, numeral := range numerals {
+		sb.WriteByte(alphabet[numeral])
+	}
+	return sb.String()
+}
+
+func mustDecodeHex(t *testing.T, s string) []byte {
+	b, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	return b
+}
+
+func TestFF1(t *testing.T) {
+	// Test vectors from the NIST FF1 samples.
+	testCases := []struct {
+		key        string
+		tweak      string
+		alphabet   string
+		plaintext  string
+		ciphertext string
+	}{
+		{
+			key:        "2B7E151628AED2A6ABF7158809CF4F3C",
+			tweak:      "",
+			alphabet:   "0123456789",
+			plaintext:  "0123456789",
+			ciphertext: "2433477484",
+		},
+		{
+			key:        "2B7E151628AED2A6ABF7158809CF4F3C",
+			tweak:      "39383736353433323130",
+			alphabet:   "0123456789",
+			plaintext:  "0123456789",
+			ciphertext: "6124200773",
+		},
+		{
+			key:        "2B7E151628AED2A6ABF7158809CF4F3C",
+			tweak:      "3737373770717273373737",
+			alphabet:   "0123456789abcdefghijklmnopqrstuvwxyz",
+			plaintext:  "0123456789abcdefghi",
+			ciphertext: "a9tv40mll9kdu509eum",
+		},
+	}
+
+	a := require.New(t)
+	for _, tc := range testCases {
+		c, err := newFF1Cipher(mustDecodeHex(t, tc.key), mustDecodeHex(t, tc.tweak))
+		a.NoError(err)
+		radix := len(tc.alphabet)
+		encrypted, err := c.encrypt(toNumerals(t, tc.plaintext, tc.alphabet), radix)
+		a.NoError(err)
+		a.Equal(tc.ciphertext, fromNumerals(encrypted, tc.alphabet))
+		decrypted, err := c.decrypt(encrypted, radix)
+		a.NoError(err)
+		a.Equal(tc.plaintext, fromNumerals(decrypted, tc.alphabet))
+	}
+}
+
+func TestFF3(t *testing.T) {
+	a := require.New(t)
+	// Test vector from the NIST FF3 samples with the 64-bit tweak.
+	c, err := newFF3Cipher(mustDecodeHex(t, "EF4359D8D580AA4F7F036D6F04FC6A94"), mustDecodeHex(t, "D8E7920A"), mustDecodeHex(t, "FA330A73"))
+	a.NoError(err)
+	encrypted, err := c.encrypt(toNumerals(t, "890121234567890000", digitAlphabet), 10)
+	a.NoError(err)
+	a.Equal("750918814058654607", fromNumerals(encrypted, digitAlphabet))
+	decrypted, err := c.decrypt(encrypted, 10)
+	a.NoError(err)
+	a.Equal("890121234567890000", fromNumerals(decrypted, digitAlphabet))
+
+	c1, err := newFF31Cipher(mustDecodeHex(t, "EF4359D8D580AA4F7F036D6F04FC6A94"), mustDecodeHex(t, "D8E7920AFA330A"))
+	a.NoError(err)
+	for _, plaintext := range []string{"890121234567890000", "123456", "0000000"} {
+		encrypted, err := c1.encrypt(toNumerals(t, plaintext, digitAlphabet), 10)
+		a.NoError(err)
+		a.NotEqual(plaintext, fromNumerals(encrypted, digitAlphabet))
+		decrypted, err := c1.decrypt(encrypted, 10)
+		a.NoError(err)
+		a.Equal(plaintext, fromNumerals(decrypted, digitAlphabet))
+	}
+
+	_, err = c1.encrypt(toNumerals(t, "12345", digitAlphabet), 10)
+	a.Error(err)
+	_, err = newFF31Cipher(mustDecodeHex(t, "EF4359D8D580AA4F7F036D6F04FC6A94"), mustDecodeHex(t, "D8E7920AFA330A73"))
+	a.Error(err)
+}
+
+func TestFormatPreservingEncryptionMasker(t *testing.T) {
+	a := require.New(t)
+	key := mustDecodeHex(t, "2B7E151628AED2A6ABF7158809CF4F3C")
+	tweak := mustDecodeHex(t, "39383736353433")
+
+	isSameClass := func(x, y rune) bool {
+		for _, alphabet := range []string{digitAlphabet, lowerAlphabet, upperAlphabet} {
+			if strings.ContainsRune(alphabet, x) {
+				return strings.ContainsRune(alphabet, y)
+			}
+		}
+		return x == y
+	}
+
+	for _, mode := range []storepb.Algorithm_FormatPreservingEncryptionMask_Mode{
+		storepb.Algorithm_FormatPreservingEncryptionMask_FF1,
+		storepb.Algorithm_FormatPreservingEncryptionMask_FF3_1,
+	} {
+		m, err := NewFormatPreservingEncryptionMasker(mode, key, tweak, storepb.Algorithm_FormatPreservingEncryptionMask_FORMAT_UNSPECIFIED)
+		a.NoError(err)
+
+		// Preserve the length and character classes.
+		for _, input := range []string{"138-0013-8000", "hello world 2024-01-01", "ABCDEFGH"} {
+			got := m.Mask(&MaskData{Data: &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: input}}}).GetStringValue()
+			a.NotEqual(input, got)
+			a.Equal(len(input), len(got))
+			for i, r := range input {
+				a.True(isSameClass(r, rune(got[i])), "input %q, got %q", input, got)
+			}
+			// Deterministic.
+			again := m.Mask(&MaskData{Data: &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: input}}}).GetStringValue()
+			a.Equal(got, again)
+		}
+
+		// A class too short to be encrypted alone falls back to the alphanumeric characters.
+		mixed := m.Mask(&MaskData{Data: &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: "Hello World"}}}).GetStringValue()
+		a.Len(mixed, 11)
+		a.Equal(" ", mixed[5:6])
+
+		// Too short to be encrypted.
+		got := m.Mask(&MaskData{Data: &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: "ab1"}}})
+		a.Equal("******", got.GetStringValue())
+		got = m.Mask(&MaskData{Data: &v1pb.RowValue{Kind: &v1pb.RowValue_BoolValue{BoolValue: true}}})
+		a.Equal("******", got.GetStringValue())
+
+		// Numbers.
+		got = m.Mask(&MaskData{Data: &v1pb.RowValue{Kind: &v1pb.RowValue_Int64Value{Int64Value: -12345678}}})
+		a.Len(got.GetStringValue(), 9)
+		a.True(strings.HasPrefix(got.GetStringValue(), "-"))
+
+		// Email.
+		emailMasker, err := NewFormatPreservingEncryptionMasker(mode, key, tweak, storepb.Algorithm_FormatPreservingEncryptionMask_EMAIL)
+		a.NoError(err)
+		email := emailMasker.Mask(&MaskData{Data: &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: "john.doe@example.com"}}}).GetStringValue()
+		a.True(strings.HasSuffix(email, "@example.com"))
+		a.NotEqual("john.doe@example.com", email)
+		a.Equal(".", email[4:5])
+
+		// Credit card.
+		cardMasker, err := NewFormatPreservingEncryptionMasker(mode, key, tweak, storepb.Algorithm_FormatPreservingEncryptionMask_CREDIT_CARD)
+		a.NoError(err)
+		card := cardMasker.Mask(&MaskData{Data: &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: "4111-1111-1111-1111"}}}).GetStringValue()
+		a.Len(card, 19)
+		a.NotEqual("4111-1111-1111-1111", card)
+		a.True(isValidLuhn(strings.ReplaceAll(card, "-", "")), card)
+
+		a.True(m.Equal(m))
+		a.False(m.Equal(emailMasker))
+	}
+}
+
+func isValidLuhn(digits string) bool {
+	return luhnCheckDigit([]rune(digits[:len(digits)-1])) == rune(digits[len(digits)-1])
+}
+
+func TestLuhnCheckDigit(t *testing.T) {
+	a := require.New(t)
+	a.Equal('1', luhnCheckDigit([]rune("411111111111111")))
+	a.Equal('3', luhnCheckDigit([]rune("7992739871")))
+}
+
+func TestTokenizationMasker(t *testing.T) {
+	a := require.New(t)
+	m := NewTokenizationMasker([]byte("key"), "tok_", 0)
+	got := m.Mask(&MaskData{Data: &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: "The quick brown fox jumps over the lazy dog"}}})
+	// HMAC-SHA256("key", "The quick brown fox jumps over the lazy dog").
+	a.Equal("tok_f7bc83f430538424b13298e6aa6fb143", got.GetStringValue())
+
+	got = m.Mask(&MaskData{Data: &v1pb.RowValue{Kind: &v1pb.RowValue_Int32Value{Int32Value: 42}}})
+	again := m.Mask(&MaskData{Data: &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: "42"}}})
+	a.Equal(got.GetStringValue(), again.GetStringValue())
+
+	long := NewTokenizationMasker([]byte("key"), "", 100)
+	got = long.Mask(&MaskData{Data: &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: "The quick brown fox jumps over the lazy dog"}}})
+	a.Equal("f7bc83f430538424b13298e6aa6fb143ef4d59a14946175997479dbc2d1a3cd8", got.GetStringValue())
+
+	a.False(m.Equal(long))
+	a.True(m.Equal(NewTokenizationMasker([]byte("key"), "tok_", 32)))
+}
@@ -0,0 +1,420 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/plugin/webhook"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	deliveryRunnerInterval = 10 * time.Second
+	// deliveryBatchSize is the maximum number of deliveries posted concurrently.
+	deliveryBatchSize = 20
+	// deliveryLease is how long a claimed delivery is not claimed again.
+	// The lease is extended every deliveryLeaseRenewInterval while the attempt is being posted.
+	deliveryLease              = 1 * time.Minute
+	deliveryLeaseRenewInterval = deliveryLease / 3
+
+	// maxDeliveryAttempts is the number of attempts before a delivery is dead.
+	// With the backoff below, the last attempt happens about 1.4 hours after the first one.
+	maxDeliveryAttempts = 10
+	deliveryBackoffBase = 10 * time.Second
+	deliveryBackoffMax  = 1 * time.Hour
+
+	// maxDeliveryAttemptHistory is the number of the latest attempts kept in a delivery.
+	maxDeliveryAttemptHistory = 20
+	// maxDeliveryBodySize is the number of characters of the request and response body kept in an attempt.
+	maxDeliveryBodySize = 4096
+
+	// deliveryRetention is how long the succeeded and dead deliveries are kept.
+	deliveryRetention       = 30 * 24 * time.Hour
+	deliveryCleanupInterval = 1 * time.Hour
+)
+
+// ErrWebhookDeliveryInProgress is returned when redelivering a delivery which is being posted.
+var ErrWebhookDeliveryInProgress = errors.New("webhook delivery is being posted")
+
+// Run runs the runner posting the webhook deliveries.
+func (m *Manager) Run(ctx context.Context, wg *sync.WaitGroup) {
+	ticker := time.NewTicker(deliveryRunnerInterval)
+	defer ticker.Stop()
+	cleanupTicker := time.NewTicker(deliveryCleanupInterval)
+	defer cleanupTicker.Stop()
+	defer wg.Done()
+	slog.Debug(fmt.Sprintf("Webhook delivery runner started and will run every %v", deliveryRunnerInterval))
+	for {
+		select {
+		case <-ticker.C:
+		case <-m.deliveryTickets:
+		case <-cleanupTicker.C:
+			m.cleanupDeliveries(ctx)
+			continue
+		case <-ctx.Done():
+			return
+		}
+		func() {
+			defer func() {
+				if r := recover(); r != nil {
+					err, ok := r.(error)
+					if !ok {
+						err = errors.Errorf("%v", r)
+					}
+					slog.Error("Webhook delivery runner PANIC RECOVER", log.BBError(err), log.BBStack("panic-stack"))
+				}
+			}()
+			m.deliverPending(ctx)
+		}()
+	}
+}
+
+// RedeliverWebhookDelivery queues the delivery to be posted again with a fresh attempt budget.
+// It returns ErrWebhookDeliveryInProgress if the delivery is being posted.
+func (m *Manager) RedeliverWebhookDelivery(ctx context.Context, deliveryUID int64) (*store.WebhookDeliveryMessage, error) {
+	delivery, ok, err := m.store.RedeliverWebhookDelivery(ctx, deliveryUID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrWebhookDeliveryInProgress
+	}
+	m.notifyDelivery()
+	return delivery, nil
+}
+
+// cleanupDeliveries deletes the finished deliveries older than the retention.
+func (m *Manager) cleanupDeliveries(ctx context.Context) {
+	count, err := m.store.DeleteFinishedWebhookDeliveries(ctx, time.Now().Add(-deliveryRetention))
+	if err != nil {
+		slog.Error("failed to delete finished webhook deliveries", log.BBError(err))
+		return
+	}
+	if count > 0 {
+		slog.Debug("deleted finished webhook deliveries", slog.Int64("count", count))
+	}
+}
+
+// enqueueWebhookList persists a delivery of the webhook context for each webhook.
+func (m *Manager) enqueueWebhookList(ctx context.Context, webhookCtx *webhook.Context, webhookList []*store.ProjectWebhookMessage, dedupKey string) {
+	now := time.Now()
+	webhookCtx.CreatedTs = now.Unix()
+	event := convertToWebhookEvent(webhookCtx)
+
+	var creates []*store.WebhookDeliveryMessage
+	for _, hook := range webhookList {
+		creates = append(creates, &store.WebhookDeliveryMessage{
+			ProjectWebhookUID: hook.ID,
+			Status:            store.WebhookDeliveryPending,
+			NextAttemptTime:   now,
+			DedupKey:          dedupKey,
+			Payload: &storepb.WebhookDeliveryPayload{
+				Event: event,
+			},
+		})
+	}
+	if err := m.store.CreateWebhookDeliveries(ctx, creates); err != nil {
+		slog.Error("failed to create webhook deliveries",
+			slog.String("activity type", webhookCtx.ActivityType),
+			slog.String("title", webhookCtx.Title),
+			log.BBError(err))
+		return
+	}
+	m.notifyDelivery()
+}
+
+func (m *Manager) notifyDelivery() {
+	select {
+	case m.deliveryTickets <- struct{}{}:
+	default:
+	}
+}
+
+func (m *Manager) deliverPending(ctx context.Context) {
+	for {
+		deliveries, err := m.store.ClaimWebhookDeliveries(ctx, deliveryBatchSize, time.Now().Add(deliveryLease))
+		if err != nil {
+			slog.Error("failed to claim webhook deliveries", log.BBError(err))
+			return
+		}
+		if len(deliveries) == 0 {
+			return
+		}
+
+		imSetting, err := m.store.GetAppIMSetting(ctx)
+		if err != nil {
+			slog.Error("failed to get app im setting", log.BBError(err))
+		}
+		setting, err := m.store.GetWorkspaceGeneralSetting(ctx)
+		if err != nil {
+			slog.Error("failed to get workspace setting", log.BBError(err))
+		}
+		var wg sync.WaitGroup
+		for _, delivery := range deliveries {
+			wg.Add(1)
+			go func(delivery *store.WebhookDeliveryMessage) {
+				defer wg.Done()
+				if err := m.deliver(ctx, delivery, imSetting, setting.GetExternalUrl()); err != nil {
+					slog.Error("failed to deliver webhook", slog.Int64("delivery", delivery.UID), log.BBError(err))
+				}
+			}(delivery)
+		}
+		wg.Wait()
+
+		if len(deliveries) < deliveryBatchSize {
+			return
+		}
+	}
+}
+
+// deliver makes an attempt to post the delivery, and schedules the next attempt if it fails.
+func (m *Manager) deliver(ctx context.Context, delivery *store.WebhookDeliveryMessage, imSetting *storepb.AppIMSetting, externalURL string) error {
+	hook, err := m.store.GetProjectWebhookV2(ctx, &store.FindProjectWebhookMessage{ID: &delivery.ProjectWebhookUID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get project webhook")
+	}
+	if hook == nil {
+		// The delivery is removed with the webhook.
+		return nil
+	}
+
+	webhookCtx := convertToWebhookContext(delivery.Payload.GetEvent())
+	webhookCtx.URL = hook.URL
+	webhookCtx.DirectMessage = hook.Payload.GetDirectMessage()
+	webhookCtx.IMSetting = imSetting
+	webhookCtx.ExternalURL = externalURL
+	webhookCtx.GenericConfig = hook.Payload.GetGenericConfig()
+	webhookCtx.SigningSecret = hook.Payload.GetSigningSecret()
+	webhookCtx.DeliveryID = strconv.FormatInt(delivery.UID, 10)
+	webhookCtx.Exchange = &webhook.Exchange{}
+
+	start := time.Now()
+	stopRenew := m.renewDeliveryLease(ctx, delivery.UID)
+	postErr := webhook.Post(hook.Type, *webhookCtx)
+	stopRenew()
+	latency := webhookCtx.Exchange.Latency
+	if latency == 0 {
+		latency = time.Since(start)
+	}
+	requestBody, _ := common.TruncateString(string(webhookCtx.Exchange.RequestBody), maxDeliveryBodySize)
+	responseBody, _ := common.TruncateString(string(webhookCtx.Exchange.ResponseBody), maxDeliveryBodySize)
+	attempt := &storepb.WebhookDeliveryAttempt{
+		CreateTime:   timestamppb.New(start),
+		RequestBody:  requestBody,
+		ResponseCode: int32(webhookCtx.Exchange.StatusCode),
+		ResponseBody: responseBody,
+		Latency:      durationpb.New(latency),
+	}
+	if postErr != nil {
+		attempt.Error = postErr.Error()
+	}
+
+	payload := delivery.Payload
+	payload.Attempts = append(payload.Attempts, attempt)
+	if len(payload.Attempts) > maxDeliveryAttemptHistory {
+		payload.Attempts = payload.Attempts[len(payload.Attempts)-maxDeliveryAttemptHistory:]
+	}
+	attemptCount := delivery.AttemptCount + 1
+	update := &store.UpdateWebhookDeliveryMessage{
+		UID:          delivery.UID,
+		AttemptCount: &attemptCount,
+		Payload:      payload,
+		ReleaseLease: true,
+	}
+	status := store.WebhookDeliveryPending
+	switch {
+	case postErr == nil:
+		status = store.WebhookDeliverySucceeded
+	case attemptCount >= maxDeliveryAttempts:
+		status = store.WebhookDeliveryDead
+		// The external webhook endpoint might be invalid which is out of our code control, so we just emit a warning
+		slog.Warn("Failed to post webhook event on activity after all attempts",
+			slog.String("webhook type", hook.Type),
+			slog.String("webhook name", hook.Title),
+			slog.String("activity type", webhookCtx.ActivityType),
+			slog.String("title", webhookCtx.Title),
+			log.BBError(postErr))
+	default:
+		nextAttemptTime := time.Now().Add(getDeliveryBackoff(attemptCount))
+		update.NextAttemptTime = &nextAttemptTime
+	}
+	update.Status = &status
+
+	if _, err := m.store.UpdateWebhookDelivery(ctx, update); err != nil {
+		return errors.Wrapf(err, "failed to update webhook delivery")
+	}
+	return nil
+}
+
+// renewDeliveryLease extends the lease of the delivery periodically until the returned function is called,
+// so that a slow attempt is not claimed and posted again.
+func (m *Manager) renewDeliveryLease(ctx context.Context, deliveryUID int64) func() {
+	renewCtx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(deliveryLeaseRenewInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if err := m.store.ExtendWebhookDeliveryLease(renewCtx, deliveryUID, time.Now().Add(deliveryLease)); err != nil {
+					slog.Warn("failed to extend webhook delivery lease", slog.Int64("delivery", deliveryUID), log.BBError(err))
+				}
+			case <-renewCtx.Done():
+				return
+			}
+		}
+	}()
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
+// getDeliveryBackoff returns the delay before the next attempt after attemptCount failed attempts.
+func getDeliveryBackoff(attemptCount int) time.Duration {
+	backoff := deliveryBackoffBase
+	for i := 1; i < attemptCount; i++ {
+		backoff *= 2
+		if backoff >= deliveryBackoffMax {
+			return deliveryBackoffMax
+		}
+	}
+	return backoff
+}
+
+func convertToWebhookEvent(webhookCtx *webhook.Context) *storepb.WebhookEvent {
+	event := &storepb.WebhookEvent{
+		Level:               string(webhookCtx.Level),
+		ActivityType:        webhookCtx.ActivityType,
+		Title:               webhookCtx.Title,
+		TitleZh:             webhookCtx.TitleZh,
+		Description:         webhookCtx.Description,
+		Link:                webhookCtx.Link,
+		ActorId:             int32(webhookCtx.ActorID),
+		ActorName:           webhookCtx.ActorName,
+		ActorEmail:          webhookCtx.ActorEmail,
+		CreateTime:          timestamppb.New(time.Unix(webhookCtx.CreatedTs, 0)),
+		MentionUsersByPhone: webhookCtx.MentionUsersByPhone,
+	}
+	if v := webhookCtx.Issue; v != nil {
+		event.Issue = &storepb.WebhookEvent_Issue{
+			Id:          int32(v.ID),
+			Name:        v.Name,
+			Status:      v.Status,
+			Type:        v.Type,
+			Description: v.Description,
+		}
+		if v.Creator != nil {
+			event.Issue.Creator = &storepb.WebhookEvent_User{Name: v.Creator.Name, Email: v.Creator.Email}
+		}
+	}
+	if v := webhookCtx.Stage; v != nil {
+		event.Stage = &storepb.WebhookEvent_Stage{Name: v.Name}
+	}
+	if v := webhookCtx.Project; v != nil {
+		event.Project = &storepb.WebhookEvent_Project{Name: v.Name, Title: v.Title}
+	}
+	if v := webhookCtx.TaskResult; v != nil {
+		event.TaskResult = &storepb.WebhookEvent_TaskResult{
+			Name:          v.Name,
+			Status:        v.Status,
+			Detail:        v.Detail,
+			SkippedReason: v.SkippedReason,
+		}
+	}
+	if v := webhookCtx.Database; v != nil {
+		event.Database = &storepb.WebhookEvent_Database{Name: v.Name}
+	}
+	if v := webhookCtx.Approval; v != nil {
+		event.Approval = &storepb.WebhookEvent_Approval{
+			Status:    v.Status,
+			RiskLevel: v.RiskLevel,
+		}
+		for _, approver := range v.Approvers {
+			event.Approval.Approvers = append(event.Approval.Approvers, &storepb.WebhookEvent_Approver{
+				Name:   approver.Name,
+				Email:  approver.Email,
+				Status: approver.Status,
+			})
+		}
+	}
+	for _, u := range webhookCtx.MentionEndUsers {
+		event.MentionEndUsers = append(event.MentionEndUsers, &storepb.WebhookEvent_User{Name: u.Name, Email: u.Email})
+	}
+	return event
+}
+
+func convertToWebhookContext(event *storepb.WebhookEvent) *webhook.Context {
+	webhookCtx := &webhook.Context{
+		Level:               webhook.Level(event.GetLevel()),
+		ActivityType:        event.GetActivityType(),
+		Title:               event.GetTitle(),
+		TitleZh:             event.GetTitleZh(),
+		Description:         event.GetDescription(),
+		Link:                event.GetLink(),
+		ActorID:             int(event.GetActorId()),
+		ActorName:           event.GetActorName(),
+		ActorEmail:          event.GetActorEmail(),
+		CreatedTs:           event.GetCreateTime().GetSeconds(),
+		MentionUsersByPhone: event.GetMentionUsersByPhone(),
+	}
+	if v := event.GetIssue(); v != nil {
+		webhookCtx.Issue = &webhook.Issue{
+			ID:          int(v.Id),
+			Name:        v.Name,
+			Status:      v.Status,
+			Type:        v.Type,
+			Description: v.Description,
+		}
+		if u := v.Creator; u != nil {
+			webhookCtx.Issue.Creator = &store.UserMessage{Name: u.Name, Email: u.Email}
+		}
+	}
+	if v := event.GetStage(); v != nil {
+		webhookCtx.Stage = &webhook.Stage{Name: v.Name}
+	}
+	if v := event.GetProject(); v != nil {
+		webhookCtx.Project = &webhook.Project{Name: v.Name, Title: v.Title}
+	}
+	if v := event.GetTaskResult(); v != nil {
+		webhookCtx.TaskResult = &webhook.TaskResult{
+			Name:          v.Name,
+			Status:        v.Status,
+			Detail:        v.Detail,
+			SkippedReason: v.SkippedReason,
+		}
+	}
+	if v := event.GetDatabase(); v != nil {
+		webhookCtx.Database = &webhook.Database{Name: v.Name}
+	}
+	if v := event.GetApproval(); v != nil {
+		webhookCtx.Approval = &webhook.Approval{
+			Status:    v.Status,
+			RiskLevel: v.RiskLevel,
+		}
+		for _, approver := range v.Approvers {
+			webhookCtx.Approval.Approvers = append(webhookCtx.Approval.Approvers, &webhook.Approver{
+				Name:   approver.Name,
+				Email:  approver.Email,
+				Status: approver.Status,
+			})
+		}
+	}
+	for _, u := range event.GetMentionEndUsers() {
+		webhookCtx.MentionEndUsers = append(webhookCtx.MentionEndUsers, &store.UserMessage{Name: u.Name, Email: u.Email})
+	}
+	return webhookCtx
+}
@@ -0,0 +1,59 @@
+package webhook
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/webhook"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+func TestGetDeliveryBackoff(t *testing.T) {
+	a := require.New(t)
+	a.Equal(10*time.Second, getDeliveryBackoff(1))
+	a.Equal(20*time.Second, getDeliveryBackoff(2))
+	a.Equal(2560*time.Second, getDeliveryBackoff(9))
+	a.Equal(time.Hour, getDeliveryBackoff(10))
+	a.Equal(time.Hour, getDeliveryBackoff(100))
+}
+
+func TestConvertWebhookEvent(t *testing.T) {
+	a := require.New(t)
+	webhookCtx := &webhook.Context{
+		Level:        webhook.WebhookSuccess,
+		ActivityType: "bb.issue.status.update",
+		Title:        "Issue resolved",
+		TitleZh:      "工单完成",
+		Description:  "comment",
+		Link:         "https://bytebase.example.com/projects/p1/issues/i-101",
+		ActorID:      101,
+		ActorName:    "Alice",
+		ActorEmail:   "alice@example.com",
+		CreatedTs:    1700000000,
+		Issue: &webhook.Issue{
+			ID:          101,
+			Name:        "i",
+			Status:      "DONE",
+			Type:        "bb.issue.database.general",
+			Description: "description",
+			Creator:     &store.UserMessage{Name: "Bob", Email: "bob@example.com"},
+		},
+		Stage:   &webhook.Stage{Name: "prod"},
+		Project: &webhook.Project{Name: "projects/p1", Title: "P1"},
+		TaskResult: &webhook.TaskResult{
+			Name:   "task",
+			Status: "DONE",
+		},
+		Database: &webhook.Database{Name: "instances/prod/databases/db"},
+		Approval: &webhook.Approval{
+			Status:    "APPROVED",
+			RiskLevel: "HIGH",
+			Approvers: []*webhook.Approver{{Name: "Dave", Email: "dave@example.com", Status: "APPROVED"}},
+		},
+		MentionEndUsers:     []*store.UserMessage{{Name: "Carol", Email: "carol@example.com"}},
+		MentionUsersByPhone: []string{"4155552671"},
+	}
+	a.Equal(webhookCtx, convertToWebhookContext(convertToWebhookEvent(webhookCtx)))
+}
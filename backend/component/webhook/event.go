@@ -1,6 +1,8 @@
 package webhook
 
 import (
+	"time"
+
 	"github.com/bytebase/bytebase/backend/store"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 )
@@ -18,6 +20,12 @@ const (
 
 	EventTypeStageStatusUpdate   = "bb.webhook.event.stage.status.update"
 	EventTypeTaskRunStatusUpdate = "bb.webhook.event.taskRun.status.update"
+
+	EventTypeProjectMemberExpire = "bb.webhook.event.project.member.expire"
+
+	EventTypeDatabaseSchemaDrift       = "bb.webhook.event.database.schema.drift"
+	EventTypeDatabaseConnectionAnomaly = "bb.webhook.event.database.anomaly.connection"
+	EventTypeDatabaseSlowQuery         = "bb.webhook.event.database.slowQuery"
 )
 
 type Event struct {
@@ -26,12 +34,20 @@ type Event struct {
 	Comment string
 	Issue   *Issue
 	Project *Project
+	// Database is the database of the database events.
+	Database *Database
+	// DedupKey de-duplicates the events of the same type in the project.
+	// The events with the same non-empty key are sent at most once in eventDedupWindow.
+	DedupKey string
 
 	IssueUpdate         *EventIssueUpdate
 	IssueApprovalCreate *EventIssueApprovalCreate
 	IssueRolloutReady   *EventIssueRolloutReady
 	StageStatusUpdate   *EventStageStatusUpdate
 	TaskRunStatusUpdate *EventTaskRunStatusUpdate
+	ProjectMemberExpire *EventProjectMemberExpire
+	DatabaseAnomaly     *EventDatabaseAnomaly
+	DatabaseSlowQuery   *EventDatabaseSlowQuery
 }
 
 func NewIssue(i *store.IssueMessage) *Issue {
@@ -54,6 +70,13 @@ func NewProject(p *store.ProjectMessage) *Project {
 	}
 }
 
+func NewDatabase(d *store.DatabaseMessage) *Database {
+	return &Database{
+		InstanceID:   d.InstanceID,
+		DatabaseName: d.DatabaseName,
+	}
+}
+
 type Issue struct {
 	UID         int
 	Status      string
@@ -70,6 +93,11 @@ type Project struct {
 	Title      string
 }
 
+type Database struct {
+	InstanceID   string
+	DatabaseName string
+}
+
 type EventIssueUpdate struct {
 	Path string
 }
@@ -94,3 +122,24 @@ type EventTaskRunStatusUpdate struct {
 	Detail        string
 	SkippedReason string
 }
+
+type EventProjectMemberExpire struct {
+	Role      string
+	Members   []string
+	Condition string
+}
+
+type EventDatabaseAnomaly struct {
+	// Open is true if the anomaly is opened, and false if it is closed.
+	Open   bool
+	Detail string
+}
+
+type EventDatabaseSlowQuery struct {
+	Fingerprint string
+	// Rank is the 1-based rank of the fingerprint by the total query time.
+	Rank             int
+	Count            int64
+	AverageQueryTime time.Duration
+	MaximumQueryTime time.Duration
+}
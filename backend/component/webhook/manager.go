@@ -27,8 +27,15 @@ import (
 type Manager struct {
 	store      *store.Store
 	iamManager *iam.Manager
+
+	// deliveryTickets wakes up the delivery runner when there are new deliveries.
+	deliveryTickets chan struct{}
 }
 
+// eventDedupWindow is the window in which the events with the same dedup key are only sent once,
+// so that a flapping instance does not spam the channels.
+const eventDedupWindow = 1 * time.Hour
+
 // Metadata is the activity metadata.
 type Metadata struct {
 	Issue *store.IssueMessage
@@ -37,8 +44,9 @@ type Metadata struct {
 // NewManager creates an activity manager.
 func NewManager(store *store.Store, iamManager *iam.Manager) *Manager {
 	return &Manager{
-		store:      store,
-		iamManager: iamManager,
+		store:           store,
+		iamManager:      iamManager,
+		deliveryTickets: make(chan struct{}, 1),
 	}
 }
 
@@ -64,15 +72,27 @@ func (m *Manager) CreateEvent(ctx context.Context, e *Event) {
 		activityType = api.ActivityPipelineStageStatusUpdate
 	case EventTypeTaskRunStatusUpdate:
 		activityType = api.ActivityPipelineTaskRunStatusUpdate
+	case EventTypeProjectMemberExpire:
+		activityType = api.ActivityProjectMemberDelete
+	case EventTypeDatabaseSchemaDrift:
+		activityType = api.ActivityDatabaseSchemaDrift
+	case EventTypeDatabaseConnectionAnomaly:
+		activityType = api.ActivityDatabaseConnectionAnomaly
+	case EventTypeDatabaseSlowQuery:
+		activityType = api.ActivityDatabaseSlowQuery
 	default:
 		return
 	}
+	dedupKey := getEventDedupKey(e)
+	if dedupKey != "" && m.isDuplicateEvent(ctx, dedupKey) {
+		return
+	}
 	webhookList, err := m.store.FindProjectWebhookV2(ctx, &store.FindProjectWebhookMessage{
 		ProjectID:    &e.Project.UID,
 		ActivityType: &activityType,
 	})
 	if err != nil {
-		slog.Warn("failed to find project webhook", "issue_name", e.Issue.Title, log.BBError(err))
+		slog.Warn("failed to find project webhook", "project", e.Project.ResourceID, log.BBError(err))
 		return
 	}
 
@@ -83,12 +103,12 @@ func (m *Manager) CreateEvent(ctx context.Context, e *Event) {
 	webhookCtx, err := m.getWebhookContextFromEvent(ctx, e, activityType)
 	if err != nil {
 		slog.Warn("failed to get webhook context",
-			slog.String("issue_name", e.Issue.Title),
+			slog.String("project", e.Project.ResourceID),
 			log.BBError(err))
 		return
 	}
-	// Call external webhook endpoint in Go routine to avoid blocking web serving thread.
-	go m.postWebhookList(ctx, webhookCtx, webhookList)
+	// The deliveries are posted by the delivery runner to avoid blocking web serving thread.
+	m.enqueueWebhookList(ctx, webhookCtx, webhookList, dedupKey)
 }
 
 func (m *Manager) getWebhookContextFromEvent(ctx context.Context, e *Event, activityType api.ActivityType) (*webhook.Context, error) {
@@ -104,7 +124,13 @@ func (m *Manager) getWebhookContextFromEvent(ctx context.Context, e *Event, acti
 	level := webhook.WebhookInfo
 	title := ""
 	titleZh := ""
-	link := fmt.Sprintf("%s/projects/%s/issues/%s-%d", setting.ExternalUrl, e.Project.ResourceID, slug.Make(e.Issue.Title), e.Issue.UID)
+	link := fmt.Sprintf("%s/projects/%s/members", setting.ExternalUrl, e.Project.ResourceID)
+	if e.Issue != nil {
+		link = fmt.Sprintf("%s/projects/%s/issues/%s-%d", setting.ExternalUrl, e.Project.ResourceID, slug.Make(e.Issue.Title), e.Issue.UID)
+	}
+	if e.Database != nil {
+		link = fmt.Sprintf("%s/projects/%s/instances/%s/databases/%s", setting.ExternalUrl, e.Project.ResourceID, e.Database.InstanceID, e.Database.DatabaseName)
+	}
 	switch e.Type {
 	case EventTypeIssueCreate:
 		title = "Issue created"
@@ -148,6 +174,33 @@ func (m *Manager) getWebhookContextFromEvent(ctx context.Context, e *Event, acti
 		title = "Stage ends"
 		titleZh = "阶段结束"
 
+	case EventTypeProjectMemberExpire:
+		u := e.ProjectMemberExpire
+		level = webhook.WebhookWarn
+		title = fmt.Sprintf("Member access expired for %s", u.Role)
+		titleZh = fmt.Sprintf("成员 %s 权限已过期", u.Role)
+
+	case EventTypeDatabaseSchemaDrift:
+		level = webhook.WebhookWarn
+		title = "Schema drift detected"
+		titleZh = "检测到数据库结构漂移"
+
+	case EventTypeDatabaseConnectionAnomaly:
+		if e.DatabaseAnomaly.Open {
+			level = webhook.WebhookError
+			title = "Database connection failed"
+			titleZh = "数据库连接失败"
+		} else {
+			level = webhook.WebhookSuccess
+			title = "Database connection recovered"
+			titleZh = "数据库连接恢复"
+		}
+
+	case EventTypeDatabaseSlowQuery:
+		level = webhook.WebhookWarn
+		title = "New slow query"
+		titleZh = "新增慢查询"
+
 	case EventTypeTaskRunStatusUpdate:
 		u := e.TaskRunStatusUpdate
 		switch u.Status {
@@ -320,14 +373,6 @@ func (m *Manager) getWebhookContextFromEvent(ctx context.Context, e *Event, acti
 		ActivityType: string(activityType),
 		Title:        title,
 		TitleZh:      titleZh,
-		Issue: &webhook.Issue{
-			ID:          e.Issue.UID,
-			Name:        e.Issue.Title,
-			Status:      e.Issue.Status,
-			Type:        e.Issue.Type,
-			Description: e.Issue.Description,
-			Creator:     e.Issue.Creator,
-		},
 		Project: &webhook.Project{
 			Name:  common.FormatProject(e.Project.ResourceID),
 			Title: e.Project.Title,
@@ -342,6 +387,24 @@ func (m *Manager) getWebhookContextFromEvent(ctx context.Context, e *Event, acti
 		MentionEndUsers:     mentionEndUsers,
 		MentionUsersByPhone: mentions,
 	}
+	if e.Issue != nil {
+		webhookCtx.Issue = &webhook.Issue{
+			ID:          e.Issue.UID,
+			Name:        e.Issue.Title,
+			Status:      e.Issue.Status,
+			Type:        e.Issue.Type,
+			Description: e.Issue.Description,
+			Creator:     e.Issue.Creator,
+		}
+		approval, err := m.getWebhookApproval(ctx, e.Issue.Approval)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get issue approval")
+		}
+		webhookCtx.Approval = approval
+	}
+	if u := e.ProjectMemberExpire; u != nil {
+		webhookCtx.Description = fmt.Sprintf("The access of %s to %s has expired and is removed.", strings.Join(u.Members, ", "), u.Role)
+	}
 	if u := e.TaskRunStatusUpdate; u != nil {
 		webhookCtx.TaskResult = &webhook.TaskResult{
 			Name:          u.Title,
@@ -355,39 +418,80 @@ func (m *Manager) getWebhookContextFromEvent(ctx context.Context, e *Event, acti
 			Name: u.StageTitle,
 		}
 	}
+	if e.Database != nil {
+		webhookCtx.Database = &webhook.Database{
+			Name: common.FormatDatabase(e.Database.InstanceID, e.Database.DatabaseName),
+		}
+	}
+	if u := e.DatabaseAnomaly; u != nil {
+		webhookCtx.Description = u.Detail
+	}
+	if u := e.DatabaseSlowQuery; u != nil {
+		webhookCtx.Description = fmt.Sprintf("The query ranks #%d by the total query time with %d executions, average %v and maximum %v.\n%s", u.Rank, u.Count, u.AverageQueryTime, u.MaximumQueryTime, u.Fingerprint)
+	}
 
 	return &webhookCtx, nil
 }
 
-func (m *Manager) postWebhookList(ctx context.Context, webhookCtx *webhook.Context, webhookList []*store.ProjectWebhookMessage) {
-	ctx = context.WithoutCancel(ctx)
-	setting, err := m.store.GetAppIMSetting(ctx)
+// getEventDedupKey returns the key de-duplicating the event in the project, or empty if the event is not de-duplicated.
+func getEventDedupKey(e *Event) string {
+	if e.DedupKey == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s/%s/%s", e.Type, e.Project.ResourceID, e.DedupKey)
+}
+
+// isDuplicateEvent returns true if a delivery of the event with the same dedup key is created in eventDedupWindow.
+// The deliveries are persisted, so that the events are de-duplicated across the restarts and the replicas.
+func (m *Manager) isDuplicateEvent(ctx context.Context, dedupKey string) bool {
+	createdAfter := time.Now().Add(-eventDedupWindow)
+	limit := 1
+	deliveries, err := m.store.ListWebhookDeliveries(ctx, &store.FindWebhookDeliveryMessage{
+		DedupKey:     &dedupKey,
+		CreatedAfter: &createdAfter,
+		Limit:        &limit,
+	})
 	if err != nil {
-		slog.Error("failed to get app im setting", log.BBError(err))
-	} else {
-		webhookCtx.IMSetting = setting
-	}
-
-	for _, hook := range webhookList {
-		webhookCtx := *webhookCtx
-		webhookCtx.URL = hook.URL
-		webhookCtx.CreatedTs = time.Now().Unix()
-		webhookCtx.DirectMessage = hook.Payload.GetDirectMessage()
-		go func(webhookCtx *webhook.Context, hook *store.ProjectWebhookMessage) {
-			if err := common.Retry(ctx, func() error {
-				return webhook.Post(hook.Type, *webhookCtx)
-			}); err != nil {
-				// The external webhook endpoint might be invalid which is out of our code control, so we just emit a warning
-				slog.Warn("Failed to post webhook event on activity",
-					slog.String("webhook type", hook.Type),
-					slog.String("webhook name", hook.Title),
-					slog.String("activity type", webhookCtx.ActivityType),
-					slog.String("title", webhookCtx.Title),
-					log.BBError(err))
-				return
-			}
-		}(&webhookCtx, hook)
+		slog.Warn("failed to list webhook deliveries", slog.String("dedup key", dedupKey), log.BBError(err))
+		return false
+	}
+	return len(deliveries) > 0
+}
+
+// getWebhookApproval returns the approval of the issue, or nil if the approval is not found yet.
+func (m *Manager) getWebhookApproval(ctx context.Context, approval *storepb.IssuePayloadApproval) (*webhook.Approval, error) {
+	if approval == nil || !approval.ApprovalFindingDone {
+		return nil, nil
+	}
+	approved, err := utils.CheckApprovalApproved(approval)
+	if err != nil {
+		return nil, err
+	}
+	webhookApproval := &webhook.Approval{
+		Status:    "PENDING",
+		RiskLevel: approval.RiskLevel.String(),
+	}
+	if approved {
+		webhookApproval.Status = "APPROVED"
+	}
+	for _, approver := range approval.Approvers {
+		if approver.Status == storepb.IssuePayloadApproval_Approver_REJECTED {
+			webhookApproval.Status = "REJECTED"
+		}
+		user, err := m.store.GetUserByID(ctx, int(approver.PrincipalId))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get approver")
+		}
+		if user == nil {
+			continue
+		}
+		webhookApproval.Approvers = append(webhookApproval.Approvers, &webhook.Approver{
+			Name:   user.Name,
+			Email:  user.Email,
+			Status: approver.Status.String(),
+		})
 	}
+	return webhookApproval, nil
 }
 
 func (m *Manager) getUsersFromWorkspaceRole(role api.Role) func(context.Context) ([]*store.UserMessage, error) {
@@ -0,0 +1,21 @@
+package webhook
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+func TestGetEventDedupKey(t *testing.T) {
+	a := require.New(t)
+	project := NewProject(&store.ProjectMessage{ResourceID: "prod"})
+
+	a.Equal("", getEventDedupKey(&Event{Type: EventTypeDatabaseSchemaDrift, Project: project}))
+	a.Equal("bb.webhook.event.database.schema.drift/prod/instances/prod/databases/db/true", getEventDedupKey(&Event{
+		Type:     EventTypeDatabaseSchemaDrift,
+		Project:  project,
+		DedupKey: "instances/prod/databases/db/true",
+	}))
+}
@@ -0,0 +1,449 @@
+// Package writeback writes the deployed schema and the changelog back to the repositories of the VCS connectors.
+package writeback
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/vcs"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	writeBackRunnerInterval = 30 * time.Second
+	// writeBackBatchSize is the maximum number of write backs claimed at a time.
+	writeBackBatchSize = 10
+	// writeBackLease is how long a claimed write back is not claimed again.
+	writeBackLease = 10 * time.Minute
+
+	// maxWriteBackAttempts is the number of attempts before a write back fails.
+	// With the backoff below, the last attempt happens about 15 minutes after the first one.
+	maxWriteBackAttempts = 5
+	writeBackBackoffBase = 1 * time.Minute
+	writeBackBackoffMax  = 1 * time.Hour
+)
+
+// Manager is the write back manager.
+type Manager struct {
+	store *store.Store
+
+	// mu serializes the write backs so that the commits to the same branch don't race with each other.
+	mu sync.Mutex
+	// writeBackTickets wakes up the write back runner when there are new write backs.
+	writeBackTickets chan struct{}
+}
+
+// NewManager creates a write back manager.
+func NewManager(store *store.Store) *Manager {
+	return &Manager{
+		store:            store,
+		writeBackTickets: make(chan struct{}, 1),
+	}
+}
+
+// Changelog is the machine-readable changelog of a database written back to the repository.
+type Changelog struct {
+	// Database is the database resource name, e.g. instances/{instance}/databases/{database}.
+	Database string `json:"database"`
+	// Environment is the effective environment resource name, e.g. environments/{environment}.
+	Environment string            `json:"environment"`
+	Changelogs  []*ChangelogEntry `json:"changelogs"`
+}
+
+// ChangelogEntry is an entry of the changelog.
+type ChangelogEntry struct {
+	Name       string `json:"name"`
+	Version    string `json:"version,omitempty"`
+	Type       string `json:"type"`
+	Issue      string `json:"issue,omitempty"`
+	TaskRun    string `json:"taskRun,omitempty"`
+	CreateTime string `json:"createTime"`
+}
+
+// WriteBackStage queues the write backs of the schema and the changelog of the databases changed in the stage to
+// the repositories of the project VCS connectors having the write back enabled.
+// The write backs are made by the runner, and the status of each stage and VCS connector is recorded,
+// so that the failed write backs are retried and reported on the issue.
+// The errors are logged rather than returned, since the write back should not affect the rollout.
+func (m *Manager) WriteBackStage(ctx context.Context, issue *store.IssueMessage, stage *store.StageMessage) {
+	if issue.Type == api.IssueDatabaseDataExport {
+		return
+	}
+	vcsConnectors, err := m.store.ListVCSConnectors(ctx, &store.FindVCSConnectorMessage{ProjectID: &issue.Project.ResourceID})
+	if err != nil {
+		slog.Error("failed to list vcs connectors", slog.String("project", issue.Project.ResourceID), log.BBError(err))
+		return
+	}
+	var creates []*store.VCSWriteBackMessage
+	for _, vcsConnector := range vcsConnectors {
+		if vcsConnector.Payload.GetWriteBack().GetEnabled() {
+			creates = append(creates, &store.VCSWriteBackMessage{
+				IssueUID:        issue.UID,
+				StageUID:        stage.ID,
+				VCSConnectorUID: vcsConnector.UID,
+			})
+		}
+	}
+	if len(creates) == 0 {
+		return
+	}
+	if err := m.store.UpsertVCSWriteBacks(ctx, creates); err != nil {
+		slog.Error("failed to create vcs write backs", slog.Int("stage", stage.ID), log.BBError(err))
+		return
+	}
+	m.notifyWriteBack()
+}
+
+// Run runs the runner making the pending write backs.
+func (m *Manager) Run(ctx context.Context, wg *sync.WaitGroup) {
+	ticker := time.NewTicker(writeBackRunnerInterval)
+	defer ticker.Stop()
+	defer wg.Done()
+	slog.Debug(fmt.Sprintf("VCS write back runner started and will run every %v", writeBackRunnerInterval))
+	for {
+		select {
+		case <-ticker.C:
+		case <-m.writeBackTickets:
+		case <-ctx.Done():
+			return
+		}
+		func() {
+			defer func() {
+				if r := recover(); r != nil {
+					err, ok := r.(error)
+					if !ok {
+						err = errors.Errorf("%v", r)
+					}
+					slog.Error("VCS write back runner PANIC RECOVER", log.BBError(err), log.BBStack("panic-stack"))
+				}
+			}()
+			m.writeBackPending(ctx)
+		}()
+	}
+}
+
+func (m *Manager) notifyWriteBack() {
+	select {
+	case m.writeBackTickets <- struct{}{}:
+	default:
+	}
+}
+
+func (m *Manager) writeBackPending(ctx context.Context) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for {
+		writeBacks, err := m.store.ClaimVCSWriteBacks(ctx, writeBackBatchSize, time.Now().Add(writeBackLease))
+		if err != nil {
+			slog.Error("failed to claim vcs write backs", log.BBError(err))
+			return
+		}
+		for _, writeBack := range writeBacks {
+			if err := m.attemptWriteBack(ctx, writeBack); err != nil {
+				slog.Error("failed to write back", slog.Int64("writeBack", writeBack.UID), log.BBError(err))
+			}
+		}
+		if len(writeBacks) < writeBackBatchSize {
+			return
+		}
+	}
+}
+
+// attemptWriteBack makes an attempt to write back, and schedules the next attempt if it fails.
+// The failures are reported on the issue at the first attempt and after all attempts.
+func (m *Manager) attemptWriteBack(ctx context.Context, writeBack *store.VCSWriteBackMessage) error {
+	issue, err := m.store.GetIssueV2(ctx, &store.FindIssueMessage{UID: &writeBack.IssueUID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get issue %d", writeBack.IssueUID)
+	}
+	if issue == nil || issue.PipelineUID == nil {
+		return errors.Errorf("issue %d not found", writeBack.IssueUID)
+	}
+	stages, err := m.store.ListStageV2(ctx, *issue.PipelineUID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to list stages")
+	}
+	var stage *store.StageMessage
+	for _, s := range stages {
+		if s.ID == writeBack.StageUID {
+			stage = s
+			break
+		}
+	}
+	if stage == nil {
+		return errors.Errorf("stage %d not found", writeBack.StageUID)
+	}
+	vcsConnectors, err := m.store.ListVCSConnectors(ctx, &store.FindVCSConnectorMessage{ProjectID: &issue.Project.ResourceID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to list vcs connectors")
+	}
+	var vcsConnector *store.VCSConnectorMessage
+	for _, c := range vcsConnectors {
+		if c.UID == writeBack.VCSConnectorUID {
+			vcsConnector = c
+			break
+		}
+	}
+	if vcsConnector == nil {
+		// The write back is removed with the VCS connector.
+		return nil
+	}
+
+	writeBackErr := func() error {
+		if !vcsConnector.Payload.GetWriteBack().GetEnabled() {
+			return errors.Errorf("the write back of the VCS connector is disabled")
+		}
+		files, err := m.getStageFiles(ctx, stage)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get the files to write back")
+		}
+		if len(files) == 0 {
+			return nil
+		}
+		return m.writeBack(ctx, issue, stage, vcsConnector, files)
+	}()
+
+	attemptCount := writeBack.AttemptCount + 1
+	update := &store.UpdateVCSWriteBackMessage{
+		UID:          writeBack.UID,
+		AttemptCount: &attemptCount,
+	}
+	status := store.VCSWriteBackPending
+	errorMessage := ""
+	switch {
+	case writeBackErr == nil:
+		status = store.VCSWriteBackSucceeded
+	case attemptCount >= maxWriteBackAttempts:
+		status = store.VCSWriteBackFailed
+		errorMessage = writeBackErr.Error()
+	default:
+		errorMessage = writeBackErr.Error()
+		nextAttemptTime := time.Now().Add(getWriteBackBackoff(attemptCount))
+		update.NextAttemptTime = &nextAttemptTime
+	}
+	update.Status = &status
+	update.Error = &errorMessage
+	if _, err := m.store.UpdateVCSWriteBack(ctx, update); err != nil {
+		return errors.Wrapf(err, "failed to update vcs write back")
+	}
+
+	if writeBackErr != nil {
+		slog.Warn("failed to write back to the repository",
+			slog.String("project", vcsConnector.ProjectID),
+			slog.String("vcsConnector", vcsConnector.ResourceID),
+			slog.Int("stage", stage.ID),
+			slog.Int("attempt", attemptCount),
+			log.BBError(writeBackErr),
+		)
+		if comment := getWriteBackFailureComment(stage, vcsConnector, attemptCount, writeBackErr); comment != "" {
+			if _, err := m.store.CreateIssueComment(ctx, &store.IssueCommentMessage{
+				IssueUID: issue.UID,
+				Payload:  &storepb.IssueCommentPayload{Comment: comment},
+			}, api.SystemBotID); err != nil {
+				return errors.Wrapf(err, "failed to create issue comment")
+			}
+		}
+	}
+	return nil
+}
+
+// getWriteBackFailureComment returns the issue comment of the failed attempt, or empty if the attempt is not reported.
+func getWriteBackFailureComment(stage *store.StageMessage, vcsConnector *store.VCSConnectorMessage, attemptCount int, err error) string {
+	switch {
+	case attemptCount >= maxWriteBackAttempts:
+		return fmt.Sprintf("Failed to write back the schema of stage %q to the repository of VCS connector %q after %d attempts: %v", stage.Name, vcsConnector.ResourceID, attemptCount, err)
+	case attemptCount == 1:
+		return fmt.Sprintf("Failed to write back the schema of stage %q to the repository of VCS connector %q, will retry: %v", stage.Name, vcsConnector.ResourceID, err)
+	default:
+		return ""
+	}
+}
+
+// getWriteBackBackoff returns the delay before the next attempt after attemptCount failed attempts.
+func getWriteBackBackoff(attemptCount int) time.Duration {
+	backoff := writeBackBackoffBase
+	for i := 1; i < attemptCount; i++ {
+		backoff *= 2
+		if backoff >= writeBackBackoffMax {
+			return writeBackBackoffMax
+		}
+	}
+	return backoff
+}
+
+// databaseFiles are the files to write back for a database, relative to the write back directory.
+type databaseFiles struct {
+	schemaPath       string
+	schema           string
+	changelogPath    string
+	changelogContent string
+}
+
+func (m *Manager) getStageFiles(ctx context.Context, stage *store.StageMessage) ([]*databaseFiles, error) {
+	tasks, err := m.store.ListTasks(ctx, &api.TaskFind{StageID: &stage.ID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list tasks")
+	}
+	databaseUIDs := map[int]bool{}
+	for _, task := range tasks {
+		if task.DatabaseID == nil || task.LatestTaskRunStatus != api.TaskRunDone {
+			continue
+		}
+		databaseUIDs[*task.DatabaseID] = true
+	}
+
+	var files []*databaseFiles
+	for databaseUID := range databaseUIDs {
+		database, err := m.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{UID: &databaseUID, ShowDeleted: true})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get database %d", databaseUID)
+		}
+		if database == nil {
+			continue
+		}
+		dbSchema, err := m.store.GetDBSchema(ctx, database.UID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get schema of database %q", database.DatabaseName)
+		}
+		if dbSchema == nil {
+			continue
+		}
+		doneStatus := store.ChangelogStatusDone
+		changelogs, err := m.store.ListChangelogs(ctx, &store.FindChangelogMessage{
+			DatabaseUID: &database.UID,
+			Status:      &doneStatus,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to list changelogs of database %q", database.DatabaseName)
+		}
+		changelogContent, err := buildChangelog(database, changelogs)
+		if err != nil {
+			return nil, err
+		}
+		dir := getDatabaseDirectory(database)
+		files = append(files, &databaseFiles{
+			schemaPath:       path.Join(dir, fmt.Sprintf("%s.sql", database.DatabaseName)),
+			schema:           string(dbSchema.GetSchema()),
+			changelogPath:    path.Join(dir, fmt.Sprintf("%s.changelog.json", database.DatabaseName)),
+			changelogContent: changelogContent,
+		})
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].schemaPath < files[j].schemaPath
+	})
+	return files, nil
+}
+
+func (m *Manager) writeBack(ctx context.Context, issue *store.IssueMessage, stage *store.StageMessage, vcsConnector *store.VCSConnectorMessage, files []*databaseFiles) error {
+	vcsProvider, err := m.store.GetVCSProvider(ctx, &store.FindVCSProviderMessage{ResourceID: &vcsConnector.VCSResourceID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get vcs provider")
+	}
+	if vcsProvider == nil {
+		return errors.Errorf("vcs provider %q not found", vcsConnector.VCSResourceID)
+	}
+	provider := vcs.Get(vcsProvider.Type, vcs.ProviderConfig{InstanceURL: vcsProvider.InstanceURL, AuthToken: vcsProvider.AccessToken})
+
+	writeBack := vcsConnector.Payload.WriteBack
+	targetBranch := writeBack.Branch
+	if targetBranch == "" {
+		targetBranch = vcsConnector.Payload.Branch
+	}
+	var commitFiles []*vcs.CommitFile
+	for _, f := range files {
+		commitFiles = append(commitFiles,
+			&vcs.CommitFile{Path: getWriteBackPath(writeBack.Directory, f.schemaPath), Content: f.schema},
+			&vcs.CommitFile{Path: getWriteBackPath(writeBack.Directory, f.changelogPath), Content: f.changelogContent},
+		)
+	}
+	title := fmt.Sprintf("Bytebase: write back schema after rolling out %q", stage.Name)
+	message := fmt.Sprintf("%s\n\nIssue: %s", title, common.FormatIssue(issue.Project.ResourceID, issue.UID))
+
+	if !writeBack.CreatePullRequest {
+		return provider.CommitFiles(ctx, vcsConnector.Payload.ExternalId, targetBranch, message, commitFiles)
+	}
+
+	branch, err := provider.GetBranch(ctx, vcsConnector.Payload.ExternalId, targetBranch)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get branch %q", targetBranch)
+	}
+	sourceBranch := fmt.Sprintf("bytebase/write-back-%d-%d", issue.UID, stage.ID)
+	if err := provider.CreateBranch(ctx, vcsConnector.Payload.ExternalId, sourceBranch, branch.LastCommitID); err != nil {
+		// The branch may be left by a previous attempt, we will commit on top of it.
+		if common.ErrorCode(err) != common.Conflict {
+			return errors.Wrapf(err, "failed to create branch %q", sourceBranch)
+		}
+	}
+	if err := provider.CommitFiles(ctx, vcsConnector.Payload.ExternalId, sourceBranch, message, commitFiles); err != nil {
+		return err
+	}
+	webURL, err := provider.CreatePullRequest(ctx, vcsConnector.Payload.ExternalId, &vcs.PullRequestCreate{
+		Title:        title,
+		Description:  getPullRequestDescription(issue, stage, files),
+		SourceBranch: sourceBranch,
+		TargetBranch: targetBranch,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to create pull request")
+	}
+	slog.Info("created the write back pull request", slog.String("vcsConnector", vcsConnector.ResourceID), slog.String("url", webURL))
+	return nil
+}
+
+// getDatabaseDirectory returns the directory of the database files, {environment}/{instance}.
+func getDatabaseDirectory(database *store.DatabaseMessage) string {
+	return path.Join(database.EffectiveEnvironmentID, database.InstanceID)
+}
+
+// getWriteBackPath returns the file path in the repository, without the leading slash.
+func getWriteBackPath(directory, filePath string) string {
+	return strings.TrimPrefix(path.Join(directory, filePath), "/")
+}
+
+func buildChangelog(database *store.DatabaseMessage, changelogs []*store.ChangelogMessage) (string, error) {
+	changelog := &Changelog{
+		Database:    common.FormatDatabase(database.InstanceID, database.DatabaseName),
+		Environment: common.FormatEnvironment(database.EffectiveEnvironmentID),
+		Changelogs:  []*ChangelogEntry{},
+	}
+	// The changelogs are listed in the reverse order, we write them in the order they are applied.
+	for i := len(changelogs) - 1; i >= 0; i-- {
+		c := changelogs[i]
+		changelog.Changelogs = append(changelog.Changelogs, &ChangelogEntry{
+			Name:       common.FormatChangelog(database.InstanceID, database.DatabaseName, c.UID),
+			Version:    c.Payload.GetVersion(),
+			Type:       c.Payload.GetType().String(),
+			Issue:      c.Payload.GetIssue(),
+			TaskRun:    c.Payload.GetTaskRun(),
+			CreateTime: c.CreatedTime.UTC().Format(time.RFC3339),
+		})
+	}
+	content, err := json.MarshalIndent(changelog, "", "  ")
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to marshal changelog")
+	}
+	return string(content) + "\n", nil
+}
+
+func getPullRequestDescription(issue *store.IssueMessage, stage *store.StageMessage, files []*databaseFiles) string {
+	var sb strings.Builder
+	_, _ = fmt.Fprintf(&sb, "The schema deployed by the stage %q of the issue %q.\n\n", stage.Name, issue.Title)
+	for _, f := range files {
+		_, _ = fmt.Fprintf(&sb, "- %s\n", strings.TrimSuffix(f.schemaPath, ".sql"))
+	}
+	return sb.String()
+}
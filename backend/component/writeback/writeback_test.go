@@ -0,0 +1,96 @@
+package writeback
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestGetWriteBackPath(t *testing.T) {
+	a := require.New(t)
+	database := &store.DatabaseMessage{
+		InstanceID:             "mysql",
+		EffectiveEnvironmentID: "prod",
+		DatabaseName:           "db",
+	}
+	dir := getDatabaseDirectory(database)
+	a.Equal("prod/mysql", dir)
+	a.Equal("bytebase/prod/mysql/db.sql", getWriteBackPath("/bytebase", dir+"/db.sql"))
+	a.Equal("prod/mysql/db.sql", getWriteBackPath("/", dir+"/db.sql"))
+}
+
+func TestBuildChangelog(t *testing.T) {
+	a := require.New(t)
+	database := &store.DatabaseMessage{
+		InstanceID:             "mysql",
+		EffectiveEnvironmentID: "prod",
+		DatabaseName:           "db",
+	}
+	createdTime := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	// The changelogs are listed in the reverse order.
+	changelogs := []*store.ChangelogMessage{
+		{
+			UID:         2,
+			CreatedTime: createdTime,
+			Payload: &storepb.ChangelogPayload{
+				Version: "0002",
+				Type:    storepb.ChangelogPayload_MIGRATE,
+				Issue:   "projects/p/issues/2",
+			},
+		},
+		{
+			UID:         1,
+			CreatedTime: createdTime,
+			Payload: &storepb.ChangelogPayload{
+				Type: storepb.ChangelogPayload_BASELINE,
+			},
+		},
+	}
+	got, err := buildChangelog(database, changelogs)
+	a.NoError(err)
+	want := `{
+  "database": "instances/mysql/databases/db",
+  "environment": "environments/prod",
+  "changelogs": [
+    {
+      "name": "instances/mysql/databases/db/changelogs/1",
+      "type": "BASELINE",
+      "createTime": "2024-01-02T03:04:05Z"
+    },
+    {
+      "name": "instances/mysql/databases/db/changelogs/2",
+      "version": "0002",
+      "type": "MIGRATE",
+      "issue": "projects/p/issues/2",
+      "createTime": "2024-01-02T03:04:05Z"
+    }
+  ]
+}
+`
+	a.Equal(want, got)
+}
+
+func TestGetWriteBackBackoff(t *testing.T) {
+	a := require.New(t)
+	a.Equal(1*time.Minute, getWriteBackBackoff(1))
+	a.Equal(2*time.Minute, getWriteBackBackoff(2))
+	a.Equal(8*time.Minute, getWriteBackBackoff(4))
+	a.Equal(1*time.Hour, getWriteBackBackoff(20))
+}
+
+func TestGetWriteBackFailureComment(t *testing.T) {
+	a := require.New(t)
+	stage := &store.StageMessage{Name: "Prod Stage"}
+	vcsConnector := &store.VCSConnectorMessage{ResourceID: "repo"}
+	err := errors.New("unauthorized")
+
+	a.Equal(`Failed to write back the schema of stage "Prod Stage" to the repository of VCS connector "repo", will retry: unauthorized`, getWriteBackFailureComment(stage, vcsConnector, 1, err))
+	// The retries in between are not reported.
+	a.Empty(getWriteBackFailureComment(stage, vcsConnector, 2, err))
+	a.Equal(`Failed to write back the schema of stage "Prod Stage" to the repository of VCS connector "repo" after 5 attempts: unauthorized`, getWriteBackFailureComment(stage, vcsConnector, maxWriteBackAttempts, err))
+}
@@ -76,4 +76,16 @@ const (
 	ActivitySQLQuery ActivityType = "bb.sql.query"
 	// ActivitySQLExport is the type for exporting SQL.
 	ActivitySQLExport ActivityType = "bb.sql.export"
+
+	// Database related.
+
+	// ActivityDatabaseSchemaDrift is the type for detecting the schema drift of databases.
+	// Used for notification only.
+	ActivityDatabaseSchemaDrift ActivityType = "bb.database.schema.drift"
+	// ActivityDatabaseConnectionAnomaly is the type for opening or closing the connection anomalies of databases.
+	// Used for notification only.
+	ActivityDatabaseConnectionAnomaly ActivityType = "bb.database.anomaly.connection"
+	// ActivityDatabaseSlowQuery is the type for query fingerprints newly ranking among the slowest queries of databases.
+	// Used for notification only.
+	ActivityDatabaseSlowQuery ActivityType = "bb.database.slow-query"
 )
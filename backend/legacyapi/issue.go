@@ -28,6 +28,9 @@ const (
 
 	// IssueDatabaseDataExport is the issue type for requesting data export.
 	IssueDatabaseDataExport IssueType = "bb.issue.database.data-export"
+
+	// IssueDatabaseJITAccess is the issue type for requesting just-in-time database access.
+	IssueDatabaseJITAccess IssueType = "bb.issue.database.jit-access"
 )
 
 func (t IssueType) String() string {
@@ -63,7 +63,7 @@ const (
 	FeatureExternalSecretManager FeatureType = "bb.feature.external-secret-manager"
 	// FeaturePasswordRestriction allows user to configure the password restriction.
 	FeaturePasswordRestriction FeatureType = "bb.feature.password-restriction"
-	// FeatureDirectorySync allows to sync users and groups from Entra ID.
+	// FeatureDirectorySync allows to sync users and groups from Entra ID and other SCIM 2.0 identity providers.
 	FeatureDirectorySync FeatureType = "bb.feature.directory-sync"
 
 	// FeatureRBAC enables RBAC.
@@ -43,6 +43,12 @@ const (
 	PolicyTypeTag PolicyType = "bb.policy.tag"
 	// PolicyTypeDataSourceQuery is the policy type for data source query.
 	PolicyTypeDataSourceQuery PolicyType = "bb.policy.data-source-query"
+	// PolicyTypeTaskRunConcurrency is the policy type for task run concurrency quota.
+	PolicyTypeTaskRunConcurrency PolicyType = "bb.policy.task-run-concurrency"
+	// PolicyTypeRowFilter is the policy type for row-level filters.
+	PolicyTypeRowFilter PolicyType = "bb.policy.row-filter"
+	// PolicyTypeDryRun is the policy type for the dry run plan checks.
+	PolicyTypeDryRun PolicyType = "bb.policy.dry-run"
 
 	// PipelineApprovalValueManualNever means the pipeline will automatically be approved without user intervention.
 	PipelineApprovalValueManualNever PipelineApprovalValue = "MANUAL_APPROVAL_NEVER"
@@ -86,5 +92,8 @@ var (
 		PolicyTypeRestrictIssueCreationForSQLReview: {PolicyResourceTypeWorkspace, PolicyResourceTypeProject},
 		PolicyTypeIAM:                               {PolicyResourceTypeWorkspace},
 		PolicyTypeDataSourceQuery:                   {PolicyResourceTypeEnvironment, PolicyResourceTypeProject},
+		PolicyTypeTaskRunConcurrency:                {PolicyResourceTypeEnvironment},
+		PolicyTypeRowFilter:                         {PolicyResourceTypeProject},
+		PolicyTypeDryRun:                            {PolicyResourceTypeEnvironment},
 	}
 )
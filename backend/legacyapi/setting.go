@@ -46,4 +46,6 @@ const (
 	SettingSCIM SettingName = "bb.workspace.scim"
 	// SettingPasswordRestriction is the setting name for password.
 	SettingPasswordRestriction SettingName = "bb.workspace.password-restriction"
+	// SettingMaskingKeys is the setting name for the keys used by the encryption and tokenization masking algorithms.
+	SettingMaskingKeys SettingName = "bb.workspace.masking-keys"
 )
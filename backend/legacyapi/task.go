@@ -151,6 +151,10 @@ type TaskPatch struct {
 
 	// Flags for gh-ost.
 	Flags *map[string]string
+
+	// DMLBatchConfig is removed from the payload if it's empty.
+	DMLBatchConfig     *storepb.DMLBatchConfig
+	DMLBatchCheckpoint *storepb.DMLBatchCheckpoint
 }
 
 func GetSheetUIDFromTaskPayload(payload string) (*int, error) {
@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS access_token (
+    id BIGSERIAL PRIMARY KEY,
+    principal_id INTEGER NOT NULL REFERENCES principal (id),
+    creator_id INTEGER NOT NULL REFERENCES principal (id),
+    created_ts TIMESTAMPTZ NOT NULL DEFAULT now(),
+    expire_ts TIMESTAMPTZ,
+    last_used_ts TIMESTAMPTZ,
+    revoke_ts TIMESTAMPTZ,
+    token_hash TEXT NOT NULL,
+    payload JSONB NOT NULL DEFAULT '{}'
+);
+
+ALTER SEQUENCE access_token_id_seq RESTART WITH 101;
+
+CREATE UNIQUE INDEX idx_access_token_unique_token_hash ON access_token (token_hash);
+
+CREATE INDEX idx_access_token_principal_id ON access_token (principal_id);
@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS user_session (
+    id BIGSERIAL PRIMARY KEY,
+    principal_id INTEGER NOT NULL REFERENCES principal (id),
+    session_id TEXT NOT NULL,
+    created_ts TIMESTAMPTZ NOT NULL DEFAULT now(),
+    expire_ts TIMESTAMPTZ NOT NULL,
+    last_active_ts TIMESTAMPTZ NOT NULL DEFAULT now(),
+    revoke_ts TIMESTAMPTZ,
+    payload JSONB NOT NULL DEFAULT '{}'
+);
+
+ALTER SEQUENCE user_session_id_seq RESTART WITH 101;
+
+CREATE UNIQUE INDEX idx_user_session_unique_session_id ON user_session (session_id);
+
+CREATE INDEX idx_user_session_principal_id ON user_session (principal_id);
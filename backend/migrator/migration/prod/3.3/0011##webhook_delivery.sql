@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS webhook_delivery (
+    id BIGSERIAL PRIMARY KEY,
+    project_webhook_id INTEGER NOT NULL REFERENCES project_webhook (id) ON DELETE CASCADE,
+    created_ts TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_ts TIMESTAMPTZ NOT NULL DEFAULT now(),
+    status TEXT NOT NULL CHECK (status IN ('PENDING', 'SUCCEEDED', 'DEAD')),
+    attempt_count INTEGER NOT NULL DEFAULT 0,
+    next_attempt_ts TIMESTAMPTZ NOT NULL DEFAULT now(),
+    -- lease_expire_ts is set while an attempt is being posted.
+    lease_expire_ts TIMESTAMPTZ,
+    -- dedup_key de-duplicates the deliveries of the same event in a time window.
+    dedup_key TEXT NOT NULL DEFAULT '',
+    payload JSONB NOT NULL DEFAULT '{}'
+);
+
+ALTER SEQUENCE webhook_delivery_id_seq RESTART WITH 101;
+
+CREATE INDEX idx_webhook_delivery_project_webhook_id ON webhook_delivery (project_webhook_id);
+
+CREATE INDEX idx_webhook_delivery_pending_next_attempt_ts ON webhook_delivery (next_attempt_ts) WHERE status = 'PENDING';
+
+CREATE INDEX idx_webhook_delivery_updated_ts ON webhook_delivery (updated_ts) WHERE status <> 'PENDING';
+
+CREATE INDEX idx_webhook_delivery_dedup_key_created_ts ON webhook_delivery (dedup_key, created_ts) WHERE dedup_key <> '';
@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS vcs_write_back (
+    id BIGSERIAL PRIMARY KEY,
+    issue_id INTEGER NOT NULL REFERENCES issue (id),
+    stage_id INTEGER NOT NULL REFERENCES stage (id),
+    vcs_connector_id INTEGER NOT NULL REFERENCES vcs_connector (id) ON DELETE CASCADE,
+    created_ts TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_ts TIMESTAMPTZ NOT NULL DEFAULT now(),
+    status TEXT NOT NULL CHECK (status IN ('PENDING', 'SUCCEEDED', 'FAILED')),
+    attempt_count INTEGER NOT NULL DEFAULT 0,
+    next_attempt_ts TIMESTAMPTZ NOT NULL DEFAULT now(),
+    -- error is the error of the last failed attempt.
+    error TEXT NOT NULL DEFAULT ''
+);
+
+ALTER SEQUENCE vcs_write_back_id_seq RESTART WITH 101;
+
+CREATE UNIQUE INDEX idx_vcs_write_back_unique_stage_id_vcs_connector_id ON vcs_write_back (stage_id, vcs_connector_id);
+
+CREATE INDEX idx_vcs_write_back_issue_id ON vcs_write_back (issue_id);
+
+CREATE INDEX idx_vcs_write_back_vcs_connector_id ON vcs_write_back (vcs_connector_id);
+
+CREATE INDEX idx_vcs_write_back_pending_next_attempt_ts ON vcs_write_back (next_attempt_ts) WHERE status = 'PENDING';
@@ -10,7 +10,7 @@ CREATE TABLE idp (
   resource_id TEXT NOT NULL,
   name TEXT NOT NULL,
   domain TEXT NOT NULL,
-  type TEXT NOT NULL CONSTRAINT idp_type_check CHECK (type IN ('OAUTH2', 'OIDC', 'LDAP')),
+  type TEXT NOT NULL CONSTRAINT idp_type_check CHECK (type IN ('OAUTH2', 'OIDC', 'LDAP', 'SAML')),
   -- config stores the corresponding configuration of the IdP, which may vary depending on the type of the IdP.
   config JSONB NOT NULL DEFAULT '{}'
 );
@@ -704,7 +704,7 @@ CREATE TABLE vcs (
     updated_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
     resource_id TEXT NOT NULL,
     name TEXT NOT NULL,
-    type TEXT NOT NULL CHECK (type IN ('GITLAB', 'GITHUB', 'BITBUCKET', 'AZURE_DEVOPS')),
+    type TEXT NOT NULL CHECK (type IN ('GITLAB', 'GITHUB', 'BITBUCKET', 'AZURE_DEVOPS', 'GITEA')),
     instance_url TEXT NOT NULL CHECK ((instance_url LIKE 'http://%' OR instance_url LIKE 'https://%') AND instance_url = rtrim(instance_url, '/')),
     access_token TEXT NOT NULL DEFAULT ''
 );
@@ -1021,3 +1021,87 @@ CREATE TABLE IF NOT EXISTS release (
 ALTER SEQUENCE release_id_seq RESTART WITH 101;
 
 CREATE INDEX idx_release_project_id ON release (project_id);
+
+CREATE TABLE IF NOT EXISTS access_token (
+    id BIGSERIAL PRIMARY KEY,
+    principal_id INTEGER NOT NULL REFERENCES principal (id),
+    creator_id INTEGER NOT NULL REFERENCES principal (id),
+    created_ts TIMESTAMPTZ NOT NULL DEFAULT now(),
+    expire_ts TIMESTAMPTZ,
+    last_used_ts TIMESTAMPTZ,
+    revoke_ts TIMESTAMPTZ,
+    token_hash TEXT NOT NULL,
+    payload JSONB NOT NULL DEFAULT '{}'
+);
+
+ALTER SEQUENCE access_token_id_seq RESTART WITH 101;
+
+CREATE UNIQUE INDEX idx_access_token_unique_token_hash ON access_token (token_hash);
+
+CREATE INDEX idx_access_token_principal_id ON access_token (principal_id);
+
+CREATE TABLE IF NOT EXISTS user_session (
+    id BIGSERIAL PRIMARY KEY,
+    principal_id INTEGER NOT NULL REFERENCES principal (id),
+    session_id TEXT NOT NULL,
+    created_ts TIMESTAMPTZ NOT NULL DEFAULT now(),
+    expire_ts TIMESTAMPTZ NOT NULL,
+    last_active_ts TIMESTAMPTZ NOT NULL DEFAULT now(),
+    revoke_ts TIMESTAMPTZ,
+    payload JSONB NOT NULL DEFAULT '{}'
+);
+
+ALTER SEQUENCE user_session_id_seq RESTART WITH 101;
+
+CREATE UNIQUE INDEX idx_user_session_unique_session_id ON user_session (session_id);
+
+CREATE INDEX idx_user_session_principal_id ON user_session (principal_id);
+
+CREATE TABLE IF NOT EXISTS webhook_delivery (
+    id BIGSERIAL PRIMARY KEY,
+    project_webhook_id INTEGER NOT NULL REFERENCES project_webhook (id) ON DELETE CASCADE,
+    created_ts TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_ts TIMESTAMPTZ NOT NULL DEFAULT now(),
+    status TEXT NOT NULL CHECK (status IN ('PENDING', 'SUCCEEDED', 'DEAD')),
+    attempt_count INTEGER NOT NULL DEFAULT 0,
+    next_attempt_ts TIMESTAMPTZ NOT NULL DEFAULT now(),
+    -- lease_expire_ts is set while an attempt is being posted.
+    lease_expire_ts TIMESTAMPTZ,
+    -- dedup_key de-duplicates the deliveries of the same event in a time window.
+    dedup_key TEXT NOT NULL DEFAULT '',
+    payload JSONB NOT NULL DEFAULT '{}'
+);
+
+ALTER SEQUENCE webhook_delivery_id_seq RESTART WITH 101;
+
+CREATE INDEX idx_webhook_delivery_project_webhook_id ON webhook_delivery (project_webhook_id);
+
+CREATE INDEX idx_webhook_delivery_pending_next_attempt_ts ON webhook_delivery (next_attempt_ts) WHERE status = 'PENDING';
+
+CREATE INDEX idx_webhook_delivery_updated_ts ON webhook_delivery (updated_ts) WHERE status <> 'PENDING';
+
+CREATE INDEX idx_webhook_delivery_dedup_key_created_ts ON webhook_delivery (dedup_key, created_ts) WHERE dedup_key <> '';
+
+CREATE TABLE IF NOT EXISTS vcs_write_back (
+    id BIGSERIAL PRIMARY KEY,
+    issue_id INTEGER NOT NULL REFERENCES issue (id),
+    stage_id INTEGER NOT NULL REFERENCES stage (id),
+    vcs_connector_id INTEGER NOT NULL REFERENCES vcs_connector (id) ON DELETE CASCADE,
+    created_ts TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_ts TIMESTAMPTZ NOT NULL DEFAULT now(),
+    status TEXT NOT NULL CHECK (status IN ('PENDING', 'SUCCEEDED', 'FAILED')),
+    attempt_count INTEGER NOT NULL DEFAULT 0,
+    next_attempt_ts TIMESTAMPTZ NOT NULL DEFAULT now(),
+    -- error is the error of the last failed attempt.
+    error TEXT NOT NULL DEFAULT ''
+);
+
+ALTER SEQUENCE vcs_write_back_id_seq RESTART WITH 101;
+
+CREATE UNIQUE INDEX idx_vcs_write_back_unique_stage_id_vcs_connector_id ON vcs_write_back (stage_id, vcs_connector_id);
+
+CREATE INDEX idx_vcs_write_back_issue_id ON vcs_write_back (issue_id);
+
+CREATE INDEX idx_vcs_write_back_vcs_connector_id ON vcs_write_back (vcs_connector_id);
+
+CREATE INDEX idx_vcs_write_back_pending_next_attempt_ts ON vcs_write_back (next_attempt_ts) WHERE status = 'PENDING';
@@ -231,5 +231,5 @@ func TestMigrationCompatibility(t *testing.T) {
 func TestGetCutoffVersion(t *testing.T) {
 	releaseVersion, err := getProdCutoffVersion()
 	require.NoError(t, err)
-	require.Equal(t, semver.MustParse("3.3.8"), releaseVersion)
+	require.Equal(t, semver.MustParse("3.3.14"), releaseVersion)
 }
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"log/slog"
+	"strings"
 	"sync"
 	"time"
 
@@ -356,6 +357,50 @@ type ExecuteOptions struct {
 	// Record the connection id first before executing.
 	SetConnectionID    func(id string)
 	DeleteConnectionID func()
+
+	// The hook statements run in the same session before and after the statement.
+	// Only drivers executing the statement on a single connection honor them.
+	PreExecuteStatement  string
+	PostExecuteStatement string
+	// PostExecuteFailed receives the failure of the post-execute hook.
+	PostExecuteFailed func(error)
+
+	// BeforeCommit runs with the affected rows before the transaction commits, and the transaction rolls back if it fails.
+	// Only drivers executing the statement in a single transaction honor it.
+	BeforeCommit func(rowsAffected int64) error
+}
+
+// ExecutePreHook executes the pre-execute hook statement on the connection.
+func (o *ExecuteOptions) ExecutePreHook(ctx context.Context, conn *sql.Conn) error {
+	if o == nil || o.PreExecuteStatement == "" {
+		return nil
+	}
+	return o.executeHook(ctx, conn, storepb.TaskRunLog_HookExecuteStart_PRE, o.PreExecuteStatement)
+}
+
+// ExecutePostHook executes the post-execute hook statement on the connection.
+// The post-execute hook runs after the changes are committed, so its failure is recorded
+// in the task run log and reported to PostExecuteFailed instead of failing the committed execution.
+func (o *ExecuteOptions) ExecutePostHook(ctx context.Context, conn *sql.Conn) {
+	if o == nil || o.PostExecuteStatement == "" {
+		return
+	}
+	if err := o.executeHook(ctx, conn, storepb.TaskRunLog_HookExecuteStart_POST, o.PostExecuteStatement); err != nil {
+		slog.Warn("the post-execute hook failed after the changes are committed", log.BBError(err))
+		if o.PostExecuteFailed != nil {
+			o.PostExecuteFailed(err)
+		}
+	}
+}
+
+func (o *ExecuteOptions) executeHook(ctx context.Context, conn *sql.Conn, phase storepb.TaskRunLog_HookExecuteStart_Phase, statement string) error {
+	o.LogHookExecuteStart(phase, statement)
+	if _, err := conn.ExecContext(ctx, statement); err != nil {
+		o.LogHookExecuteEnd(err.Error())
+		return errors.Wrapf(err, "failed to execute %s hook", strings.ToLower(phase.String()))
+	}
+	o.LogHookExecuteEnd("")
+	return nil
 }
 
 func (o *ExecuteOptions) LogDatabaseSyncStart() {
@@ -463,6 +508,37 @@ func (o *ExecuteOptions) LogTransactionControl(t storepb.TaskRunLog_TransactionC
 	}
 }
 
+func (o *ExecuteOptions) LogHookExecuteStart(phase storepb.TaskRunLog_HookExecuteStart_Phase, statement string) {
+	if o == nil || o.CreateTaskRunLog == nil {
+		return
+	}
+	err := o.CreateTaskRunLog(time.Now(), &storepb.TaskRunLog{
+		Type: storepb.TaskRunLog_HOOK_EXECUTE_START,
+		HookExecuteStart: &storepb.TaskRunLog_HookExecuteStart{
+			Phase:     phase,
+			Statement: statement,
+		},
+	})
+	if err != nil {
+		slog.Warn("failed to log hook execute start", log.BBError(err))
+	}
+}
+
+func (o *ExecuteOptions) LogHookExecuteEnd(rerr string) {
+	if o == nil || o.CreateTaskRunLog == nil {
+		return
+	}
+	err := o.CreateTaskRunLog(time.Now(), &storepb.TaskRunLog{
+		Type: storepb.TaskRunLog_HOOK_EXECUTE_END,
+		HookExecuteEnd: &storepb.TaskRunLog_HookExecuteEnd{
+			Error: rerr,
+		},
+	})
+	if err != nil {
+		slog.Warn("failed to log hook execute end", log.BBError(err))
+	}
+}
+
 // ErrorWithPosition is the error with the position information.
 type ErrorWithPosition struct {
 	Err   error
@@ -311,6 +311,10 @@ func (d *Driver) Execute(ctx context.Context, statement string, opts db.ExecuteO
 		originalIndex = []int32{0}
 	}
 
+	if err := opts.ExecutePreHook(ctx, conn); err != nil {
+		return 0, err
+	}
+
 	var totalRowsAffected int64
 
 	if err := conn.Raw(func(driverConn any) error {
@@ -380,6 +384,11 @@ func (d *Driver) Execute(ctx context.Context, statement string, opts db.ExecuteO
 			opts.LogCommandResponse(indexes, int32(rowsAffected), allRowsAffectedInt32, "")
 		}
 
+		if opts.BeforeCommit != nil {
+			if err := opts.BeforeCommit(totalRowsAffected); err != nil {
+				return err
+			}
+		}
 		if err := tx.Commit(); err != nil {
 			opts.LogTransactionControl(storepb.TaskRunLog_TransactionControl_COMMIT, err.Error())
 			return errors.Wrapf(err, "failed to commit execute transaction")
@@ -392,6 +401,7 @@ func (d *Driver) Execute(ctx context.Context, statement string, opts db.ExecuteO
 		return 0, err
 	}
 
+	opts.ExecutePostHook(ctx, conn)
 	return totalRowsAffected, nil
 }
 
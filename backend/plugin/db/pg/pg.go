@@ -439,6 +439,10 @@ func (driver *Driver) Execute(ctx context.Context, statement string, opts db.Exe
 		}
 	}
 
+	if err := opts.ExecutePreHook(ctx, conn); err != nil {
+		return 0, err
+	}
+
 	if isPlsql {
 		if driver.connectionCtx.UseDatabaseOwner {
 			// USE SET SESSION ROLE to set the role for the current session.
@@ -453,6 +457,7 @@ func (driver *Driver) Execute(ctx context.Context, statement string, opts db.Exe
 		}
 		opts.LogCommandResponse([]int32{0}, 0, []int32{0}, "")
 
+		opts.ExecutePostHook(ctx, conn)
 		return 0, nil
 	}
 
@@ -524,6 +529,11 @@ func (driver *Driver) Execute(ctx context.Context, statement string, opts db.Exe
 				totalRowsAffected += rowsAffected
 			}
 
+			if opts.BeforeCommit != nil {
+				if err := opts.BeforeCommit(totalRowsAffected); err != nil {
+					return err
+				}
+			}
 			if err := tx.Commit(ctx); err != nil {
 				opts.LogTransactionControl(storepb.TaskRunLog_TransactionControl_COMMIT, err.Error())
 				return errors.Wrapf(err, "failed to commit transaction")
@@ -554,6 +564,7 @@ func (driver *Driver) Execute(ctx context.Context, statement string, opts db.Exe
 		}
 		opts.LogCommandResponse(indexes, 0, []int32{0}, "")
 	}
+	opts.ExecutePostHook(ctx, conn)
 	return totalRowsAffected, nil
 }
 
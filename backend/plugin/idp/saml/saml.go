@@ -0,0 +1,380 @@
+// Package saml is the plugin for SAML 2.0 Identity Provider.
+package saml
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"encoding/xml"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	saml2 "github.com/russellhaering/gosaml2"
+	"github.com/russellhaering/gosaml2/types"
+	dsig "github.com/russellhaering/goxmldsig"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// NameIDFieldName is the field name in the field mapping referring to the
+// NameID of the assertion subject instead of an attribute.
+const NameIDFieldName = "NameID"
+
+// authnRequestLifetime is how long the identity provider may take to respond
+// to an AuthnRequest.
+const authnRequestLifetime = 10 * time.Minute
+
+var (
+	// pendingRequests holds the IDs of the AuthnRequests not responded yet. A
+	// SAML response is only accepted in response to one of them.
+	pendingRequests = newIDCache()
+	// consumedAssertions holds the IDs of the accepted assertions until they
+	// expire so that a captured SAML response cannot be replayed.
+	consumedAssertions = newIDCache()
+)
+
+// IdentityProvider represents a SAML 2.0 Identity Provider. Bytebase acts as
+// the service provider.
+type IdentityProvider struct {
+	sp     *saml2.SAMLServiceProvider
+	config IdentityProviderConfig
+}
+
+// IdentityProviderConfig is the configuration to be consumed by the SAML
+// Identity Provider.
+type IdentityProviderConfig struct {
+	// EntityID is the entity ID of Bytebase as the service provider.
+	EntityID string `json:"entityId"`
+	// ACSURL is the assertion consumer service URL receiving the SAML responses
+	// with HTTP-POST binding.
+	ACSURL string `json:"acsUrl"`
+	// IDPEntityID is the entity ID of the identity provider, which is the
+	// issuer of the SAML responses.
+	IDPEntityID string `json:"idpEntityId"`
+	// SSOURL is the single sign-on URL of the identity provider receiving the
+	// AuthnRequest with HTTP-Redirect binding.
+	SSOURL string `json:"ssoUrl"`
+	// IDPCertificate is the PEM encoded certificate of the identity provider
+	// to verify the signatures of the SAML responses.
+	IDPCertificate string `json:"idpCertificate"`
+	// SPCertificate and SPPrivateKey are the PEM encoded key pair of Bytebase
+	// to sign the AuthnRequest.
+	SPCertificate string `json:"spCertificate"`
+	SPPrivateKey  string `json:"spPrivateKey"`
+	// NameIDFormat is the NameID format requested in the AuthnRequest, e.g.
+	// "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress".
+	NameIDFormat string `json:"nameIdFormat"`
+	// FieldMapping is the mapping of the assertion attributes.
+	FieldMapping *storepb.FieldMapping `json:"fieldMapping"`
+}
+
+// NewIdentityProvider initializes a new SAML Identity Provider with the given
+// configuration.
+func NewIdentityProvider(config IdentityProviderConfig) (*IdentityProvider, error) {
+	if config.FieldMapping == nil {
+		return nil, errors.New("the field \"fieldMapping\" is empty but required")
+	}
+	for v, field := range map[string]string{
+		config.EntityID:                "entityId",
+		config.ACSURL:                  "acsUrl",
+		config.IDPEntityID:             "idpEntityId",
+		config.SSOURL:                  "ssoUrl",
+		config.IDPCertificate:          "idpCertificate",
+		config.SPCertificate:           "spCertificate",
+		config.SPPrivateKey:            "spPrivateKey",
+		config.FieldMapping.Identifier: "fieldMapping.identifier",
+	} {
+		if v == "" {
+			return nil, errors.Errorf("the field %q is empty but required", field)
+		}
+	}
+
+	idpCertificate, err := parseCertificate(config.IDPCertificate)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse identity provider certificate")
+	}
+	keyPair, err := tls.X509KeyPair([]byte(config.SPCertificate), []byte(config.SPPrivateKey))
+	if err != nil {
+		return nil, errors.Wrap(err, "parse service provider key pair")
+	}
+	keyStore := dsig.TLSCertKeyStore(keyPair)
+
+	sp := &saml2.SAMLServiceProvider{
+		IdentityProviderSSOURL:      config.SSOURL,
+		IdentityProviderSSOBinding:  saml2.BindingHttpRedirect,
+		IdentityProviderIssuer:      config.IDPEntityID,
+		AssertionConsumerServiceURL: config.ACSURL,
+		ServiceProviderIssuer:       config.EntityID,
+		AudienceURI:                 config.EntityID,
+		SignAuthnRequests:           true,
+		IDPCertificateStore: &dsig.MemoryX509CertificateStore{
+			Roots: []*x509.Certificate{idpCertificate},
+		},
+		SPKeyStore:             &keyStore,
+		NameIdFormat:           config.NameIDFormat,
+		AllowMissingAttributes: true,
+	}
+	return &IdentityProvider{
+		sp:     sp,
+		config: config,
+	}, nil
+}
+
+// NewIdentityProviderWithExternalURL initializes a new SAML Identity Provider
+// with the stored configuration of the identity provider idpID, deriving the
+// service provider URLs from the external URL of Bytebase.
+func NewIdentityProviderWithExternalURL(externalURL, idpID string, config *storepb.SAMLIdentityProviderConfig) (*IdentityProvider, error) {
+	return NewIdentityProvider(IdentityProviderConfig{
+		EntityID:       GetEntityID(externalURL, idpID),
+		ACSURL:         GetACSURL(externalURL, idpID),
+		IDPEntityID:    config.GetIdpEntityId(),
+		SSOURL:         config.GetSsoUrl(),
+		IDPCertificate: config.GetIdpCertificate(),
+		SPCertificate:  config.GetSpCertificate(),
+		SPPrivateKey:   config.GetSpPrivateKey(),
+		NameIDFormat:   config.GetNameIdFormat(),
+		FieldMapping:   config.GetFieldMapping(),
+	})
+}
+
+// GetEntityID returns the entity ID of Bytebase as the service provider, which
+// is the URL of the service provider metadata as well.
+func GetEntityID(externalURL, idpID string) string {
+	return fmt.Sprintf("%s/saml/%s/metadata", strings.TrimSuffix(externalURL, "/"), idpID)
+}
+
+// GetACSURL returns the assertion consumer service URL of Bytebase as the
+// service provider.
+func GetACSURL(externalURL, idpID string) string {
+	return fmt.Sprintf("%s/saml/%s/acs", strings.TrimSuffix(externalURL, "/"), idpID)
+}
+
+// AuthURL returns the URL of the identity provider carrying the signed
+// AuthnRequest with HTTP-Redirect binding.
+func (p *IdentityProvider) AuthURL(relayState string) (string, error) {
+	doc, err := p.sp.BuildAuthRequestDocumentNoSig()
+	if err != nil {
+		return "", errors.Wrap(err, "build AuthnRequest")
+	}
+	requestID := doc.Root().SelectAttrValue("ID", "")
+	if requestID == "" {
+		return "", errors.New("the AuthnRequest has no ID")
+	}
+	// The signature is carried by the query string with HTTP-Redirect binding.
+	url, err := p.sp.BuildAuthURLRedirect(relayState, doc)
+	if err != nil {
+		return "", errors.Wrap(err, "build auth URL")
+	}
+	pendingRequests.add(requestID, time.Now().Add(authnRequestLifetime))
+	return url, nil
+}
+
+// Metadata returns the service provider metadata XML to be imported into the
+// identity provider.
+func (p *IdentityProvider) Metadata() ([]byte, error) {
+	metadata, err := p.sp.Metadata()
+	if err != nil {
+		return nil, errors.Wrap(err, "build metadata")
+	}
+	if p.config.NameIDFormat != "" {
+		metadata.SPSSODescriptor.NameIDFormats = []string{p.config.NameIDFormat}
+	}
+	b, err := xml.MarshalIndent(metadata, "", "  ")
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal metadata")
+	}
+	return append([]byte(xml.Header), b...), nil
+}
+
+// UserInfo validates the base64 encoded SAML response with the certificate of
+// the identity provider and returns the user information mapped from the
+// assertion. The response must answer a pending AuthnRequest issued by
+// AuthURL, and each assertion is only accepted once.
+func (p *IdentityProvider) UserInfo(samlResponse string) (*storepb.IdentityProviderUserInfo, error) {
+	assertionInfo, err := p.sp.RetrieveAssertionInfo(samlResponse)
+	if err != nil {
+		return nil, errors.Wrap(err, "validate SAML response")
+	}
+	if assertionInfo.WarningInfo.InvalidTime {
+		return nil, errors.New("the assertion is expired or not yet valid")
+	}
+	if assertionInfo.WarningInfo.NotInAudience {
+		return nil, errors.Errorf("the assertion is not issued for audience %q", p.config.EntityID)
+	}
+	if err := consumeAssertions(assertionInfo.Assertions, time.Now()); err != nil {
+		return nil, err
+	}
+	slog.Debug("SAML assertion", slog.String("nameID", assertionInfo.NameID), slog.Int("attributes", len(assertionInfo.Values)))
+
+	getValue := func(field string) string {
+		if field == NameIDFieldName {
+			return assertionInfo.NameID
+		}
+		return assertionInfo.Values.Get(field)
+	}
+
+	userInfo := &storepb.IdentityProviderUserInfo{
+		Identifier: getValue(p.config.FieldMapping.Identifier),
+	}
+	if userInfo.Identifier == "" {
+		return nil, errors.Errorf("the field %q is not found in the assertion or has empty value", p.config.FieldMapping.Identifier)
+	}
+
+	// Best effort to map optional fields
+	if p.config.FieldMapping.DisplayName != "" {
+		userInfo.DisplayName = getValue(p.config.FieldMapping.DisplayName)
+	}
+	if userInfo.DisplayName == "" {
+		userInfo.DisplayName = userInfo.Identifier
+	}
+	if p.config.FieldMapping.Email != "" {
+		userInfo.Email = getValue(p.config.FieldMapping.Email)
+	}
+	if p.config.FieldMapping.Phone != "" {
+		v := getValue(p.config.FieldMapping.Phone)
+		// Only set phone if it's valid.
+		if err := common.ValidatePhone(v); err == nil {
+			userInfo.Phone = v
+		}
+	}
+	if p.config.FieldMapping.Groups != "" {
+		for _, group := range assertionInfo.Values.GetAll(p.config.FieldMapping.Groups) {
+			if group = strings.TrimSpace(group); group != "" {
+				userInfo.Groups = append(userInfo.Groups, group)
+			}
+		}
+	}
+	return userInfo, nil
+}
+
+// consumeAssertions checks that the assertions answer a pending AuthnRequest
+// and have not been accepted before, then records them as consumed until they
+// expire.
+func consumeAssertions(assertions []types.Assertion, now time.Time) error {
+	if len(assertions) == 0 {
+		return errors.New("the SAML response has no assertion")
+	}
+	for _, assertion := range assertions {
+		if assertion.ID == "" {
+			return errors.New("the assertion has no ID")
+		}
+		if consumedAssertions.contains(assertion.ID, now) {
+			return errors.Errorf("the assertion %q has been used", assertion.ID)
+		}
+		var inResponseTo string
+		if subject := assertion.Subject; subject != nil && subject.SubjectConfirmation != nil && subject.SubjectConfirmation.SubjectConfirmationData != nil {
+			inResponseTo = subject.SubjectConfirmation.SubjectConfirmationData.InResponseTo
+		}
+		if inResponseTo == "" {
+			return errors.New("the assertion is not in response to an AuthnRequest, the identity provider initiated sign-in is not supported")
+		}
+		if !pendingRequests.contains(inResponseTo, now) {
+			return errors.Errorf("the assertion is in response to an unknown or expired AuthnRequest %q", inResponseTo)
+		}
+	}
+	for _, assertion := range assertions {
+		expireAt := now.Add(authnRequestLifetime)
+		if assertion.Conditions != nil {
+			if notOnOrAfter, err := time.Parse(time.RFC3339, assertion.Conditions.NotOnOrAfter); err == nil {
+				expireAt = notOnOrAfter
+			}
+		}
+		if !consumedAssertions.add(assertion.ID, expireAt) {
+			return errors.Errorf("the assertion %q has been used", assertion.ID)
+		}
+		pendingRequests.remove(assertion.Subject.SubjectConfirmation.SubjectConfirmationData.InResponseTo)
+	}
+	return nil
+}
+
+// idCache holds the IDs until their expiration time.
+type idCache struct {
+	sync.Mutex
+	ids map[string]time.Time
+}
+
+func newIDCache() *idCache {
+	return &idCache{ids: map[string]time.Time{}}
+}
+
+// add records the ID until expireAt, returning false if the ID is present and
+// not expired.
+func (c *idCache) add(id string, expireAt time.Time) bool {
+	c.Lock()
+	defer c.Unlock()
+	now := time.Now()
+	for k, v := range c.ids {
+		if !now.Before(v) {
+			delete(c.ids, k)
+		}
+	}
+	if _, ok := c.ids[id]; ok {
+		return false
+	}
+	c.ids[id] = expireAt
+	return true
+}
+
+func (c *idCache) contains(id string, now time.Time) bool {
+	c.Lock()
+	defer c.Unlock()
+	expireAt, ok := c.ids[id]
+	return ok && now.Before(expireAt)
+}
+
+func (c *idCache) remove(id string) {
+	c.Lock()
+	defer c.Unlock()
+	delete(c.ids, id)
+}
+
+// GenerateServiceProviderKeyPair generates a self-signed certificate and the
+// private key in PEM format for Bytebase to sign the AuthnRequest.
+func GenerateServiceProviderKeyPair(commonName string) (string, string, error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return "", "", errors.Wrap(err, "generate private key")
+	}
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return "", "", errors.Wrap(err, "generate serial number")
+	}
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber: serialNumber,
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    now.Add(-time.Hour),
+		NotAfter:     now.AddDate(10, 0, 0),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return "", "", errors.Wrap(err, "create certificate")
+	}
+	certificate := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	privateKey := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	return string(certificate), string(privateKey), nil
+}
+
+// parseCertificate parses the PEM encoded certificate. The bare base64 encoded
+// certificate copied from the identity provider metadata is accepted as well.
+func parseCertificate(certificate string) (*x509.Certificate, error) {
+	certificate = strings.TrimSpace(certificate)
+	if !strings.HasPrefix(certificate, "-----BEGIN") {
+		certificate = "-----BEGIN CERTIFICATE-----\n" + certificate + "\n-----END CERTIFICATE-----"
+	}
+	block, _ := pem.Decode([]byte(certificate))
+	if block == nil {
+		return nil, errors.New("invalid PEM encoded certificate")
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
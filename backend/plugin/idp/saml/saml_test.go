@@ -0,0 +1,240 @@
+package saml
+
+import (
+	"crypto/tls"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/beevik/etree"
+	dsig "github.com/russellhaering/goxmldsig"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	testExternalURL = "https://bytebase.example.com"
+	testIDPEntityID = "https://idp.example.com/metadata"
+	testSSOURL      = "https://idp.example.com/sso"
+)
+
+func newTestIdentityProvider(t *testing.T, idpCertificate string) *IdentityProvider {
+	spCertificate, spPrivateKey, err := GenerateServiceProviderKeyPair("bytebase")
+	require.NoError(t, err)
+	p, err := NewIdentityProviderWithExternalURL(testExternalURL, "corp", &storepb.SAMLIdentityProviderConfig{
+		IdpEntityId:    testIDPEntityID,
+		SsoUrl:         testSSOURL,
+		IdpCertificate: idpCertificate,
+		SpCertificate:  spCertificate,
+		SpPrivateKey:   spPrivateKey,
+		FieldMapping: &storepb.FieldMapping{
+			Identifier:  NameIDFieldName,
+			DisplayName: "displayName",
+			Groups:      "groups",
+		},
+	})
+	require.NoError(t, err)
+	return p
+}
+
+func TestNewIdentityProvider(t *testing.T) {
+	spCertificate, spPrivateKey, err := GenerateServiceProviderKeyPair("bytebase")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		config      IdentityProviderConfig
+		containsErr string
+	}{
+		{
+			name: "no ssoUrl",
+			config: IdentityProviderConfig{
+				EntityID:       GetEntityID(testExternalURL, "corp"),
+				ACSURL:         GetACSURL(testExternalURL, "corp"),
+				IDPEntityID:    testIDPEntityID,
+				IDPCertificate: spCertificate,
+				SPCertificate:  spCertificate,
+				SPPrivateKey:   spPrivateKey,
+				FieldMapping:   &storepb.FieldMapping{Identifier: NameIDFieldName},
+			},
+			containsErr: `the field "ssoUrl" is empty but required`,
+		},
+		{
+			name: "no field mapping",
+			config: IdentityProviderConfig{
+				EntityID:       GetEntityID(testExternalURL, "corp"),
+				ACSURL:         GetACSURL(testExternalURL, "corp"),
+				IDPEntityID:    testIDPEntityID,
+				SSOURL:         testSSOURL,
+				IDPCertificate: spCertificate,
+				SPCertificate:  spCertificate,
+				SPPrivateKey:   spPrivateKey,
+			},
+			containsErr: `the field "fieldMapping" is empty but required`,
+		},
+		{
+			name: "no identifier",
+			config: IdentityProviderConfig{
+				EntityID:       GetEntityID(testExternalURL, "corp"),
+				ACSURL:         GetACSURL(testExternalURL, "corp"),
+				IDPEntityID:    testIDPEntityID,
+				SSOURL:         testSSOURL,
+				IDPCertificate: spCertificate,
+				SPCertificate:  spCertificate,
+				SPPrivateKey:   spPrivateKey,
+				FieldMapping:   &storepb.FieldMapping{},
+			},
+			containsErr: `the field "fieldMapping.identifier" is empty but required`,
+		},
+		{
+			name: "invalid idpCertificate",
+			config: IdentityProviderConfig{
+				EntityID:       GetEntityID(testExternalURL, "corp"),
+				ACSURL:         GetACSURL(testExternalURL, "corp"),
+				IDPEntityID:    testIDPEntityID,
+				SSOURL:         testSSOURL,
+				IDPCertificate: "invalid",
+				SPCertificate:  spCertificate,
+				SPPrivateKey:   spPrivateKey,
+				FieldMapping:   &storepb.FieldMapping{Identifier: NameIDFieldName},
+			},
+			containsErr: "parse identity provider certificate",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := NewIdentityProvider(test.config)
+			assert.ErrorContains(t, err, test.containsErr)
+		})
+	}
+}
+
+func TestAuthURL(t *testing.T) {
+	idpCertificate, _, err := GenerateServiceProviderKeyPair("idp")
+	require.NoError(t, err)
+	p := newTestIdentityProvider(t, idpCertificate)
+
+	authURL, err := p.AuthURL("/projects")
+	require.NoError(t, err)
+	u, err := url.Parse(authURL)
+	require.NoError(t, err)
+	assert.Equal(t, testSSOURL, fmt.Sprintf("%s://%s%s", u.Scheme, u.Host, u.Path))
+	query := u.Query()
+	assert.NotEmpty(t, query.Get("SAMLRequest"))
+	assert.Equal(t, "/projects", query.Get("RelayState"))
+	assert.NotEmpty(t, query.Get("SigAlg"))
+	assert.NotEmpty(t, query.Get("Signature"))
+}
+
+func TestMetadata(t *testing.T) {
+	idpCertificate, _, err := GenerateServiceProviderKeyPair("idp")
+	require.NoError(t, err)
+	p := newTestIdentityProvider(t, idpCertificate)
+
+	metadata, err := p.Metadata()
+	require.NoError(t, err)
+	assert.Contains(t, string(metadata), `entityID="https://bytebase.example.com/saml/corp/metadata"`)
+	assert.Contains(t, string(metadata), `Location="https://bytebase.example.com/saml/corp/acs"`)
+	assert.Contains(t, string(metadata), `AuthnRequestsSigned="true"`)
+}
+
+func TestUserInfo(t *testing.T) {
+	idpCertificate, idpPrivateKey, err := GenerateServiceProviderKeyPair("idp")
+	require.NoError(t, err)
+	p := newTestIdentityProvider(t, idpCertificate)
+
+	now := time.Now().UTC()
+	pendingRequests.add("_request1", now.Add(authnRequestLifetime))
+	samlResponse := buildSignedResponse(t, idpCertificate, idpPrivateKey, GetEntityID(testExternalURL, "corp"), now.Add(5*time.Minute), "_assertion1", "_request1")
+	userInfo, err := p.UserInfo(samlResponse)
+	require.NoError(t, err)
+	assert.Equal(t, "alice@example.com", userInfo.Identifier)
+	assert.Equal(t, "Alice", userInfo.DisplayName)
+	assert.Equal(t, []string{"dba@example.com", "Developers"}, userInfo.Groups)
+
+	// The same response cannot be replayed.
+	_, err = p.UserInfo(samlResponse)
+	assert.ErrorContains(t, err, "has been used")
+
+	// The request has been responded.
+	samlResponse = buildSignedResponse(t, idpCertificate, idpPrivateKey, GetEntityID(testExternalURL, "corp"), now.Add(5*time.Minute), "_assertion2", "_request1")
+	_, err = p.UserInfo(samlResponse)
+	assert.ErrorContains(t, err, "unknown or expired AuthnRequest")
+
+	// The response is not solicited.
+	samlResponse = buildSignedResponse(t, idpCertificate, idpPrivateKey, GetEntityID(testExternalURL, "corp"), now.Add(5*time.Minute), "_assertion3", "")
+	_, err = p.UserInfo(samlResponse)
+	assert.ErrorContains(t, err, "not in response to an AuthnRequest")
+
+	// The assertion is issued for another service provider.
+	pendingRequests.add("_request2", now.Add(authnRequestLifetime))
+	samlResponse = buildSignedResponse(t, idpCertificate, idpPrivateKey, "https://other.example.com", now.Add(5*time.Minute), "_assertion4", "_request2")
+	_, err = p.UserInfo(samlResponse)
+	assert.ErrorContains(t, err, "not issued for audience")
+
+	// The assertion is signed by an untrusted key.
+	otherCertificate, otherPrivateKey, err := GenerateServiceProviderKeyPair("other")
+	require.NoError(t, err)
+	samlResponse = buildSignedResponse(t, otherCertificate, otherPrivateKey, GetEntityID(testExternalURL, "corp"), now.Add(5*time.Minute), "_assertion5", "_request2")
+	_, err = p.UserInfo(samlResponse)
+	assert.ErrorContains(t, err, "validate SAML response")
+}
+
+func TestAuthURLPendingRequest(t *testing.T) {
+	idpCertificate, _, err := GenerateServiceProviderKeyPair("idp")
+	require.NoError(t, err)
+	p := newTestIdentityProvider(t, idpCertificate)
+
+	pendingRequests.Lock()
+	before := len(pendingRequests.ids)
+	pendingRequests.Unlock()
+	_, err = p.AuthURL("/")
+	require.NoError(t, err)
+	pendingRequests.Lock()
+	defer pendingRequests.Unlock()
+	assert.Equal(t, before+1, len(pendingRequests.ids))
+}
+
+func buildSignedResponse(t *testing.T, certificate, privateKey, audience string, notOnOrAfter time.Time, assertionID, inResponseTo string) string {
+	notBefore := time.Now().UTC().Add(-time.Minute).Format(time.RFC3339)
+	expire := notOnOrAfter.Format(time.RFC3339)
+	acsURL := GetACSURL(testExternalURL, "corp")
+	assertion := fmt.Sprintf(`<saml:Assertion xmlns:saml="urn:oasis:names:tc:SAML:2.0:assertion" ID="%[6]s" Version="2.0" IssueInstant="%[1]s">
+<saml:Issuer>%[2]s</saml:Issuer>
+<saml:Subject>
+<saml:NameID Format="urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress">alice@example.com</saml:NameID>
+<saml:SubjectConfirmation Method="urn:oasis:names:tc:SAML:2.0:cm:bearer"><saml:SubjectConfirmationData NotOnOrAfter="%[3]s" Recipient="%[4]s" InResponseTo="%[7]s"/></saml:SubjectConfirmation>
+</saml:Subject>
+<saml:Conditions NotBefore="%[1]s" NotOnOrAfter="%[3]s"><saml:AudienceRestriction><saml:Audience>%[5]s</saml:Audience></saml:AudienceRestriction></saml:Conditions>
+<saml:AttributeStatement>
+<saml:Attribute Name="displayName"><saml:AttributeValue>Alice</saml:AttributeValue></saml:Attribute>
+<saml:Attribute Name="groups"><saml:AttributeValue>dba@example.com</saml:AttributeValue><saml:AttributeValue>Developers</saml:AttributeValue></saml:Attribute>
+</saml:AttributeStatement>
+</saml:Assertion>`, notBefore, testIDPEntityID, expire, acsURL, audience, assertionID, inResponseTo)
+
+	assertionDoc := etree.NewDocument()
+	require.NoError(t, assertionDoc.ReadFromString(assertion))
+	keyPair, err := tls.X509KeyPair([]byte(certificate), []byte(privateKey))
+	require.NoError(t, err)
+	keyStore := dsig.TLSCertKeyStore(keyPair)
+	signingContext := dsig.NewDefaultSigningContext(&keyStore)
+	signingContext.Canonicalizer = dsig.MakeC14N10ExclusiveCanonicalizerWithPrefixList("")
+	signedAssertion, err := signingContext.SignEnveloped(assertionDoc.Root())
+	require.NoError(t, err)
+
+	responseDoc := etree.NewDocument()
+	require.NoError(t, responseDoc.ReadFromString(fmt.Sprintf(`<samlp:Response xmlns:samlp="urn:oasis:names:tc:SAML:2.0:protocol" xmlns:saml="urn:oasis:names:tc:SAML:2.0:assertion" ID="_response" Version="2.0" IssueInstant="%s" Destination="%s">
+<saml:Issuer>%s</saml:Issuer>
+<samlp:Status><samlp:StatusCode Value="urn:oasis:names:tc:SAML:2.0:status:Success"/></samlp:Status>
+</samlp:Response>`, notBefore, acsURL, testIDPEntityID)))
+	responseDoc.Root().AddChild(signedAssertion)
+	b, err := responseDoc.WriteToBytes()
+	require.NoError(t, err)
+	require.True(t, strings.Contains(string(b), "SignatureValue"))
+	return base64.StdEncoding.EncodeToString(b)
+}
@@ -0,0 +1,53 @@
+package base
+
+import (
+	"strings"
+)
+
+// BatchDMLStatement is a single-table UPDATE or DELETE statement that can be executed in primary key ranged chunks.
+type BatchDMLStatement struct {
+	// Database is empty if the statement doesn't qualify the table with a database.
+	Database string
+	// Schema is empty if the statement doesn't qualify the table with a schema.
+	Schema string
+	Table  string
+	// Alias is the alias of the target table, empty if not aliased.
+	Alias string
+
+	// Head is the statement text before the WHERE clause.
+	Head string
+	// Condition is the original WHERE condition, empty if the statement has no WHERE clause.
+	Condition string
+	// Tail is the statement text after the WHERE clause, e.g. the RETURNING clause.
+	Tail string
+	// Delete is true for the DELETE statement, and false for the UPDATE statement.
+	Delete bool
+}
+
+// TableReference returns the name used to reference the target table in the predicate.
+func (s *BatchDMLStatement) TableReference() string {
+	if s.Alias != "" {
+		return s.Alias
+	}
+	return s.Table
+}
+
+// Render returns the statement with the predicate ANDed into the WHERE clause.
+func (s *BatchDMLStatement) Render(predicate string) string {
+	var buf strings.Builder
+	_, _ = buf.WriteString(strings.TrimRight(s.Head, " \t\r\n"))
+	_, _ = buf.WriteString(" WHERE ")
+	if s.Condition != "" {
+		_, _ = buf.WriteString("(")
+		_, _ = buf.WriteString(s.Condition)
+		_, _ = buf.WriteString(") AND ")
+	}
+	_, _ = buf.WriteString("(")
+	_, _ = buf.WriteString(predicate)
+	_, _ = buf.WriteString(")")
+	if tail := strings.TrimSpace(s.Tail); tail != "" {
+		_, _ = buf.WriteString(" ")
+		_, _ = buf.WriteString(tail)
+	}
+	return buf.String()
+}
@@ -27,6 +27,8 @@ var (
 	spans                   = make(map[storepb.Engine]GetQuerySpanFunc)
 	transformDMLToSelect    = make(map[storepb.Engine]TransformDMLToSelectFunc)
 	generateRestoreSQL      = make(map[storepb.Engine]GenerateRestoreSQLFunc)
+	batchDMLParsers         = make(map[storepb.Engine]ParseBatchDMLFunc)
+	rowFilterRewriters      = make(map[storepb.Engine]RewriteRowFilterFunc)
 )
 
 type ValidateSQLForEditorFunc func(string) (bool, bool, error)
@@ -45,6 +47,12 @@ type TransformDMLToSelectFunc func(ctx context.Context, tCtx TransformContext, s
 
 type GenerateRestoreSQLFunc func(ctx context.Context, rCtx RestoreContext, statement string, backupItem *storepb.PriorBackupDetail_Item) (string, error)
 
+// ParseBatchDMLFunc is the interface of parsing a single UPDATE/DELETE statement for batched execution.
+type ParseBatchDMLFunc func(statement string) (*BatchDMLStatement, error)
+
+// RewriteRowFilterFunc is the interface of rewriting a query to read only the rows matching the row filters.
+type RewriteRowFilterFunc func(statement, database, schema string, filters []*RowFilter, ignoreCaseSensitive bool) (*RowFilterResult, error)
+
 func RegisterQueryValidator(engine storepb.Engine, f ValidateSQLForEditorFunc) {
 	mux.Lock()
 	defer mux.Unlock()
@@ -263,6 +271,47 @@ func GenerateRestoreSQL(ctx context.Context, engine storepb.Engine, rCtx Restore
 	return f(ctx, rCtx, statement, backupItem)
 }
 
+// RegisterParseBatchDML registers the batch DML parser for the engine.
+func RegisterParseBatchDML(engine storepb.Engine, f ParseBatchDMLFunc) {
+	mux.Lock()
+	defer mux.Unlock()
+	if _, dup := batchDMLParsers[engine]; dup {
+		panic(fmt.Sprintf("Register called twice %s", engine))
+	}
+	batchDMLParsers[engine] = f
+}
+
+// ParseBatchDML parses the statement into a BatchDMLStatement.
+// The statement must be a single UPDATE or DELETE statement on a single table.
+func ParseBatchDML(engine storepb.Engine, statement string) (*BatchDMLStatement, error) {
+	f, ok := batchDMLParsers[engine]
+	if !ok {
+		return nil, errors.Errorf("engine %s is not supported", engine)
+	}
+	return f(statement)
+}
+
+// RegisterRewriteRowFilter registers the row filter rewriter for the engine.
+func RegisterRewriteRowFilter(engine storepb.Engine, f RewriteRowFilterFunc) {
+	mux.Lock()
+	defer mux.Unlock()
+	if _, dup := rowFilterRewriters[engine]; dup {
+		panic(fmt.Sprintf("Register called twice %s", engine))
+	}
+	rowFilterRewriters[engine] = f
+}
+
+// RewriteRowFilter rewrites every reference to the filtered tables in the statement into a subquery reading only the filtered rows.
+// It returns an error if any reference to the filtered tables cannot be rewritten safely,
+// or the statement calls the functions which may read the filtered tables bypassing the rewrite.
+func RewriteRowFilter(engine storepb.Engine, statement, database, schema string, filters []*RowFilter, ignoreCaseSensitive bool) (*RowFilterResult, error) {
+	f, ok := rowFilterRewriters[engine]
+	if !ok {
+		return nil, errors.Errorf("row filter is not supported for engine %s", engine)
+	}
+	return f(statement, database, schema, filters, ignoreCaseSensitive)
+}
+
 type ChangeSummary struct {
 	ChangedResources *model.ChangedResources
 	SampleDMLS       []string
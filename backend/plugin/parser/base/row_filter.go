@@ -0,0 +1,19 @@
+package base
+
+// RowFilter is the filter restricting the rows of a table that a query can read.
+type RowFilter struct {
+	// Database is the normalized database name.
+	Database string
+	// Schema is the normalized schema name, it's empty for the engines that don't support schema.
+	Schema string
+	Table  string
+	// Predicate is the boolean expression selecting the visible rows, its placeholders must have been resolved.
+	Predicate string
+}
+
+// RowFilterResult is the statement rewritten with the row filters.
+type RowFilterResult struct {
+	Statement string
+	// Applied are the filters applied to at least one table reference in the statement.
+	Applied []*RowFilter
+}
@@ -0,0 +1,115 @@
+package mysql
+
+import (
+	"github.com/antlr4-go/antlr/v4"
+	"github.com/pkg/errors"
+
+	parser "github.com/bytebase/mysql-parser"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func init() {
+	base.RegisterParseBatchDML(storepb.Engine_MYSQL, ParseBatchDML)
+	base.RegisterParseBatchDML(storepb.Engine_MARIADB, ParseBatchDML)
+	base.RegisterParseBatchDML(storepb.Engine_OCEANBASE, ParseBatchDML)
+}
+
+// ParseBatchDML parses a single-table UPDATE or DELETE statement for batched execution.
+func ParseBatchDML(statement string) (*base.BatchDMLStatement, error) {
+	list, err := ParseMySQL(statement)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse statement")
+	}
+
+	listener := &batchDMLListener{}
+	for _, item := range list {
+		antlr.ParseTreeWalkerDefault.Walk(listener, item.Tree)
+		if listener.err != nil {
+			return nil, listener.err
+		}
+	}
+	if listener.statementCount != 1 {
+		return nil, errors.Errorf("expect exactly one statement, but got %d", listener.statementCount)
+	}
+	if listener.result == nil {
+		return nil, errors.New("only UPDATE and DELETE statements are supported")
+	}
+	return listener.result, nil
+}
+
+type batchDMLListener struct {
+	*parser.BaseMySQLParserListener
+
+	statementCount int
+	result         *base.BatchDMLStatement
+	err            error
+}
+
+func (l *batchDMLListener) EnterSimpleStatement(ctx *parser.SimpleStatementContext) {
+	if isTopLevel(ctx.GetParent()) {
+		l.statementCount++
+	}
+}
+
+func (l *batchDMLListener) EnterUpdateStatement(ctx *parser.UpdateStatementContext) {
+	if !isTopLevel(ctx.GetParent()) || l.err != nil {
+		return
+	}
+	if ctx.OrderClause() != nil || ctx.SimpleLimitClause() != nil {
+		l.err = errors.New("UPDATE statement with ORDER BY or LIMIT cannot be executed in batches")
+		return
+	}
+	references := ctx.TableReferenceList().AllTableReference()
+	if len(references) != 1 || len(references[0].AllJoinedTable()) != 0 || references[0].TableFactor() == nil || references[0].TableFactor().SingleTable() == nil {
+		l.err = errors.New("multi-table UPDATE statement cannot be executed in batches")
+		return
+	}
+	singleTable := references[0].TableFactor().SingleTable()
+	database, table := NormalizeMySQLTableRef(singleTable.TableRef())
+	alias := ""
+	if singleTable.TableAlias() != nil {
+		alias = NormalizeMySQLIdentifier(singleTable.TableAlias().Identifier())
+	}
+	l.result = buildBatchDMLStatement(ctx.GetParser().GetTokenStream(), ctx, ctx.WhereClause(), database, table, alias)
+}
+
+func (l *batchDMLListener) EnterDeleteStatement(ctx *parser.DeleteStatementContext) {
+	if !isTopLevel(ctx.GetParent()) || l.err != nil {
+		return
+	}
+	if ctx.TableRef() == nil {
+		l.err = errors.New("multi-table DELETE statement cannot be executed in batches")
+		return
+	}
+	if ctx.OrderClause() != nil || ctx.SimpleLimitClause() != nil {
+		l.err = errors.New("DELETE statement with ORDER BY or LIMIT cannot be executed in batches")
+		return
+	}
+	database, table := NormalizeMySQLTableRef(ctx.TableRef())
+	alias := ""
+	if ctx.TableAlias() != nil {
+		alias = NormalizeMySQLIdentifier(ctx.TableAlias().Identifier())
+	}
+	l.result = buildBatchDMLStatement(ctx.GetParser().GetTokenStream(), ctx, ctx.WhereClause(), database, table, alias)
+	l.result.Delete = true
+}
+
+func buildBatchDMLStatement(stream antlr.TokenStream, ctx antlr.ParserRuleContext, where parser.IWhereClauseContext, database, table, alias string) *base.BatchDMLStatement {
+	result := &base.BatchDMLStatement{
+		Database: database,
+		Table:    table,
+		Alias:    alias,
+	}
+	if where == nil {
+		result.Head = stream.GetTextFromRuleContext(ctx)
+		return result
+	}
+	result.Head = stream.GetTextFromInterval(antlr.NewInterval(ctx.GetStart().GetTokenIndex(), where.GetStart().GetTokenIndex()-1))
+	result.Condition = stream.GetTextFromRuleContext(where.Expr())
+	if where.GetStop().GetTokenIndex() < ctx.GetStop().GetTokenIndex() {
+		result.Tail = stream.GetTextFromInterval(antlr.NewInterval(where.GetStop().GetTokenIndex()+1, ctx.GetStop().GetTokenIndex()))
+	}
+	return result
+}
@@ -0,0 +1,61 @@
+package mysql
+
+import (
+	"io"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+type batchDMLTestCase struct {
+	Input  string
+	Table  string `yaml:"table,omitempty"`
+	Output string `yaml:"output,omitempty"`
+	Error  string `yaml:"error,omitempty"`
+}
+
+func TestParseBatchDML(t *testing.T) {
+	const (
+		record       = false
+		testDataPath = "test-data/batch_dml.yaml"
+	)
+
+	a := require.New(t)
+	yamlFile, err := os.Open(testDataPath)
+	a.NoError(err)
+
+	var testCases []batchDMLTestCase
+	byteValue, err := io.ReadAll(yamlFile)
+	a.NoError(err)
+	a.NoError(yamlFile.Close())
+	a.NoError(yaml.Unmarshal(byteValue, &testCases))
+
+	for i, tc := range testCases {
+		var table, output, errMessage string
+		result, err := ParseBatchDML(tc.Input)
+		if err != nil {
+			errMessage = err.Error()
+		} else {
+			table = result.Table
+			output = result.Render("id > 10")
+		}
+		if record {
+			testCases[i].Table = table
+			testCases[i].Output = output
+			testCases[i].Error = errMessage
+		} else {
+			a.Equal(tc.Table, table, "Input: %s", tc.Input)
+			a.Equal(tc.Output, output, "Input: %s", tc.Input)
+			a.Equal(tc.Error, errMessage, "Input: %s", tc.Input)
+		}
+	}
+
+	if record {
+		byteValue, err := yaml.Marshal(testCases)
+		a.NoError(err)
+		err = os.WriteFile(testDataPath, byteValue, 0644)
+		a.NoError(err)
+	}
+}
@@ -0,0 +1,157 @@
+package mysql
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+	"github.com/pkg/errors"
+
+	parser "github.com/bytebase/mysql-parser"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func init() {
+	base.RegisterRewriteRowFilter(storepb.Engine_MYSQL, RewriteRowFilter)
+	base.RegisterRewriteRowFilter(storepb.Engine_MARIADB, RewriteRowFilter)
+}
+
+// rowFilterSafeFunctions are the built-in functions which cannot read tables.
+// The other functions, for example, the stored functions, may read the filtered tables bypassing the rewrite.
+// The aggregate functions and the functions named by keywords are parsed separately and always allowed.
+var rowFilterSafeFunctions = map[string]bool{
+	"lower": true, "upper": true, "lcase": true, "ucase": true, "length": true, "char_length": true,
+	"concat": true, "concat_ws": true, "substr": true, "substring_index": true, "ltrim": true, "rtrim": true,
+	"replace": true, "ifnull": true, "nullif": true, "if": true, "coalesce": true,
+	"round": true, "floor": true, "ceil": true, "ceiling": true, "abs": true,
+	"now": true, "date_format": true, "datediff": true, "from_unixtime": true, "unix_timestamp": true,
+	"json_extract": true, "json_unquote": true, "json_object": true, "json_array": true,
+}
+
+// RewriteRowFilter replaces every filtered table reference `t` with `(SELECT * FROM t WHERE (predicate)) AS t`.
+// The references which cannot be replaced by a derived table, for example, the ones with partitions or index hints, are rejected.
+// The statements calling the functions other than rowFilterSafeFunctions are rejected as well.
+func RewriteRowFilter(statement, database, _ string, filters []*base.RowFilter, ignoreCaseSensitive bool) (*base.RowFilterResult, error) {
+	list, err := ParseMySQL(statement)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse statement")
+	}
+
+	var buf strings.Builder
+	applied := make(map[*base.RowFilter]bool)
+	for _, item := range list {
+		listener := &rowFilterListener{
+			rewriter:            antlr.NewTokenStreamRewriter(item.Tokens),
+			database:            database,
+			filters:             filters,
+			applied:             applied,
+			ignoreCaseSensitive: ignoreCaseSensitive,
+		}
+		antlr.ParseTreeWalkerDefault.Walk(listener, item.Tree)
+		if listener.err != nil {
+			return nil, listener.err
+		}
+		_, _ = buf.WriteString(listener.rewriter.GetText(antlr.DefaultProgramName, antlr.NewInterval(0, item.Tokens.Size()-1)))
+	}
+	result := buf.String()
+
+	// Make sure the predicates don't change the statement structure.
+	rewritten, err := ParseMySQL(result)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse the statement with row filters")
+	}
+	if len(rewritten) != len(list) {
+		return nil, errors.Errorf("expect %d statements after applying row filters, but got %d", len(list), len(rewritten))
+	}
+	var appliedFilters []*base.RowFilter
+	for _, filter := range filters {
+		if applied[filter] {
+			appliedFilters = append(appliedFilters, filter)
+		}
+	}
+	return &base.RowFilterResult{Statement: result, Applied: appliedFilters}, nil
+}
+
+type rowFilterListener struct {
+	*parser.BaseMySQLParserListener
+
+	rewriter            *antlr.TokenStreamRewriter
+	database            string
+	filters             []*base.RowFilter
+	applied             map[*base.RowFilter]bool
+	ignoreCaseSensitive bool
+	err                 error
+}
+
+func (l *rowFilterListener) EnterFunctionCall(ctx *parser.FunctionCallContext) {
+	if l.err != nil {
+		return
+	}
+	if ctx.PureIdentifier() == nil {
+		l.err = errors.Errorf("cannot apply row filter to the query calling function %q", ctx.QualifiedIdentifier().GetText())
+		return
+	}
+	name := strings.ToLower(NormalizeMySQLPureIdentifier(ctx.PureIdentifier()))
+	if !rowFilterSafeFunctions[name] {
+		l.err = errors.Errorf("cannot apply row filter to the query calling function %q", name)
+	}
+}
+
+func (l *rowFilterListener) EnterTableRef(ctx *parser.TableRefContext) {
+	if l.err != nil {
+		return
+	}
+	database, table := NormalizeMySQLTableRef(ctx)
+	predicates := l.getPredicates(database, table)
+	if len(predicates) == 0 {
+		return
+	}
+
+	singleTable, ok := ctx.GetParent().(*parser.SingleTableContext)
+	if !ok {
+		l.err = errors.Errorf("cannot apply row filter to table %q outside of the FROM clause", table)
+		return
+	}
+	if singleTable.UsePartition() != nil || singleTable.IndexHintList() != nil {
+		l.err = errors.Errorf("cannot apply row filter to table %q with partitions or index hints", table)
+		return
+	}
+	replacement := fmt.Sprintf("(SELECT * FROM %s WHERE %s)", ctx.GetParser().GetTokenStream().GetTextFromRuleContext(ctx), strings.Join(predicates, " AND "))
+	if singleTable.TableAlias() == nil {
+		replacement = fmt.Sprintf("%s AS `%s`", replacement, strings.ReplaceAll(table, "`", "``"))
+	}
+	l.rewriter.ReplaceDefault(ctx.GetStart().GetTokenIndex(), ctx.GetStop().GetTokenIndex(), replacement)
+}
+
+func (l *rowFilterListener) EnterTableRefWithWildcard(ctx *parser.TableRefWithWildcardContext) {
+	if l.err != nil {
+		return
+	}
+	database, table := NormalizeMySQLTableRefWithWildcard(ctx)
+	if len(l.getPredicates(database, table)) > 0 {
+		l.err = errors.Errorf("cannot apply row filter to table %q in multi-table statements", table)
+	}
+}
+
+func (l *rowFilterListener) getPredicates(database, table string) []string {
+	if database == "" {
+		database = l.database
+	}
+	var predicates []string
+	for _, filter := range l.filters {
+		if l.equal(filter.Database, database) && l.equal(filter.Table, table) {
+			predicates = append(predicates, fmt.Sprintf("(%s)", filter.Predicate))
+			l.applied[filter] = true
+		}
+	}
+	return predicates
+}
+
+func (l *rowFilterListener) equal(a, b string) bool {
+	if l.ignoreCaseSensitive {
+		return strings.EqualFold(a, b)
+	}
+	return a == b
+}
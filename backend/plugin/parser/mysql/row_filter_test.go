@@ -0,0 +1,64 @@
+package mysql
+
+import (
+	"io"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+type rowFilterTestCase struct {
+	Input  string
+	Output string `yaml:"output,omitempty"`
+	Error  string `yaml:"error,omitempty"`
+}
+
+func TestRewriteRowFilter(t *testing.T) {
+	const (
+		record       = false
+		testDataPath = "test-data/row_filter.yaml"
+	)
+
+	a := require.New(t)
+	yamlFile, err := os.Open(testDataPath)
+	a.NoError(err)
+
+	var testCases []rowFilterTestCase
+	byteValue, err := io.ReadAll(yamlFile)
+	a.NoError(err)
+	a.NoError(yamlFile.Close())
+	a.NoError(yaml.Unmarshal(byteValue, &testCases))
+
+	filters := []*base.RowFilter{
+		{Database: "db", Table: "orders", Predicate: "region = 'us'"},
+		{Database: "db", Table: "users", Predicate: "tenant_id = 1"},
+		{Database: "db", Table: "users", Predicate: "deleted = 0"},
+	}
+	for i, tc := range testCases {
+		var output, errMessage string
+		result, err := RewriteRowFilter(tc.Input, "db", "", filters, true)
+		if err != nil {
+			errMessage = err.Error()
+		} else {
+			output = result.Statement
+		}
+		if record {
+			testCases[i].Output = output
+			testCases[i].Error = errMessage
+		} else {
+			a.Equal(tc.Output, output, "Input: %s", tc.Input)
+			a.Equal(tc.Error, errMessage, "Input: %s", tc.Input)
+		}
+	}
+
+	if record {
+		byteValue, err := yaml.Marshal(testCases)
+		a.NoError(err)
+		err = os.WriteFile(testDataPath, byteValue, 0644)
+		a.NoError(err)
+	}
+}
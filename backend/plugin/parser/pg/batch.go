@@ -0,0 +1,111 @@
+package pg
+
+import (
+	"github.com/antlr4-go/antlr/v4"
+	"github.com/pkg/errors"
+
+	parser "github.com/bytebase/postgresql-parser"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func init() {
+	base.RegisterParseBatchDML(storepb.Engine_POSTGRES, ParseBatchDML)
+}
+
+// ParseBatchDML parses a single-table UPDATE or DELETE statement for batched execution.
+func ParseBatchDML(statement string) (*base.BatchDMLStatement, error) {
+	tree, err := ParsePostgreSQL(statement)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse statement")
+	}
+
+	listener := &batchDMLListener{}
+	antlr.ParseTreeWalkerDefault.Walk(listener, tree.Tree)
+	if listener.err != nil {
+		return nil, listener.err
+	}
+	if listener.statementCount != 1 {
+		return nil, errors.Errorf("expect exactly one statement, but got %d", listener.statementCount)
+	}
+	if listener.result == nil {
+		return nil, errors.New("only UPDATE and DELETE statements are supported")
+	}
+	return listener.result, nil
+}
+
+type batchDMLListener struct {
+	*parser.BasePostgreSQLParserListener
+
+	statementCount int
+	result         *base.BatchDMLStatement
+	err            error
+}
+
+func (l *batchDMLListener) EnterStmt(ctx *parser.StmtContext) {
+	if isTopLevel(ctx.GetParent()) && ctx.GetChildCount() > 0 {
+		l.statementCount++
+	}
+}
+
+func (l *batchDMLListener) EnterUpdatestmt(ctx *parser.UpdatestmtContext) {
+	if !isTopLevel(ctx.GetParent()) || l.err != nil {
+		return
+	}
+	if ctx.From_clause() != nil && ctx.From_clause().GetChildCount() > 0 {
+		l.err = errors.New("UPDATE statement with FROM cannot be executed in batches")
+		return
+	}
+	l.result, l.err = buildBatchDMLStatement(ctx.GetParser().GetTokenStream(), ctx, ctx.Relation_expr_opt_alias(), ctx.Where_or_current_clause(), ctx.Returning_clause())
+}
+
+func (l *batchDMLListener) EnterDeletestmt(ctx *parser.DeletestmtContext) {
+	if !isTopLevel(ctx.GetParent()) || l.err != nil {
+		return
+	}
+	if ctx.Using_clause() != nil && ctx.Using_clause().GetChildCount() > 0 {
+		l.err = errors.New("DELETE statement with USING cannot be executed in batches")
+		return
+	}
+	l.result, l.err = buildBatchDMLStatement(ctx.GetParser().GetTokenStream(), ctx, ctx.Relation_expr_opt_alias(), ctx.Where_or_current_clause(), ctx.Returning_clause())
+	if l.result != nil {
+		l.result.Delete = true
+	}
+}
+
+func buildBatchDMLStatement(stream antlr.TokenStream, ctx antlr.ParserRuleContext, relation parser.IRelation_expr_opt_aliasContext, where parser.IWhere_or_current_clauseContext, returning parser.IReturning_clauseContext) (*base.BatchDMLStatement, error) {
+	table := extractTableReference(relation)
+	if table == nil {
+		return nil, errors.New("failed to extract the target table")
+	}
+	if where != nil && where.CURRENT_P() != nil {
+		return nil, errors.New("WHERE CURRENT OF cannot be executed in batches")
+	}
+	if where != nil && where.WHERE() == nil {
+		where = nil
+	}
+	if returning != nil && returning.GetChildCount() == 0 {
+		returning = nil
+	}
+
+	result := &base.BatchDMLStatement{
+		Database: table.Database,
+		Schema:   table.Schema,
+		Table:    table.Table,
+		Alias:    table.Alias,
+	}
+	headStop := ctx.GetStop().GetTokenIndex()
+	switch {
+	case where != nil:
+		headStop = where.GetStart().GetTokenIndex() - 1
+		result.Condition = stream.GetTextFromRuleContext(where.A_expr())
+	case returning != nil:
+		headStop = returning.GetStart().GetTokenIndex() - 1
+	}
+	result.Head = stream.GetTextFromInterval(antlr.NewInterval(ctx.GetStart().GetTokenIndex(), headStop))
+	if returning != nil {
+		result.Tail = stream.GetTextFromRuleContext(returning)
+	}
+	return result, nil
+}
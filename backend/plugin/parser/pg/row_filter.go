@@ -0,0 +1,176 @@
+package pg
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+	"github.com/pkg/errors"
+
+	parser "github.com/bytebase/postgresql-parser"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func init() {
+	base.RegisterRewriteRowFilter(storepb.Engine_POSTGRES, RewriteRowFilter)
+}
+
+// rowFilterSafeFunctions are the built-in functions which cannot read tables.
+// The other functions, for example, query_to_xml and the user-defined functions, may read the filtered tables bypassing the rewrite.
+var rowFilterSafeFunctions = map[string]bool{
+	"count": true, "sum": true, "avg": true, "min": true, "max": true,
+	"array_agg": true, "string_agg": true, "bool_and": true, "bool_or": true,
+	"lower": true, "upper": true, "length": true, "char_length": true, "concat": true, "concat_ws": true,
+	"substr": true, "btrim": true, "ltrim": true, "rtrim": true, "replace": true, "split_part": true,
+	"round": true, "floor": true, "ceil": true, "abs": true, "mod": true,
+	"now": true, "date_trunc": true, "date_part": true, "age": true, "to_char": true, "to_date": true, "to_timestamp": true,
+	"row_number": true, "rank": true, "dense_rank": true, "lag": true, "lead": true, "first_value": true, "last_value": true,
+	"json_build_object": true, "jsonb_build_object": true, "json_agg": true, "jsonb_agg": true,
+}
+
+// RewriteRowFilter replaces every filtered table reference `t` with `(SELECT * FROM t WHERE (predicate)) AS t`.
+// The references which cannot be replaced by a subquery, for example, the ones with TABLESAMPLE or in the TABLE statements, are rejected.
+// The statements calling the functions other than rowFilterSafeFunctions are rejected as well.
+func RewriteRowFilter(statement, database, schema string, filters []*base.RowFilter, _ bool) (*base.RowFilterResult, error) {
+	tree, err := ParsePostgreSQL(statement)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse statement")
+	}
+	if schema == "" {
+		schema = "public"
+	}
+
+	listener := &rowFilterListener{
+		rewriter: antlr.NewTokenStreamRewriter(tree.Tokens),
+		database: database,
+		schema:   schema,
+		filters:  filters,
+		applied:  make(map[*base.RowFilter]bool),
+	}
+	antlr.ParseTreeWalkerDefault.Walk(listener, tree.Tree)
+	if listener.err != nil {
+		return nil, listener.err
+	}
+	result := listener.rewriter.GetText(antlr.DefaultProgramName, antlr.NewInterval(0, tree.Tokens.Size()-1))
+
+	// Make sure the predicates don't change the statement structure.
+	originalCount, err := countStatements(tree.Tree)
+	if err != nil {
+		return nil, err
+	}
+	rewritten, err := ParsePostgreSQL(result)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse the statement with row filters")
+	}
+	rewrittenCount, err := countStatements(rewritten.Tree)
+	if err != nil {
+		return nil, err
+	}
+	if originalCount != rewrittenCount {
+		return nil, errors.Errorf("expect %d statements after applying row filters, but got %d", originalCount, rewrittenCount)
+	}
+	return &base.RowFilterResult{Statement: result, Applied: listener.getApplied()}, nil
+}
+
+func countStatements(tree antlr.Tree) (int, error) {
+	root, ok := tree.(*parser.RootContext)
+	if !ok || root.Stmtblock() == nil || root.Stmtblock().Stmtmulti() == nil {
+		return 0, errors.New("failed to find statements")
+	}
+	count := 0
+	for _, stmt := range root.Stmtblock().Stmtmulti().AllStmt() {
+		if stmt.GetChildCount() > 0 {
+			count++
+		}
+	}
+	return count, nil
+}
+
+type rowFilterListener struct {
+	*parser.BasePostgreSQLParserListener
+
+	rewriter *antlr.TokenStreamRewriter
+	database string
+	schema   string
+	filters  []*base.RowFilter
+	applied  map[*base.RowFilter]bool
+	err      error
+}
+
+func (l *rowFilterListener) EnterFunc_application(ctx *parser.Func_applicationContext) {
+	if l.err != nil {
+		return
+	}
+	name := normalizePostgreSQLFuncName(ctx.Func_name())
+	if len(name) == 2 && name[0] == "pg_catalog" {
+		name = name[1:]
+	}
+	if len(name) != 1 || !rowFilterSafeFunctions[name[0]] {
+		l.err = errors.Errorf("cannot apply row filter to the query calling function %q", strings.Join(name, "."))
+	}
+}
+
+func (l *rowFilterListener) EnterQualified_name(ctx *parser.Qualified_nameContext) {
+	if l.err != nil {
+		return
+	}
+	list := NormalizePostgreSQLQualifiedName(ctx)
+	database, schema, table := l.database, l.schema, ""
+	switch len(list) {
+	case 1:
+		table = list[0]
+	case 2:
+		schema, table = list[0], list[1]
+	case 3:
+		database, schema, table = list[0], list[1], list[2]
+	default:
+		return
+	}
+	predicates := l.getPredicates(database, schema, table)
+	if len(predicates) == 0 {
+		return
+	}
+
+	relation, ok := ctx.GetParent().(*parser.Relation_exprContext)
+	if !ok {
+		l.err = errors.Errorf("cannot apply row filter to table %q outside of the FROM clause", table)
+		return
+	}
+	tableRef, ok := relation.GetParent().(*parser.Table_refContext)
+	if !ok {
+		l.err = errors.Errorf("cannot apply row filter to table %q outside of the FROM clause", table)
+		return
+	}
+	if tableRef.Tablesample_clause() != nil {
+		l.err = errors.Errorf("cannot apply row filter to table %q with TABLESAMPLE", table)
+		return
+	}
+	replacement := fmt.Sprintf("(SELECT * FROM %s WHERE %s)", relation.GetParser().GetTokenStream().GetTextFromRuleContext(relation), strings.Join(predicates, " AND "))
+	if tableRef.Opt_alias_clause() == nil {
+		replacement = fmt.Sprintf(`%s AS "%s"`, replacement, strings.ReplaceAll(table, `"`, `""`))
+	}
+	l.rewriter.ReplaceDefault(relation.GetStart().GetTokenIndex(), relation.GetStop().GetTokenIndex(), replacement)
+}
+
+func (l *rowFilterListener) getPredicates(database, schema, table string) []string {
+	var predicates []string
+	for _, filter := range l.filters {
+		if filter.Database == database && filter.Schema == schema && filter.Table == table {
+			predicates = append(predicates, fmt.Sprintf("(%s)", filter.Predicate))
+			l.applied[filter] = true
+		}
+	}
+	return predicates
+}
+
+func (l *rowFilterListener) getApplied() []*base.RowFilter {
+	var applied []*base.RowFilter
+	for _, filter := range l.filters {
+		if l.applied[filter] {
+			applied = append(applied, filter)
+		}
+	}
+	return applied
+}
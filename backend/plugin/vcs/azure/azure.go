@@ -527,6 +527,240 @@ func (p *Provider) UpdatePullRequestComment(ctx context.Context, repositoryID, p
 	return nil
 }
 
+// RefUpdate is the API message for Azure ref update.
+type RefUpdate struct {
+	Name        string `json:"name"`
+	OldObjectID string `json:"oldObjectId"`
+	NewObjectID string `json:"newObjectId,omitempty"`
+}
+
+// RefUpdateResult is the API message for Azure ref update result.
+type RefUpdateResult struct {
+	Name         string `json:"name"`
+	Success      bool   `json:"success"`
+	UpdateStatus string `json:"updateStatus"`
+}
+
+// emptyObjectID is the object ID used as the old object ID to create a ref.
+const emptyObjectID = "0000000000000000000000000000000000000000"
+
+// CreateBranch creates a branch pointing to the given commit.
+//
+// Docs: https://learn.microsoft.com/en-us/rest/api/azure/devops/git/refs/update-refs?view=azure-devops-rest-7.0&tabs=HTTP
+func (p *Provider) CreateBranch(ctx context.Context, repositoryID, branchName, commitID string) error {
+	payload, err := json.Marshal([]*RefUpdate{
+		{
+			Name:        fmt.Sprintf("refs/heads/%s", branchName),
+			OldObjectID: emptyObjectID,
+			NewObjectID: commitID,
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request body for creating branch")
+	}
+
+	apiURL, err := p.getRepositoryAPIURL(repositoryID)
+	if err != nil {
+		return err
+	}
+
+	values := &url.Values{}
+	values.Set("api-version", "7.0")
+	url := fmt.Sprintf("%s/refs?%s", apiURL, values.Encode())
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), payload)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+	if code != http.StatusOK {
+		return errors.Errorf("failed to create branch, code: %v, body: %s", code, string(body))
+	}
+
+	var r struct {
+		Value []*RefUpdateResult `json:"value"`
+	}
+	if err := json.Unmarshal([]byte(body), &r); err != nil {
+		return errors.Wrapf(err, "failed to unmarshal create branch response, body: %s", string(body))
+	}
+	for _, result := range r.Value {
+		if !result.Success {
+			return common.Errorf(common.Conflict, "failed to create branch %s, update status: %s", branchName, result.UpdateStatus)
+		}
+	}
+	return nil
+}
+
+// PushItem is the API message for Azure push change item.
+type PushItem struct {
+	Path string `json:"path"`
+}
+
+// PushContent is the API message for Azure push change content.
+type PushContent struct {
+	Content     string `json:"content"`
+	ContentType string `json:"contentType"`
+}
+
+// PushChange is the API message for Azure push change.
+type PushChange struct {
+	// Available values: "add", "edit".
+	ChangeType string       `json:"changeType"`
+	Item       *PushItem    `json:"item"`
+	NewContent *PushContent `json:"newContent"`
+}
+
+// PushCommit is the API message for Azure push commit.
+type PushCommit struct {
+	Comment string        `json:"comment"`
+	Changes []*PushChange `json:"changes"`
+}
+
+// Push is the API message for Azure push.
+type Push struct {
+	RefUpdates []*RefUpdate  `json:"refUpdates"`
+	Commits    []*PushCommit `json:"commits"`
+}
+
+// CommitFiles creates or updates the files on the branch in a single commit.
+//
+// Docs: https://learn.microsoft.com/en-us/rest/api/azure/devops/git/pushes/create?view=azure-devops-rest-7.0&tabs=HTTP
+func (p *Provider) CommitFiles(ctx context.Context, repositoryID, branchName, message string, files []*vcs.CommitFile) error {
+	branch, err := p.GetBranch(ctx, repositoryID, branchName)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get branch %s", branchName)
+	}
+
+	commit := &PushCommit{Comment: message}
+	for _, file := range files {
+		path := "/" + strings.TrimPrefix(file.Path, "/")
+		// Azure DevOps requires to tell adding from editing the file.
+		exists, err := p.fileExists(ctx, repositoryID, path, branch.LastCommitID)
+		if err != nil {
+			return err
+		}
+		changeType := "add"
+		if exists {
+			changeType = "edit"
+		}
+		commit.Changes = append(commit.Changes, &PushChange{
+			ChangeType: changeType,
+			Item:       &PushItem{Path: path},
+			NewContent: &PushContent{Content: file.Content, ContentType: "rawtext"},
+		})
+	}
+	payload, err := json.Marshal(&Push{
+		RefUpdates: []*RefUpdate{
+			{
+				Name:        fmt.Sprintf("refs/heads/%s", branchName),
+				OldObjectID: branch.LastCommitID,
+			},
+		},
+		Commits: []*PushCommit{commit},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request body for creating push")
+	}
+
+	apiURL, err := p.getRepositoryAPIURL(repositoryID)
+	if err != nil {
+		return err
+	}
+
+	values := &url.Values{}
+	values.Set("api-version", "7.0")
+	url := fmt.Sprintf("%s/pushes?%s", apiURL, values.Encode())
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), payload)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+	if code == http.StatusConflict {
+		// The branch has moved since we read it.
+		return common.Errorf(common.Conflict, "failed to create push, body: %s", string(body))
+	}
+	if code != http.StatusCreated {
+		return errors.Errorf("failed to create push, code: %v, body: %s", code, string(body))
+	}
+	return nil
+}
+
+// fileExists returns whether the file exists in the given commit.
+//
+// Docs: https://learn.microsoft.com/en-us/rest/api/azure/devops/git/items/get?view=azure-devops-rest-7.0&tabs=HTTP
+func (p *Provider) fileExists(ctx context.Context, repositoryID, filePath, commitID string) (bool, error) {
+	apiURL, err := p.getRepositoryAPIURL(repositoryID)
+	if err != nil {
+		return false, err
+	}
+
+	values := &url.Values{}
+	values.Set("api-version", "7.0")
+	values.Set("path", filePath)
+	values.Set("versionDescriptor.versionType", "commit")
+	values.Set("versionDescriptor.version", commitID)
+	url := fmt.Sprintf("%s/items?%s", apiURL, values.Encode())
+	code, body, err := internal.Get(ctx, url, p.getAuthorization())
+	if err != nil {
+		return false, errors.Wrapf(err, "GET %s", url)
+	}
+	if code == http.StatusNotFound {
+		return false, nil
+	}
+	if code != http.StatusOK {
+		return false, errors.Errorf("non-200 GET %s status code %d with body %q", url, code, string(body))
+	}
+	return true, nil
+}
+
+// PullRequestCreate is the API message to create the Azure pull request.
+type PullRequestCreate struct {
+	SourceRefName string `json:"sourceRefName"`
+	TargetRefName string `json:"targetRefName"`
+	Title         string `json:"title"`
+	Description   string `json:"description"`
+}
+
+// CreatePullRequest creates a pull request.
+//
+// Docs: https://learn.microsoft.com/en-us/rest/api/azure/devops/git/pull-requests/create?view=azure-devops-rest-7.0&tabs=HTTP
+func (p *Provider) CreatePullRequest(ctx context.Context, repositoryID string, create *vcs.PullRequestCreate) (string, error) {
+	payload, err := json.Marshal(&PullRequestCreate{
+		SourceRefName: fmt.Sprintf("refs/heads/%s", create.SourceBranch),
+		TargetRefName: fmt.Sprintf("refs/heads/%s", create.TargetBranch),
+		Title:         create.Title,
+		// Azure DevOps limits the description to 4000 characters.
+		Description: vcs.TruncateDescription(create.Description, 4000),
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal request body for creating pull request")
+	}
+
+	apiURL, err := p.getRepositoryAPIURL(repositoryID)
+	if err != nil {
+		return "", err
+	}
+
+	values := &url.Values{}
+	values.Set("api-version", "7.0")
+	url := fmt.Sprintf("%s/pullrequests?%s", apiURL, values.Encode())
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), payload)
+	if err != nil {
+		return "", errors.Wrapf(err, "POST %s", url)
+	}
+	if code != http.StatusCreated {
+		return "", errors.Errorf("failed to create pull request, code: %v, body: %s", code, string(body))
+	}
+
+	var pr struct {
+		PullRequestID int `json:"pullRequestId"`
+		Repository    struct {
+			WebURL string `json:"webUrl"`
+		} `json:"repository"`
+	}
+	if err := json.Unmarshal([]byte(body), &pr); err != nil {
+		return "", errors.Wrapf(err, "failed to unmarshal create pull request response, body: %s", string(body))
+	}
+	return fmt.Sprintf("%s/pullrequest/%d", pr.Repository.WebURL, pr.PullRequestID), nil
+}
+
 // CreateWebhook creates a webhook in the organization, and returns the webhook ID which can be used in PatchWebhook.
 // API Version 7.0 do not specify the OAuth scope for creating webhook explicitly, but it works.
 //
@@ -584,6 +818,65 @@ func (p *Provider) DeleteWebhook(ctx context.Context, _, webhookID string) error
 	return nil
 }
 
+// PullRequestStatusContext is the API message for Azure pull request status context.
+type PullRequestStatusContext struct {
+	Name  string `json:"name"`
+	Genre string `json:"genre"`
+}
+
+// PullRequestStatus is the API message for Azure pull request status.
+type PullRequestStatus struct {
+	// Available values: "pending", "succeeded", "failed", "error", "notApplicable".
+	State       string                    `json:"state"`
+	Description string                    `json:"description,omitempty"`
+	TargetURL   string                    `json:"targetUrl,omitempty"`
+	Context     *PullRequestStatusContext `json:"context"`
+}
+
+// CreateCommitStatus creates the status on the pull request, which can be required by the branch policy.
+// Azure DevOps posts the status to the pull request rather than the commit, and the latest status with
+// the same context takes effect. Azure DevOps doesn't support annotations on the pull request status.
+//
+// Docs: https://learn.microsoft.com/en-us/rest/api/azure/devops/git/pull-request-statuses/create?view=azure-devops-rest-7.1&tabs=HTTP
+func (p *Provider) CreateCommitStatus(ctx context.Context, repositoryID, pullRequestID, _ string, status *vcs.CommitStatus) error {
+	state := "failed"
+	switch status.State {
+	case vcs.CommitStatusPending:
+		state = "pending"
+	case vcs.CommitStatusSuccess:
+		state = "succeeded"
+	}
+	payload, err := json.Marshal(&PullRequestStatus{
+		State:       state,
+		Description: status.Description,
+		TargetURL:   status.TargetURL,
+		Context: &PullRequestStatusContext{
+			Name:  status.Name,
+			Genre: "bytebase",
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request body for creating pull request status")
+	}
+
+	apiURL, err := p.getRepositoryAPIURL(repositoryID)
+	if err != nil {
+		return err
+	}
+
+	values := &url.Values{}
+	values.Set("api-version", "7.0")
+	url := fmt.Sprintf("%s/pullRequests/%s/statuses?%s", apiURL, pullRequestID, values.Encode())
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), payload)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+	if code != http.StatusOK {
+		return errors.Errorf("failed to create pull request status, code: %v, body: %s", code, string(body))
+	}
+	return nil
+}
+
 func getAzureRepositoryIDs(repositoryID string) (string, string, string, error) {
 	// By design, we encode the repository ID as <organization>/<projectID>/<repositoryID> for Azure DevOps.
 	parts := strings.Split(repositoryID, "/")
@@ -499,6 +499,133 @@ type Webhook struct {
 	UUID string `json:"uuid"`
 }
 
+// BranchCreate is the API message to create the branch.
+type BranchCreate struct {
+	Name   string `json:"name"`
+	Target Target `json:"target"`
+}
+
+// CreateBranch creates a branch pointing to the given commit.
+//
+// Docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-refs/#api-repositories-workspace-repo-slug-refs-branches-post
+func (p *Provider) CreateBranch(ctx context.Context, repositoryID, branchName, commitID string) error {
+	payload, err := json.Marshal(&BranchCreate{
+		Name:   branchName,
+		Target: Target{Hash: commitID},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request body for creating branch")
+	}
+	url := fmt.Sprintf("%s/repositories/%s/refs/branches", p.APIURL(p.instanceURL), repositoryID)
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), payload)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to create branch through URL %s", url)
+	} else if code == http.StatusBadRequest && strings.Contains(body, "already exists") {
+		return common.Errorf(common.Conflict, "failed to create branch through URL %s, body: %s", url, body)
+	} else if code != http.StatusCreated {
+		return errors.Errorf("failed to create branch through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	return nil
+}
+
+// CommitFiles creates or updates the files on the branch in a single commit.
+// Bitbucket Cloud takes the files as the form fields keyed by the file paths.
+//
+// Docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-source/#api-repositories-workspace-repo-slug-src-post
+func (p *Provider) CommitFiles(ctx context.Context, repositoryID, branchName, message string, files []*vcs.CommitFile) error {
+	form := url.Values{}
+	form.Set("message", message)
+	form.Set("branch", branchName)
+	for _, file := range files {
+		form.Set(file.Path, file.Content)
+	}
+	url := fmt.Sprintf("%s/repositories/%s/src", p.APIURL(p.instanceURL), repositoryID)
+	code, body, err := internal.PostWithHeader(ctx, url, p.getAuthorization(),
+		map[string]string{
+			"Content-Type": "application/x-www-form-urlencoded",
+		},
+		[]byte(form.Encode()),
+	)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to create commit through URL %s", url)
+	} else if code != http.StatusCreated {
+		return errors.Errorf("failed to create commit through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	return nil
+}
+
+// PullRequestBranch is the API message for the branch of Bitbucket Cloud pull request.
+type PullRequestBranch struct {
+	Branch struct {
+		Name string `json:"name"`
+	} `json:"branch"`
+}
+
+// PullRequestCreate is the API message to create the pull request.
+type PullRequestCreate struct {
+	Title             string            `json:"title"`
+	Description       string            `json:"description"`
+	Source            PullRequestBranch `json:"source"`
+	Destination       PullRequestBranch `json:"destination"`
+	CloseSourceBranch bool              `json:"close_source_branch"`
+}
+
+// CreatePullRequest creates a pull request.
+//
+// Docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pullrequests/#api-repositories-workspace-repo-slug-pullrequests-post
+func (p *Provider) CreatePullRequest(ctx context.Context, repositoryID string, create *vcs.PullRequestCreate) (string, error) {
+	pullRequestCreate := &PullRequestCreate{
+		Title:             create.Title,
+		Description:       create.Description,
+		CloseSourceBranch: true,
+	}
+	pullRequestCreate.Source.Branch.Name = create.SourceBranch
+	pullRequestCreate.Destination.Branch.Name = create.TargetBranch
+	payload, err := json.Marshal(pullRequestCreate)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal request body for creating pull request")
+	}
+	url := fmt.Sprintf("%s/repositories/%s/pullrequests", p.APIURL(p.instanceURL), repositoryID)
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), payload)
+	if err != nil {
+		return "", errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return "", common.Errorf(common.NotFound, "failed to create pull request through URL %s", url)
+	} else if code != http.StatusCreated {
+		return "", errors.Errorf("failed to create pull request through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+
+	var pr struct {
+		Links Links `json:"links"`
+	}
+	if err := json.Unmarshal([]byte(body), &pr); err != nil {
+		return "", errors.Wrap(err, "failed to unmarshal pull request")
+	}
+	return pr.Links.HTML.Href, nil
+}
+
 // CreateWebhook creates a webhook in the repository with given payload.
 //
 // Docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-repositories/#api-repositories-workspace-repo-slug-hooks-post
@@ -552,6 +679,58 @@ func (p *Provider) DeleteWebhook(ctx context.Context, repositoryID, webhookID st
 	return nil
 }
 
+// BuildStatus is the API message for Bitbucket commit build status.
+type BuildStatus struct {
+	// Key identifies the build status, the status with the same key replaces the previous one.
+	Key string `json:"key"`
+	// Available values: "INPROGRESS", "SUCCESSFUL", "FAILED", "STOPPED".
+	State string `json:"state"`
+	Name  string `json:"name"`
+	// URL is required by Bitbucket.
+	URL         string `json:"url"`
+	Description string `json:"description,omitempty"`
+}
+
+// CreateCommitStatus creates or updates the build status of the commit.
+// Bitbucket doesn't support annotations on the build status.
+//
+// Docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-commit-statuses/#api-repositories-workspace-repo-slug-commit-commit-statuses-build-post
+func (p *Provider) CreateCommitStatus(ctx context.Context, repositoryID, _, commitID string, status *vcs.CommitStatus) error {
+	state := "FAILED"
+	switch status.State {
+	case vcs.CommitStatusPending:
+		state = "INPROGRESS"
+	case vcs.CommitStatusSuccess:
+		state = "SUCCESSFUL"
+	}
+	payload, err := json.Marshal(&BuildStatus{
+		Key:         status.Name,
+		State:       state,
+		Name:        status.Name,
+		URL:         status.TargetURL,
+		Description: status.Description,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request body for creating build status")
+	}
+	url := fmt.Sprintf("%s/repositories/%s/commit/%s/statuses/build", p.APIURL(p.instanceURL), repositoryID, commitID)
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), payload)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to create build status through URL %s", url)
+	} else if code >= 300 {
+		return errors.Errorf("failed to create build status through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	return nil
+}
+
 func (p *Provider) getAuthorization() string {
 	encoded := base64.StdEncoding.EncodeToString([]byte(p.authToken))
 	return fmt.Sprintf("Basic %s", encoded)
@@ -0,0 +1,788 @@
+// Package gitea is the plugin for Gitea and Forgejo.
+package gitea
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/plugin/vcs"
+	"github.com/bytebase/bytebase/backend/plugin/vcs/internal"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	// apiPageSize is the default page size when making API requests.
+	// Gitea caps the page size with the MAX_RESPONSE_ITEMS setting, which is 50 by default.
+	apiPageSize = 50
+)
+
+func init() {
+	vcs.Register(storepb.VCSType_GITEA, newProvider)
+}
+
+var _ vcs.Provider = (*Provider)(nil)
+
+// Provider is a Gitea VCS provider.
+// Forgejo is a fork of Gitea and keeps the same API, so the provider works for both of them.
+type Provider struct {
+	instanceURL string
+	authToken   string
+}
+
+func newProvider(config vcs.ProviderConfig) vcs.Provider {
+	return &Provider{
+		instanceURL: config.InstanceURL,
+		authToken:   config.AuthToken,
+	}
+}
+
+// APIURL returns the API URL path of Gitea.
+func (*Provider) APIURL(instanceURL string) string {
+	return fmt.Sprintf("%s/api/v1", strings.TrimSuffix(instanceURL, "/"))
+}
+
+// Repository represents a Gitea API response for a repository.
+type Repository struct {
+	ID          int64  `json:"id"`
+	Name        string `json:"name"`
+	FullName    string `json:"full_name"`
+	HTMLURL     string `json:"html_url"`
+	Permissions struct {
+		Admin bool `json:"admin"`
+	} `json:"permissions"`
+}
+
+// WebhookInfo represents a Gitea API response for the webhook information.
+type WebhookInfo struct {
+	ID int64 `json:"id"`
+}
+
+// WebhookConfig represents the Gitea API message for webhook configuration.
+type WebhookConfig struct {
+	// URL is the URL to which the payloads will be delivered.
+	URL string `json:"url"`
+	// ContentType is the media type used to serialize the payloads. Supported
+	// values include "json" and "form".
+	ContentType string `json:"content_type"`
+	// Secret is the secret will be used as the key to generate the HMAC hex digest
+	// value in the "X-Gitea-Signature" header.
+	Secret string `json:"secret"`
+}
+
+// WebhookCreate represents a Gitea API request for creating a webhook.
+type WebhookCreate struct {
+	// Type is the webhook type. Use "gitea" for the plain JSON payloads, which is also supported by Forgejo.
+	Type   string        `json:"type"`
+	Config WebhookConfig `json:"config"`
+	// Events determines what events the hook is triggered for.
+	// The "pull_request" event includes the pull request synchronization.
+	Events       []string `json:"events"`
+	BranchFilter string   `json:"branch_filter"`
+	Active       bool     `json:"active"`
+}
+
+// FetchRepositoryList fetches all repositories where the authenticated user
+// has admin permissions, which is required to create webhook in the repository.
+//
+// NOTE: The repository ID is the full name, e.g. "owner/repo", because Gitea API
+// addresses the repository by the owner and the name.
+//
+// Docs: https://docs.gitea.com/api/1.22/#tag/user/operation/userCurrentListRepos
+func (p *Provider) FetchRepositoryList(ctx context.Context, listAll bool) ([]*vcs.Repository, error) {
+	var giteaRepos []Repository
+	page := 1
+	for {
+		repos, hasNextPage, err := p.fetchPaginatedRepositoryList(ctx, page)
+		if err != nil {
+			return nil, errors.Wrap(err, "fetch paginated list")
+		}
+		giteaRepos = append(giteaRepos, repos...)
+
+		if !hasNextPage || !listAll {
+			break
+		}
+		page++
+	}
+
+	var allRepos []*vcs.Repository
+	for _, r := range giteaRepos {
+		if !r.Permissions.Admin {
+			continue
+		}
+		allRepos = append(allRepos,
+			&vcs.Repository{
+				ID:       r.FullName,
+				Name:     r.Name,
+				FullPath: r.FullName,
+				WebURL:   r.HTMLURL,
+			},
+		)
+	}
+	return allRepos, nil
+}
+
+// fetchPaginatedRepositoryList fetches repositories where the authenticated
+// user has access to in given page. It returns the paginated results along
+// with a boolean indicating whether the next page exists.
+func (p *Provider) fetchPaginatedRepositoryList(ctx context.Context, page int) (repos []Repository, hasNextPage bool, err error) {
+	url := fmt.Sprintf("%s/user/repos?page=%d&limit=%d", p.APIURL(p.instanceURL), page, apiPageSize)
+	code, body, err := internal.Get(ctx, url, p.getAuthorization())
+	if err != nil {
+		return nil, false, errors.Wrapf(err, "GET %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return nil, false, common.Errorf(common.NotFound, "failed to fetch repository list from URL %s", url)
+	} else if code >= 300 {
+		return nil, false,
+			errors.Errorf("failed to fetch repository list from URL %s, status code: %d, body: %s",
+				url,
+				code,
+				body,
+			)
+	}
+
+	if err := json.Unmarshal([]byte(body), &repos); err != nil {
+		return nil, false, errors.Wrap(err, "unmarshal")
+	}
+	return repos, len(repos) >= apiPageSize, nil
+}
+
+// ReadFileContent reads the content of the given file in the repository.
+//
+// Docs: https://docs.gitea.com/api/1.22/#tag/repository/operation/repoGetRawFile
+func (p *Provider) ReadFileContent(ctx context.Context, repositoryID, filePath string, refInfo vcs.RefInfo) (string, error) {
+	url := fmt.Sprintf("%s/repos/%s/raw/%s?ref=%s", p.APIURL(p.instanceURL), repositoryID, escapeFilePath(filePath), url.QueryEscape(refInfo.RefName))
+	code, body, err := internal.Get(ctx, url, p.getAuthorization())
+	if err != nil {
+		return "", errors.Wrapf(err, "GET %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return "", common.Errorf(common.NotFound, "failed to read file content from URL %s", url)
+	} else if code >= 300 {
+		return "",
+			errors.Errorf("failed to read file content from URL %s, status code: %d, body: %s",
+				url,
+				code,
+				body,
+			)
+	}
+	return body, nil
+}
+
+// PullRequestFile is the API message for files in Gitea pull request.
+type PullRequestFile struct {
+	FileName string `json:"filename"`
+	// The file status in Gitea PR.
+	// Available values: "added", "deleted", "changed", "renamed", "copied".
+	Status string `json:"status"`
+	// The file web URL at the head commit, e.g. https://gitea.com/owner/repo/src/commit/{sha}/file1.sql
+	HTMLURL string `json:"html_url"`
+	// The file content API URL, which contains the head commit in the query.
+	// Example: https://gitea.com/api/v1/repos/owner/repo/contents/file1.sql?ref={sha}
+	ContentsURL string `json:"contents_url"`
+}
+
+// ListPullRequestFile lists the changed files in the pull request.
+//
+// Docs: https://docs.gitea.com/api/1.22/#tag/repository/operation/repoGetPullRequestFiles
+func (p *Provider) ListPullRequestFile(ctx context.Context, repositoryID, pullRequestID string) ([]*vcs.PullRequestFile, error) {
+	var allPRFiles []PullRequestFile
+	page := 1
+	for {
+		fileList, err := p.listPaginatedPullRequestFile(ctx, repositoryID, pullRequestID, page)
+		if err != nil {
+			return nil, errors.Wrap(err, "Failed to list pull request file")
+		}
+
+		allPRFiles = append(allPRFiles, fileList...)
+		if len(fileList) < apiPageSize {
+			break
+		}
+		page++
+	}
+
+	var res []*vcs.PullRequestFile
+	for _, file := range allPRFiles {
+		u, err := url.Parse(file.ContentsURL)
+		if err != nil {
+			slog.Debug("Failed to parse content url for file",
+				slog.String("content_url", file.ContentsURL),
+				slog.String("file", file.FileName),
+				log.BBError(err),
+			)
+			continue
+		}
+		ref := u.Query().Get("ref")
+		if ref == "" {
+			continue
+		}
+
+		res = append(res, &vcs.PullRequestFile{
+			Path:         file.FileName,
+			LastCommitID: ref,
+			IsDeleted:    file.Status == "deleted",
+			// Gitea has no stable anchor for the file in the PR diff, so we use the file web URL at the head commit.
+			// Web URL for file with a specific line:
+			// {file web URL}#L{line}
+			WebURL: file.HTMLURL,
+		})
+	}
+
+	return res, nil
+}
+
+// listPaginatedPullRequestFile lists the changed files in the pull request with pagination.
+func (p *Provider) listPaginatedPullRequestFile(ctx context.Context, repositoryID, pullRequestID string, page int) ([]PullRequestFile, error) {
+	requestURL := fmt.Sprintf("%s/repos/%s/pulls/%s/files?limit=%d&page=%d", p.APIURL(p.instanceURL), repositoryID, pullRequestID, apiPageSize, page)
+	code, body, err := internal.Get(ctx, requestURL, p.getAuthorization())
+	if err != nil {
+		return nil, errors.Wrapf(err, "GET %s", requestURL)
+	}
+	if code == http.StatusNotFound {
+		return nil, common.Errorf(common.NotFound, "failed to list pull request file from URL %s", requestURL)
+	} else if code >= 300 {
+		return nil, errors.Errorf("failed to list pull request file from URL %s, status code: %d, body: %s",
+			requestURL,
+			code,
+			body,
+		)
+	}
+
+	var prFiles []PullRequestFile
+	if err := json.Unmarshal([]byte(body), &prFiles); err != nil {
+		return nil, err
+	}
+	return prFiles, nil
+}
+
+type Comment struct {
+	ID   int64  `json:"id,omitempty"`
+	Body string `json:"body"`
+}
+
+// CreatePullRequestComment creates a comment on the pull request.
+//
+// Pull requests are issues in Gitea, so we use the issue comment API.
+// Docs: https://docs.gitea.com/api/1.22/#tag/issue/operation/issueCreateComment
+func (p *Provider) CreatePullRequestComment(ctx context.Context, repositoryID, pullRequestID, comment string) error {
+	commentCreatePayload, err := json.Marshal(Comment{Body: comment})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request body for creating pull request comment")
+	}
+	url := fmt.Sprintf("%s/repos/%s/issues/%s/comments", p.APIURL(p.instanceURL), repositoryID, pullRequestID)
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), commentCreatePayload)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to create pull request comment through URL %s", url)
+	}
+
+	// Gitea returns 201 HTTP status codes upon successful issue comment creation,
+	if code != http.StatusCreated {
+		return errors.Errorf("failed to create pull request comment through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	return nil
+}
+
+// ListPullRequestComments lists comments in a pull request.
+//
+// Docs: https://docs.gitea.com/api/1.22/#tag/issue/operation/issueGetComments
+func (p *Provider) ListPullRequestComments(ctx context.Context, repositoryID, pullRequestID string) ([]*vcs.PullRequestComment, error) {
+	// The API returns all comments without pagination.
+	url := fmt.Sprintf("%s/repos/%s/issues/%s/comments", p.APIURL(p.instanceURL), repositoryID, pullRequestID)
+
+	code, body, err := internal.Get(ctx, url, p.getAuthorization())
+	if err != nil {
+		return nil, errors.Wrapf(err, "GET %s", url)
+	}
+	if code == http.StatusNotFound {
+		return nil, common.Errorf(common.NotFound, "failed to list pull request comments from URL %s", url)
+	} else if code >= 300 {
+		return nil, errors.Errorf("failed to list pull request comments from URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+
+	var comments []Comment
+	if err := json.Unmarshal([]byte(body), &comments); err != nil {
+		return nil, err
+	}
+
+	var res []*vcs.PullRequestComment
+	for _, comment := range comments {
+		res = append(res, &vcs.PullRequestComment{
+			ID:      strconv.FormatInt(comment.ID, 10),
+			Content: comment.Body,
+		})
+	}
+	return res, nil
+}
+
+// UpdatePullRequestComment updates a comment in a pull request.
+//
+// Docs: https://docs.gitea.com/api/1.22/#tag/issue/operation/issueEditComment
+func (p *Provider) UpdatePullRequestComment(ctx context.Context, repositoryID, _ string, comment *vcs.PullRequestComment) error {
+	url := fmt.Sprintf("%s/repos/%s/issues/comments/%s", p.APIURL(p.instanceURL), repositoryID, comment.ID)
+	commentUpdatePayload, err := json.Marshal(Comment{Body: comment.Content})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request body for updating pull request comment")
+	}
+
+	code, body, err := internal.Patch(ctx, url, p.getAuthorization(), commentUpdatePayload)
+	if err != nil {
+		return errors.Wrapf(err, "PATCH %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "cannot found pull request comment through URL %s", url)
+	} else if code >= 300 {
+		return errors.Errorf("failed to update pull request comment through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+
+	return nil
+}
+
+// Branch is the API message for Gitea branch.
+type Branch struct {
+	Name   string `json:"name"`
+	Commit struct {
+		ID string `json:"id"`
+	} `json:"commit"`
+}
+
+// GetBranch gets the given branch in the repository.
+//
+// Docs: https://docs.gitea.com/api/1.22/#tag/repository/operation/repoGetBranch
+func (p *Provider) GetBranch(ctx context.Context, repositoryID, branchName string) (*vcs.BranchInfo, error) {
+	url := fmt.Sprintf("%s/repos/%s/branches/%s", p.APIURL(p.instanceURL), repositoryID, url.PathEscape(branchName))
+	code, body, err := internal.Get(ctx, url, p.getAuthorization())
+	if err != nil {
+		return nil, errors.Wrapf(err, "GET %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return nil, common.Errorf(common.NotFound, "failed to get branch from URL %s", url)
+	} else if code >= 300 {
+		return nil, errors.Errorf("failed to get branch from URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+
+	res := new(Branch)
+	if err := json.Unmarshal([]byte(body), res); err != nil {
+		return nil, err
+	}
+
+	return &vcs.BranchInfo{
+		Name:         res.Name,
+		LastCommitID: res.Commit.ID,
+	}, nil
+}
+
+// BranchCreate is the API message to create the Gitea branch.
+type BranchCreate struct {
+	NewBranchName string `json:"new_branch_name"`
+	OldRefName    string `json:"old_ref_name"`
+}
+
+// CreateBranch creates a branch pointing to the given commit.
+//
+// Docs: https://docs.gitea.com/api/1.22/#tag/repository/operation/repoCreateBranch
+func (p *Provider) CreateBranch(ctx context.Context, repositoryID, branchName, commitID string) error {
+	payload, err := json.Marshal(&BranchCreate{
+		NewBranchName: branchName,
+		OldRefName:    commitID,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request body for creating branch")
+	}
+	url := fmt.Sprintf("%s/repos/%s/branches", p.APIURL(p.instanceURL), repositoryID)
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), payload)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to create branch through URL %s", url)
+	} else if code == http.StatusConflict {
+		return common.Errorf(common.Conflict, "failed to create branch through URL %s, body: %s", url, body)
+	} else if code != http.StatusCreated {
+		return errors.Errorf("failed to create branch through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	return nil
+}
+
+// ChangeFileOperation is the API message for the file operation in the Gitea commit.
+type ChangeFileOperation struct {
+	// Available values: "create", "update", "delete".
+	Operation string `json:"operation"`
+	Path      string `json:"path"`
+	// Content is base64 encoded.
+	Content string `json:"content"`
+	// SHA is the blob SHA of the file to update.
+	SHA string `json:"sha,omitempty"`
+}
+
+// ChangeFilesCreate is the API message to change multiple files in a Gitea commit.
+type ChangeFilesCreate struct {
+	Branch  string                 `json:"branch"`
+	Message string                 `json:"message"`
+	Files   []*ChangeFileOperation `json:"files"`
+}
+
+// CommitFiles creates or updates the files on the branch in a single commit.
+//
+// Docs: https://docs.gitea.com/api/1.22/#tag/repository/operation/repoChangeFiles
+func (p *Provider) CommitFiles(ctx context.Context, repositoryID, branchName, message string, files []*vcs.CommitFile) error {
+	changeFiles := &ChangeFilesCreate{
+		Branch:  branchName,
+		Message: message,
+	}
+	for _, file := range files {
+		// Gitea requires the blob SHA to update the file.
+		sha, err := p.getFileSHA(ctx, repositoryID, file.Path, branchName)
+		if err != nil {
+			return err
+		}
+		operation := "create"
+		if sha != "" {
+			operation = "update"
+		}
+		changeFiles.Files = append(changeFiles.Files, &ChangeFileOperation{
+			Operation: operation,
+			Path:      strings.TrimPrefix(file.Path, "/"),
+			Content:   base64.StdEncoding.EncodeToString([]byte(file.Content)),
+			SHA:       sha,
+		})
+	}
+	payload, err := json.Marshal(changeFiles)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request body for changing files")
+	}
+	url := fmt.Sprintf("%s/repos/%s/contents", p.APIURL(p.instanceURL), repositoryID)
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), payload)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to change files through URL %s", url)
+	} else if code != http.StatusCreated {
+		return errors.Errorf("failed to change files through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	return nil
+}
+
+// getFileSHA returns the blob SHA of the file on the ref, or an empty string if the file does not exist.
+//
+// Docs: https://docs.gitea.com/api/1.22/#tag/repository/operation/repoGetContents
+func (p *Provider) getFileSHA(ctx context.Context, repositoryID, filePath, ref string) (string, error) {
+	url := fmt.Sprintf("%s/repos/%s/contents/%s?ref=%s", p.APIURL(p.instanceURL), repositoryID, escapeFilePath(filePath), url.QueryEscape(ref))
+	code, body, err := internal.Get(ctx, url, p.getAuthorization())
+	if err != nil {
+		return "", errors.Wrapf(err, "GET %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return "", nil
+	} else if code >= 300 {
+		return "",
+			errors.Errorf("failed to get file from URL %s, status code: %d, body: %s",
+				url,
+				code,
+				body,
+			)
+	}
+
+	var file struct {
+		Type string `json:"type"`
+		Sha  string `json:"sha"`
+	}
+	if err := json.Unmarshal([]byte(body), &file); err != nil {
+		return "", errors.Wrapf(err, "failed to unmarshal file %q", filePath)
+	}
+	if file.Type != "file" {
+		return "", errors.Errorf("expecting %q to be a file, but found %s", filePath, file.Type)
+	}
+	return file.Sha, nil
+}
+
+// PullRequestCreate is the API message to create the Gitea pull request.
+type PullRequestCreate struct {
+	Title string `json:"title"`
+	Body  string `json:"body"`
+	Head  string `json:"head"`
+	Base  string `json:"base"`
+}
+
+// CreatePullRequest creates a pull request.
+//
+// Docs: https://docs.gitea.com/api/1.22/#tag/repository/operation/repoCreatePullRequest
+func (p *Provider) CreatePullRequest(ctx context.Context, repositoryID string, create *vcs.PullRequestCreate) (string, error) {
+	payload, err := json.Marshal(&PullRequestCreate{
+		Title: create.Title,
+		Body:  create.Description,
+		Head:  create.SourceBranch,
+		Base:  create.TargetBranch,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal request body for creating pull request")
+	}
+	url := fmt.Sprintf("%s/repos/%s/pulls", p.APIURL(p.instanceURL), repositoryID)
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), payload)
+	if err != nil {
+		return "", errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return "", common.Errorf(common.NotFound, "failed to create pull request through URL %s", url)
+	} else if code != http.StatusCreated {
+		return "", errors.Errorf("failed to create pull request through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+
+	var pr struct {
+		HTMLURL string `json:"html_url"`
+	}
+	if err := json.Unmarshal([]byte(body), &pr); err != nil {
+		return "", errors.Wrap(err, "failed to unmarshal pull request")
+	}
+	return pr.HTMLURL, nil
+}
+
+// CreateWebhook creates a webhook in the repository with given payload.
+//
+// Docs: https://docs.gitea.com/api/1.22/#tag/repository/operation/repoCreateHook
+func (p *Provider) CreateWebhook(ctx context.Context, repositoryID string, payload []byte) (string, error) {
+	url := fmt.Sprintf("%s/repos/%s/hooks", p.APIURL(p.instanceURL), repositoryID)
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), payload)
+	if err != nil {
+		return "", errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return "", common.Errorf(common.NotFound, "failed to create webhook through URL %s", url)
+	}
+
+	// Gitea returns 201 HTTP status codes upon successful webhook creation.
+	if code != http.StatusCreated {
+		return "", errors.Errorf("failed to create webhook through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+
+	var webhookInfo WebhookInfo
+	if err = json.Unmarshal([]byte(body), &webhookInfo); err != nil {
+		return "", errors.Wrap(err, "unmarshal body")
+	}
+	return strconv.FormatInt(webhookInfo.ID, 10), nil
+}
+
+// DeleteWebhook deletes the webhook from the repository.
+//
+// Docs: https://docs.gitea.com/api/1.22/#tag/repository/operation/repoDeleteHook
+func (p *Provider) DeleteWebhook(ctx context.Context, repositoryID, webhookID string) error {
+	url := fmt.Sprintf("%s/repos/%s/hooks/%s", p.APIURL(p.instanceURL), repositoryID, webhookID)
+	code, body, err := internal.Delete(ctx, url, p.getAuthorization())
+	if err != nil {
+		return errors.Wrapf(err, "DELETE %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return nil // It is OK if the webhook has already gone
+	} else if code >= 300 {
+		return errors.Errorf("failed to delete webhook through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	return nil
+}
+
+// CommitStatus is the API message for Gitea commit status.
+type CommitStatus struct {
+	// Available values: "pending", "success", "error", "failure", "warning".
+	State       string `json:"state"`
+	TargetURL   string `json:"target_url,omitempty"`
+	Description string `json:"description,omitempty"`
+	Context     string `json:"context"`
+}
+
+// CreateCommitStatus creates the commit status. The status with the same context replaces the previous one.
+// Gitea doesn't support annotations on the commit status.
+//
+// Docs: https://docs.gitea.com/api/1.22/#tag/repository/operation/repoCreateStatus
+func (p *Provider) CreateCommitStatus(ctx context.Context, repositoryID, _, commitID string, status *vcs.CommitStatus) error {
+	state := "failure"
+	switch status.State {
+	case vcs.CommitStatusPending:
+		state = "pending"
+	case vcs.CommitStatusSuccess:
+		state = "success"
+	}
+	payload, err := json.Marshal(&CommitStatus{
+		State:       state,
+		TargetURL:   status.TargetURL,
+		Description: status.Description,
+		Context:     status.Name,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request body for creating commit status")
+	}
+	url := fmt.Sprintf("%s/repos/%s/statuses/%s", p.APIURL(p.instanceURL), repositoryID, commitID)
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), payload)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to create commit status through URL %s", url)
+	}
+
+	// Gitea returns 201 HTTP status codes upon successful commit status creation.
+	if code != http.StatusCreated {
+		return errors.Errorf("failed to create commit status through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	return nil
+}
+
+func (p *Provider) getAuthorization() string {
+	return fmt.Sprintf("token %s", p.authToken)
+}
+
+// GetPullRequestMergedCommit gets the merge commit of the pull request.
+//
+// Docs: https://docs.gitea.com/api/1.22/#tag/repository/operation/repoGetPullRequest
+func (p *Provider) GetPullRequestMergedCommit(ctx context.Context, repoID string, prID string) (string, error) {
+	url := fmt.Sprintf("%s/repos/%s/pulls/%s", p.APIURL(p.instanceURL), repoID, prID)
+	code, body, err := internal.Get(ctx, url, p.getAuthorization())
+	if err != nil {
+		return "", errors.Wrapf(err, "GET %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return "", common.Errorf(common.NotFound, "failed to get pull request from URL %s", url)
+	} else if code >= 300 {
+		return "", errors.Errorf("failed to get pull request from URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+
+	var pr struct {
+		MergeCommitSha string `json:"merge_commit_sha"`
+	}
+
+	if err := json.Unmarshal([]byte(body), &pr); err != nil {
+		return "", errors.Wrapf(err, "failed to unmarshal")
+	}
+
+	return pr.MergeCommitSha, nil
+}
+
+// GetDirectoryFiles gets the files directly under the directory with the content at the commit.
+//
+// Docs: https://docs.gitea.com/api/1.22/#tag/repository/operation/repoGetContents
+func (p *Provider) GetDirectoryFiles(ctx context.Context, repoID string, commitSha string, path string) ([]*vcs.File, error) {
+	path = strings.Trim(path, "/")
+	url := fmt.Sprintf("%s/repos/%s/contents/%s?ref=%s", p.APIURL(p.instanceURL), repoID, escapeFilePath(path), url.QueryEscape(commitSha))
+	code, body, err := internal.Get(ctx, url, p.getAuthorization())
+	if err != nil {
+		return nil, errors.Wrapf(err, "GET %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return nil, common.Errorf(common.NotFound, "failed to get directory from URL %s", url)
+	} else if code >= 300 {
+		return nil,
+			errors.Errorf("failed to get directory from URL %s, status code: %d, body: %s",
+				url,
+				code,
+				body,
+			)
+	}
+
+	// The API returns a list for the directory, and an object for the file.
+	if !strings.HasPrefix(strings.TrimSpace(body), "[") {
+		return nil, errors.Errorf("expecting %q to be a directory", path)
+	}
+	var entries []struct {
+		Type string `json:"type"`
+		Name string `json:"name"`
+		Path string `json:"path"`
+		Sha  string `json:"sha"`
+	}
+	if err := json.Unmarshal([]byte(body), &entries); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal")
+	}
+
+	var files []*vcs.File
+	for _, e := range entries {
+		if e.Type != "file" {
+			continue
+		}
+		content, err := p.ReadFileContent(ctx, repoID, e.Path, vcs.RefInfo{RefType: vcs.RefTypeCommit, RefName: commitSha})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read file %q", e.Path)
+		}
+		files = append(files, &vcs.File{
+			Path:    e.Path,
+			Name:    e.Name,
+			Sha:     e.Sha,
+			Content: content,
+		})
+	}
+
+	return files, nil
+}
+
+// escapeFilePath escapes each segment of the file path, e.g. "dir/a b.sql" to "dir/a%20b.sql".
+func escapeFilePath(filePath string) string {
+	return (&url.URL{Path: strings.TrimPrefix(filePath, "/")}).EscapedPath()
+}
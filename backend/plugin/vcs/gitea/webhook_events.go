@@ -0,0 +1,38 @@
+package gitea
+
+type PullRequestEventType string
+
+const (
+	// A pull request was created.
+	PullRequestEventOpened PullRequestEventType = "opened"
+	// A pull request was closed. If merged is true in the webhook payload, the pull request was merged.
+	PullRequestEventClosed PullRequestEventType = "closed"
+	// A pull request's head branch was updated.
+	// NOTE: Gitea uses "synchronized" rather than "synchronize" used by GitHub.
+	PullRequestEventSynchronized PullRequestEventType = "synchronized"
+)
+
+// PullRequestPushEvent is the json message for pull request push event.
+// Gitea and Forgejo send the same payload, and set both "X-Gitea-Event" and "X-Forgejo-Event" headers.
+// Docs: https://docs.gitea.com/usage/webhooks#event-information
+type PullRequestPushEvent struct {
+	Action PullRequestEventType `json:"action"`
+	Number int                  `json:"number"`
+	// PR close will also send webhook event with "closed" action, so we need to check the "merged" field in "pull_request".
+	PullRequest EventPullRequest `json:"pull_request"`
+}
+
+type EventPullRequest struct {
+	HTMLURL string      `json:"html_url"`
+	Title   string      `json:"title"`
+	Body    string      `json:"body"`
+	Base    EventBranch `json:"base"`
+	Head    EventBranch `json:"head"`
+	Merged  bool        `json:"merged"`
+}
+
+type EventBranch struct {
+	// The branch name, e.g. main.
+	Ref string `json:"ref"`
+	SHA string `json:"sha"`
+}
@@ -501,6 +501,232 @@ type PullRequest struct {
 	HTMLURL string `json:"html_url"`
 }
 
+// ReferenceCreate is the API message for creating a GitHub reference.
+type ReferenceCreate struct {
+	Ref string `json:"ref"`
+	SHA string `json:"sha"`
+}
+
+// CreateBranch creates a branch pointing to the given commit.
+//
+// Docs: https://docs.github.com/en/rest/git/refs#create-a-reference
+func (p *Provider) CreateBranch(ctx context.Context, repositoryID, branchName, commitID string) error {
+	payload, err := json.Marshal(&ReferenceCreate{
+		Ref: fmt.Sprintf("refs/heads/%s", branchName),
+		SHA: commitID,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request body for creating branch")
+	}
+	url := fmt.Sprintf("%s/repos/%s/git/refs", p.APIURL(p.instanceURL), repositoryID)
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), payload)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to create branch through URL %s", url)
+	} else if code == http.StatusUnprocessableEntity {
+		return common.Errorf(common.Conflict, "failed to create branch through URL %s, body: %s", url, body)
+	} else if code != http.StatusCreated {
+		return errors.Errorf("failed to create branch through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	return nil
+}
+
+// TreeEntry is the API message for an entry of the GitHub tree.
+type TreeEntry struct {
+	Path    string `json:"path"`
+	Mode    string `json:"mode"`
+	Type    string `json:"type"`
+	Content string `json:"content"`
+}
+
+// TreeCreate is the API message for creating a GitHub tree.
+type TreeCreate struct {
+	BaseTree string       `json:"base_tree"`
+	Tree     []*TreeEntry `json:"tree"`
+}
+
+// GitCommit is the API message for the GitHub git commit.
+type GitCommit struct {
+	SHA  string `json:"sha"`
+	Tree struct {
+		SHA string `json:"sha"`
+	} `json:"tree"`
+}
+
+// GitCommitCreate is the API message for creating a GitHub git commit.
+type GitCommitCreate struct {
+	Message string   `json:"message"`
+	Tree    string   `json:"tree"`
+	Parents []string `json:"parents"`
+}
+
+// CommitFiles creates or updates the files on the branch in a single commit.
+// It creates a tree on top of the branch head tree, commits the tree and then moves the branch to the new commit.
+//
+// Docs: https://docs.github.com/en/rest/git/trees#create-a-tree
+func (p *Provider) CommitFiles(ctx context.Context, repositoryID, branchName, message string, files []*vcs.CommitFile) error {
+	branch, err := p.GetBranch(ctx, repositoryID, branchName)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get branch %s", branchName)
+	}
+	headCommit, err := p.getGitCommit(ctx, repositoryID, branch.LastCommitID)
+	if err != nil {
+		return err
+	}
+
+	treeCreate := &TreeCreate{BaseTree: headCommit.Tree.SHA}
+	for _, file := range files {
+		treeCreate.Tree = append(treeCreate.Tree, &TreeEntry{
+			Path:    file.Path,
+			Mode:    "100644",
+			Type:    "blob",
+			Content: file.Content,
+		})
+	}
+	tree := new(GitCommit)
+	if err := p.postGitObject(ctx, fmt.Sprintf("%s/repos/%s/git/trees", p.APIURL(p.instanceURL), repositoryID), treeCreate, tree); err != nil {
+		return errors.Wrap(err, "failed to create tree")
+	}
+
+	commit := new(GitCommit)
+	if err := p.postGitObject(ctx, fmt.Sprintf("%s/repos/%s/git/commits", p.APIURL(p.instanceURL), repositoryID), &GitCommitCreate{
+		Message: message,
+		Tree:    tree.SHA,
+		Parents: []string{branch.LastCommitID},
+	}, commit); err != nil {
+		return errors.Wrap(err, "failed to create commit")
+	}
+
+	// Docs: https://docs.github.com/en/rest/git/refs#update-a-reference
+	payload, err := json.Marshal(map[string]string{"sha": commit.SHA})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request body for updating branch")
+	}
+	url := fmt.Sprintf("%s/repos/%s/git/refs/heads/%s", p.APIURL(p.instanceURL), repositoryID, branchName)
+	code, body, err := internal.Patch(ctx, url, p.getAuthorization(), payload)
+	if err != nil {
+		return errors.Wrapf(err, "PATCH %s", url)
+	}
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to update branch through URL %s", url)
+	} else if code == http.StatusUnprocessableEntity {
+		// The branch has moved since we read it, the update is not a fast-forward.
+		return common.Errorf(common.Conflict, "failed to update branch through URL %s, body: %s", url, body)
+	} else if code >= 300 {
+		return errors.Errorf("failed to update branch through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	return nil
+}
+
+// getGitCommit gets the git commit.
+//
+// Docs: https://docs.github.com/en/rest/git/commits#get-a-commit-object
+func (p *Provider) getGitCommit(ctx context.Context, repositoryID, commitID string) (*GitCommit, error) {
+	url := fmt.Sprintf("%s/repos/%s/git/commits/%s", p.APIURL(p.instanceURL), repositoryID, commitID)
+	code, body, err := internal.Get(ctx, url, p.getAuthorization())
+	if err != nil {
+		return nil, errors.Wrapf(err, "GET %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return nil, common.Errorf(common.NotFound, "failed to get commit from URL %s", url)
+	} else if code >= 300 {
+		return nil, errors.Errorf("failed to get commit from URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+
+	commit := new(GitCommit)
+	if err := json.Unmarshal([]byte(body), commit); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal commit")
+	}
+	return commit, nil
+}
+
+// postGitObject creates the git object with the request and unmarshals the created object into the response.
+func (p *Provider) postGitObject(ctx context.Context, url string, request, response any) error {
+	payload, err := json.Marshal(request)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request body")
+	}
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), payload)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to create git object through URL %s", url)
+	} else if code != http.StatusCreated {
+		return errors.Errorf("failed to create git object through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+
+	if err := json.Unmarshal([]byte(body), response); err != nil {
+		return errors.Wrap(err, "failed to unmarshal git object")
+	}
+	return nil
+}
+
+// PullRequestCreate is the API message for creating a GitHub pull request.
+type PullRequestCreate struct {
+	Title string `json:"title"`
+	Body  string `json:"body"`
+	Head  string `json:"head"`
+	Base  string `json:"base"`
+}
+
+// CreatePullRequest creates a pull request.
+//
+// Docs: https://docs.github.com/en/rest/pulls/pulls#create-a-pull-request
+func (p *Provider) CreatePullRequest(ctx context.Context, repositoryID string, create *vcs.PullRequestCreate) (string, error) {
+	payload, err := json.Marshal(&PullRequestCreate{
+		Title: create.Title,
+		Body:  create.Description,
+		Head:  create.SourceBranch,
+		Base:  create.TargetBranch,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal request body for creating pull request")
+	}
+	url := fmt.Sprintf("%s/repos/%s/pulls", p.APIURL(p.instanceURL), repositoryID)
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), payload)
+	if err != nil {
+		return "", errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return "", common.Errorf(common.NotFound, "failed to create pull request through URL %s", url)
+	} else if code != http.StatusCreated {
+		return "", errors.Errorf("failed to create pull request through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+
+	pr := new(PullRequest)
+	if err := json.Unmarshal([]byte(body), pr); err != nil {
+		return "", errors.Wrap(err, "failed to unmarshal pull request")
+	}
+	return pr.HTMLURL, nil
+}
+
 // CreateWebhook creates a webhook in the repository with given payload.
 //
 // Docs: https://docs.github.com/en/rest/webhooks/repos#create-a-repository-webhook
@@ -554,6 +780,223 @@ func (p *Provider) DeleteWebhook(ctx context.Context, repositoryID, webhookID st
 	return nil
 }
 
+const (
+	// maxCheckRunAnnotations is the maximum number of annotations in a check run request.
+	maxCheckRunAnnotations = 50
+	// maxStatusDescriptionLength is the maximum length of the commit status description.
+	maxStatusDescriptionLength = 140
+)
+
+// CheckRunAnnotation is the API message for GitHub check run annotation.
+type CheckRunAnnotation struct {
+	Path      string `json:"path"`
+	StartLine int    `json:"start_line"`
+	EndLine   int    `json:"end_line"`
+	// Available values: "notice", "warning", "failure".
+	AnnotationLevel string `json:"annotation_level"`
+	Title           string `json:"title,omitempty"`
+	Message         string `json:"message"`
+}
+
+// CheckRunOutput is the API message for GitHub check run output.
+type CheckRunOutput struct {
+	Title       string                `json:"title"`
+	Summary     string                `json:"summary"`
+	Annotations []*CheckRunAnnotation `json:"annotations,omitempty"`
+}
+
+// CheckRun is the API message for GitHub check run.
+type CheckRun struct {
+	ID         int64  `json:"id,omitempty"`
+	Name       string `json:"name,omitempty"`
+	HeadSHA    string `json:"head_sha,omitempty"`
+	DetailsURL string `json:"details_url,omitempty"`
+	// Available values: "queued", "in_progress", "completed".
+	Status string `json:"status,omitempty"`
+	// Available values: "success", "failure", "neutral" and so on. Required if the status is "completed".
+	Conclusion string          `json:"conclusion,omitempty"`
+	Output     *CheckRunOutput `json:"output,omitempty"`
+}
+
+// CommitStatus is the API message for GitHub commit status.
+type CommitStatus struct {
+	// Available values: "error", "failure", "pending", "success".
+	State       string `json:"state"`
+	TargetURL   string `json:"target_url,omitempty"`
+	Description string `json:"description,omitempty"`
+	Context     string `json:"context"`
+}
+
+// CreateCommitStatus creates or updates the check run with the annotations on the commit.
+//
+// The Checks API is only available to GitHub Apps, so we fall back to the commit status without
+// the annotations if the token is not allowed to create check runs.
+//
+// Docs: https://docs.github.com/en/rest/checks/runs#create-a-check-run
+func (p *Provider) CreateCommitStatus(ctx context.Context, repositoryID, _, commitID string, status *vcs.CommitStatus) error {
+	err := p.upsertCheckRun(ctx, repositoryID, commitID, status)
+	if err == nil {
+		return nil
+	}
+	if common.ErrorCode(err) != common.NotAuthorized {
+		return err
+	}
+	slog.Debug("Failed to create check run, fall back to the commit status", log.BBError(err))
+	return p.createStatus(ctx, repositoryID, commitID, status)
+}
+
+func (p *Provider) upsertCheckRun(ctx context.Context, repositoryID, commitID string, status *vcs.CommitStatus) error {
+	checkRunID, err := p.getCheckRunID(ctx, repositoryID, commitID, status.Name)
+	if err != nil {
+		return err
+	}
+
+	checkRun := &CheckRun{
+		Name:       status.Name,
+		HeadSHA:    commitID,
+		DetailsURL: status.TargetURL,
+		Output: &CheckRunOutput{
+			Title:   status.Description,
+			Summary: status.Summary,
+		},
+	}
+	switch status.State {
+	case vcs.CommitStatusPending:
+		checkRun.Status = "in_progress"
+	case vcs.CommitStatusSuccess:
+		checkRun.Status = "completed"
+		checkRun.Conclusion = "success"
+	default:
+		checkRun.Status = "completed"
+		checkRun.Conclusion = "failure"
+	}
+	if checkRun.Output.Summary == "" {
+		checkRun.Output.Summary = status.Description
+	}
+	for _, annotation := range status.Annotations {
+		if len(checkRun.Output.Annotations) >= maxCheckRunAnnotations {
+			break
+		}
+		checkRun.Output.Annotations = append(checkRun.Output.Annotations, &CheckRunAnnotation{
+			Path:            annotation.Path,
+			StartLine:       annotation.StartLine,
+			EndLine:         annotation.EndLine,
+			AnnotationLevel: string(annotation.Level),
+			Title:           annotation.Title,
+			Message:         annotation.Message,
+		})
+	}
+	payload, err := json.Marshal(checkRun)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request body for creating check run")
+	}
+
+	// Update the existing check run so that there is only one check run with the name on the commit.
+	var code int
+	var body, url string
+	if checkRunID == 0 {
+		url = fmt.Sprintf("%s/repos/%s/check-runs", p.APIURL(p.instanceURL), repositoryID)
+		code, body, err = internal.Post(ctx, url, p.getAuthorization(), payload)
+		if err != nil {
+			return errors.Wrapf(err, "POST %s", url)
+		}
+	} else {
+		url = fmt.Sprintf("%s/repos/%s/check-runs/%d", p.APIURL(p.instanceURL), repositoryID, checkRunID)
+		code, body, err = internal.Patch(ctx, url, p.getAuthorization(), payload)
+		if err != nil {
+			return errors.Wrapf(err, "PATCH %s", url)
+		}
+	}
+
+	if code == http.StatusForbidden {
+		return common.Errorf(common.NotAuthorized, "not allowed to create check run through URL %s, body: %s", url, body)
+	} else if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to create check run through URL %s", url)
+	} else if code >= 300 {
+		return errors.Errorf("failed to create check run through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	return nil
+}
+
+// getCheckRunID returns the ID of the latest check run with the name on the commit, or 0 if not found.
+//
+// Docs: https://docs.github.com/en/rest/checks/runs#list-check-runs-for-a-git-reference
+func (p *Provider) getCheckRunID(ctx context.Context, repositoryID, commitID, name string) (int64, error) {
+	url := fmt.Sprintf("%s/repos/%s/commits/%s/check-runs?check_name=%s&filter=latest", p.APIURL(p.instanceURL), repositoryID, commitID, url.QueryEscape(name))
+	code, body, err := internal.Get(ctx, url, p.getAuthorization())
+	if err != nil {
+		return 0, errors.Wrapf(err, "GET %s", url)
+	}
+
+	if code == http.StatusForbidden {
+		return 0, common.Errorf(common.NotAuthorized, "not allowed to list check runs from URL %s, body: %s", url, body)
+	} else if code == http.StatusNotFound {
+		return 0, common.Errorf(common.NotFound, "failed to list check runs from URL %s", url)
+	} else if code >= 300 {
+		return 0, errors.Errorf("failed to list check runs from URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+
+	var res struct {
+		CheckRuns []*CheckRun `json:"check_runs"`
+	}
+	if err := json.Unmarshal([]byte(body), &res); err != nil {
+		return 0, errors.Wrapf(err, "failed to unmarshal")
+	}
+	if len(res.CheckRuns) == 0 {
+		return 0, nil
+	}
+	return res.CheckRuns[0].ID, nil
+}
+
+// createStatus creates the commit status. The status with the same context replaces the previous one.
+//
+// Docs: https://docs.github.com/en/rest/commits/statuses#create-a-commit-status
+func (p *Provider) createStatus(ctx context.Context, repositoryID, commitID string, status *vcs.CommitStatus) error {
+	state := "failure"
+	switch status.State {
+	case vcs.CommitStatusPending:
+		state = "pending"
+	case vcs.CommitStatusSuccess:
+		state = "success"
+	}
+	payload, err := json.Marshal(&CommitStatus{
+		State:       state,
+		TargetURL:   status.TargetURL,
+		Description: vcs.TruncateDescription(status.Description, maxStatusDescriptionLength),
+		Context:     status.Name,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request body for creating commit status")
+	}
+	url := fmt.Sprintf("%s/repos/%s/statuses/%s", p.APIURL(p.instanceURL), repositoryID, commitID)
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), payload)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to create commit status through URL %s", url)
+	}
+
+	// GitHub returns 201 HTTP status codes upon successful commit status creation.
+	if code != http.StatusCreated {
+		return errors.Errorf("failed to create commit status through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	return nil
+}
+
 func (p *Provider) getAuthorization() string {
 	return fmt.Sprintf("Bearer %s", p.authToken)
 }
@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -452,6 +453,133 @@ type MergeRequest struct {
 	WebURL string `json:"web_url"`
 }
 
+// CreateBranch creates a branch pointing to the given commit.
+//
+// Docs: https://docs.gitlab.com/ee/api/branches.html#create-repository-branch
+func (p *Provider) CreateBranch(ctx context.Context, repositoryID, branchName, commitID string) error {
+	payload, err := json.Marshal(&BranchCreate{
+		Branch: branchName,
+		Ref:    commitID,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request body for creating branch")
+	}
+	url := fmt.Sprintf("%s/projects/%s/repository/branches", p.APIURL(p.instanceURL), repositoryID)
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), payload)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to create branch through URL %s", url)
+	} else if code == http.StatusBadRequest && strings.Contains(body, "already exists") {
+		return common.Errorf(common.Conflict, "failed to create branch through URL %s, body: %s", url, body)
+	} else if code != http.StatusCreated {
+		return errors.Errorf("failed to create branch through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	return nil
+}
+
+// CommitAction is the API message for an action of the GitLab commit.
+type CommitAction struct {
+	Action   string `json:"action"`
+	FilePath string `json:"file_path"`
+	Content  string `json:"content"`
+}
+
+// CommitCreate is the API message to create the commit.
+type CommitCreate struct {
+	Branch        string          `json:"branch"`
+	CommitMessage string          `json:"commit_message"`
+	Actions       []*CommitAction `json:"actions"`
+}
+
+// CommitFiles creates or updates the files on the branch in a single commit.
+//
+// Docs: https://docs.gitlab.com/ee/api/commits.html#create-a-commit-with-multiple-files-and-actions
+func (p *Provider) CommitFiles(ctx context.Context, repositoryID, branchName, message string, files []*vcs.CommitFile) error {
+	commitCreate := &CommitCreate{
+		Branch:        branchName,
+		CommitMessage: message,
+	}
+	for _, file := range files {
+		// GitLab requires to tell creating from updating the file.
+		action := "update"
+		if _, err := p.readFile(ctx, repositoryID, file.Path, vcs.RefInfo{RefType: vcs.RefTypeBranch, RefName: branchName}); err != nil {
+			if common.ErrorCode(err) != common.NotFound {
+				return errors.Wrapf(err, "failed to read file %s", file.Path)
+			}
+			action = "create"
+		}
+		commitCreate.Actions = append(commitCreate.Actions, &CommitAction{
+			Action:   action,
+			FilePath: file.Path,
+			Content:  file.Content,
+		})
+	}
+	payload, err := json.Marshal(commitCreate)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request body for creating commit")
+	}
+	url := fmt.Sprintf("%s/projects/%s/repository/commits", p.APIURL(p.instanceURL), repositoryID)
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), payload)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to create commit through URL %s", url)
+	} else if code != http.StatusCreated {
+		return errors.Errorf("failed to create commit through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	return nil
+}
+
+// CreatePullRequest creates a merge request.
+//
+// Docs: https://docs.gitlab.com/ee/api/merge_requests.html#create-mr
+func (p *Provider) CreatePullRequest(ctx context.Context, repositoryID string, create *vcs.PullRequestCreate) (string, error) {
+	payload, err := json.Marshal(&MergeRequestCreate{
+		Title:              create.Title,
+		Description:        create.Description,
+		SourceBranch:       create.SourceBranch,
+		TargetBranch:       create.TargetBranch,
+		RemoveSourceBranch: true,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal request body for creating merge request")
+	}
+	url := fmt.Sprintf("%s/projects/%s/merge_requests", p.APIURL(p.instanceURL), repositoryID)
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), payload)
+	if err != nil {
+		return "", errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return "", common.Errorf(common.NotFound, "failed to create merge request through URL %s", url)
+	} else if code != http.StatusCreated {
+		return "", errors.Errorf("failed to create merge request through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+
+	mr := new(MergeRequest)
+	if err := json.Unmarshal([]byte(body), mr); err != nil {
+		return "", errors.Wrap(err, "failed to unmarshal merge request")
+	}
+	return mr.WebURL, nil
+}
+
 // CreateWebhook creates a webhook in the repository with given payload.
 //
 // Docs: https://docs.gitlab.com/ee/api/projects.html#add-project-hook
@@ -540,6 +668,59 @@ func (p *Provider) readFile(ctx context.Context, repositoryID, filePath string,
 	}, nil
 }
 
+// CommitStatus is the API message for GitLab commit status.
+type CommitStatus struct {
+	// Available values: "pending", "running", "success", "failed", "canceled".
+	State       string `json:"state"`
+	Name        string `json:"name"`
+	TargetURL   string `json:"target_url,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// CreateCommitStatus creates or updates the commit status, which shows as an external stage in the merge request pipeline.
+// GitLab doesn't support annotations on the commit status.
+//
+// Docs: https://docs.gitlab.com/ee/api/commits.html#set-the-pipeline-status-of-a-commit
+func (p *Provider) CreateCommitStatus(ctx context.Context, repositoryID, _, commitID string, status *vcs.CommitStatus) error {
+	state := "failed"
+	switch status.State {
+	case vcs.CommitStatusPending:
+		state = "running"
+	case vcs.CommitStatusSuccess:
+		state = "success"
+	}
+	payload, err := json.Marshal(&CommitStatus{
+		State:       state,
+		Name:        status.Name,
+		TargetURL:   status.TargetURL,
+		Description: status.Description,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request body for creating commit status")
+	}
+	url := fmt.Sprintf("%s/projects/%s/statuses/%s", p.APIURL(p.instanceURL), repositoryID, commitID)
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), payload)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to create commit status through URL %s", url)
+	}
+	// GitLab rejects the status in the same state as the existing one, e.g. reviewing the same commit twice.
+	if code == http.StatusBadRequest && strings.Contains(body, "Cannot transition status") {
+		return nil
+	}
+	if code >= 300 {
+		return errors.Errorf("failed to create commit status through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	return nil
+}
+
 func (p *Provider) getAuthorization() string {
 	return fmt.Sprintf("Bearer %s", p.authToken)
 }
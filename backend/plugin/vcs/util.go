@@ -17,3 +17,16 @@ func Branch(ref string) (string, error) {
 
 	return "", errors.Errorf("invalid Git ref: %s", ref)
 }
+
+// TruncateDescription truncates the status description to at most n runes,
+// because some providers reject the description exceeding their limits.
+func TruncateDescription(description string, n int) string {
+	runes := []rune(description)
+	if len(runes) <= n {
+		return description
+	}
+	if n <= 3 {
+		return string(runes[:n])
+	}
+	return string(runes[:n-3]) + "..."
+}
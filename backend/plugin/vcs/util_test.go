@@ -38,3 +38,10 @@ func TestBranch(t *testing.T) {
 		assert.Equal(t, result, test.want)
 	}
 }
+
+func TestTruncateDescription(t *testing.T) {
+	assert.Equal(t, "SQL review passed", TruncateDescription("SQL review passed", 17))
+	assert.Equal(t, "SQL re...", TruncateDescription("SQL review passed", 9))
+	assert.Equal(t, "审核...", TruncateDescription("审核未通过的语句", 5))
+	assert.Equal(t, "SQ", TruncateDescription("SQL review passed", 2))
+}
@@ -82,6 +82,68 @@ type File struct {
 	Content string
 }
 
+// CommitStatusState is the state of the commit status.
+type CommitStatusState string
+
+const (
+	// CommitStatusPending means the check is running.
+	CommitStatusPending CommitStatusState = "pending"
+	// CommitStatusSuccess means the check passed.
+	CommitStatusSuccess CommitStatusState = "success"
+	// CommitStatusFailure means the check failed.
+	CommitStatusFailure CommitStatusState = "failure"
+)
+
+// AnnotationLevel is the level of the annotation.
+type AnnotationLevel string
+
+const (
+	// AnnotationLevelWarning is the warning annotation level.
+	AnnotationLevelWarning AnnotationLevel = "warning"
+	// AnnotationLevelFailure is the failure annotation level.
+	AnnotationLevelFailure AnnotationLevel = "failure"
+)
+
+// CommitStatusAnnotation is the annotation on the lines of a file.
+type CommitStatusAnnotation struct {
+	Path string
+	// StartLine and EndLine are 1-based.
+	StartLine int
+	EndLine   int
+	Level     AnnotationLevel
+	Title     string
+	Message   string
+}
+
+// CommitStatus is the status of a check on the commit.
+type CommitStatus struct {
+	// Name identifies the check. Reporting the status with the same name replaces the previous one.
+	Name  string
+	State CommitStatusState
+	// Description is the one-line description of the status.
+	Description string
+	// Summary is the markdown summary, only used by the providers supporting the detailed check output.
+	Summary string
+	// TargetURL is the link to the details of the check.
+	TargetURL string
+	// Annotations are only used by the providers supporting the file annotations.
+	Annotations []*CommitStatusAnnotation
+}
+
+// CommitFile is the file to create or update in a commit.
+type CommitFile struct {
+	Path    string
+	Content string
+}
+
+// PullRequestCreate is the message to create a pull request.
+type PullRequestCreate struct {
+	Title        string
+	Description  string
+	SourceBranch string
+	TargetBranch string
+}
+
 // Provider is the interface for VCS provider.
 type Provider interface {
 	// Returns the API URL for a given VCS instance URL
@@ -108,6 +170,19 @@ type Provider interface {
 	// ListPullRequestComments lists comments in a pull request.
 	ListPullRequestComments(ctx context.Context, repositoryID, pullRequestID string) ([]*PullRequestComment, error)
 
+	// CreateCommitStatus creates or updates the status of a check on the head commit of the pull request,
+	// so that the branch protection can require the check to pass before merging.
+	CreateCommitStatus(ctx context.Context, repositoryID, pullRequestID, commitID string, status *CommitStatus) error
+
+	// CreateBranch creates a branch pointing to the given commit.
+	CreateBranch(ctx context.Context, repositoryID, branchName, commitID string) error
+
+	// CommitFiles creates or updates the files on the branch in a single commit.
+	CommitFiles(ctx context.Context, repositoryID, branchName, message string, files []*CommitFile) error
+
+	// CreatePullRequest creates a pull request. Returns the web URL of the created pull request on success.
+	CreatePullRequest(ctx context.Context, repositoryID string, create *PullRequestCreate) (string, error)
+
 	// Creates a webhook. Returns the created webhook ID on success.
 	CreateWebhook(ctx context.Context, repositoryID string, payload []byte) (string, error)
 
@@ -0,0 +1,25 @@
+package webhook
+
+import (
+	"fmt"
+)
+
+const (
+	// ActionApproveIssue is the interactive action approving the issue.
+	ActionApproveIssue = "bb.issue.approve"
+	// ActionRejectIssue is the interactive action rejecting the issue.
+	ActionRejectIssue = "bb.issue.reject"
+
+	// activityIssueApprovalNotify is the activity type of the issue approval notifications.
+	activityIssueApprovalNotify = "bb.issue.approval.notify"
+)
+
+// GetApprovalIssueName returns the name of the issue to approve or reject in the interactive messages,
+// or an empty string if the message should not carry the approval actions.
+// Format: projects/{project}/issues/{issue}
+func (c *Context) GetApprovalIssueName() string {
+	if c.ActivityType != activityIssueApprovalNotify || c.Issue == nil || c.Project == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s/issues/%d", c.Project.Name, c.Issue.ID)
+}
@@ -1,11 +1,8 @@
 package webhook
 
 import (
-	"bytes"
 	"encoding/json"
 	"fmt"
-	"io"
-	"net/http"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -80,29 +77,9 @@ func (*DingTalkReceiver) Post(context webhook.Context) error {
 	if err != nil {
 		return errors.Wrapf(err, "failed to marshal webhook POST request to %s", context.URL)
 	}
-	req, err := http.NewRequest("POST",
-		context.URL, bytes.NewBuffer(body))
+	b, err := webhook.DoPost(context, body)
 	if err != nil {
-		return errors.Wrapf(err, "failed to construct webhook POST request to %s", context.URL)
-	}
-
-	req.Header.Set("Content-Type", "application/json")
-	client := &http.Client{
-		Timeout: webhook.Timeout,
-	}
-	resp, err := client.Do(req)
-	if err != nil {
-		return errors.Wrapf(err, "failed to POST webhook to %s", context.URL)
-	}
-
-	b, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return errors.Wrapf(err, "failed to read POST webhook response from %s", context.URL)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return errors.Errorf("failed to POST webhook %s, status code: %d, response body: %s", context.URL, resp.StatusCode, b)
+		return err
 	}
 
 	webhookResponse := &Response{}
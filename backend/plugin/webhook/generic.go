@@ -0,0 +1,267 @@
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	// GenericWebhookType is the type of the generic webhooks.
+	GenericWebhookType = "bb.plugin.webhook.generic"
+
+	cloudEventsSpecVersion = "1.0"
+	cloudEventsContentType = "application/cloudevents+json"
+	// cloudEventsTypePrefix is the reverse-DNS prefix of the CloudEvents types, e.g. com.bytebase.issue.create.
+	cloudEventsTypePrefix = "com.bytebase."
+)
+
+func init() {
+	Register(GenericWebhookType, &GenericReceiver{})
+}
+
+// GenericReceiver is the receiver posting the events rendered by the user-defined templates.
+type GenericReceiver struct{}
+
+// GenericUser is the user in the payload templates.
+type GenericUser struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+// GenericIssue is the issue in the payload templates.
+type GenericIssue struct {
+	ID          int          `json:"id"`
+	Name        string       `json:"name"`
+	Status      string       `json:"status"`
+	Type        string       `json:"type"`
+	Description string       `json:"description"`
+	Creator     *GenericUser `json:"creator"`
+}
+
+// GenericEvent is the event the payload templates of the generic webhooks are executed over.
+// It only exposes what can be sent to the external systems.
+type GenericEvent struct {
+	Level        string         `json:"level"`
+	ActivityType string         `json:"activityType"`
+	Title        string         `json:"title"`
+	Description  string         `json:"description"`
+	Link         string         `json:"link"`
+	CreatedTime  time.Time      `json:"createdTime"`
+	Actor        *GenericUser   `json:"actor"`
+	Issue        *GenericIssue  `json:"issue,omitempty"`
+	Stage        *Stage         `json:"stage,omitempty"`
+	Project      *Project       `json:"project,omitempty"`
+	TaskResult   *TaskResult    `json:"taskResult,omitempty"`
+	Approval     *Approval      `json:"approval,omitempty"`
+	Database     *Database      `json:"database,omitempty"`
+	MentionUsers []*GenericUser `json:"mentionUsers"`
+}
+
+type cloudEvent struct {
+	SpecVersion     string          `json:"specversion"`
+	ID              string          `json:"id"`
+	Source          string          `json:"source"`
+	Type            string          `json:"type"`
+	Subject         string          `json:"subject,omitempty"`
+	Time            time.Time       `json:"time"`
+	DataContentType string          `json:"datacontenttype"`
+	Data            json.RawMessage `json:"data"`
+}
+
+func (*GenericReceiver) Post(context Context) error {
+	body, headers, err := RenderGenericPayload(context)
+	if err != nil {
+		return err
+	}
+	statusCode, b, err := doPost(context, body, headers)
+	if err != nil {
+		return err
+	}
+	if statusCode < 200 || statusCode >= 300 {
+		return errors.Errorf("failed to POST webhook to %s, status code: %d, response body: %s", context.URL, statusCode, b)
+	}
+	return nil
+}
+
+// ValidateGenericConfig validates the payload template and the headers of the generic webhook config.
+// The payload template is rendered over a sample event of each activity type, so that a template
+// referring to the fields absent from some events, e.g. .Issue of the database events, is rejected.
+func ValidateGenericConfig(config *storepb.GenericWebhookConfig, activityTypes []string) error {
+	if _, err := parsePayloadTemplate(config.GetPayloadTemplate()); err != nil {
+		return err
+	}
+	for k := range config.GetHeaders() {
+		switch strings.ToLower(k) {
+		case "":
+			return errors.New("header name cannot be empty")
+		case strings.ToLower(SignatureHeader), strings.ToLower(TimestampHeader), strings.ToLower(DeliveryHeader):
+			return errors.Errorf("header %q is reserved", k)
+		default:
+		}
+	}
+	for _, activityType := range activityTypes {
+		context := getSampleGenericContext(activityType)
+		context.GenericConfig = config
+		if _, _, err := RenderGenericPayload(context); err != nil {
+			return errors.Wrapf(err, "failed to render the payload of %q events", activityType)
+		}
+	}
+	return nil
+}
+
+// getSampleGenericContext returns the sample context of the activity type, which only has the fields
+// always present in the events of the type. The optional fields, e.g. .Approval, must be guarded by
+// the `with` or `if` actions in the payload templates.
+func getSampleGenericContext(activityType string) Context {
+	context := Context{
+		Level:        WebhookInfo,
+		ActivityType: activityType,
+		Title:        "Sample event",
+		Description:  "This is a sample event",
+		Link:         "https://bytebase.example.com",
+		ActorName:    "Bytebase",
+		ActorEmail:   "support@bytebase.com",
+		CreatedTs:    time.Now().Unix(),
+		Project:      &Project{Name: "projects/sample", Title: "Sample"},
+	}
+	switch {
+	case strings.HasPrefix(activityType, "bb.database."):
+		context.Database = &Database{Name: "instances/sample/databases/sample"}
+	case strings.HasPrefix(activityType, "bb.issue."), strings.HasPrefix(activityType, "bb.pipeline."), strings.HasPrefix(activityType, "bb.notify."):
+		context.Issue = &Issue{ID: 1, Name: "Sample issue", Status: "OPEN", Type: "bb.issue.database.general"}
+		switch activityType {
+		case "bb.pipeline.stage.status.update":
+			context.Stage = &Stage{Name: "Sample stage"}
+		case "bb.pipeline.taskrun.status.update":
+			context.TaskResult = &TaskResult{Name: "Sample task", Status: "DONE"}
+		default:
+		}
+	default:
+	}
+	return context
+}
+
+// RenderGenericPayload renders the request body and headers of the generic webhook from the context.
+func RenderGenericPayload(context Context) ([]byte, map[string]string, error) {
+	config := context.GenericConfig
+	tmpl, err := parsePayloadTemplate(config.GetPayloadTemplate())
+	if err != nil {
+		return nil, nil, err
+	}
+	event := newGenericEvent(context)
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, event); err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to execute payload template")
+	}
+	body := buf.Bytes()
+	if !json.Valid(body) {
+		return nil, nil, errors.Errorf("the rendered payload is not valid JSON: %s", body)
+	}
+
+	contentType := "application/json"
+	if config.GetCloudEvents() {
+		body, err = json.Marshal(newCloudEvent(context, event, body))
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to marshal CloudEvents event")
+		}
+		contentType = cloudEventsContentType
+	}
+	headers := map[string]string{"Content-Type": contentType}
+	for k, v := range config.GetHeaders() {
+		headers[k] = v
+	}
+	return body, headers, nil
+}
+
+func parsePayloadTemplate(payloadTemplate string) (*template.Template, error) {
+	if payloadTemplate == "" {
+		payloadTemplate = "{{json .}}"
+	}
+	tmpl, err := template.New("payload").Option("missingkey=error").Funcs(template.FuncMap{
+		"json": func(v any) (string, error) {
+			b, err := json.Marshal(v)
+			if err != nil {
+				return "", err
+			}
+			return string(b), nil
+		},
+		"upper": strings.ToUpper,
+		"lower": strings.ToLower,
+	}).Parse(payloadTemplate)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid payload template")
+	}
+	return tmpl, nil
+}
+
+func newGenericEvent(context Context) *GenericEvent {
+	event := &GenericEvent{
+		Level:        string(context.Level),
+		ActivityType: context.ActivityType,
+		Title:        context.Title,
+		Description:  context.Description,
+		Link:         context.Link,
+		CreatedTime:  time.Unix(context.CreatedTs, 0).UTC(),
+		Actor: &GenericUser{
+			Name:  context.ActorName,
+			Email: context.ActorEmail,
+		},
+		Stage:        context.Stage,
+		Project:      context.Project,
+		TaskResult:   context.TaskResult,
+		Approval:     context.Approval,
+		Database:     context.Database,
+		MentionUsers: []*GenericUser{},
+	}
+	if v := context.Issue; v != nil {
+		event.Issue = &GenericIssue{
+			ID:          v.ID,
+			Name:        v.Name,
+			Status:      v.Status,
+			Type:        v.Type,
+			Description: v.Description,
+		}
+		if v.Creator != nil {
+			event.Issue.Creator = &GenericUser{Name: v.Creator.Name, Email: v.Creator.Email}
+		}
+	}
+	for _, u := range context.MentionEndUsers {
+		event.MentionUsers = append(event.MentionUsers, &GenericUser{Name: u.Name, Email: u.Email})
+	}
+	return event
+}
+
+func newCloudEvent(context Context, event *GenericEvent, data []byte) *cloudEvent {
+	// The delivery ID stays the same across the retries so that the consumers can deduplicate the events.
+	id := context.DeliveryID
+	if id == "" {
+		id = uuid.NewString()
+	}
+	source := "bytebase"
+	if event.Project != nil {
+		source = event.Project.Name
+	}
+	var subject string
+	if event.Issue != nil {
+		subject = fmt.Sprintf("issues/%d", event.Issue.ID)
+	}
+	return &cloudEvent{
+		SpecVersion:     cloudEventsSpecVersion,
+		ID:              id,
+		Source:          source,
+		Type:            cloudEventsTypePrefix + strings.TrimPrefix(event.ActivityType, "bb."),
+		Subject:         subject,
+		Time:            event.CreatedTime,
+		DataContentType: "application/json",
+		Data:            data,
+	}
+}
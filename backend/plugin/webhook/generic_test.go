@@ -0,0 +1,127 @@
+package webhook
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func newTestGenericContext(config *storepb.GenericWebhookConfig) Context {
+	return Context{
+		Level:        WebhookInfo,
+		ActivityType: "bb.issue.approval.notify",
+		Title:        `Issue "approval" needed`,
+		Link:         "https://bytebase.example.com/projects/p1/issues/i-101",
+		ActorName:    "Alice",
+		ActorEmail:   "alice@example.com",
+		CreatedTs:    1700000000,
+		Issue: &Issue{
+			ID:      101,
+			Name:    "i",
+			Status:  "OPEN",
+			Creator: &store.UserMessage{Name: "Bob", Email: "bob@example.com", PasswordHash: "hash"},
+		},
+		Project: &Project{Name: "projects/p1", Title: "P1"},
+		Approval: &Approval{
+			Status:    "PENDING",
+			RiskLevel: "HIGH",
+			Approvers: []*Approver{{Name: "Carol", Email: "carol@example.com", Status: "APPROVED"}},
+		},
+		IMSetting:     &storepb.AppIMSetting{},
+		GenericConfig: config,
+		DeliveryID:    "101",
+	}
+}
+
+func TestRenderGenericPayload(t *testing.T) {
+	a := require.New(t)
+
+	body, headers, err := RenderGenericPayload(newTestGenericContext(nil))
+	a.NoError(err)
+	a.Equal(map[string]string{"Content-Type": "application/json"}, headers)
+	var event map[string]any
+	a.NoError(json.Unmarshal(body, &event))
+	a.Equal(`Issue "approval" needed`, event["title"])
+	a.Equal("2023-11-14T22:13:20Z", event["createdTime"])
+	a.Equal(map[string]any{"name": "Bob", "email": "bob@example.com"}, event["issue"].(map[string]any)["creator"])
+	a.Equal("HIGH", event["approval"].(map[string]any)["riskLevel"])
+	a.NotContains(string(body), "hash")
+
+	body, headers, err = RenderGenericPayload(newTestGenericContext(&storepb.GenericWebhookConfig{
+		PayloadTemplate: `{"summary": {{json .Title}}, "severity": "{{lower .Level}}"{{with .Approval}}, "approvers": [{{range $i, $a := .Approvers}}{{if $i}}, {{end}}{{json $a.Email}}{{end}}]{{end}}}`,
+		Headers:         map[string]string{"Authorization": "GenieKey xxx"},
+	}))
+	a.NoError(err)
+	a.JSONEq(`{"summary": "Issue \"approval\" needed", "severity": "info", "approvers": ["carol@example.com"]}`, string(body))
+	a.Equal(map[string]string{"Content-Type": "application/json", "Authorization": "GenieKey xxx"}, headers)
+
+	body, headers, err = RenderGenericPayload(newTestGenericContext(&storepb.GenericWebhookConfig{
+		PayloadTemplate: `{"issue": {{.Issue.ID}}}`,
+		CloudEvents:     true,
+	}))
+	a.NoError(err)
+	a.Equal(cloudEventsContentType, headers["Content-Type"])
+	a.JSONEq(`{
+		"specversion": "1.0",
+		"id": "101",
+		"source": "projects/p1",
+		"type": "com.bytebase.issue.approval.notify",
+		"subject": "issues/101",
+		"time": "2023-11-14T22:13:20Z",
+		"datacontenttype": "application/json",
+		"data": {"issue": 101}
+	}`, string(body))
+
+	_, _, err = RenderGenericPayload(newTestGenericContext(&storepb.GenericWebhookConfig{PayloadTemplate: `{"title": {{.Title}}}`}))
+	a.ErrorContains(err, "not valid JSON")
+	_, _, err = RenderGenericPayload(newTestGenericContext(&storepb.GenericWebhookConfig{PayloadTemplate: `{{.IMSetting}}`}))
+	a.ErrorContains(err, "failed to execute payload template")
+}
+
+func TestValidateGenericConfig(t *testing.T) {
+	a := require.New(t)
+	a.NoError(ValidateGenericConfig(nil, []string{"bb.issue.create", "bb.database.slow-query"}))
+	a.NoError(ValidateGenericConfig(&storepb.GenericWebhookConfig{PayloadTemplate: `{"title": {{json .Title}}}`, Headers: map[string]string{"X-Custom": "v"}}, nil))
+	a.ErrorContains(ValidateGenericConfig(&storepb.GenericWebhookConfig{PayloadTemplate: `{{.Title`}, nil), "invalid payload template")
+	a.ErrorContains(ValidateGenericConfig(&storepb.GenericWebhookConfig{Headers: map[string]string{"x-bytebase-signature": "v"}}, nil), "reserved")
+
+	// The database events have no issue.
+	issueTemplate := &storepb.GenericWebhookConfig{PayloadTemplate: `{"issue": {{json .Issue.Name}}}`}
+	a.NoError(ValidateGenericConfig(issueTemplate, []string{"bb.issue.create", "bb.pipeline.taskrun.status.update"}))
+	a.ErrorContains(ValidateGenericConfig(issueTemplate, []string{"bb.issue.create", "bb.database.schema.drift"}), `"bb.database.schema.drift" events`)
+	a.NoError(ValidateGenericConfig(&storepb.GenericWebhookConfig{PayloadTemplate: `{"issue": {{with .Issue}}{{json .Name}}{{else}}null{{end}}}`}, []string{"bb.issue.create", "bb.database.schema.drift"}))
+	// The task result is only present in the task run events.
+	a.ErrorContains(ValidateGenericConfig(&storepb.GenericWebhookConfig{PayloadTemplate: `{"task": {{json .TaskResult.Status}}}`}, []string{"bb.issue.create"}), "failed to render")
+}
+
+func TestGenericReceiverPost(t *testing.T) {
+	a := require.New(t)
+	var header http.Header
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header
+		b, err := io.ReadAll(r.Body)
+		a.NoError(err)
+		body = b
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	context := newTestGenericContext(&storepb.GenericWebhookConfig{
+		PayloadTemplate: `{"title": {{json .Title}}}`,
+		Headers:         map[string]string{"X-Routing-Key": "key"},
+	})
+	context.URL = server.URL
+	context.SigningSecret = "secret"
+	a.NoError(Post(GenericWebhookType, context))
+	a.JSONEq(`{"title": "Issue \"approval\" needed"}`, string(body))
+	a.Equal("key", header.Get("X-Routing-Key"))
+	a.Equal(Sign("secret", header.Get(TimestampHeader), body), header.Get(SignatureHeader))
+}
@@ -0,0 +1,101 @@
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// SignatureHeader is the header of the HMAC-SHA256 signature of the request.
+	SignatureHeader = "X-Bytebase-Signature"
+	// TimestampHeader is the header of the unix timestamp when the request is signed.
+	TimestampHeader = "X-Bytebase-Timestamp"
+	// DeliveryHeader is the header of the delivery ID, which stays the same across the retries of a delivery.
+	DeliveryHeader = "X-Bytebase-Delivery"
+)
+
+// Exchange is the HTTP exchange of posting a webhook.
+type Exchange struct {
+	RequestBody  []byte
+	StatusCode   int
+	ResponseBody []byte
+	Latency      time.Duration
+}
+
+// Sign returns the signature of the request body signed at the timestamp.
+// The receivers can verify the request by computing the same signature from the headers and the body.
+func Sign(secret, timestamp string, body []byte) string {
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write([]byte(timestamp))
+	h.Write([]byte("."))
+	h.Write(body)
+	return "sha256=" + hex.EncodeToString(h.Sum(nil))
+}
+
+// DoPost posts the JSON body to the webhook URL and returns the response body if the status code is 200.
+// The request is signed if context.SigningSecret is set, and the exchange is recorded to context.Exchange if set.
+func DoPost(context Context, body []byte) ([]byte, error) {
+	statusCode, b, err := doPost(context, body, map[string]string{"Content-Type": "application/json"})
+	if err != nil {
+		return nil, err
+	}
+	if statusCode != http.StatusOK {
+		return nil, errors.Errorf("failed to POST webhook to %s, status code: %d, response body: %s", context.URL, statusCode, b)
+	}
+	return b, nil
+}
+
+// doPost posts the body with the headers and returns the status code and the body of the response.
+func doPost(context Context, body []byte, headers map[string]string) (int, []byte, error) {
+	req, err := http.NewRequest("POST",
+		context.URL, bytes.NewBuffer(body))
+	if err != nil {
+		return 0, nil, errors.Wrapf(err, "failed to construct webhook POST request to %s", context.URL)
+	}
+
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	// Set the headers below at last so that they cannot be overridden.
+	if context.DeliveryID != "" {
+		req.Header.Set(DeliveryHeader, context.DeliveryID)
+	}
+	if context.SigningSecret != "" {
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		req.Header.Set(TimestampHeader, timestamp)
+		req.Header.Set(SignatureHeader, Sign(context.SigningSecret, timestamp, body))
+	}
+	exchange := context.Exchange
+	if exchange == nil {
+		exchange = &Exchange{}
+	}
+	exchange.RequestBody = body
+
+	client := &http.Client{
+		Timeout: Timeout,
+	}
+	start := time.Now()
+	resp, err := client.Do(req)
+	if err != nil {
+		exchange.Latency = time.Since(start)
+		return 0, nil, errors.Wrapf(err, "failed to POST webhook to %s", context.URL)
+	}
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(resp.Body)
+	exchange.Latency = time.Since(start)
+	exchange.StatusCode = resp.StatusCode
+	exchange.ResponseBody = b
+	if err != nil {
+		return 0, nil, errors.Wrapf(err, "failed to read POST webhook response from %s", context.URL)
+	}
+	return resp.StatusCode, b, nil
+}
@@ -0,0 +1,54 @@
+package webhook
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDoPost(t *testing.T) {
+	a := require.New(t)
+	body := []byte(`{"title":"test"}`)
+
+	var header http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header
+		b, err := io.ReadAll(r.Body)
+		a.NoError(err)
+		if r.Header.Get(SignatureHeader) != Sign("secret", r.Header.Get(TimestampHeader), b) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	exchange := &Exchange{}
+	b, err := DoPost(Context{URL: server.URL, SigningSecret: "secret", DeliveryID: "101", Exchange: exchange}, body)
+	a.NoError(err)
+	a.Equal("ok", string(b))
+	a.Equal("101", header.Get(DeliveryHeader))
+	a.Equal(body, exchange.RequestBody)
+	a.Equal(http.StatusOK, exchange.StatusCode)
+	a.Equal("ok", string(exchange.ResponseBody))
+	a.Positive(exchange.Latency)
+
+	exchange = &Exchange{}
+	_, err = DoPost(Context{URL: server.URL, SigningSecret: "wrong", Exchange: exchange}, body)
+	a.ErrorContains(err, "status code: 401")
+	a.Equal(http.StatusUnauthorized, exchange.StatusCode)
+
+	_, err = DoPost(Context{URL: server.URL}, body)
+	a.Error(err)
+	a.Empty(header.Get(SignatureHeader))
+	a.Empty(header.Get(DeliveryHeader))
+}
+
+func TestSign(t *testing.T) {
+	a := require.New(t)
+	// echo -n '1700000000.{}' | openssl dgst -sha256 -hmac secret
+	a.Equal("sha256=b8569b78799ff9e3cbff0fc2d63a33a2b57f3282abd07c37ae5e8e7d79a5f163", Sign("secret", "1700000000", []byte("{}")))
+}
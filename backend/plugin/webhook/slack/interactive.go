@@ -0,0 +1,155 @@
+package slack
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// signatureHeader is the header of the signature of the requests from Slack.
+	signatureHeader = "X-Slack-Signature"
+	// timestampHeader is the header of the timestamp when Slack sends the requests.
+	timestampHeader = "X-Slack-Request-Timestamp"
+	// maxRequestAge is the maximum age of the requests to prevent replay attacks.
+	maxRequestAge = 5 * time.Minute
+)
+
+// InteractionPayload is the payload of the interactive requests from Slack.
+// https://api.slack.com/reference/interaction-payloads/block-actions
+type InteractionPayload struct {
+	Type string `json:"type"`
+	User struct {
+		ID string `json:"id"`
+	} `json:"user"`
+	Actions []struct {
+		ActionID string `json:"action_id"`
+		Value    string `json:"value"`
+	} `json:"actions"`
+	ResponseURL string `json:"response_url"`
+}
+
+type usersInfoResponse struct {
+	OK    bool   `json:"ok"`
+	Error string `json:"error"`
+	User  struct {
+		Profile struct {
+			Email string `json:"email"`
+		} `json:"profile"`
+	} `json:"user"`
+}
+
+// VerifyRequest verifies the request from Slack is signed by the signing secret of the Slack app.
+// https://api.slack.com/authentication/verifying-requests-from-slack
+func VerifyRequest(signingSecret string, header http.Header, body []byte, now time.Time) error {
+	ts, err := strconv.ParseInt(header.Get(timestampHeader), 10, 64)
+	if err != nil {
+		return errors.Errorf("invalid request timestamp %q", header.Get(timestampHeader))
+	}
+	if age := now.Sub(time.Unix(ts, 0)); age > maxRequestAge || age < -maxRequestAge {
+		return errors.Errorf("request timestamp %d is too old", ts)
+	}
+	h := hmac.New(sha256.New, []byte(signingSecret))
+	h.Write([]byte("v0:" + header.Get(timestampHeader) + ":"))
+	h.Write(body)
+	expected := "v0=" + hex.EncodeToString(h.Sum(nil))
+	if !hmac.Equal([]byte(expected), []byte(header.Get(signatureHeader))) {
+		return errors.New("invalid request signature")
+	}
+	return nil
+}
+
+// ParseInteractionPayload parses the form encoded body of the interactive requests from Slack.
+func ParseInteractionPayload(body []byte) (*InteractionPayload, error) {
+	values, err := url.ParseQuery(string(body))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse body")
+	}
+	var payload InteractionPayload
+	if err := json.Unmarshal([]byte(values.Get("payload")), &payload); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal payload")
+	}
+	return &payload, nil
+}
+
+// GetUserEmail returns the email of the Slack user.
+func GetUserEmail(ctx context.Context, token string, userID string) (string, error) {
+	return newProvider(token).getUserEmail(ctx, userID)
+}
+
+// Respond posts the ephemeral message to the user taking the interactive action.
+// https://api.slack.com/interactivity/handling#message_responses
+func Respond(ctx context.Context, responseURL string, text string) error {
+	body, err := json.Marshal(map[string]any{
+		"response_type":    "ephemeral",
+		"replace_original": false,
+		"text":             text,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal response")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, responseURL, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrapf(err, "failed to new request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := (&http.Client{}).Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "failed to send request")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("received non-200 status code %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// https://api.slack.com/methods/users.info
+func (p *provider) getUserEmail(ctx context.Context, userID string) (string, error) {
+	q := url.Values{}
+	q.Set("user", userID)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://slack.com/api/users.info", nil)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to new request")
+	}
+	req.URL.RawQuery = q.Encode()
+	req.Header.Add("Authorization", "Bearer "+p.token)
+
+	resp, err := p.c.Do(req)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to send GET request")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", errors.Errorf("received non-200 status code %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to read body")
+	}
+	var res usersInfoResponse
+	if err := json.Unmarshal(body, &res); err != nil {
+		return "", errors.Wrapf(err, "failed to unmarshal")
+	}
+	if !res.OK {
+		return "", errors.Errorf("failed to get user, error: %v", res.Error)
+	}
+	if res.User.Profile.Email == "" {
+		return "", errors.Errorf("the email of user %q is not visible", userID)
+	}
+	return res.User.Profile.Email, nil
+}
@@ -0,0 +1,59 @@
+package slack
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyRequest(t *testing.T) {
+	a := require.New(t)
+	secret := "8f742231b10e8888abcd99yyyzzz85a5"
+	body := []byte("payload=%7B%22type%22%3A%22block_actions%22%7D")
+	now := time.Unix(1531420618, 0)
+	sign := func(ts int64, body []byte) http.Header {
+		h := hmac.New(sha256.New, []byte(secret))
+		h.Write([]byte("v0:" + strconv.FormatInt(ts, 10) + ":"))
+		h.Write(body)
+		header := http.Header{}
+		header.Set(timestampHeader, strconv.FormatInt(ts, 10))
+		header.Set(signatureHeader, "v0="+hex.EncodeToString(h.Sum(nil)))
+		return header
+	}
+
+	a.NoError(VerifyRequest(secret, sign(now.Unix(), body), body, now))
+	// Tampered body.
+	a.Error(VerifyRequest(secret, sign(now.Unix(), body), []byte("payload=%7B%7D"), now))
+	// Wrong secret.
+	a.Error(VerifyRequest("another", sign(now.Unix(), body), body, now))
+	// Replayed request.
+	a.Error(VerifyRequest(secret, sign(now.Unix(), body), body, now.Add(10*time.Minute)))
+	// Missing headers.
+	a.Error(VerifyRequest(secret, http.Header{}, body, now))
+}
+
+func TestParseInteractionPayload(t *testing.T) {
+	a := require.New(t)
+	body := url.Values{
+		"payload": []string{`{"type":"block_actions","user":{"id":"U123"},"actions":[{"action_id":"bb.issue.approve","value":"projects/p/issues/1"}],"response_url":"https://hooks.slack.com/actions/1"}`},
+	}.Encode()
+
+	payload, err := ParseInteractionPayload([]byte(body))
+	a.NoError(err)
+	a.Equal("block_actions", payload.Type)
+	a.Equal("U123", payload.User.ID)
+	a.Len(payload.Actions, 1)
+	a.Equal("bb.issue.approve", payload.Actions[0].ActionID)
+	a.Equal("projects/p/issues/1", payload.Actions[0].Value)
+	a.Equal("https://hooks.slack.com/actions/1", payload.ResponseURL)
+
+	_, err = ParseInteractionPayload([]byte("payload=invalid"))
+	a.Error(err)
+}
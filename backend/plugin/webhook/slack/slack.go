@@ -1,13 +1,10 @@
 package slack
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"log/slog"
-	"net/http"
 
 	"github.com/pkg/errors"
 	"go.uber.org/multierr"
@@ -31,9 +28,12 @@ type ElementButton struct {
 
 // Element is the API message for Slack webhook element.
 type Element struct {
-	Type   string        `json:"type"`
-	Button ElementButton `json:"text,omitempty"`
-	URL    string        `json:"url,omitempty"`
+	Type     string        `json:"type"`
+	Button   ElementButton `json:"text,omitempty"`
+	URL      string        `json:"url,omitempty"`
+	ActionID string        `json:"action_id,omitempty"`
+	Value    string        `json:"value,omitempty"`
+	Style    string        `json:"style,omitempty"`
 }
 
 // Block is the API message for Slack webhook block.
@@ -105,18 +105,44 @@ func GetBlocks(context webhook.Context) []Block {
 		},
 	})
 
-	blockList = append(blockList, Block{
-		Type: "actions",
-		ElementList: []Element{
-			{
+	elementList := []Element{
+		{
+			Type: "button",
+			Button: ElementButton{
+				Type: "plain_text",
+				Text: "View in Bytebase",
+			},
+			URL: context.Link,
+		},
+	}
+	// The interactive requests are verified by the signing secret, so only carry the approval buttons if it is set.
+	if issueName := context.GetApprovalIssueName(); issueName != "" && context.IMSetting.GetSlack().GetSigningSecret() != "" {
+		elementList = append(elementList,
+			Element{
 				Type: "button",
 				Button: ElementButton{
 					Type: "plain_text",
-					Text: "View in Bytebase",
+					Text: "Approve",
 				},
-				URL: context.Link,
+				ActionID: webhook.ActionApproveIssue,
+				Value:    issueName,
+				Style:    "primary",
 			},
-		},
+			Element{
+				Type: "button",
+				Button: ElementButton{
+					Type: "plain_text",
+					Text: "Reject",
+				},
+				ActionID: webhook.ActionRejectIssue,
+				Value:    issueName,
+				Style:    "danger",
+			},
+		)
+	}
+	blockList = append(blockList, Block{
+		Type:        "actions",
+		ElementList: elementList,
 	})
 
 	return blockList
@@ -141,29 +167,9 @@ func postMessage(context webhook.Context) error {
 	if err != nil {
 		return errors.Wrapf(err, "failed to marshal webhook POST request to %s", context.URL)
 	}
-	req, err := http.NewRequest("POST",
-		context.URL, bytes.NewBuffer(body))
-	if err != nil {
-		return errors.Wrapf(err, "failed to construct webhook POST request to %s", context.URL)
-	}
-
-	req.Header.Set("Content-Type", "application/json")
-	client := &http.Client{
-		Timeout: webhook.Timeout,
-	}
-	resp, err := client.Do(req)
+	b, err := webhook.DoPost(context, body)
 	if err != nil {
-		return errors.Wrapf(err, "failed to POST webhook to %s", context.URL)
-	}
-
-	b, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return errors.Wrapf(err, "failed to read POST webhook response from %s", context.URL)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return errors.Errorf("failed to POST webhook to %s, status code: %d, response body: %s", context.URL, resp.StatusCode, b)
+		return err
 	}
 
 	if string(b) != "ok" {
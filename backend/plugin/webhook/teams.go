@@ -1,11 +1,9 @@
 package webhook
 
 import (
-	"bytes"
 	"encoding/json"
 	"fmt"
-	"io"
-	"net/http"
+	"strings"
 
 	"github.com/pkg/errors"
 )
@@ -22,7 +20,10 @@ type TeamsWebhookActionTarget struct {
 type TeamsWebhookAction struct {
 	Type       string                     `json:"@type"`
 	Name       string                     `json:"name"`
-	TargetList []TeamsWebhookActionTarget `json:"targets"`
+	TargetList []TeamsWebhookActionTarget `json:"targets,omitempty"`
+	// Target and Body are the URL and the body of the HttpPOST actions.
+	Target string `json:"target,omitempty"`
+	Body   string `json:"body,omitempty"`
 }
 
 // TeamsWebhookSectionFact is the API message for Teams webhook section fact.
@@ -41,8 +42,10 @@ type TeamsWebhookSection struct {
 
 // TeamsWebhook is the API message for Teams webhook.
 type TeamsWebhook struct {
-	Type        string                `json:"@type"`
-	Context     string                `json:"@context"`
+	Type    string `json:"@type"`
+	Context string `json:"@context"`
+	// Originator is the provider ID of the actionable messages.
+	Originator  string                `json:"originator,omitempty"`
 	Summary     string                `json:"summary"`
 	ThemeColor  string                `json:"themeColor"`
 	Title       string                `json:"title"`
@@ -64,9 +67,44 @@ func (*TeamsReceiver) Post(context Context) error {
 		factList = append(factList, TeamsWebhookSectionFact(meta))
 	}
 
+	actionList := []TeamsWebhookAction{
+		{
+			Type: "OpenUri",
+			Name: "View in Bytebase",
+			TargetList: []TeamsWebhookActionTarget{
+				{
+					OS:  "default",
+					URI: context.Link,
+				},
+			},
+		},
+	}
+	originator := context.IMSetting.GetTeams().GetOriginator()
+	if issueName := context.GetApprovalIssueName(); issueName != "" && context.IMSetting.GetTeams().GetEnabled() && originator != "" && context.ExternalURL != "" {
+		for _, action := range []struct {
+			name   string
+			action string
+		}{
+			{name: "Approve", action: ActionApproveIssue},
+			{name: "Reject", action: ActionRejectIssue},
+		} {
+			body, err := json.Marshal(&TeamsActionBody{Issue: issueName, Action: action.action})
+			if err != nil {
+				return errors.Wrapf(err, "failed to marshal action body")
+			}
+			actionList = append(actionList, TeamsWebhookAction{
+				Type:   "HttpPOST",
+				Name:   action.name,
+				Target: strings.TrimSuffix(context.ExternalURL, "/") + TeamsActionPath,
+				Body:   string(body),
+			})
+		}
+	}
+
 	post := TeamsWebhook{
 		Type:       "MessageCard",
 		Context:    "https://schema.org/extensions",
+		Originator: originator,
 		Summary:    context.Title,
 		ThemeColor: themeColor,
 		Title:      context.Title,
@@ -77,46 +115,15 @@ func (*TeamsReceiver) Post(context Context) error {
 				Text:          context.Description,
 			},
 		},
-		ActionList: []TeamsWebhookAction{
-			{
-				Type: "OpenUri",
-				Name: "View in Bytebase",
-				TargetList: []TeamsWebhookActionTarget{
-					{
-						OS:  "default",
-						URI: context.Link,
-					},
-				},
-			},
-		},
+		ActionList: actionList,
 	}
 	body, err := json.Marshal(post)
 	if err != nil {
 		return errors.Wrapf(err, "failed to marshal webhook POST request to %s", context.URL)
 	}
-	req, err := http.NewRequest("POST",
-		context.URL, bytes.NewBuffer(body))
+	b, err := DoPost(context, body)
 	if err != nil {
-		return errors.Wrapf(err, "failed to construct webhook POST request to %s", context.URL)
-	}
-
-	req.Header.Set("Content-Type", "application/json")
-	client := &http.Client{
-		Timeout: Timeout,
-	}
-	resp, err := client.Do(req)
-	if err != nil {
-		return errors.Wrapf(err, "failed to POST webhook to %s", context.URL)
-	}
-
-	b, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return errors.Wrapf(err, "failed to read POST webhook response from %s", context.URL)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return errors.Errorf("failed to POST webhook %s, status code: %d, response body: %s", context.URL, resp.StatusCode, b)
+		return err
 	}
 
 	if string(b) != "1" {
@@ -0,0 +1,201 @@
+package webhook
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/pkg/errors"
+)
+
+const (
+	// TeamsActionPath is the path receiving the HttpPOST actions of the Teams messages.
+	// It must be consistent with the route of the IM service.
+	TeamsActionPath = "/hook/im/teams"
+
+	// The actionable message tokens are issued by Microsoft for the app below.
+	// https://learn.microsoft.com/en-us/outlook/actionable-messages/security-requirements
+	teamsActionTokenIssuer = "https://substrate.office.com/sts/"
+	teamsActionTokenAppID  = "48af08dc-f6d2-435f-b2a7-069abd99c086"
+	teamsActionKeysURL     = "https://substrate.office.com/sts/common/discovery/keys"
+	teamsActionKeysTTL     = 24 * time.Hour
+	// teamsActionKeysMinRefetchInterval limits the refetches of the signing keys for the unknown key IDs.
+	teamsActionKeysMinRefetchInterval = 5 * time.Minute
+)
+
+// TeamsActionBody is the body of the HttpPOST actions of the Teams messages.
+type TeamsActionBody struct {
+	// Issue is the issue name, e.g. projects/{project}/issues/{issue}.
+	Issue string `json:"issue"`
+	// Action is ActionApproveIssue or ActionRejectIssue.
+	Action string `json:"action"`
+}
+
+type teamsActionClaims struct {
+	jwt.RegisteredClaims
+	AppID string `json:"appid"`
+	// Sender is the email address of the sender of the message with the action.
+	Sender string `json:"sender"`
+}
+
+var teamsActionKeys = struct {
+	sync.Mutex
+	keys       map[string]*rsa.PublicKey
+	expireTime time.Time
+	// fetchTime is the time of the latest fetch.
+	fetchTime time.Time
+	// fetching is closed when the ongoing fetch finishes, it's nil if there is no ongoing fetch.
+	fetching chan struct{}
+}{}
+
+// fetchTeamsActionKeysFunc fetches the signing keys, it's replaced in tests.
+var fetchTeamsActionKeysFunc = fetchTeamsActionKeys
+
+// VerifyTeamsActionToken verifies the actionable message token in the Authorization header of the HttpPOST actions,
+// and returns the email of the user taking the action.
+// The audience is the base URL of the action target, e.g. https://bytebase.example.com.
+// The sender is the email address registered with the actionable message provider, which sends the messages.
+func VerifyTeamsActionToken(ctx context.Context, token string, audience string, sender string) (string, error) {
+	return verifyTeamsActionToken(token, audience, sender, func(t *jwt.Token) (any, error) {
+		kid, _ := t.Header["kid"].(string)
+		return getTeamsActionKey(ctx, kid)
+	})
+}
+
+func verifyTeamsActionToken(token string, audience string, sender string, keyFunc jwt.Keyfunc) (string, error) {
+	claims := &teamsActionClaims{}
+	if _, err := jwt.ParseWithClaims(token, claims, keyFunc,
+		jwt.WithValidMethods([]string{"RS256"}),
+		jwt.WithIssuer(teamsActionTokenIssuer),
+		jwt.WithAudience(audience),
+		jwt.WithExpirationRequired(),
+	); err != nil {
+		return "", errors.Wrapf(err, "invalid token")
+	}
+	if claims.AppID != teamsActionTokenAppID {
+		return "", errors.Errorf("invalid token app ID %q", claims.AppID)
+	}
+	// The token of a message sent by others must not take actions on Bytebase.
+	if sender == "" || !strings.EqualFold(claims.Sender, sender) {
+		return "", errors.Errorf("invalid token sender %q", claims.Sender)
+	}
+	if claims.Subject == "" {
+		return "", errors.New("token subject is empty")
+	}
+	return claims.Subject, nil
+}
+
+// getTeamsActionKey returns the signing key of the kid.
+// The keys are refetched if they expire, or for an unknown kid at most once per teamsActionKeysMinRefetchInterval,
+// so that the tokens with random key IDs cannot flood the key endpoint. The concurrent callers share a fetch.
+func getTeamsActionKey(ctx context.Context, kid string) (*rsa.PublicKey, error) {
+	for {
+		teamsActionKeys.Lock()
+		now := time.Now()
+		if key, ok := teamsActionKeys.keys[kid]; ok && now.Before(teamsActionKeys.expireTime) {
+			teamsActionKeys.Unlock()
+			return key, nil
+		}
+		if fetching := teamsActionKeys.fetching; fetching != nil {
+			teamsActionKeys.Unlock()
+			select {
+			case <-fetching:
+				continue
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+		if now.Sub(teamsActionKeys.fetchTime) < teamsActionKeysMinRefetchInterval {
+			teamsActionKeys.Unlock()
+			return nil, errors.Errorf("signing key %q not found", kid)
+		}
+		fetching := make(chan struct{})
+		teamsActionKeys.fetching = fetching
+		teamsActionKeys.Unlock()
+
+		keys, err := fetchTeamsActionKeysFunc(ctx)
+
+		teamsActionKeys.Lock()
+		teamsActionKeys.fetching = nil
+		teamsActionKeys.fetchTime = time.Now()
+		if err == nil {
+			teamsActionKeys.keys = keys
+			teamsActionKeys.expireTime = time.Now().Add(teamsActionKeysTTL)
+		}
+		close(fetching)
+		teamsActionKeys.Unlock()
+		if err != nil {
+			return nil, err
+		}
+		key, ok := keys[kid]
+		if !ok {
+			return nil, errors.Errorf("signing key %q not found", kid)
+		}
+		return key, nil
+	}
+}
+
+func fetchTeamsActionKeys(ctx context.Context) (map[string]*rsa.PublicKey, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, teamsActionKeysURL, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to new request")
+	}
+	client := &http.Client{
+		Timeout: Timeout,
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get signing keys")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("failed to get signing keys, status code: %d", resp.StatusCode)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read signing keys")
+	}
+	return parseJWKS(body)
+}
+
+// parseJWKS parses the RSA keys of the JSON Web Key Set.
+func parseJWKS(body []byte) (map[string]*rsa.PublicKey, error) {
+	var jwks struct {
+		Keys []struct {
+			Kty string `json:"kty"`
+			Kid string `json:"kid"`
+			N   string `json:"n"`
+			E   string `json:"e"`
+		} `json:"keys"`
+	}
+	if err := json.Unmarshal(body, &jwks); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal signing keys")
+	}
+	keys := make(map[string]*rsa.PublicKey)
+	for _, k := range jwks.Keys {
+		if k.Kty != "RSA" {
+			continue
+		}
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid modulus of key %q", k.Kid)
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid exponent of key %q", k.Kid)
+		}
+		keys[k.Kid] = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+	}
+	return keys, nil
+}
@@ -0,0 +1,121 @@
+package webhook
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyTeamsActionToken(t *testing.T) {
+	a := require.New(t)
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	a.NoError(err)
+	keyFunc := func(*jwt.Token) (any, error) {
+		return &key.PublicKey, nil
+	}
+	audience := "https://bytebase.example.com"
+	sender := "bytebase@example.com"
+	newToken := func(modify func(*teamsActionClaims)) string {
+		claims := &teamsActionClaims{
+			RegisteredClaims: jwt.RegisteredClaims{
+				Issuer:    teamsActionTokenIssuer,
+				Subject:   "alice@example.com",
+				Audience:  jwt.ClaimStrings{audience},
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+			},
+			AppID:  teamsActionTokenAppID,
+			Sender: sender,
+		}
+		modify(claims)
+		token, err := jwt.NewWithClaims(jwt.SigningMethodRS256, claims).SignedString(key)
+		a.NoError(err)
+		return token
+	}
+
+	email, err := verifyTeamsActionToken(newToken(func(*teamsActionClaims) {}), audience, sender, keyFunc)
+	a.NoError(err)
+	a.Equal("alice@example.com", email)
+	_, err = verifyTeamsActionToken(newToken(func(*teamsActionClaims) {}), audience, "", keyFunc)
+	a.Error(err)
+
+	tests := []func(*teamsActionClaims){
+		func(c *teamsActionClaims) { c.Issuer = "https://example.com" },
+		func(c *teamsActionClaims) { c.Audience = jwt.ClaimStrings{"https://another.example.com"} },
+		func(c *teamsActionClaims) { c.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Minute)) },
+		func(c *teamsActionClaims) { c.ExpiresAt = nil },
+		func(c *teamsActionClaims) { c.AppID = "another" },
+		func(c *teamsActionClaims) { c.Subject = "" },
+		func(c *teamsActionClaims) { c.Sender = "someone@example.com" },
+		func(c *teamsActionClaims) { c.Sender = "" },
+	}
+	for i, modify := range tests {
+		_, err := verifyTeamsActionToken(newToken(modify), audience, sender, keyFunc)
+		a.Error(err, "test %d", i)
+	}
+
+	// Signed by another key.
+	anotherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	a.NoError(err)
+	token, err := jwt.NewWithClaims(jwt.SigningMethodRS256, &teamsActionClaims{AppID: teamsActionTokenAppID}).SignedString(anotherKey)
+	a.NoError(err)
+	_, err = verifyTeamsActionToken(token, audience, sender, keyFunc)
+	a.Error(err)
+}
+
+func TestGetTeamsActionKey(t *testing.T) {
+	a := require.New(t)
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	a.NoError(err)
+	fetchCount := 0
+	fetchTeamsActionKeysFunc = func(context.Context) (map[string]*rsa.PublicKey, error) {
+		fetchCount++
+		return map[string]*rsa.PublicKey{"k1": &key.PublicKey}, nil
+	}
+	t.Cleanup(func() {
+		fetchTeamsActionKeysFunc = fetchTeamsActionKeys
+	})
+
+	ctx := context.Background()
+	got, err := getTeamsActionKey(ctx, "k1")
+	a.NoError(err)
+	a.True(key.PublicKey.Equal(got))
+	_, err = getTeamsActionKey(ctx, "k1")
+	a.NoError(err)
+	a.Equal(1, fetchCount)
+
+	// The unknown key IDs do not refetch the keys within the minimum refetch interval.
+	for i := 0; i < 3; i++ {
+		_, err = getTeamsActionKey(ctx, fmt.Sprintf("unknown-%d", i))
+		a.Error(err)
+	}
+	a.Equal(1, fetchCount)
+
+	teamsActionKeys.Lock()
+	teamsActionKeys.fetchTime = time.Now().Add(-teamsActionKeysMinRefetchInterval)
+	teamsActionKeys.Unlock()
+	_, err = getTeamsActionKey(ctx, "unknown")
+	a.Error(err)
+	a.Equal(2, fetchCount)
+}
+
+func TestParseJWKS(t *testing.T) {
+	a := require.New(t)
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	a.NoError(err)
+	n := base64.RawURLEncoding.EncodeToString(key.N.Bytes())
+	e := base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes())
+	body := fmt.Sprintf(`{"keys":[{"kty":"RSA","kid":"k1","n":%q,"e":%q},{"kty":"EC","kid":"k2"}]}`, n, e)
+
+	keys, err := parseJWKS([]byte(body))
+	a.NoError(err)
+	a.Len(keys, 1)
+	a.True(key.PublicKey.Equal(keys["k1"]))
+}
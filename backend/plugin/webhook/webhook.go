@@ -65,6 +65,28 @@ type TaskResult struct {
 	SkippedReason string `json:"skippedReason"`
 }
 
+// Approval is the approval of the issue.
+type Approval struct {
+	// Status is the status of the approval, e.g. PENDING, APPROVED, REJECTED.
+	Status string `json:"status"`
+	// RiskLevel is the risk level of the issue, e.g. LOW, MODERATE, HIGH.
+	RiskLevel string      `json:"riskLevel"`
+	Approvers []*Approver `json:"approvers"`
+}
+
+// Approver is the user who has approved or rejected the issue.
+type Approver struct {
+	Name   string `json:"name"`
+	Email  string `json:"email"`
+	Status string `json:"status"`
+}
+
+// Database object of database.
+type Database struct {
+	// Name is the resource name of the database, e.g. instances/{instance}/databases/{database}.
+	Name string `json:"name"`
+}
+
 // Project object of project.
 type Project struct {
 	Name  string `json:"name"`
@@ -88,12 +110,26 @@ type Context struct {
 	Stage        *Stage
 	Project      *Project
 	TaskResult   *TaskResult
+	Approval     *Approval
+	Database     *Database
 	// End users that should be mentioned.
 	MentionEndUsers     []*store.UserMessage
 	MentionUsersByPhone []string
 
 	DirectMessage bool
 	IMSetting     *storepb.AppIMSetting
+	// ExternalURL is the external URL of the workspace, which receives the interactive requests from the IM apps.
+	ExternalURL string
+
+	// GenericConfig is the config of the generic webhook.
+	GenericConfig *storepb.GenericWebhookConfig
+
+	// SigningSecret signs the request if it is not empty.
+	SigningSecret string
+	// DeliveryID is sent in the request header if it is not empty.
+	DeliveryID string
+	// Exchange records the HTTP exchange posting to the URL if it is not nil.
+	Exchange *Exchange
 }
 
 // Receiver is the webhook receiver.
@@ -133,6 +169,13 @@ func (c *Context) GetMetaList() []Meta {
 		})
 	}
 
+	if c.Database != nil {
+		m = append(m, Meta{
+			Name:  "Database",
+			Value: c.Database.Name,
+		})
+	}
+
 	if c.Stage != nil {
 		m = append(m, Meta{
 			Name:  "Stage",
@@ -198,6 +241,13 @@ func (c *Context) GetMetaListZh() []Meta {
 		})
 	}
 
+	if c.Database != nil {
+		m = append(m, Meta{
+			Name:  "数据库",
+			Value: c.Database.Name,
+		})
+	}
+
 	if c.Stage != nil {
 		m = append(m, Meta{
 			Name:  "阶段",
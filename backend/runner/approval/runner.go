@@ -332,6 +332,8 @@ func (r *Runner) getIssueRisk(ctx context.Context, issue *store.IssueMessage, ri
 		return r.getDatabaseGeneralIssueRisk(ctx, issue, risks)
 	case api.IssueDatabaseDataExport:
 		return r.getDatabaseDataExportIssueRisk(ctx, issue, risks)
+	case api.IssueDatabaseJITAccess:
+		return r.getJITAccessIssueRisk(ctx, issue, risks)
 	default:
 		return 0, store.RiskSourceUnknown, false, errors.Errorf("unknown issue type %v", issue.Type)
 	}
@@ -826,6 +828,90 @@ func (r *Runner) getGrantRequestIssueRisk(ctx context.Context, issue *store.Issu
 	return maxRisk, riskSource, true, nil
 }
 
+func (r *Runner) getJITAccessIssueRisk(ctx context.Context, issue *store.IssueMessage, risks []*store.RiskMessage) (int32, store.RiskSource, bool, error) {
+	payload := issue.Payload
+	if payload.JitAccessRequest == nil {
+		return 0, store.RiskSourceUnknown, false, errors.New("JIT access request payload not found")
+	}
+	riskSource := store.RiskRequestJITAccess
+
+	// fast path, no risks so return the DEFAULT risk level "0"
+	if len(risks) == 0 {
+		return 0, riskSource, true, nil
+	}
+
+	instanceID, databaseName, err := common.GetInstanceDatabaseID(payload.JitAccessRequest.Database)
+	if err != nil {
+		return 0, store.RiskSourceUnknown, false, errors.Wrap(err, "failed to get instance database id")
+	}
+	instance, err := r.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &instanceID})
+	if err != nil {
+		return 0, store.RiskSourceUnknown, false, errors.Wrap(err, "failed to get instance")
+	}
+	if instance == nil || instance.Deleted {
+		return 0, store.RiskSourceUnknown, false, errors.Errorf("instance %q not found", instanceID)
+	}
+	if r.licenseService.IsFeatureEnabledForInstance(api.FeatureCustomApproval, instance) != nil {
+		// nolint:nilerr
+		return 0, store.RiskSourceUnknown, true, nil
+	}
+	database, err := r.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
+		ProjectID:           &issue.Project.ResourceID,
+		InstanceID:          &instanceID,
+		DatabaseName:        &databaseName,
+		IgnoreCaseSensitive: store.IgnoreDatabaseAndTableCaseSensitive(instance),
+	})
+	if err != nil {
+		return 0, store.RiskSourceUnknown, false, errors.Wrap(err, "failed to get database")
+	}
+	if database == nil {
+		return 0, store.RiskSourceUnknown, false, errors.Errorf("database %q not found", databaseName)
+	}
+
+	e, err := cel.NewEnv(common.RiskFactors...)
+	if err != nil {
+		return 0, store.RiskSourceUnknown, false, err
+	}
+	args := map[string]any{
+		"environment_id": database.EffectiveEnvironmentID,
+		"project_id":     issue.Project.ResourceID,
+		"database_name":  database.DatabaseName,
+		// convert to string type otherwise cel-go will complain that storepb.Engine is not string type.
+		"db_engine":       instance.Engine.String(),
+		"expiration_days": payload.JitAccessRequest.GetDuration().AsDuration().Hours() / 24,
+	}
+	for _, risk := range risks {
+		if !risk.Active {
+			continue
+		}
+		if risk.Source != riskSource {
+			continue
+		}
+		if risk.Expression == nil || risk.Expression.Expression == "" {
+			continue
+		}
+
+		ast, issues := e.Parse(risk.Expression.Expression)
+		if issues != nil && issues.Err() != nil {
+			return 0, store.RiskSourceUnknown, false, errors.Errorf("failed to parse expression: %v", issues.Err())
+		}
+		prg, err := e.Program(ast)
+		if err != nil {
+			return 0, store.RiskSourceUnknown, false, err
+		}
+		out, _, err := prg.Eval(args)
+		if err != nil {
+			return 0, store.RiskSourceUnknown, false, err
+		}
+		// We can stop the loop because the risk list is sorted by level DESC.
+		if res, ok := out.Equal(celtypes.True).Value().(bool); ok && res {
+			return risk.Level, riskSource, true, nil
+		}
+	}
+
+	return 0, riskSource, true, nil
+}
+
 func getRiskSourceFromPlan(config *storepb.PlanConfig) store.RiskSource {
 	for _, step := range config.GetSteps() {
 		for _, spec := range step.GetSpecs() {
@@ -868,6 +954,8 @@ func convertToSource(source store.RiskSource) v1pb.Risk_Source {
 		return v1pb.Risk_REQUEST_QUERY
 	case store.RiskRequestExport:
 		return v1pb.Risk_REQUEST_EXPORT
+	case store.RiskRequestJITAccess:
+		return v1pb.Risk_REQUEST_JIT_ACCESS
 	case store.RiskSourceDatabaseDataExport:
 		return v1pb.Risk_DATA_EXPORT
 	}
@@ -0,0 +1,175 @@
+package discovery
+
+import (
+	"math/big"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+var (
+	emailRegexp        = regexp.MustCompile(`^[A-Za-z0-9._%+\-]+@[A-Za-z0-9\-]+(\.[A-Za-z0-9\-]+)*\.[A-Za-z]{2,}$`)
+	phoneRegexp        = regexp.MustCompile(`^\+?[0-9(][0-9 ()\-.]{5,22}$`)
+	dateRegexp         = regexp.MustCompile(`^[0-9]{4}[\-./][0-9]{1,2}[\-./][0-9]{1,2}$`)
+	chinaMobileRegexp  = regexp.MustCompile(`^1[3-9][0-9]{9}$`)
+	usSSNRegexp        = regexp.MustCompile(`^([0-9]{3})-([0-9]{2})-([0-9]{4})$`)
+	chinaIDRegexp      = regexp.MustCompile(`^[1-9][0-9]{16}[0-9Xx]$`)
+	creditCardRegexp   = regexp.MustCompile(`^[0-9][0-9 \-]{11,22}[0-9]$`)
+	ibanRegexp         = regexp.MustCompile(`^[A-Z]{2}[0-9]{2}[A-Z0-9]{11,30}$`)
+	chinaIDCheckDigits = "10X98765432"
+)
+
+// detector detects whether a sampled value matches a classification.
+type detector struct {
+	classification string
+	semanticType   string
+	detectorType   storepb.DataClassificationSetting_DataClassificationConfig_DataClassification_Detector_Type
+	match          func(string) bool
+}
+
+// getDetectors returns the detectors defined in the classification config, ordered by the classification id.
+func getDetectors(config *storepb.DataClassificationSetting_DataClassificationConfig) ([]*detector, error) {
+	var ids []string
+	for id := range config.GetClassification() {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+
+	var detectors []*detector
+	for _, id := range ids {
+		for _, d := range config.Classification[id].GetDetectors() {
+			match, err := getDetectorMatcher(d)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid detector for classification %s", id)
+			}
+			detectors = append(detectors, &detector{
+				classification: id,
+				semanticType:   d.SemanticType,
+				detectorType:   d.Type,
+				match:          match,
+			})
+		}
+	}
+	return detectors, nil
+}
+
+func getDetectorMatcher(d *storepb.DataClassificationSetting_DataClassificationConfig_DataClassification_Detector) (func(string) bool, error) {
+	switch d.Type {
+	case storepb.DataClassificationSetting_DataClassificationConfig_DataClassification_Detector_EMAIL:
+		return isEmail, nil
+	case storepb.DataClassificationSetting_DataClassificationConfig_DataClassification_Detector_PHONE:
+		return isPhone, nil
+	case storepb.DataClassificationSetting_DataClassificationConfig_DataClassification_Detector_NATIONAL_ID:
+		return isNationalID, nil
+	case storepb.DataClassificationSetting_DataClassificationConfig_DataClassification_Detector_CREDIT_CARD:
+		return isCreditCard, nil
+	case storepb.DataClassificationSetting_DataClassificationConfig_DataClassification_Detector_IBAN:
+		return isIBAN, nil
+	case storepb.DataClassificationSetting_DataClassificationConfig_DataClassification_Detector_REGEX:
+		re, err := regexp.Compile(d.Pattern)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to compile pattern %q", d.Pattern)
+		}
+		return re.MatchString, nil
+	default:
+		return nil, errors.Errorf("unsupported detector type %v", d.Type)
+	}
+}
+
+func isEmail(s string) bool {
+	return len(s) <= 254 && emailRegexp.MatchString(s)
+}
+
+// isPhone matches the phone numbers in E.164 format, with separators, or the China mobile numbers.
+// Plain digits are not matched except the China mobile numbers, to avoid matching the numeric ids.
+func isPhone(s string) bool {
+	if chinaMobileRegexp.MatchString(s) {
+		return true
+	}
+	if !phoneRegexp.MatchString(s) || dateRegexp.MatchString(s) {
+		return false
+	}
+	digits := getDigits(s)
+	if len(digits) < 7 || len(digits) > 15 {
+		return false
+	}
+	return strings.HasPrefix(s, "+") || len(digits) != len(s)
+}
+
+// isNationalID matches the US social security numbers and the China resident identity card numbers.
+func isNationalID(s string) bool {
+	if matches := usSSNRegexp.FindStringSubmatch(s); matches != nil {
+		area, group, serial := matches[1], matches[2], matches[3]
+		return area != "000" && area != "666" && area[0] != '9' && group != "00" && serial != "0000"
+	}
+	if chinaIDRegexp.MatchString(s) {
+		// ISO 7064 MOD 11-2.
+		sum := 0
+		for i := 0; i < 17; i++ {
+			weight := (1 << (17 - i)) % 11
+			sum += int(s[i]-'0') * weight
+		}
+		return strings.ToUpper(s[17:]) == string(chinaIDCheckDigits[sum%11])
+	}
+	return false
+}
+
+// isCreditCard matches the 13 to 19 digits card numbers passing the Luhn check.
+func isCreditCard(s string) bool {
+	if !creditCardRegexp.MatchString(s) {
+		return false
+	}
+	digits := getDigits(s)
+	if len(digits) < 13 || len(digits) > 19 {
+		return false
+	}
+	sum := 0
+	for i := 0; i < len(digits); i++ {
+		d := int(digits[len(digits)-1-i] - '0')
+		if i%2 == 1 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	return sum%10 == 0
+}
+
+// isIBAN matches the international bank account numbers passing the mod 97 check.
+func isIBAN(s string) bool {
+	s = strings.ToUpper(strings.ReplaceAll(s, " ", ""))
+	if !ibanRegexp.MatchString(s) {
+		return false
+	}
+	rearranged := s[4:] + s[:4]
+	var sb strings.Builder
+	for _, r := range rearranged {
+		if r >= 'A' && r <= 'Z' {
+			sb.WriteString(strconv.Itoa(int(r-'A') + 10))
+		} else {
+			sb.WriteRune(r)
+		}
+	}
+	n, ok := new(big.Int).SetString(sb.String(), 10)
+	if !ok {
+		return false
+	}
+	return new(big.Int).Mod(n, big.NewInt(97)).Int64() == 1
+}
+
+func getDigits(s string) string {
+	var sb strings.Builder
+	for _, r := range s {
+		if r >= '0' && r <= '9' {
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
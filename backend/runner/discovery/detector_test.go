@@ -0,0 +1,98 @@
+package discovery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestDetectors(t *testing.T) {
+	testCases := []struct {
+		match func(string) bool
+		value string
+		want  bool
+	}{
+		{isEmail, "john.doe@example.com", true},
+		{isEmail, "john.doe@example", false},
+		{isEmail, "not an email", false},
+		{isPhone, "+1 415-555-2671", true},
+		{isPhone, "(415) 555-2671", true},
+		{isPhone, "13812345678", true},
+		{isPhone, "4155552671", false},
+		{isPhone, "2024-01-01", false},
+		{isNationalID, "123-45-6789", true},
+		{isNationalID, "666-45-6789", false},
+		{isNationalID, "11010519491231002X", true},
+		{isNationalID, "110105194912310021", false},
+		{isCreditCard, "4111 1111 1111 1111", true},
+		{isCreditCard, "4111-1111-1111-1112", false},
+		{isCreditCard, "12345", false},
+		{isIBAN, "GB82 WEST 1234 5698 7654 32", true},
+		{isIBAN, "DE89370400440532013000", true},
+		{isIBAN, "GB82WEST12345698765433", false},
+	}
+
+	a := require.New(t)
+	for _, tc := range testCases {
+		a.Equal(tc.want, tc.match(tc.value), tc.value)
+	}
+}
+
+func TestDetect(t *testing.T) {
+	a := require.New(t)
+	detectors, err := getDetectors(&storepb.DataClassificationSetting_DataClassificationConfig{
+		Classification: map[string]*storepb.DataClassificationSetting_DataClassificationConfig_DataClassification{
+			"1-1": {
+				Id: "1-1",
+				Detectors: []*storepb.DataClassificationSetting_DataClassificationConfig_DataClassification_Detector{
+					{Type: storepb.DataClassificationSetting_DataClassificationConfig_DataClassification_Detector_EMAIL, SemanticType: "email"},
+				},
+			},
+			"1-2": {
+				Id: "1-2",
+				Detectors: []*storepb.DataClassificationSetting_DataClassificationConfig_DataClassification_Detector{
+					{Type: storepb.DataClassificationSetting_DataClassificationConfig_DataClassification_Detector_REGEX, Pattern: `^EMP-[0-9]{6}$`},
+				},
+			},
+		},
+	})
+	a.NoError(err)
+	a.Len(detectors, 2)
+
+	proposal := detect([]string{"a@example.com", "b@example.com", "c@example.com", "d@example.com", "not email"}, detectors)
+	a.NotNil(proposal)
+	a.Equal("1-1", proposal.Classification)
+	a.Equal("email", proposal.SemanticType)
+	a.Equal(0.8, proposal.MatchRatio)
+	a.Equal(int32(5), proposal.SampleSize)
+
+	proposal = detect([]string{"EMP-000001", "EMP-000002", "EMP-000003", "EMP-000004", "EMP-000005"}, detectors)
+	a.NotNil(proposal)
+	a.Equal("1-2", proposal.Classification)
+
+	// Too few samples.
+	a.Nil(detect([]string{"a@example.com"}, detectors))
+	// Below the threshold.
+	a.Nil(detect([]string{"a@example.com", "b@example.com", "c", "d", "e"}, detectors))
+
+	_, err = getDetectors(&storepb.DataClassificationSetting_DataClassificationConfig{
+		Classification: map[string]*storepb.DataClassificationSetting_DataClassificationConfig_DataClassification{
+			"1-1": {
+				Detectors: []*storepb.DataClassificationSetting_DataClassificationConfig_DataClassification_Detector{
+					{Type: storepb.DataClassificationSetting_DataClassificationConfig_DataClassification_Detector_REGEX, Pattern: `(`},
+				},
+			},
+		},
+	})
+	a.Error(err)
+}
+
+func TestGetSampleStatement(t *testing.T) {
+	a := require.New(t)
+	a.Equal("SELECT `email`, `a``b` FROM `shop`.`user` LIMIT 100", getSampleStatement(storepb.Engine_MYSQL, "shop", "", "user", []string{"email", "a`b"}))
+	a.Equal(`SELECT "email" FROM "public"."user" LIMIT 100`, getSampleStatement(storepb.Engine_POSTGRES, "shop", "public", "user", []string{"email"}))
+	a.Equal("SELECT TOP 100 [email] FROM [dbo].[user]", getSampleStatement(storepb.Engine_MSSQL, "shop", "dbo", "user", []string{"email"}))
+	a.Equal(`SELECT "EMAIL" FROM "HR"."USERS" FETCH FIRST 100 ROWS ONLY`, getSampleStatement(storepb.Engine_ORACLE, "ORCL", "HR", "USERS", []string{"EMAIL"}))
+}
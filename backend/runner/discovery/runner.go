@@ -0,0 +1,409 @@
+// Package discovery is the runner for discovering the sensitive data in the databases.
+package discovery
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/dbfactory"
+	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/utils"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+const (
+	discoveryInterval = 24 * time.Hour
+	// discoveryInitialDelay is the delay of the first discovery after the server starts.
+	discoveryInitialDelay = 10 * time.Minute
+	// maxConfigUpdateAttempts is the maximum number of attempts to save the proposals on the concurrent config updates.
+	maxConfigUpdateAttempts = 3
+	// sampleSize is the maximum number of rows sampled for each table.
+	sampleSize = 100
+	// sampleTimeout is the timeout for sampling each table.
+	sampleTimeout = 10 * time.Second
+	// minMatchedSamples is the minimum number of the non-empty values required to propose a classification.
+	minMatchedSamples = 5
+	// matchRatioThreshold is the minimum ratio of the non-empty values matching a detector to propose a classification.
+	matchRatioThreshold = 0.8
+)
+
+// Runner is the runner for discovering the sensitive data.
+// It samples the column values with the read-only connection and proposes the classification for the columns.
+type Runner struct {
+	store          *store.Store
+	dbFactory      *dbfactory.DBFactory
+	licenseService enterprise.LicenseService
+}
+
+// NewRunner creates a new runner.
+func NewRunner(store *store.Store, dbFactory *dbfactory.DBFactory, licenseService enterprise.LicenseService) *Runner {
+	return &Runner{
+		store:          store,
+		dbFactory:      dbFactory,
+		licenseService: licenseService,
+	}
+}
+
+// Run runs the runner.
+func (r *Runner) Run(ctx context.Context, wg *sync.WaitGroup) {
+	// The discovery runs shortly after the server starts instead of waiting for the whole interval.
+	timer := time.NewTimer(discoveryInitialDelay)
+	defer timer.Stop()
+	defer wg.Done()
+	slog.Debug(fmt.Sprintf("Sensitive data discovery runner started and will run every %v", discoveryInterval))
+	for {
+		select {
+		case <-timer.C:
+			timer.Reset(discoveryInterval)
+			func() {
+				defer func() {
+					if r := recover(); r != nil {
+						err, ok := r.(error)
+						if !ok {
+							err = errors.Errorf("%v", r)
+						}
+						slog.Error("Sensitive data discovery runner PANIC RECOVER", log.BBError(err), log.BBStack("panic-stack"))
+					}
+				}()
+				r.runOnce(ctx)
+			}()
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *Runner) runOnce(ctx context.Context) {
+	if err := r.licenseService.IsFeatureEnabled(api.FeatureSensitiveData); err != nil {
+		return
+	}
+	databases, err := r.store.ListDatabases(ctx, &store.FindDatabaseMessage{})
+	if err != nil {
+		slog.Error("failed to list databases", log.BBError(err))
+		return
+	}
+
+	detectorsMap := make(map[string][]*detector)
+	for _, database := range databases {
+		if ctx.Err() != nil {
+			return
+		}
+		detectors, ok := detectorsMap[database.ProjectID]
+		if !ok {
+			detectors, err = r.getProjectDetectors(ctx, database.ProjectID)
+			if err != nil {
+				slog.Error("failed to get detectors", slog.String("project", database.ProjectID), log.BBError(err))
+			}
+			detectorsMap[database.ProjectID] = detectors
+		}
+		if len(detectors) == 0 {
+			continue
+		}
+		if err := r.scanDatabase(ctx, database, detectors); err != nil {
+			slog.Error("failed to scan database for sensitive data",
+				slog.String("instance", database.InstanceID),
+				slog.String("database", database.DatabaseName),
+				log.BBError(err))
+		}
+	}
+}
+
+func (r *Runner) getProjectDetectors(ctx context.Context, projectID string) ([]*detector, error) {
+	project, err := r.store.GetProjectV2(ctx, &store.FindProjectMessage{ResourceID: &projectID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get project %q", projectID)
+	}
+	if project == nil || project.DataClassificationConfigID == "" {
+		return nil, nil
+	}
+	classificationConfig, err := r.store.GetDataClassificationConfigByID(ctx, project.DataClassificationConfigID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get classification config %q", project.DataClassificationConfigID)
+	}
+	return getDetectors(classificationConfig)
+}
+
+func (r *Runner) scanDatabase(ctx context.Context, database *store.DatabaseMessage, detectors []*detector) error {
+	instance, err := r.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &database.InstanceID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get instance %q", database.InstanceID)
+	}
+	if instance == nil {
+		return nil
+	}
+	if !isDiscoverySupported(instance.Engine) {
+		return nil
+	}
+	if err := r.licenseService.IsFeatureEnabledForInstance(api.FeatureSensitiveData, instance); err != nil {
+		return nil
+	}
+	dbSchema, err := r.store.GetDBSchema(ctx, database.UID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get database schema")
+	}
+	if dbSchema == nil {
+		return nil
+	}
+
+	dataSource := utils.DataSourceFromInstanceWithType(instance, api.RO)
+	if dataSource == nil {
+		dataSource = utils.DataSourceFromInstanceWithType(instance, api.Admin)
+	}
+	if dataSource == nil {
+		return errors.Errorf("data source not found")
+	}
+	driver, err := r.dbFactory.GetDataSourceDriver(ctx, instance, dataSource, database.DatabaseName, database.DataShare, true /* readOnly */, db.ConnectionContext{})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get database driver")
+	}
+	defer driver.Close(ctx)
+
+	config := dbSchema.GetInternalConfig()
+	proposals := make(map[[3]string]*storepb.ClassificationProposal)
+	for _, schema := range dbSchema.GetMetadata().GetSchemas() {
+		schemaConfig := config.CreateOrGetSchemaConfig(schema.Name)
+		for _, table := range schema.Tables {
+			tableConfig := schemaConfig.CreateOrGetTableConfig(table.Name)
+			var columns []string
+			for _, column := range table.Columns {
+				if !isCandidateColumnType(column.Type) {
+					continue
+				}
+				// Skip the classified columns and the columns waiting for review.
+				columnConfig := tableConfig.CreateOrGetColumnConfig(column.Name)
+				if columnConfig.Classification != "" || columnConfig.ClassificationProposal.GetState() == storepb.ClassificationProposal_PENDING {
+					continue
+				}
+				columns = append(columns, column.Name)
+			}
+			if len(columns) == 0 {
+				continue
+			}
+
+			samples, err := sampleTable(ctx, driver, instance.Engine, database.DatabaseName, schema.Name, table.Name, columns)
+			if err != nil {
+				slog.Debug("failed to sample table",
+					slog.String("database", database.DatabaseName),
+					slog.String("schema", schema.Name),
+					slog.String("table", table.Name),
+					log.BBError(err))
+				continue
+			}
+			for i, column := range columns {
+				proposal := detect(samples[i], detectors)
+				if proposal == nil {
+					continue
+				}
+				// Don't propose the rejected classification again.
+				if previous := tableConfig.CreateOrGetColumnConfig(column).ClassificationProposal; previous.GetState() == storepb.ClassificationProposal_REJECTED && previous.GetClassification() == proposal.Classification {
+					continue
+				}
+				proposals[[3]string{schema.Name, table.Name, column}] = proposal
+			}
+		}
+	}
+	if len(proposals) == 0 {
+		return nil
+	}
+
+	// Save the proposals on the latest config, and retry if the config is changed concurrently, e.g. by the catalog updates during the scan.
+	saved := false
+	for i := 0; i < maxConfigUpdateAttempts && !saved; i++ {
+		dbSchema, err = r.store.GetDBSchema(ctx, database.UID)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get database schema")
+		}
+		if dbSchema == nil {
+			return nil
+		}
+		config = dbSchema.GetInternalConfig()
+		for key, proposal := range proposals {
+			columnConfig := config.CreateOrGetSchemaConfig(key[0]).CreateOrGetTableConfig(key[1]).CreateOrGetColumnConfig(key[2])
+			if columnConfig.Classification != "" {
+				continue
+			}
+			columnConfig.ClassificationProposal = proposal
+		}
+		saved, err = r.store.UpdateDBSchemaConfigIfMatch(ctx, database.UID, dbSchema.GetConfig(), config.BuildDatabaseConfig(), api.SystemBotID)
+		if err != nil {
+			return errors.Wrapf(err, "failed to update database config")
+		}
+	}
+	if !saved {
+		return errors.Errorf("database config is changed concurrently after %d attempts", maxConfigUpdateAttempts)
+	}
+	slog.Info("proposed classifications for sensitive data",
+		slog.String("instance", database.InstanceID),
+		slog.String("database", database.DatabaseName),
+		slog.Int("count", len(proposals)))
+	return nil
+}
+
+// detect returns the proposal of the detector with the highest match ratio above the threshold.
+func detect(values []string, detectors []*detector) *storepb.ClassificationProposal {
+	if len(values) < minMatchedSamples {
+		return nil
+	}
+	var best *detector
+	bestRatio := 0.0
+	for _, d := range detectors {
+		matched := 0
+		for _, value := range values {
+			if d.match(value) {
+				matched++
+			}
+		}
+		ratio := float64(matched) / float64(len(values))
+		if ratio >= matchRatioThreshold && ratio > bestRatio {
+			best, bestRatio = d, ratio
+		}
+	}
+	if best == nil {
+		return nil
+	}
+	return &storepb.ClassificationProposal{
+		Classification: best.classification,
+		SemanticType:   best.semanticType,
+		Detector:       best.detectorType.String(),
+		MatchRatio:     bestRatio,
+		SampleSize:     int32(len(values)),
+		ScanTime:       timestamppb.Now(),
+		State:          storepb.ClassificationProposal_PENDING,
+	}
+}
+
+// sampleTable returns the non-empty sampled values for each column.
+func sampleTable(ctx context.Context, driver db.Driver, engine storepb.Engine, databaseName, schemaName, tableName string, columns []string) ([][]string, error) {
+	statement := getSampleStatement(engine, databaseName, schemaName, tableName, columns)
+	ctx, cancel := context.WithTimeout(ctx, sampleTimeout)
+	defer cancel()
+	conn, err := driver.GetDB().Conn(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get connection")
+	}
+	defer conn.Close()
+	results, err := driver.QueryConn(ctx, conn, statement, db.QueryContext{Limit: sampleSize})
+	if err != nil {
+		return nil, err
+	}
+	if len(results) != 1 {
+		return nil, errors.Errorf("expect 1 result, got %d", len(results))
+	}
+	if results[0].Error != "" {
+		return nil, errors.New(results[0].Error)
+	}
+
+	samples := make([][]string, len(columns))
+	for _, row := range results[0].Rows {
+		for i, value := range row.Values {
+			if i >= len(columns) {
+				break
+			}
+			if s, ok := getSampleString(value); ok {
+				samples[i] = append(samples[i], s)
+			}
+		}
+	}
+	return samples, nil
+}
+
+func getSampleStatement(engine storepb.Engine, databaseName, schemaName, tableName string, columns []string) string {
+	switch engine {
+	case storepb.Engine_MYSQL, storepb.Engine_MARIADB, storepb.Engine_TIDB, storepb.Engine_OCEANBASE:
+		var quoted []string
+		for _, column := range columns {
+			quoted = append(quoted, quoteIdentifier(column, "`", "`"))
+		}
+		// Qualify the table with the database, because the connection may not use the database, e.g. for the read-only data source.
+		return fmt.Sprintf("SELECT %s FROM %s.%s LIMIT %d", strings.Join(quoted, ", "), quoteIdentifier(databaseName, "`", "`"), quoteIdentifier(tableName, "`", "`"), sampleSize)
+	case storepb.Engine_MSSQL:
+		var quoted []string
+		for _, column := range columns {
+			quoted = append(quoted, quoteIdentifier(column, "[", "]"))
+		}
+		return fmt.Sprintf("SELECT TOP %d %s FROM %s.%s", sampleSize, strings.Join(quoted, ", "), quoteIdentifier(schemaName, "[", "]"), quoteIdentifier(tableName, "[", "]"))
+	default:
+		var quoted []string
+		for _, column := range columns {
+			quoted = append(quoted, quoteIdentifier(column, `"`, `"`))
+		}
+		table := quoteIdentifier(tableName, `"`, `"`)
+		if schemaName != "" {
+			table = fmt.Sprintf("%s.%s", quoteIdentifier(schemaName, `"`, `"`), table)
+		}
+		if engine == storepb.Engine_ORACLE {
+			return fmt.Sprintf("SELECT %s FROM %s FETCH FIRST %d ROWS ONLY", strings.Join(quoted, ", "), table, sampleSize)
+		}
+		return fmt.Sprintf("SELECT %s FROM %s LIMIT %d", strings.Join(quoted, ", "), table, sampleSize)
+	}
+}
+
+func quoteIdentifier(identifier, left, right string) string {
+	return left + strings.ReplaceAll(identifier, right, right+right) + right
+}
+
+func getSampleString(value *v1pb.RowValue) (string, bool) {
+	var s string
+	switch kind := value.GetKind().(type) {
+	case *v1pb.RowValue_StringValue:
+		s = kind.StringValue
+	case *v1pb.RowValue_BytesValue:
+		if !utf8.Valid(kind.BytesValue) {
+			return "", false
+		}
+		s = string(kind.BytesValue)
+	case *v1pb.RowValue_Int32Value:
+		s = strconv.FormatInt(int64(kind.Int32Value), 10)
+	case *v1pb.RowValue_Int64Value:
+		s = strconv.FormatInt(kind.Int64Value, 10)
+	case *v1pb.RowValue_Uint32Value:
+		s = strconv.FormatUint(uint64(kind.Uint32Value), 10)
+	case *v1pb.RowValue_Uint64Value:
+		s = strconv.FormatUint(kind.Uint64Value, 10)
+	default:
+		return "", false
+	}
+	s = strings.TrimSpace(s)
+	return s, s != ""
+}
+
+func isDiscoverySupported(engine storepb.Engine) bool {
+	switch engine {
+	case storepb.Engine_MYSQL, storepb.Engine_MARIADB, storepb.Engine_TIDB, storepb.Engine_OCEANBASE,
+		storepb.Engine_POSTGRES, storepb.Engine_REDSHIFT, storepb.Engine_SNOWFLAKE,
+		storepb.Engine_MSSQL, storepb.Engine_ORACLE:
+		return true
+	default:
+		return false
+	}
+}
+
+// isCandidateColumnType returns true if the column type may store the sensitive data detected by the detectors.
+func isCandidateColumnType(columnType string) bool {
+	t := strings.ToLower(columnType)
+	for _, excluded := range []string{"interval", "point", "binary", "blob", "bytea", "bool"} {
+		if strings.Contains(t, excluded) {
+			return false
+		}
+	}
+	for _, candidate := range []string{"char", "text", "string", "clob", "int", "number", "numeric", "decimal"} {
+		if strings.Contains(t, candidate) {
+			return true
+		}
+	}
+	return false
+}
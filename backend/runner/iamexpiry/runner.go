@@ -0,0 +1,223 @@
+// Package iamexpiry is the runner for pruning the expired IAM bindings.
+package iamexpiry
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/webhook"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+const (
+	iamExpiryRunnerInterval = 1 * time.Minute
+	// maxPolicyUpdateAttempts is the maximum attempts to update the iam policy changed concurrently.
+	maxPolicyUpdateAttempts = 3
+)
+
+// Runner is the runner for pruning the expired IAM bindings.
+type Runner struct {
+	store          *store.Store
+	webhookManager *webhook.Manager
+}
+
+// NewRunner creates a new runner.
+func NewRunner(store *store.Store, webhookManager *webhook.Manager) *Runner {
+	return &Runner{
+		store:          store,
+		webhookManager: webhookManager,
+	}
+}
+
+// Run runs the runner.
+func (r *Runner) Run(ctx context.Context, wg *sync.WaitGroup) {
+	ticker := time.NewTicker(iamExpiryRunnerInterval)
+	defer ticker.Stop()
+	defer wg.Done()
+	slog.Debug(fmt.Sprintf("IAM expiry runner started and will run every %v", iamExpiryRunnerInterval))
+	for {
+		select {
+		case <-ticker.C:
+			func() {
+				defer func() {
+					if r := recover(); r != nil {
+						err, ok := r.(error)
+						if !ok {
+							err = errors.Errorf("%v", r)
+						}
+						slog.Error("IAM expiry runner PANIC RECOVER", log.BBError(err), log.BBStack("panic-stack"))
+					}
+				}()
+				r.runOnce(ctx)
+			}()
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *Runner) runOnce(ctx context.Context) {
+	now := time.Now()
+	if err := r.pruneWorkspace(ctx, now); err != nil {
+		slog.Error("failed to prune expired workspace iam bindings", log.BBError(err))
+	}
+
+	projects, err := r.store.ListProjectV2(ctx, &store.FindProjectMessage{})
+	if err != nil {
+		slog.Error("failed to list projects", log.BBError(err))
+		return
+	}
+	for _, project := range projects {
+		if err := r.pruneProject(ctx, project, now); err != nil {
+			slog.Error("failed to prune expired project iam bindings", slog.String("project", project.ResourceID), log.BBError(err))
+		}
+	}
+}
+
+func (r *Runner) pruneWorkspace(ctx context.Context, now time.Time) error {
+	expired, err := r.pruneIamPolicy(ctx, api.PolicyResourceTypeWorkspace, 0, now)
+	if err != nil {
+		return err
+	}
+	if len(expired) == 0 {
+		return nil
+	}
+
+	workspaceID, err := r.store.GetWorkspaceID(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get workspace id")
+	}
+	workspace := common.FormatWorkspace(workspaceID)
+	return r.createAuditLog(ctx, workspace, workspace, expired)
+}
+
+func (r *Runner) pruneProject(ctx context.Context, project *store.ProjectMessage, now time.Time) error {
+	expired, err := r.pruneIamPolicy(ctx, api.PolicyResourceTypeProject, project.UID, now)
+	if err != nil {
+		return err
+	}
+	if len(expired) == 0 {
+		return nil
+	}
+
+	projectName := common.FormatProject(project.ResourceID)
+	if err := r.createAuditLog(ctx, projectName, projectName, expired); err != nil {
+		return err
+	}
+	for _, binding := range expired {
+		r.webhookManager.CreateEvent(ctx, &webhook.Event{
+			Actor:   r.store.GetSystemBotUser(ctx),
+			Type:    webhook.EventTypeProjectMemberExpire,
+			Project: webhook.NewProject(project),
+			ProjectMemberExpire: &webhook.EventProjectMemberExpire{
+				Role:      binding.Role,
+				Members:   binding.Members,
+				Condition: binding.GetCondition().GetExpression(),
+			},
+		})
+	}
+	return nil
+}
+
+// pruneIamPolicy removes the expired bindings from the iam policy and returns them.
+// The policy is only updated if it's unchanged since read, and read again on the conflicts, so that
+// the concurrent changes by the users are never overwritten.
+func (r *Runner) pruneIamPolicy(ctx context.Context, resourceType api.PolicyResourceType, resourceUID int, now time.Time) ([]*storepb.Binding, error) {
+	policyType := api.PolicyTypeIAM
+	for i := 0; i < maxPolicyUpdateAttempts; i++ {
+		policy, err := r.store.GetPolicyV2(ctx, &store.FindPolicyMessage{
+			ResourceType: &resourceType,
+			ResourceUID:  &resourceUID,
+			Type:         &policyType,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get iam policy")
+		}
+		if policy == nil {
+			return nil, nil
+		}
+		iamPolicy := &storepb.IamPolicy{}
+		if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(policy.Payload), iamPolicy); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal iam policy")
+		}
+		expired, remaining := splitExpiredBindings(iamPolicy, now)
+		if len(expired) == 0 {
+			return nil, nil
+		}
+		payload, err := protojson.Marshal(remaining)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to marshal iam policy")
+		}
+		updated, err := r.store.UpdatePolicyPayloadIfMatch(ctx, policy, string(payload), api.SystemBotID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to update iam policy")
+		}
+		if updated {
+			return expired, nil
+		}
+	}
+	return nil, errors.Errorf("the iam policy keeps changing in %d attempts", maxPolicyUpdateAttempts)
+}
+
+// splitExpiredBindings splits the bindings into the expired ones and the remaining policy.
+func splitExpiredBindings(policy *storepb.IamPolicy, now time.Time) ([]*storepb.Binding, *storepb.IamPolicy) {
+	var expired []*storepb.Binding
+	remaining := &storepb.IamPolicy{}
+	for _, binding := range policy.GetBindings() {
+		ok, err := common.IsBindingConditionExpired(binding.GetCondition().GetExpression(), now)
+		if err != nil {
+			slog.Warn("failed to check binding condition expiry", slog.String("expression", binding.GetCondition().GetExpression()), log.BBError(err))
+		}
+		if ok {
+			expired = append(expired, binding)
+			continue
+		}
+		remaining.Bindings = append(remaining.Bindings, binding)
+	}
+	return expired, remaining
+}
+
+func (r *Runner) createAuditLog(ctx context.Context, parent, resource string, expired []*storepb.Binding) error {
+	var deltas []*v1pb.BindingDelta
+	for _, binding := range expired {
+		for _, member := range binding.Members {
+			deltas = append(deltas, &v1pb.BindingDelta{
+				Action:    v1pb.BindingDelta_REMOVE,
+				Role:      binding.Role,
+				Member:    member,
+				Condition: binding.Condition,
+			})
+		}
+	}
+	serviceData, err := anypb.New(&v1pb.AuditData{
+		PolicyDelta: &v1pb.PolicyDelta{
+			BindingDeltas: deltas,
+		},
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal audit data")
+	}
+	if err := r.store.CreateAuditLog(ctx, &storepb.AuditLog{
+		Parent:      parent,
+		Method:      store.AuditLogMethodIamBindingExpire.String(),
+		Resource:    resource,
+		User:        common.FormatUserUID(api.SystemBotID),
+		Severity:    storepb.AuditLog_INFO,
+		ServiceData: serviceData,
+	}); err != nil {
+		return errors.Wrapf(err, "failed to create audit log")
+	}
+	return nil
+}
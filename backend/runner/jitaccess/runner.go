@@ -0,0 +1,395 @@
+// Package jitaccess is the runner for vending and revoking the just-in-time database access credentials.
+package jitaccess
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/dbfactory"
+	"github.com/bytebase/bytebase/backend/component/webhook"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/utils"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	jitAccessRunnerInterval = 30 * time.Second
+	passwordLength          = 32
+)
+
+// Runner is the runner for the JIT access issues.
+// It creates the database user once the issue is approved, and drops the user at expiry or when the issue is canceled.
+type Runner struct {
+	store          *store.Store
+	dbFactory      *dbfactory.DBFactory
+	webhookManager *webhook.Manager
+	secret         string
+}
+
+// NewRunner creates a new runner.
+func NewRunner(store *store.Store, dbFactory *dbfactory.DBFactory, webhookManager *webhook.Manager, secret string) *Runner {
+	return &Runner{
+		store:          store,
+		dbFactory:      dbFactory,
+		webhookManager: webhookManager,
+		secret:         secret,
+	}
+}
+
+// Run runs the runner.
+func (r *Runner) Run(ctx context.Context, wg *sync.WaitGroup) {
+	ticker := time.NewTicker(jitAccessRunnerInterval)
+	defer ticker.Stop()
+	defer wg.Done()
+	slog.Debug(fmt.Sprintf("JIT access runner started and will run every %v", jitAccessRunnerInterval))
+	for {
+		select {
+		case <-ticker.C:
+			func() {
+				defer func() {
+					if r := recover(); r != nil {
+						err, ok := r.(error)
+						if !ok {
+							err = errors.Errorf("%v", r)
+						}
+						slog.Error("JIT access runner PANIC RECOVER", log.BBError(err), log.BBStack("panic-stack"))
+					}
+				}()
+				r.runOnce(ctx)
+			}()
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *Runner) runOnce(ctx context.Context) {
+	// The canceled issues are listed as well because their active credentials must be revoked.
+	issues, err := r.store.ListIssueV2(ctx, &store.FindIssueMessage{
+		Types:      &[]api.IssueType{api.IssueDatabaseJITAccess},
+		StatusList: []api.IssueStatus{api.IssueOpen, api.IssueCanceled},
+	})
+	if err != nil {
+		slog.Error("failed to list JIT access issues", log.BBError(err))
+		return
+	}
+	now := time.Now()
+	for _, issue := range issues {
+		if err := r.processIssue(ctx, issue, now); err != nil {
+			slog.Error("failed to process JIT access issue", slog.Int("issue", issue.UID), log.BBError(err))
+		}
+	}
+}
+
+type credentialAction int
+
+const (
+	credentialActionNone credentialAction = iota
+	credentialActionCreate
+	credentialActionRevoke
+)
+
+func (r *Runner) processIssue(ctx context.Context, issue *store.IssueMessage, now time.Time) error {
+	request := issue.Payload.GetJitAccessRequest()
+	if request == nil {
+		return nil
+	}
+	approved := false
+	if request.Credential == nil && issue.Status == api.IssueOpen {
+		v, err := utils.CheckApprovalApproved(issue.Payload.Approval)
+		if err != nil {
+			return errors.Wrapf(err, "failed to check if the issue is approved")
+		}
+		approved = v
+	}
+	switch getCredentialAction(issue.Status, request.Credential, approved, now) {
+	case credentialActionCreate:
+		return r.createCredential(ctx, issue, request, now)
+	case credentialActionRevoke:
+		return r.revokeCredential(ctx, issue, request, now)
+	default:
+		return nil
+	}
+}
+
+// getCredentialAction returns the action on the credential of the JIT access issue.
+// The credential is created once the open issue is approved, and revoked at expiry or when the issue is canceled.
+func getCredentialAction(issueStatus api.IssueStatus, credential *storepb.JITAccessCredential, approved bool, now time.Time) credentialAction {
+	if credential == nil {
+		if issueStatus == api.IssueOpen && approved {
+			return credentialActionCreate
+		}
+		return credentialActionNone
+	}
+	if credential.State != storepb.JITAccessCredential_ACTIVE {
+		return credentialActionNone
+	}
+	if issueStatus == api.IssueCanceled || !now.Before(credential.ExpireTime.AsTime()) {
+		return credentialActionRevoke
+	}
+	return credentialActionNone
+}
+
+func (r *Runner) createCredential(ctx context.Context, issue *store.IssueMessage, request *storepb.JITAccessRequest, now time.Time) error {
+	username := getUsername(issue.UID)
+	credential := &storepb.JITAccessCredential{
+		State:      storepb.JITAccessCredential_ACTIVE,
+		Username:   username,
+		CreateTime: timestamppb.New(now),
+		ExpireTime: timestamppb.New(now.Add(request.GetDuration().AsDuration())),
+	}
+	createErr := r.createUser(ctx, request, credential)
+	if createErr != nil {
+		// Clean up the partially created user.
+		if err := r.dropUser(ctx, request, username); err != nil {
+			slog.Warn("failed to drop the partially created JIT access user", slog.Int("issue", issue.UID), log.BBError(err))
+		}
+		credential = &storepb.JITAccessCredential{
+			State:      storepb.JITAccessCredential_FAILED,
+			Username:   username,
+			CreateTime: timestamppb.New(now),
+			Error:      createErr.Error(),
+		}
+	}
+
+	request.Credential = credential
+	if err := r.updateRequest(ctx, issue, request); err != nil {
+		return err
+	}
+	if err := r.createAuditLog(ctx, issue, store.AuditLogMethodJITCredentialCreate, request, createErr); err != nil {
+		slog.Error("failed to create audit log for JIT access credential", slog.Int("issue", issue.UID), log.BBError(err))
+	}
+	if createErr != nil {
+		if err := webhook.ChangeIssueStatus(ctx, r.store, r.webhookManager, issue, api.IssueCanceled, r.store.GetSystemBotUser(ctx), "Failed to create the JIT access credential."); err != nil {
+			return errors.Wrapf(err, "failed to cancel the issue")
+		}
+	}
+	return nil
+}
+
+func (r *Runner) revokeCredential(ctx context.Context, issue *store.IssueMessage, request *storepb.JITAccessRequest, now time.Time) error {
+	credential := request.Credential
+	if dropErr := r.dropUser(ctx, request, credential.Username); dropErr != nil {
+		// Keep the credential active so that the runner retries in the next round.
+		// The failure is only audited once for the same error.
+		if credential.Error == dropErr.Error() {
+			return dropErr
+		}
+		credential.Error = dropErr.Error()
+		if err := r.updateRequest(ctx, issue, request); err != nil {
+			return err
+		}
+		if err := r.createAuditLog(ctx, issue, store.AuditLogMethodJITCredentialRevoke, request, dropErr); err != nil {
+			slog.Error("failed to create audit log for JIT access credential", slog.Int("issue", issue.UID), log.BBError(err))
+		}
+		return dropErr
+	}
+
+	credential.State = storepb.JITAccessCredential_REVOKED
+	credential.RevokeTime = timestamppb.New(now)
+	credential.ObfuscatedPassword = ""
+	credential.Error = ""
+	if err := r.updateRequest(ctx, issue, request); err != nil {
+		return err
+	}
+	if err := r.createAuditLog(ctx, issue, store.AuditLogMethodJITCredentialRevoke, request, nil); err != nil {
+		slog.Error("failed to create audit log for JIT access credential", slog.Int("issue", issue.UID), log.BBError(err))
+	}
+	if issue.Status == api.IssueOpen {
+		if err := webhook.ChangeIssueStatus(ctx, r.store, r.webhookManager, issue, api.IssueDone, r.store.GetSystemBotUser(ctx), "The JIT access has expired."); err != nil {
+			return errors.Wrapf(err, "failed to resolve the issue")
+		}
+	}
+	return nil
+}
+
+// createUser creates the database user and sets the obfuscated password of the credential.
+func (r *Runner) createUser(ctx context.Context, request *storepb.JITAccessRequest, credential *storepb.JITAccessCredential) error {
+	instance, database, err := r.getInstanceDatabase(ctx, request.Database)
+	if err != nil {
+		return err
+	}
+	var schemas []string
+	if instance.Engine == storepb.Engine_POSTGRES {
+		dbSchema, err := r.store.GetDBSchema(ctx, database.UID)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get database schema")
+		}
+		for _, schema := range dbSchema.GetMetadata().GetSchemas() {
+			schemas = append(schemas, schema.Name)
+		}
+	}
+	password, err := common.RandomString(passwordLength)
+	if err != nil {
+		return errors.Wrapf(err, "failed to generate password")
+	}
+	statement, err := getCreateUserStatement(instance.Engine, database.DatabaseName, schemas, credential.Username, password, request.Privileges, credential.ExpireTime.AsTime())
+	if err != nil {
+		return err
+	}
+
+	driver, err := r.dbFactory.GetAdminDatabaseDriver(ctx, instance, database, db.ConnectionContext{})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get database driver")
+	}
+	defer driver.Close(ctx)
+	if _, err := driver.Execute(ctx, statement, db.ExecuteOptions{}); err != nil {
+		return errors.Wrapf(err, "failed to create database user %q", credential.Username)
+	}
+	credential.ObfuscatedPassword = common.Obfuscate(password, r.secret)
+	return nil
+}
+
+// dropUser disables the database user, terminates its sessions and drops it.
+func (r *Runner) dropUser(ctx context.Context, request *storepb.JITAccessRequest, username string) error {
+	instance, database, err := r.getInstanceDatabase(ctx, request.Database)
+	if err != nil {
+		return err
+	}
+	statement, err := getDropUserStatement(instance.Engine, username)
+	if err != nil {
+		return err
+	}
+	driver, err := r.dbFactory.GetAdminDatabaseDriver(ctx, instance, database, db.ConnectionContext{})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get database driver")
+	}
+	defer driver.Close(ctx)
+	sqlDB := driver.GetDB()
+
+	switch instance.Engine {
+	case storepb.Engine_MYSQL, storepb.Engine_MARIADB:
+		// Drop the user first to reject the new connections, then kill the existing sessions.
+		if _, err := driver.Execute(ctx, statement, db.ExecuteOptions{}); err != nil {
+			return errors.Wrapf(err, "failed to drop database user %q", username)
+		}
+		rows, err := sqlDB.QueryContext(ctx, "SELECT ID FROM information_schema.PROCESSLIST WHERE USER = ?", username)
+		if err != nil {
+			return errors.Wrapf(err, "failed to list sessions of database user %q", username)
+		}
+		defer rows.Close()
+		var ids []int64
+		for rows.Next() {
+			var id int64
+			if err := rows.Scan(&id); err != nil {
+				return err
+			}
+			ids = append(ids, id)
+		}
+		if err := rows.Err(); err != nil {
+			return err
+		}
+		for _, id := range ids {
+			// The session may have ended.
+			if _, err := sqlDB.ExecContext(ctx, fmt.Sprintf("KILL %d", id)); err != nil {
+				slog.Warn("failed to kill session of JIT access user", slog.String("user", username), slog.Int64("session", id), log.BBError(err))
+			}
+		}
+	case storepb.Engine_POSTGRES:
+		var exists bool
+		if err := sqlDB.QueryRowContext(ctx, "SELECT EXISTS (SELECT 1 FROM pg_roles WHERE rolname = $1)", username).Scan(&exists); err != nil {
+			return errors.Wrapf(err, "failed to check database user %q", username)
+		}
+		if !exists {
+			return nil
+		}
+		if _, err := sqlDB.ExecContext(ctx, fmt.Sprintf("ALTER ROLE %s NOLOGIN", quotePostgresIdentifier(username))); err != nil {
+			return errors.Wrapf(err, "failed to disable database user %q", username)
+		}
+		if _, err := sqlDB.ExecContext(ctx, "SELECT pg_terminate_backend(pid) FROM pg_stat_activity WHERE usename = $1", username); err != nil {
+			return errors.Wrapf(err, "failed to terminate sessions of database user %q", username)
+		}
+		if _, err := driver.Execute(ctx, statement, db.ExecuteOptions{}); err != nil {
+			return errors.Wrapf(err, "failed to drop database user %q", username)
+		}
+	default:
+	}
+	return nil
+}
+
+func (r *Runner) getInstanceDatabase(ctx context.Context, name string) (*store.InstanceMessage, *store.DatabaseMessage, error) {
+	instanceID, databaseName, err := common.GetInstanceDatabaseID(name)
+	if err != nil {
+		return nil, nil, err
+	}
+	instance, err := r.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &instanceID})
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to get instance %q", instanceID)
+	}
+	if instance == nil {
+		return nil, nil, errors.Errorf("instance %q not found", instanceID)
+	}
+	database, err := r.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
+		InstanceID:          &instanceID,
+		DatabaseName:        &databaseName,
+		IgnoreCaseSensitive: store.IgnoreDatabaseAndTableCaseSensitive(instance),
+	})
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to get database %q", name)
+	}
+	if database == nil {
+		return nil, nil, errors.Errorf("database %q not found", name)
+	}
+	return instance, database, nil
+}
+
+func (r *Runner) updateRequest(ctx context.Context, issue *store.IssueMessage, request *storepb.JITAccessRequest) error {
+	if _, err := r.store.UpdateIssueV2(ctx, issue.UID, &store.UpdateIssueMessage{
+		PayloadUpsert: &storepb.IssuePayload{
+			JitAccessRequest: request,
+		},
+	}, api.SystemBotID); err != nil {
+		return errors.Wrapf(err, "failed to update issue payload")
+	}
+	return nil
+}
+
+func (r *Runner) createAuditLog(ctx context.Context, issue *store.IssueMessage, method store.AuditLogMethod, request *storepb.JITAccessRequest, actionErr error) error {
+	response, err := getAuditLogResponse(request)
+	if err != nil {
+		return err
+	}
+	auditLog := &storepb.AuditLog{
+		Parent:   common.FormatProject(issue.Project.ResourceID),
+		Method:   method.String(),
+		Resource: common.FormatIssue(issue.Project.ResourceID, issue.UID),
+		User:     common.FormatUserUID(api.SystemBotID),
+		Severity: storepb.AuditLog_INFO,
+		Response: response,
+	}
+	if actionErr != nil {
+		auditLog.Severity = storepb.AuditLog_ERROR
+		auditLog.Status = status.New(codes.Internal, actionErr.Error()).Proto()
+	}
+	return r.store.CreateAuditLog(ctx, auditLog)
+}
+
+// getAuditLogResponse returns the JIT access request in JSON without the password.
+func getAuditLogResponse(request *storepb.JITAccessRequest) (string, error) {
+	redacted, ok := proto.Clone(request).(*storepb.JITAccessRequest)
+	if !ok {
+		return "", errors.New("failed to clone JIT access request")
+	}
+	if redacted.Credential != nil {
+		redacted.Credential.ObfuscatedPassword = ""
+	}
+	response, err := protojson.Marshal(redacted)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to marshal JIT access request")
+	}
+	return string(response), nil
+}
@@ -0,0 +1,67 @@
+package jitaccess
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestGetCredentialAction(t *testing.T) {
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	active := &storepb.JITAccessCredential{
+		State:      storepb.JITAccessCredential_ACTIVE,
+		ExpireTime: timestamppb.New(now.Add(time.Hour)),
+	}
+	expired := &storepb.JITAccessCredential{
+		State:      storepb.JITAccessCredential_ACTIVE,
+		ExpireTime: timestamppb.New(now),
+	}
+	revoked := &storepb.JITAccessCredential{
+		State:      storepb.JITAccessCredential_REVOKED,
+		ExpireTime: timestamppb.New(now.Add(-time.Hour)),
+	}
+
+	tests := []struct {
+		name       string
+		status     api.IssueStatus
+		credential *storepb.JITAccessCredential
+		approved   bool
+		want       credentialAction
+	}{
+		{name: "waiting for approval", status: api.IssueOpen, want: credentialActionNone},
+		{name: "approved", status: api.IssueOpen, approved: true, want: credentialActionCreate},
+		{name: "canceled before creation", status: api.IssueCanceled, approved: true, want: credentialActionNone},
+		{name: "active", status: api.IssueOpen, credential: active, want: credentialActionNone},
+		{name: "expired", status: api.IssueOpen, credential: expired, want: credentialActionRevoke},
+		{name: "canceled", status: api.IssueCanceled, credential: active, want: credentialActionRevoke},
+		{name: "revoked", status: api.IssueCanceled, credential: revoked, want: credentialActionNone},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.want, getCredentialAction(test.status, test.credential, test.approved, now))
+		})
+	}
+}
+
+func TestGetAuditLogResponse(t *testing.T) {
+	a := require.New(t)
+	request := &storepb.JITAccessRequest{
+		Database: "instances/i1/databases/db",
+		Credential: &storepb.JITAccessCredential{
+			State:              storepb.JITAccessCredential_ACTIVE,
+			Username:           "bb_jit_1",
+			ObfuscatedPassword: "secret",
+		},
+	}
+	response, err := getAuditLogResponse(request)
+	a.NoError(err)
+	a.Contains(response, "bb_jit_1")
+	a.NotContains(response, "secret")
+	// The request itself is untouched.
+	a.Equal("secret", request.Credential.ObfuscatedPassword)
+}
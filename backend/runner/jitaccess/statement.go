@@ -0,0 +1,131 @@
+package jitaccess
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/pbkdf2"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	scramIterations = 4096
+	scramSaltLength = 16
+)
+
+// getUsername returns the database username of the JIT access credential for the issue.
+// The username is derived from the issue so that the runner can always find the user to drop.
+func getUsername(issueUID int) string {
+	return fmt.Sprintf("bb_jit_%d", issueUID)
+}
+
+func getPrivilegeList(privileges []storepb.JITAccessRequest_Privilege) (string, error) {
+	var list []string
+	seen := make(map[storepb.JITAccessRequest_Privilege]bool)
+	for _, privilege := range privileges {
+		if seen[privilege] {
+			continue
+		}
+		seen[privilege] = true
+		switch privilege {
+		case storepb.JITAccessRequest_SELECT, storepb.JITAccessRequest_INSERT, storepb.JITAccessRequest_UPDATE, storepb.JITAccessRequest_DELETE:
+			list = append(list, privilege.String())
+		default:
+			return "", errors.Errorf("unsupported privilege %v", privilege)
+		}
+	}
+	if len(list) == 0 {
+		return "", errors.New("no privileges")
+	}
+	return strings.Join(list, ", "), nil
+}
+
+// getCreateUserStatement returns the statement creating the database user with the privileges on the database.
+// The schemas are only used by PostgreSQL, where the privileges are granted on all tables in each schema.
+func getCreateUserStatement(engine storepb.Engine, databaseName string, schemas []string, username, password string, privileges []storepb.JITAccessRequest_Privilege, expireTime time.Time) (string, error) {
+	privilegeList, err := getPrivilegeList(privileges)
+	if err != nil {
+		return "", err
+	}
+	var buf strings.Builder
+	switch engine {
+	case storepb.Engine_MYSQL, storepb.Engine_MARIADB:
+		user := fmt.Sprintf("%s@'%%'", quoteMySQLString(username))
+		_, _ = fmt.Fprintf(&buf, "CREATE USER %s IDENTIFIED BY %s;\n", user, quoteMySQLString(password))
+		_, _ = fmt.Fprintf(&buf, "GRANT %s ON %s.* TO %s;\n", privilegeList, quoteMySQLIdentifier(databaseName), user)
+	case storepb.Engine_POSTGRES:
+		// The server may log the statement, so the password is sent as the SCRAM-SHA-256 verifier instead of the plaintext.
+		salt := make([]byte, scramSaltLength)
+		if _, err := rand.Read(salt); err != nil {
+			return "", errors.Wrapf(err, "failed to generate salt")
+		}
+		role := quotePostgresIdentifier(username)
+		_, _ = fmt.Fprintf(&buf, "CREATE ROLE %s LOGIN PASSWORD %s VALID UNTIL '%s';\n", role, quotePostgresString(getPostgresSCRAMVerifier(password, salt)), expireTime.UTC().Format(time.RFC3339))
+		_, _ = fmt.Fprintf(&buf, "GRANT CONNECT ON DATABASE %s TO %s;\n", quotePostgresIdentifier(databaseName), role)
+		for _, schema := range schemas {
+			_, _ = fmt.Fprintf(&buf, "GRANT USAGE ON SCHEMA %s TO %s;\n", quotePostgresIdentifier(schema), role)
+			_, _ = fmt.Fprintf(&buf, "GRANT %s ON ALL TABLES IN SCHEMA %s TO %s;\n", privilegeList, quotePostgresIdentifier(schema), role)
+		}
+	default:
+		return "", errors.Errorf("unsupported engine %v", engine)
+	}
+	return buf.String(), nil
+}
+
+// getPostgresSCRAMVerifier returns the SCRAM-SHA-256 verifier of the password in the format stored by PostgreSQL.
+// PostgreSQL stores the verifier as is if the password of CREATE ROLE is given in this format.
+func getPostgresSCRAMVerifier(password string, salt []byte) string {
+	saltedPassword := pbkdf2.Key([]byte(password), salt, scramIterations, sha256.Size, sha256.New)
+	clientKey := getHMACSHA256(saltedPassword, "Client Key")
+	storedKey := sha256.Sum256(clientKey)
+	serverKey := getHMACSHA256(saltedPassword, "Server Key")
+	return fmt.Sprintf("SCRAM-SHA-256$%d:%s$%s:%s",
+		scramIterations,
+		base64.StdEncoding.EncodeToString(salt),
+		base64.StdEncoding.EncodeToString(storedKey[:]),
+		base64.StdEncoding.EncodeToString(serverKey),
+	)
+}
+
+func getHMACSHA256(key []byte, message string) []byte {
+	h := hmac.New(sha256.New, key)
+	_, _ = h.Write([]byte(message))
+	return h.Sum(nil)
+}
+
+// getDropUserStatement returns the statement dropping the database user.
+// For PostgreSQL, the caller must check that the role exists because DROP OWNED BY fails on the missing roles.
+func getDropUserStatement(engine storepb.Engine, username string) (string, error) {
+	switch engine {
+	case storepb.Engine_MYSQL, storepb.Engine_MARIADB:
+		return fmt.Sprintf("DROP USER IF EXISTS %s@'%%';\n", quoteMySQLString(username)), nil
+	case storepb.Engine_POSTGRES:
+		role := quotePostgresIdentifier(username)
+		return fmt.Sprintf("DROP OWNED BY %s;\nDROP ROLE IF EXISTS %s;\n", role, role), nil
+	default:
+		return "", errors.Errorf("unsupported engine %v", engine)
+	}
+}
+
+func quoteMySQLString(s string) string {
+	return fmt.Sprintf("'%s'", strings.ReplaceAll(strings.ReplaceAll(s, `\`, `\\`), "'", "''"))
+}
+
+func quoteMySQLIdentifier(s string) string {
+	return fmt.Sprintf("`%s`", strings.ReplaceAll(s, "`", "``"))
+}
+
+func quotePostgresString(s string) string {
+	return fmt.Sprintf("'%s'", strings.ReplaceAll(s, "'", "''"))
+}
+
+func quotePostgresIdentifier(s string) string {
+	return fmt.Sprintf(`"%s"`, strings.ReplaceAll(s, `"`, `""`))
+}
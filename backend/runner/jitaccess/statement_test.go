@@ -0,0 +1,58 @@
+package jitaccess
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestGetCreateUserStatement(t *testing.T) {
+	a := require.New(t)
+	expireTime := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	privileges := []storepb.JITAccessRequest_Privilege{storepb.JITAccessRequest_SELECT, storepb.JITAccessRequest_UPDATE, storepb.JITAccessRequest_SELECT}
+
+	statement, err := getCreateUserStatement(storepb.Engine_MYSQL, "a`b", nil, "bb_jit_1", "pass'word", privileges, expireTime)
+	a.NoError(err)
+	a.Equal("CREATE USER 'bb_jit_1'@'%' IDENTIFIED BY 'pass''word';\nGRANT SELECT, UPDATE ON `a``b`.* TO 'bb_jit_1'@'%';\n", statement)
+
+	statement, err = getCreateUserStatement(storepb.Engine_POSTGRES, "db", []string{"public", "hr"}, "bb_jit_1", "password", privileges, expireTime)
+	a.NoError(err)
+	// The plaintext password never appears in the statement.
+	a.NotContains(statement, "'password'")
+	a.Regexp(`^CREATE ROLE "bb_jit_1" LOGIN PASSWORD 'SCRAM-SHA-256\$4096:[A-Za-z0-9+/=]+\$[A-Za-z0-9+/=]+:[A-Za-z0-9+/=]+' VALID UNTIL '2024-01-02T03:04:05Z';\n`, statement)
+	a.Equal(`GRANT CONNECT ON DATABASE "db" TO "bb_jit_1";
+GRANT USAGE ON SCHEMA "public" TO "bb_jit_1";
+GRANT SELECT, UPDATE ON ALL TABLES IN SCHEMA "public" TO "bb_jit_1";
+GRANT USAGE ON SCHEMA "hr" TO "bb_jit_1";
+GRANT SELECT, UPDATE ON ALL TABLES IN SCHEMA "hr" TO "bb_jit_1";
+`, statement[strings.Index(statement, "GRANT"):])
+
+	_, err = getCreateUserStatement(storepb.Engine_MYSQL, "db", nil, "bb_jit_1", "password", nil, expireTime)
+	a.Error(err)
+	_, err = getCreateUserStatement(storepb.Engine_MYSQL, "db", nil, "bb_jit_1", "password", []storepb.JITAccessRequest_Privilege{storepb.JITAccessRequest_PRIVILEGE_UNSPECIFIED}, expireTime)
+	a.Error(err)
+	_, err = getCreateUserStatement(storepb.Engine_ORACLE, "db", nil, "bb_jit_1", "password", privileges, expireTime)
+	a.Error(err)
+}
+
+func TestGetDropUserStatement(t *testing.T) {
+	a := require.New(t)
+	statement, err := getDropUserStatement(storepb.Engine_MARIADB, "bb_jit_1")
+	a.NoError(err)
+	a.Equal("DROP USER IF EXISTS 'bb_jit_1'@'%';\n", statement)
+	statement, err = getDropUserStatement(storepb.Engine_POSTGRES, "bb_jit_1")
+	a.NoError(err)
+	a.Equal("DROP OWNED BY \"bb_jit_1\";\nDROP ROLE IF EXISTS \"bb_jit_1\";\n", statement)
+}
+
+func TestGetPostgresSCRAMVerifier(t *testing.T) {
+	a := require.New(t)
+	a.Equal(
+		"SCRAM-SHA-256$4096:MDEyMzQ1Njc4OWFiY2RlZg==$wjGCKoCIcEWiPxSG7t/wnb/YICMEFr1JZNZSKNje12g=:6NG/vkzOjK2oyl11qeNEBeKuOY3QQ4atswXYbIBO79Q=",
+		getPostgresSCRAMVerifier("password", []byte("0123456789abcdef")),
+	)
+}
@@ -0,0 +1,664 @@
+package plancheck
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/dbfactory"
+	"github.com/bytebase/bytebase/backend/component/sheet"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	pgdriver "github.com/bytebase/bytebase/backend/plugin/db/pg"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/utils"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	// lockWaitSampleInterval is the interval to sample whether the dry run session is waiting for a lock.
+	lockWaitSampleInterval = 100 * time.Millisecond
+	// dryRunLockTimeout is the maximum time the dry run statements wait for a lock,
+	// so that the dry run doesn't queue the other sessions behind its lock requests for long.
+	dryRunLockTimeout = 3 * time.Second
+	// dryRunStatementTimeout is the maximum execution time of a dry run statement.
+	dryRunStatementTimeout = 30 * time.Second
+	// dryRunTimeout is the maximum time of the whole dry run.
+	dryRunTimeout = 5 * time.Minute
+	// dryRunSavepoint is the savepoint marking the dry run transaction.
+	dryRunSavepoint = "bb_dry_run"
+)
+
+var (
+	// sessionIDQueries are the queries to get the session ID of the current connection.
+	sessionIDQueries = map[storepb.Engine]string{
+		storepb.Engine_POSTGRES: "SELECT pg_backend_pid()",
+		storepb.Engine_MSSQL:    "SELECT @@SPID",
+	}
+	// lockWaitQueries are the queries to check if the session is waiting for a lock.
+	lockWaitQueries = map[storepb.Engine]string{
+		storepb.Engine_POSTGRES: "SELECT COALESCE(bool_or(wait_event_type = 'Lock'), false) FROM pg_stat_activity WHERE pid = $1",
+		storepb.Engine_MSSQL:    "SELECT CAST(COUNT(*) AS BIT) FROM sys.dm_exec_requests WHERE session_id = @p1 AND wait_type LIKE 'LCK%'",
+	}
+)
+
+// NewStatementDryRunExecutor creates a statement dry run executor.
+func NewStatementDryRunExecutor(store *store.Store, sheetManager *sheet.Manager, dbFactory *dbfactory.DBFactory) Executor {
+	return &StatementDryRunExecutor{
+		store:        store,
+		sheetManager: sheetManager,
+		dbFactory:    dbFactory,
+	}
+}
+
+// StatementDryRunExecutor is the statement dry run executor.
+// It executes the statement without persisting the changes to catch the errors that static analysis misses.
+type StatementDryRunExecutor struct {
+	store        *store.Store
+	sheetManager *sheet.Manager
+	dbFactory    *dbfactory.DBFactory
+}
+
+// Run runs the statement dry run executor.
+func (e *StatementDryRunExecutor) Run(ctx context.Context, config *storepb.PlanCheckRunConfig) ([]*storepb.PlanCheckRunResult_Result, error) {
+	sheetUID := int(config.SheetUid)
+	sheet, err := e.store.GetSheet(ctx, &store.FindSheetMessage{UID: &sheetUID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get sheet %d", sheetUID)
+	}
+	if sheet == nil {
+		return nil, errors.Errorf("sheet %d not found", sheetUID)
+	}
+	if sheet.Size > common.MaxSheetCheckSize {
+		return []*storepb.PlanCheckRunResult_Result{
+			{
+				Status:  storepb.PlanCheckRunResult_Result_WARNING,
+				Code:    common.SizeExceeded.Int32(),
+				Title:   "Dry run for large SQL is not supported",
+				Content: "",
+			},
+		}, nil
+	}
+	statement, err := e.store.GetSheetStatementByID(ctx, sheetUID)
+	if err != nil {
+		return nil, err
+	}
+
+	instanceUID := int(config.InstanceUid)
+	instance, err := e.store.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &instanceUID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get instance UID %v", instanceUID)
+	}
+	if instance == nil {
+		return nil, errors.Errorf("instance not found UID %v", instanceUID)
+	}
+	if !common.DryRunTransactionEngines[instance.Engine] && !common.DryRunCloneEngines[instance.Engine] {
+		return []*storepb.PlanCheckRunResult_Result{
+			{
+				Status:  storepb.PlanCheckRunResult_Result_SUCCESS,
+				Code:    common.Ok.Int32(),
+				Title:   fmt.Sprintf("Dry run is not supported for %s", instance.Engine),
+				Content: "",
+			},
+		}, nil
+	}
+
+	database, err := e.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{InstanceID: &instance.ResourceID, DatabaseName: &config.DatabaseName})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get database %q", config.DatabaseName)
+	}
+	if database == nil {
+		return nil, errors.Errorf("database not found %q", config.DatabaseName)
+	}
+	// The policy may be disabled after the plan check is created.
+	environment, err := e.store.GetEnvironmentV2(ctx, &store.FindEnvironmentMessage{ResourceID: &database.EffectiveEnvironmentID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get environment %q", database.EffectiveEnvironmentID)
+	}
+	if environment == nil {
+		return nil, errors.Errorf("environment %q not found", database.EffectiveEnvironmentID)
+	}
+	policy, err := e.store.GetDryRunPolicy(ctx, environment.UID)
+	if err != nil {
+		return nil, err
+	}
+	if !policy.Enabled {
+		return []*storepb.PlanCheckRunResult_Result{
+			{
+				Status:  storepb.PlanCheckRunResult_Result_SUCCESS,
+				Code:    common.Ok.Int32(),
+				Title:   "Dry run is disabled",
+				Content: fmt.Sprintf("Dry run is not enabled by the policy of environment %q.", environment.Title),
+			},
+		}, nil
+	}
+
+	materials := utils.GetSecretMapFromDatabaseMessage(database)
+	// To avoid leaking the rendered statement, the error message should use the original statement and not the rendered statement.
+	renderedStatement := utils.RenderStatement(statement, materials)
+	singleSQLs, err := base.SplitMultiSQL(instance.Engine, renderedStatement)
+	if err != nil {
+		return []*storepb.PlanCheckRunResult_Result{
+			{
+				Status:  storepb.PlanCheckRunResult_Result_ERROR,
+				Code:    common.Invalid.Int32(),
+				Title:   "Failed to split the statement",
+				Content: err.Error(),
+			},
+		}, nil
+	}
+	statements := base.FilterEmptySQL(singleSQLs)
+
+	ctx, cancel := context.WithTimeout(ctx, dryRunTimeout)
+	defer cancel()
+	if common.DryRunTransactionEngines[instance.Engine] {
+		// The statements ending the transaction would persist the changes, so they must never be executed in the dry run transaction.
+		if statement, reason := getTransactionUnsafeStatement(instance.Engine, statements); reason != "" {
+			return []*storepb.PlanCheckRunResult_Result{
+				newDryRunSkippedResult(fmt.Sprintf("The statement at line %d %s, which cannot be dry run in a transaction.", statement.FirstStatementLine+1, reason)),
+			}, nil
+		}
+		return e.runInTransaction(ctx, instance, database, statements)
+	}
+	return e.runInClone(ctx, instance, database, policy.SandboxInstance, statement, statements)
+}
+
+// runInTransaction executes the statements in a transaction and rolls it back.
+// The statements must have been checked by getTransactionUnsafeStatement.
+func (e *StatementDryRunExecutor) runInTransaction(ctx context.Context, instance *store.InstanceMessage, database *store.DatabaseMessage, statements []base.SingleSQL) ([]*storepb.PlanCheckRunResult_Result, error) {
+	// The engines without transactional DDL, e.g. MySQL, commit the DDL implicitly.
+	if !common.DryRunTransactionEngines[instance.Engine] {
+		return nil, errors.Errorf("dry run in a transaction is not supported for %s", instance.Engine)
+	}
+	useDatabaseOwner, err := getUseDatabaseOwner(ctx, e.store, instance, database)
+	if err != nil {
+		return nil, err
+	}
+	driver, err := e.dbFactory.GetAdminDatabaseDriver(ctx, instance, database, db.ConnectionContext{
+		UseDatabaseOwner: useDatabaseOwner,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer driver.Close(ctx)
+
+	conn, err := driver.GetDB().Conn(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get connection")
+	}
+	defer conn.Close()
+
+	var sessionID int64
+	if query, ok := sessionIDQueries[instance.Engine]; ok {
+		if err := conn.QueryRowContext(ctx, query).Scan(&sessionID); err != nil {
+			return nil, errors.Wrapf(err, "failed to get session id")
+		}
+	}
+
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to begin transaction")
+	}
+	// The transaction is always rolled back, the changes must not be persisted.
+	defer tx.Rollback()
+	for _, query := range getDryRunTimeoutStatements(instance.Engine) {
+		if _, err := tx.ExecContext(ctx, query); err != nil {
+			return nil, errors.Wrapf(err, "failed to set the dry run timeout")
+		}
+	}
+	checkTransactionOpen, err := markDryRunTransaction(ctx, tx, instance.Engine)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to mark the dry run transaction")
+	}
+
+	var results []*storepb.PlanCheckRunResult_Result
+	var executable []base.SingleSQL
+	for _, statement := range statements {
+		if instance.Engine == storepb.Engine_POSTGRES && pgdriver.IsNonTransactionStatement(statement.Text) {
+			results = append(results, &storepb.PlanCheckRunResult_Result{
+				Status:  storepb.PlanCheckRunResult_Result_WARNING,
+				Code:    common.Ok.Int32(),
+				Title:   "Skip the statement that cannot run in a transaction",
+				Content: fmt.Sprintf("The statement at line %d is skipped in the dry run.", statement.FirstStatementLine+1),
+			})
+			continue
+		}
+		executable = append(executable, statement)
+	}
+
+	stopMonitor := func() time.Duration { return 0 }
+	if query, ok := lockWaitQueries[instance.Engine]; ok {
+		stopMonitor = monitorLockWait(ctx, driver.GetDB(), query, sessionID)
+	}
+	startTime := time.Now()
+	executeErr := executeDryRunStatements(ctx, tx, executable)
+	executionDuration := time.Since(startTime)
+	lockWaitDuration := stopMonitor()
+
+	// The transaction must be the one to roll back, otherwise the changes may have been persisted.
+	if err := checkTransactionOpen(ctx); err != nil {
+		slog.Error("the dry run transaction was ended by the statements",
+			slog.String("instance", instance.ResourceID),
+			slog.String("database", database.DatabaseName),
+			log.BBError(err))
+		return append(results, &storepb.PlanCheckRunResult_Result{
+			Status:  storepb.PlanCheckRunResult_Result_ERROR,
+			Code:    common.Internal.Int32(),
+			Title:   "Dry run transaction was ended unexpectedly",
+			Content: fmt.Sprintf("The dry run transaction was ended by the statements, and the changes may have been persisted: %v", err),
+		}), nil
+	}
+
+	return append(results, buildDryRunResult(executeErr, executionDuration, lockWaitDuration, false /* sandboxClone */)), nil
+}
+
+// runInClone executes the statements against a schema-only clone of the database created from the schema dump.
+// The clone is created on the sandbox instance, never on the instance of the database.
+func (e *StatementDryRunExecutor) runInClone(ctx context.Context, instance *store.InstanceMessage, database *store.DatabaseMessage, sandboxInstanceID string, originalStatement string, statements []base.SingleSQL) ([]*storepb.PlanCheckRunResult_Result, error) {
+	sandbox, skipped, err := e.getSandboxInstance(ctx, instance, sandboxInstanceID)
+	if err != nil {
+		return nil, err
+	}
+	if skipped != nil {
+		return []*storepb.PlanCheckRunResult_Result{skipped}, nil
+	}
+
+	dbSchema, err := e.store.GetDBSchema(ctx, database.UID)
+	if err != nil {
+		return nil, err
+	}
+	if dbSchema == nil {
+		return nil, errors.Errorf("database schema not found: %d", database.UID)
+	}
+
+	// The clone only isolates the unqualified objects, so the statement must not touch other databases.
+	asts, advices := e.sheetManager.GetASTsForChecks(instance.Engine, originalStatement)
+	if len(advices) > 0 {
+		advice := advices[0]
+		return []*storepb.PlanCheckRunResult_Result{
+			{
+				Status:  storepb.PlanCheckRunResult_Result_ERROR,
+				Title:   advice.Title,
+				Content: advice.Content,
+				Code:    advice.Code,
+			},
+		}, nil
+	}
+	changeSummary, err := base.ExtractChangedResources(instance.Engine, database.DatabaseName, "" /* defaultSchema */, dbSchema, asts, originalStatement)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to extract changed resources")
+	}
+	for _, changedDatabase := range changeSummary.ChangedResources.Build().GetDatabases() {
+		if changedDatabase.GetName() != database.DatabaseName {
+			return []*storepb.PlanCheckRunResult_Result{
+				newDryRunSkippedResult(fmt.Sprintf("The statement changes the database %q, which cannot be isolated in the schema-only clone.", changedDatabase.GetName())),
+			}, nil
+		}
+	}
+
+	driver, err := e.dbFactory.GetAdminDatabaseDriver(ctx, instance, database, db.ConnectionContext{})
+	if err != nil {
+		return nil, err
+	}
+	defer driver.Close(ctx)
+	var schemaBuf bytes.Buffer
+	if err := driver.Dump(ctx, &schemaBuf, dbSchema.GetMetadata()); err != nil {
+		return nil, errors.Wrapf(err, "failed to dump schema")
+	}
+
+	sandboxDataSource := utils.DataSourceFromInstanceWithType(sandbox, api.Admin)
+	if sandboxDataSource == nil {
+		return nil, common.Errorf(common.Internal, "admin data source not found for sandbox instance %q", sandbox.ResourceID)
+	}
+	sandboxDriver, err := e.dbFactory.GetAdminDatabaseDriver(ctx, sandbox, nil /* database */, db.ConnectionContext{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get driver for the sandbox instance %q", sandbox.ResourceID)
+	}
+	defer sandboxDriver.Close(ctx)
+
+	cloneDatabaseName := fmt.Sprintf("_dryrun_%d_%d", database.UID, time.Now().Unix())
+	if _, err := sandboxDriver.Execute(ctx, fmt.Sprintf("CREATE DATABASE `%s`;", cloneDatabaseName), db.ExecuteOptions{}); err != nil {
+		return nil, errors.Wrapf(err, "failed to create the clone database %q", cloneDatabaseName)
+	}
+	defer func() {
+		// Use a new context to drop the clone database even if the check is canceled.
+		if _, err := sandboxDriver.Execute(context.Background(), fmt.Sprintf("DROP DATABASE IF EXISTS `%s`;", cloneDatabaseName), db.ExecuteOptions{}); err != nil {
+			slog.Error("failed to drop the dry run clone database", slog.String("instance", sandbox.ResourceID), slog.String("database", cloneDatabaseName), log.BBError(err))
+		}
+	}()
+
+	cloneDriver, err := e.dbFactory.GetDataSourceDriver(ctx, sandbox, sandboxDataSource, cloneDatabaseName, false /* datashare */, false /* readOnly */, db.ConnectionContext{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get driver for the clone database %q", cloneDatabaseName)
+	}
+	defer cloneDriver.Close(ctx)
+	if schemaBuf.Len() > 0 {
+		if _, err := cloneDriver.Execute(ctx, schemaBuf.String(), db.ExecuteOptions{}); err != nil {
+			return nil, errors.Wrapf(err, "failed to restore the schema to the clone database %q", cloneDatabaseName)
+		}
+	}
+
+	conn, err := cloneDriver.GetDB().Conn(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get connection")
+	}
+	defer conn.Close()
+
+	startTime := time.Now()
+	executeErr := executeDryRunStatements(ctx, conn, statements)
+	executionDuration := time.Since(startTime)
+
+	return []*storepb.PlanCheckRunResult_Result{
+		buildDryRunResult(executeErr, executionDuration, 0 /* lockWaitDuration */, true /* sandboxClone */),
+	}, nil
+}
+
+// getSandboxInstance returns the sandbox instance to create the schema-only clone on,
+// or the skipped result if the sandbox instance cannot be used.
+func (e *StatementDryRunExecutor) getSandboxInstance(ctx context.Context, instance *store.InstanceMessage, sandboxInstanceID string) (*store.InstanceMessage, *storepb.PlanCheckRunResult_Result, error) {
+	if sandboxInstanceID == "" {
+		return nil, newDryRunSkippedResult("No sandbox instance is set in the dry run policy to create the schema-only clone of the database."), nil
+	}
+	if sandboxInstanceID == instance.ResourceID {
+		return nil, newDryRunSkippedResult("The sandbox instance must not be the instance of the database."), nil
+	}
+	sandbox, err := e.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &sandboxInstanceID})
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to get sandbox instance %q", sandboxInstanceID)
+	}
+	if sandbox == nil || sandbox.Deleted {
+		return nil, newDryRunSkippedResult(fmt.Sprintf("The sandbox instance %q is not found.", sandboxInstanceID)), nil
+	}
+	if sandbox.Engine != instance.Engine {
+		return nil, newDryRunSkippedResult(fmt.Sprintf("The sandbox instance %q is %v, but the database is %v.", sandboxInstanceID, sandbox.Engine, instance.Engine)), nil
+	}
+	return sandbox, nil, nil
+}
+
+func newDryRunSkippedResult(content string) *storepb.PlanCheckRunResult_Result {
+	return &storepb.PlanCheckRunResult_Result{
+		Status:  storepb.PlanCheckRunResult_Result_WARNING,
+		Code:    common.Ok.Int32(),
+		Title:   "Dry run is skipped",
+		Content: content,
+	}
+}
+
+// getDryRunTimeoutStatements returns the statements limiting the lock wait and the execution time of the dry run transaction.
+func getDryRunTimeoutStatements(engine storepb.Engine) []string {
+	switch engine {
+	case storepb.Engine_POSTGRES:
+		// SET LOCAL only applies to the transaction, which is rolled back.
+		return []string{
+			fmt.Sprintf("SET LOCAL lock_timeout = '%dms'", dryRunLockTimeout.Milliseconds()),
+			fmt.Sprintf("SET LOCAL statement_timeout = '%dms'", dryRunStatementTimeout.Milliseconds()),
+		}
+	case storepb.Engine_MSSQL:
+		// SQL Server has no statement timeout, the execution is bounded by the dry run timeout.
+		return []string{
+			fmt.Sprintf("SET LOCK_TIMEOUT %d", dryRunLockTimeout.Milliseconds()),
+		}
+	default:
+		return nil
+	}
+}
+
+// markDryRunTransaction marks the dry run transaction, and returns the function checking that the transaction is still open.
+func markDryRunTransaction(ctx context.Context, tx *sql.Tx, engine storepb.Engine) (func(context.Context) error, error) {
+	switch engine {
+	case storepb.Engine_MSSQL:
+		// The transaction ID changes if the transaction is committed and another one begins.
+		const query = "SELECT transaction_id FROM sys.dm_tran_current_transaction"
+		var transactionID int64
+		if err := tx.QueryRowContext(ctx, query).Scan(&transactionID); err != nil {
+			return nil, err
+		}
+		return func(ctx context.Context) error {
+			var trancount int
+			if err := tx.QueryRowContext(ctx, "SELECT @@TRANCOUNT").Scan(&trancount); err != nil {
+				return err
+			}
+			if trancount == 0 {
+				return errors.New("no open transaction")
+			}
+			var currentID int64
+			if err := tx.QueryRowContext(ctx, query).Scan(&currentID); err != nil {
+				return err
+			}
+			if currentID != transactionID {
+				return errors.Errorf("the transaction %d has been replaced by %d", transactionID, currentID)
+			}
+			return nil
+		}, nil
+	default:
+		// The savepoint is released with the transaction, and rolling back to it works even if the transaction is aborted by an error.
+		if _, err := tx.ExecContext(ctx, "SAVEPOINT "+dryRunSavepoint); err != nil {
+			return nil, err
+		}
+		return func(ctx context.Context) error {
+			_, err := tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT "+dryRunSavepoint)
+			return err
+		}, nil
+	}
+}
+
+// getTransactionUnsafeStatement returns the first statement that ends the dry run transaction or resets its timeouts,
+// and the reason, which is empty if all statements are safe.
+func getTransactionUnsafeStatement(engine storepb.Engine, statements []base.SingleSQL) (base.SingleSQL, string) {
+	for _, statement := range statements {
+		if reason := getTransactionUnsafeReason(engine, getStatementKeywords(statement.Text)); reason != "" {
+			return statement, reason
+		}
+	}
+	return base.SingleSQL{}, ""
+}
+
+func getTransactionUnsafeReason(engine storepb.Engine, keywords []string) string {
+	keyword := func(i int) string {
+		if i < len(keywords) {
+			return keywords[i]
+		}
+		return ""
+	}
+	switch engine {
+	case storepb.Engine_MSSQL:
+		// The transaction control statements can be nested in the blocks, e.g. BEGIN TRY ... COMMIT ... END TRY.
+		for i, k := range keywords {
+			switch k {
+			case "COMMIT", "ROLLBACK":
+				return "controls the transaction"
+			case "BEGIN":
+				if next := keyword(i + 1); next == "TRAN" || next == "TRANSACTION" || next == "DISTRIBUTED" {
+					return "controls the transaction"
+				}
+			case "EXEC", "EXECUTE":
+				return "executes a procedure or dynamic SQL, which may commit the transaction"
+			case "SET":
+				if next := keyword(i + 1); next == "IMPLICIT_TRANSACTIONS" || next == "LOCK_TIMEOUT" {
+					return "changes the transaction settings"
+				}
+			}
+		}
+	case storepb.Engine_POSTGRES:
+		switch keyword(0) {
+		case "BEGIN", "START", "COMMIT", "END", "ABORT":
+			return "controls the transaction"
+		case "ROLLBACK":
+			if keyword(1) != "TO" {
+				return "controls the transaction"
+			}
+		case "PREPARE":
+			if keyword(1) == "TRANSACTION" {
+				return "controls the transaction"
+			}
+		case "CALL":
+			return "calls a procedure, which may commit the transaction"
+		case "SET", "RESET":
+			for _, k := range keywords[1:] {
+				if k == "LOCK_TIMEOUT" || k == "STATEMENT_TIMEOUT" || k == "ALL" {
+					return "changes the dry run timeouts"
+				}
+			}
+		}
+	default:
+		switch keyword(0) {
+		case "BEGIN", "COMMIT", "END":
+			return "controls the transaction"
+		case "ROLLBACK":
+			if keyword(1) != "TO" {
+				return "controls the transaction"
+			}
+		}
+	}
+	return ""
+}
+
+// getStatementKeywords returns the upper case words of the statement,
+// skipping the comments, the string literals and the quoted identifiers.
+func getStatementKeywords(text string) []string {
+	var keywords []string
+	for i := 0; i < len(text); {
+		switch {
+		case strings.HasPrefix(text[i:], "--"):
+			end := strings.IndexByte(text[i:], '\n')
+			if end < 0 {
+				return keywords
+			}
+			i += end + 1
+		case strings.HasPrefix(text[i:], "/*"):
+			end := strings.Index(text[i+2:], "*/")
+			if end < 0 {
+				return keywords
+			}
+			i += end + 4
+		case text[i] == '\'' || text[i] == '"' || text[i] == '`':
+			end := strings.IndexByte(text[i+1:], text[i])
+			if end < 0 {
+				return keywords
+			}
+			i += end + 2
+		case text[i] == '[':
+			end := strings.IndexByte(text[i+1:], ']')
+			if end < 0 {
+				return keywords
+			}
+			i += end + 2
+		case text[i] == '$':
+			// The dollar-quoted string of Postgres, e.g. $$...$$ or $body$...$body$.
+			tagEnd := strings.IndexByte(text[i+1:], '$')
+			if tagEnd < 0 || !isDollarQuoteTag(text[i+1:i+1+tagEnd]) {
+				i++
+				continue
+			}
+			tag := text[i : i+tagEnd+2]
+			end := strings.Index(text[i+len(tag):], tag)
+			if end < 0 {
+				return keywords
+			}
+			i += len(tag) + end + len(tag)
+		case text[i] == '_' || unicode.IsLetter(rune(text[i])):
+			j := i
+			for j < len(text) && (text[j] == '_' || unicode.IsLetter(rune(text[j])) || unicode.IsDigit(rune(text[j]))) {
+				j++
+			}
+			keywords = append(keywords, strings.ToUpper(text[i:j]))
+			i = j
+		default:
+			i++
+		}
+	}
+	return keywords
+}
+
+func isDollarQuoteTag(tag string) bool {
+	for _, r := range tag {
+		if r != '_' && !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			return false
+		}
+	}
+	return true
+}
+
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+func executeDryRunStatements(ctx context.Context, e execer, statements []base.SingleSQL) error {
+	for _, statement := range statements {
+		if _, err := e.ExecContext(ctx, statement.Text); err != nil {
+			return errors.Wrapf(err, "failed to execute the statement at line %d", statement.FirstStatementLine+1)
+		}
+	}
+	return nil
+}
+
+// monitorLockWait samples whether the session is waiting for a lock until the returned stop function is called.
+// The stop function returns the estimated lock wait duration.
+func monitorLockWait(ctx context.Context, sqlDB *sql.DB, query string, sessionID int64) func() time.Duration {
+	ctx, cancel := context.WithCancel(ctx)
+	var wg sync.WaitGroup
+	var lockWaitDuration time.Duration
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(lockWaitSampleInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				var waiting bool
+				if err := sqlDB.QueryRowContext(ctx, query, sessionID).Scan(&waiting); err != nil {
+					continue
+				}
+				if waiting {
+					lockWaitDuration += lockWaitSampleInterval
+				}
+			}
+		}
+	}()
+	return func() time.Duration {
+		cancel()
+		wg.Wait()
+		return lockWaitDuration
+	}
+}
+
+func buildDryRunResult(executeErr error, executionDuration, lockWaitDuration time.Duration, sandboxClone bool) *storepb.PlanCheckRunResult_Result {
+	report := &storepb.PlanCheckRunResult_Result_DryRunReport_{
+		DryRunReport: &storepb.PlanCheckRunResult_Result_DryRunReport{
+			ExecutionDuration: durationpb.New(executionDuration),
+			LockWaitDuration:  durationpb.New(lockWaitDuration),
+			SandboxClone:      sandboxClone,
+		},
+	}
+	if executeErr != nil {
+		return &storepb.PlanCheckRunResult_Result{
+			Status:  storepb.PlanCheckRunResult_Result_ERROR,
+			Code:    common.DbExecutionError.Int32(),
+			Title:   "Dry run failed",
+			Content: executeErr.Error(),
+			Report:  report,
+		}
+	}
+	return &storepb.PlanCheckRunResult_Result{
+		Status:  storepb.PlanCheckRunResult_Result_SUCCESS,
+		Code:    common.Ok.Int32(),
+		Title:   "OK",
+		Content: "",
+		Report:  report,
+	}
+}
@@ -0,0 +1,149 @@
+package plancheck
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestGetDryRunTimeoutStatements(t *testing.T) {
+	a := require.New(t)
+	a.Equal([]string{
+		"SET LOCAL lock_timeout = '3000ms'",
+		"SET LOCAL statement_timeout = '30000ms'",
+	}, getDryRunTimeoutStatements(storepb.Engine_POSTGRES))
+	a.Equal([]string{"SET LOCK_TIMEOUT 3000"}, getDryRunTimeoutStatements(storepb.Engine_MSSQL))
+	a.Empty(getDryRunTimeoutStatements(storepb.Engine_SQLITE))
+}
+
+type fakeExecer struct {
+	executed []string
+	failOn   string
+}
+
+func (f *fakeExecer) ExecContext(_ context.Context, query string, _ ...any) (sql.Result, error) {
+	if query == f.failOn {
+		return nil, errors.New("relation does not exist")
+	}
+	f.executed = append(f.executed, query)
+	return nil, nil
+}
+
+func TestExecuteDryRunStatements(t *testing.T) {
+	a := require.New(t)
+	statements := []base.SingleSQL{
+		{Text: "ALTER TABLE t ADD COLUMN c INT", FirstStatementLine: 0},
+		{Text: "UPDATE u SET c = 1", FirstStatementLine: 2},
+		{Text: "DROP TABLE v", FirstStatementLine: 4},
+	}
+
+	e := &fakeExecer{}
+	a.NoError(executeDryRunStatements(context.Background(), e, statements))
+	a.Len(e.executed, 3)
+
+	// The execution stops at the failed statement, reporting its line.
+	e = &fakeExecer{failOn: "UPDATE u SET c = 1"}
+	err := executeDryRunStatements(context.Background(), e, statements)
+	a.ErrorContains(err, "line 3")
+	a.Equal([]string{"ALTER TABLE t ADD COLUMN c INT"}, e.executed)
+}
+
+func TestBuildDryRunResult(t *testing.T) {
+	a := require.New(t)
+	result := buildDryRunResult(nil, time.Second, 100*time.Millisecond, false /* sandboxClone */)
+	a.Equal(storepb.PlanCheckRunResult_Result_SUCCESS, result.Status)
+	a.Equal(time.Second, result.GetDryRunReport().GetExecutionDuration().AsDuration())
+	a.Equal(100*time.Millisecond, result.GetDryRunReport().GetLockWaitDuration().AsDuration())
+
+	result = buildDryRunResult(errors.New("lock timeout"), time.Second, 3*time.Second, true /* sandboxClone */)
+	a.Equal(storepb.PlanCheckRunResult_Result_ERROR, result.Status)
+	a.Equal("lock timeout", result.Content)
+	a.True(result.GetDryRunReport().GetSandboxClone())
+}
+
+func TestGetTransactionUnsafeStatement(t *testing.T) {
+	a := require.New(t)
+	tests := []struct {
+		engine     storepb.Engine
+		statements []string
+		wantLine   int
+		wantUnsafe bool
+	}{
+		{
+			engine:     storepb.Engine_POSTGRES,
+			statements: []string{"BEGIN;", "ALTER TABLE t ADD COLUMN c int;", "COMMIT;"},
+			wantLine:   0,
+			wantUnsafe: true,
+		},
+		{
+			engine:     storepb.Engine_POSTGRES,
+			statements: []string{"ALTER TABLE t ADD COLUMN c int;", "/* done */ COMMIT;"},
+			wantLine:   1,
+			wantUnsafe: true,
+		},
+		{
+			engine:     storepb.Engine_POSTGRES,
+			statements: []string{"ALTER TABLE t ADD COLUMN c int;", "END;"},
+			wantLine:   1,
+			wantUnsafe: true,
+		},
+		{
+			engine:     storepb.Engine_POSTGRES,
+			statements: []string{"CALL archive_orders();"},
+			wantUnsafe: true,
+		},
+		{
+			engine:     storepb.Engine_POSTGRES,
+			statements: []string{"SET statement_timeout = 0;"},
+			wantUnsafe: true,
+		},
+		{
+			engine: storepb.Engine_POSTGRES,
+			statements: []string{
+				"SAVEPOINT s;",
+				"ROLLBACK TO SAVEPOINT s;",
+				"UPDATE t SET note = 'COMMIT';",
+				"CREATE PROCEDURE p() LANGUAGE plpgsql AS $$ BEGIN COMMIT; END $$;",
+				"SELECT CASE WHEN a THEN 1 END FROM t; -- COMMIT",
+			},
+		},
+		{
+			engine:     storepb.Engine_MSSQL,
+			statements: []string{"BEGIN TRY UPDATE t SET a = 1; COMMIT; END TRY BEGIN CATCH END CATCH"},
+			wantUnsafe: true,
+		},
+		{
+			engine:     storepb.Engine_MSSQL,
+			statements: []string{"EXEC sp_executesql N'UPDATE t SET a = 1'"},
+			wantUnsafe: true,
+		},
+		{
+			engine:     storepb.Engine_MSSQL,
+			statements: []string{"UPDATE [commit] SET a = 'ROLLBACK'", "IF 1 = 1 BEGIN UPDATE t SET a = 1 END"},
+		},
+		{
+			engine:     storepb.Engine_SQLITE,
+			statements: []string{"DELETE FROM t;", "COMMIT;"},
+			wantLine:   1,
+			wantUnsafe: true,
+		},
+	}
+	for i, test := range tests {
+		var statements []base.SingleSQL
+		for line, text := range test.statements {
+			statements = append(statements, base.SingleSQL{Text: text, FirstStatementLine: line})
+		}
+		statement, reason := getTransactionUnsafeStatement(test.engine, statements)
+		a.Equal(test.wantUnsafe, reason != "", "test %d", i)
+		if test.wantUnsafe {
+			a.Equal(test.wantLine, statement.FirstStatementLine, "test %d", i)
+		}
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/bytebase/bytebase/backend/component/config"
 	"github.com/bytebase/bytebase/backend/component/dbfactory"
 	"github.com/bytebase/bytebase/backend/component/state"
+	"github.com/bytebase/bytebase/backend/component/webhook"
 	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/plugin/db"
@@ -42,13 +43,14 @@ const (
 )
 
 // NewSyncer creates a schema syncer.
-func NewSyncer(stores *store.Store, dbFactory *dbfactory.DBFactory, stateCfg *state.State, profile *config.Profile, licenseService enterprise.LicenseService) *Syncer {
+func NewSyncer(stores *store.Store, dbFactory *dbfactory.DBFactory, stateCfg *state.State, profile *config.Profile, licenseService enterprise.LicenseService, webhookManager *webhook.Manager) *Syncer {
 	return &Syncer{
 		store:          stores,
 		dbFactory:      dbFactory,
 		stateCfg:       stateCfg,
 		profile:        profile,
 		licenseService: licenseService,
+		webhookManager: webhookManager,
 	}
 }
 
@@ -61,6 +63,7 @@ type Syncer struct {
 	stateCfg        *state.State
 	profile         *config.Profile
 	licenseService  enterprise.LicenseService
+	webhookManager  *webhook.Manager
 	databaseSyncMap sync.Map // map[int]*store.DatabaseMessage
 }
 
@@ -601,14 +604,24 @@ func (s *Syncer) SyncDatabaseSchema(ctx context.Context, database *store.Databas
 			}
 			latestSchema := string(rawDump)
 			if changelog.Schema != latestSchema {
-				if _, err = s.store.UpsertActiveAnomalyV2(ctx, api.SystemBotID, &store.AnomalyMessage{
+				_, opened, err := s.store.UpsertActiveAnomalyV2(ctx, api.SystemBotID, &store.AnomalyMessage{
 					ProjectID:   database.ProjectID,
 					InstanceUID: instance.UID,
 					DatabaseUID: database.UID,
 					Type:        api.AnomalyDatabaseSchemaDrift,
-				}); err != nil {
+				})
+				if err != nil {
 					return errors.Wrapf(err, "failed to create anomaly")
 				}
+				if opened {
+					s.createDatabaseEvent(ctx, database, &webhook.Event{
+						Type: webhook.EventTypeDatabaseSchemaDrift,
+						DatabaseAnomaly: &webhook.EventDatabaseAnomaly{
+							Open:   true,
+							Detail: fmt.Sprintf("The schema of database %q differs from the schema after the latest migration.", database.DatabaseName),
+						},
+					})
+				}
 			} else {
 				err := s.store.DeleteAnomalyV2(ctx, &store.DeleteAnomalyMessage{
 					DatabaseUID: database.UID,
@@ -669,17 +682,28 @@ func (s *Syncer) hasBackupSchema(ctx context.Context, instance *store.InstanceMe
 
 func (s *Syncer) upsertDatabaseConnectionAnomaly(ctx context.Context, instance *store.InstanceMessage, database *store.DatabaseMessage, connErr error) {
 	if connErr != nil {
-		if _, err := s.store.UpsertActiveAnomalyV2(ctx, api.SystemBotID, &store.AnomalyMessage{
+		_, opened, err := s.store.UpsertActiveAnomalyV2(ctx, api.SystemBotID, &store.AnomalyMessage{
 			ProjectID:   database.ProjectID,
 			InstanceUID: instance.UID,
 			DatabaseUID: database.UID,
 			Type:        api.AnomalyDatabaseConnection,
-		}); err != nil {
+		})
+		if err != nil {
 			slog.Error("Failed to create anomaly",
 				slog.String("instance", instance.ResourceID),
 				slog.String("database", database.DatabaseName),
 				slog.String("type", string(api.AnomalyDatabaseConnection)),
 				log.BBError(err))
+			return
+		}
+		if opened {
+			s.createDatabaseEvent(ctx, database, &webhook.Event{
+				Type: webhook.EventTypeDatabaseConnectionAnomaly,
+				DatabaseAnomaly: &webhook.EventDatabaseAnomaly{
+					Open:   true,
+					Detail: connErr.Error(),
+				},
+			})
 		}
 		return
 	}
@@ -688,13 +712,41 @@ func (s *Syncer) upsertDatabaseConnectionAnomaly(ctx context.Context, instance *
 		DatabaseUID: database.UID,
 		Type:        api.AnomalyDatabaseConnection,
 	})
-	if err != nil && common.ErrorCode(err) != common.NotFound {
-		slog.Error("Failed to close anomaly",
-			slog.String("instance", instance.ResourceID),
-			slog.String("database", database.DatabaseName),
-			slog.String("type", string(api.AnomalyDatabaseConnection)),
-			log.BBError(err))
+	if err != nil {
+		if common.ErrorCode(err) != common.NotFound {
+			slog.Error("Failed to close anomaly",
+				slog.String("instance", instance.ResourceID),
+				slog.String("database", database.DatabaseName),
+				slog.String("type", string(api.AnomalyDatabaseConnection)),
+				log.BBError(err))
+		}
+		return
+	}
+	s.createDatabaseEvent(ctx, database, &webhook.Event{
+		Type: webhook.EventTypeDatabaseConnectionAnomaly,
+		DatabaseAnomaly: &webhook.EventDatabaseAnomaly{
+			Open:   false,
+			Detail: fmt.Sprintf("The connection to database %q is recovered.", database.DatabaseName),
+		},
+	})
+}
+
+// createDatabaseEvent creates the webhook event of the database anomaly in the project of the database.
+// The events of the same database and anomaly state are de-duplicated, so that a flapping instance does not spam the channels.
+func (s *Syncer) createDatabaseEvent(ctx context.Context, database *store.DatabaseMessage, e *webhook.Event) {
+	project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{ResourceID: &database.ProjectID})
+	if err != nil {
+		slog.Error("Failed to get project", slog.String("project", database.ProjectID), log.BBError(err))
+		return
+	}
+	if project == nil {
+		return
 	}
+	e.Actor = s.store.GetSystemBotUser(ctx)
+	e.Project = webhook.NewProject(project)
+	e.Database = webhook.NewDatabase(database)
+	e.DedupKey = fmt.Sprintf("%s/%t", common.FormatDatabase(database.InstanceID, database.DatabaseName), e.DatabaseAnomaly.Open)
+	s.webhookManager.CreateEvent(ctx, e)
 }
 
 func setClassificationAndUserCommentFromComment(dbSchema *storepb.DatabaseSchemaMetadata, databaseConfig *model.DatabaseConfig, classificationConfig *storepb.DataClassificationSetting_DataClassificationConfig) {
@@ -733,7 +785,8 @@ func setClassificationAndUserCommentFromComment(dbSchema *storepb.DatabaseSchema
 func isEmptyColumnConfig(config *storepb.ColumnCatalog) bool {
 	return config == nil || (len(config.Labels) == 0 &&
 		config.Classification == "" &&
-		config.SemanticType == "")
+		config.SemanticType == "" &&
+		config.ClassificationProposal == nil)
 }
 
 func setUserCommentFromComment(dbSchema *storepb.DatabaseSchemaMetadata) {
@@ -5,16 +5,20 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"slices"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/types/known/durationpb"
 
+	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/component/config"
 	"github.com/bytebase/bytebase/backend/component/dbfactory"
 	"github.com/bytebase/bytebase/backend/component/state"
+	"github.com/bytebase/bytebase/backend/component/webhook"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/plugin/db"
 	pgparser "github.com/bytebase/bytebase/backend/plugin/parser/pg"
@@ -27,24 +31,29 @@ const (
 	slowQuerySyncInterval = 12 * time.Hour
 	// retentionCycle is the number of days to keep slow query logs.
 	retentionCycle = 30
+	// topSlowQueryCount is the number of the slowest query fingerprints of a database.
+	// A webhook event is created when a fingerprint newly ranks among them.
+	topSlowQueryCount = 5
 )
 
 // NewSyncer creates a new slow query syncer.
-func NewSyncer(store *store.Store, dbFactory *dbfactory.DBFactory, stateCfg *state.State, profile *config.Profile) *Syncer {
+func NewSyncer(store *store.Store, dbFactory *dbfactory.DBFactory, stateCfg *state.State, profile *config.Profile, webhookManager *webhook.Manager) *Syncer {
 	return &Syncer{
-		store:     store,
-		dbFactory: dbFactory,
-		stateCfg:  stateCfg,
-		profile:   profile,
+		store:          store,
+		dbFactory:      dbFactory,
+		stateCfg:       stateCfg,
+		profile:        profile,
+		webhookManager: webhookManager,
 	}
 }
 
 // Syncer is the slow query syncer.
 type Syncer struct {
-	store     *store.Store
-	dbFactory *dbfactory.DBFactory
-	stateCfg  *state.State
-	profile   *config.Profile
+	store          *store.Store
+	dbFactory      *dbfactory.DBFactory
+	stateCfg       *state.State
+	profile        *config.Profile
+	webhookManager *webhook.Manager
 }
 
 // Run will run the slow query syncer.
@@ -116,14 +125,120 @@ func (s *Syncer) syncInstanceSlowQuery(ctx context.Context, instance *store.Inst
 		return nil
 	}
 
+	var syncFunc func(context.Context, *store.InstanceMessage) error
 	switch instance.Engine {
 	case storepb.Engine_MYSQL:
-		return s.syncMySQLSlowQuery(ctx, instance)
+		syncFunc = s.syncMySQLSlowQuery
 	case storepb.Engine_POSTGRES:
-		return s.syncPostgreSQLSlowQuery(ctx, instance)
+		syncFunc = s.syncPostgreSQLSlowQuery
 	default:
 		return errors.Errorf("unsupported database engine: %s", instance.Engine)
 	}
+
+	previousTopSlowQueries, err := s.listTopSlowQueries(ctx, instance)
+	if err != nil {
+		return err
+	}
+	if err := syncFunc(ctx, instance); err != nil {
+		return err
+	}
+	topSlowQueries, err := s.listTopSlowQueries(ctx, instance)
+	if err != nil {
+		return err
+	}
+	databases, err := s.store.ListDatabases(ctx, &store.FindDatabaseMessage{
+		InstanceID: &instance.ResourceID,
+	})
+	if err != nil {
+		return err
+	}
+	for _, database := range databases {
+		previous, ok := previousTopSlowQueries[database.UID]
+		if !ok {
+			// The first slow query logs of the database are the baseline, otherwise all of them would be new,
+			// e.g. when the slow query policy is turned on or the events are introduced.
+			continue
+		}
+		for i, statistics := range topSlowQueries[database.UID] {
+			if slices.ContainsFunc(previous, func(p *v1pb.SlowQueryStatistics) bool {
+				return p.SqlFingerprint == statistics.SqlFingerprint
+			}) {
+				continue
+			}
+			s.createSlowQueryEvent(ctx, database, i+1, statistics)
+		}
+	}
+	return nil
+}
+
+// listTopSlowQueries lists the slowest query fingerprints in the retention cycle for each database of the instance.
+func (s *Syncer) listTopSlowQueries(ctx context.Context, instance *store.InstanceMessage) (map[int][]*v1pb.SlowQueryStatistics, error) {
+	earliestDate := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, -retentionCycle)
+	logs, err := s.store.ListDatabaseSlowQueryStatistics(ctx, &store.ListSlowQueryMessage{
+		InstanceUID:  &instance.UID,
+		StartLogDate: &earliestDate,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list slow query statistics")
+	}
+	result := make(map[int][]*v1pb.SlowQueryStatistics)
+	for databaseUID, databaseLogs := range logs {
+		if top := getTopSlowQueries(databaseLogs, topSlowQueryCount); len(top) > 0 {
+			result[databaseUID] = top
+		}
+	}
+	return result, nil
+}
+
+// getTopSlowQueries returns at most n statistics with the longest total query time in descending order.
+func getTopSlowQueries(logs []*v1pb.SlowQueryLog, n int) []*v1pb.SlowQueryStatistics {
+	var statistics []*v1pb.SlowQueryStatistics
+	for _, log := range logs {
+		if log.Statistics != nil {
+			statistics = append(statistics, log.Statistics)
+		}
+	}
+	totalQueryTime := func(s *v1pb.SlowQueryStatistics) time.Duration {
+		return s.AverageQueryTime.AsDuration() * time.Duration(s.Count)
+	}
+	slices.SortFunc(statistics, func(a, b *v1pb.SlowQueryStatistics) int {
+		if c := totalQueryTime(b) - totalQueryTime(a); c != 0 {
+			if c > 0 {
+				return 1
+			}
+			return -1
+		}
+		return strings.Compare(a.SqlFingerprint, b.SqlFingerprint)
+	})
+	if len(statistics) > n {
+		statistics = statistics[:n]
+	}
+	return statistics
+}
+
+func (s *Syncer) createSlowQueryEvent(ctx context.Context, database *store.DatabaseMessage, rank int, statistics *v1pb.SlowQueryStatistics) {
+	project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{ResourceID: &database.ProjectID})
+	if err != nil {
+		slog.Error("Failed to get project", slog.String("project", database.ProjectID), log.BBError(err))
+		return
+	}
+	if project == nil {
+		return
+	}
+	s.webhookManager.CreateEvent(ctx, &webhook.Event{
+		Actor:    s.store.GetSystemBotUser(ctx),
+		Type:     webhook.EventTypeDatabaseSlowQuery,
+		Project:  webhook.NewProject(project),
+		Database: webhook.NewDatabase(database),
+		DedupKey: fmt.Sprintf("%s/%s", common.FormatDatabase(database.InstanceID, database.DatabaseName), statistics.SqlFingerprint),
+		DatabaseSlowQuery: &webhook.EventDatabaseSlowQuery{
+			Fingerprint:      statistics.SqlFingerprint,
+			Rank:             rank,
+			Count:            statistics.Count,
+			AverageQueryTime: statistics.AverageQueryTime.AsDuration(),
+			MaximumQueryTime: statistics.MaximumQueryTime.AsDuration(),
+		},
+	})
 }
 
 func (s *Syncer) syncPostgreSQLSlowQuery(ctx context.Context, instance *store.InstanceMessage) error {
@@ -0,0 +1,38 @@
+package slowquerysync
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+func TestGetTopSlowQueries(t *testing.T) {
+	a := require.New(t)
+	newLog := func(fingerprint string, count int64, average time.Duration) *v1pb.SlowQueryLog {
+		return &v1pb.SlowQueryLog{
+			Statistics: &v1pb.SlowQueryStatistics{
+				SqlFingerprint:   fingerprint,
+				Count:            count,
+				AverageQueryTime: durationpb.New(average),
+			},
+		}
+	}
+	logs := []*v1pb.SlowQueryLog{
+		newLog("select a", 1, 10*time.Second),
+		newLog("select b", 100, time.Second),
+		newLog("select c", 2, 3*time.Second),
+		newLog("select d", 5, 2*time.Second),
+	}
+
+	var fingerprints []string
+	for _, statistics := range getTopSlowQueries(logs, 3) {
+		fingerprints = append(fingerprints, statistics.SqlFingerprint)
+	}
+	a.Equal([]string{"select b", "select a", "select d"}, fingerprints)
+	a.Len(getTopSlowQueries(logs, 10), 4)
+	a.Empty(getTopSlowQueries(nil, 3))
+}
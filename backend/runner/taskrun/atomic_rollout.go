@@ -0,0 +1,393 @@
+package taskrun
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	pgparser "github.com/bytebase/bytebase/backend/plugin/parser/pg"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// startAtomicRolloutEnforcement enforces the atomic rollout in the background, so that the reverts of the other
+// tenants neither hold up the finished task run nor the enforcement of the other database groups.
+func (s *SchedulerV2) startAtomicRolloutEnforcement(ctx context.Context, task *store.TaskMessage) {
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				err, ok := r.(error)
+				if !ok {
+					err = errors.Errorf("%v", r)
+				}
+				slog.Error("atomic rollout enforcement PANIC RECOVER", log.BBError(err), log.BBStack("panic-stack"))
+			}
+		}()
+		if err := s.enforceAtomicRollout(ctx, task); err != nil {
+			slog.Warn("failed to enforce atomic rollout", slog.Int("task", task.ID), log.BBError(err))
+		}
+	}()
+}
+
+// enforceAtomicRollout reverts the succeeded tenants of an atomic multitenancy database group
+// once any tenant task of the group in the same stage fails, so that either all tenants are changed or none.
+func (s *SchedulerV2) enforceAtomicRollout(ctx context.Context, task *store.TaskMessage) error {
+	databaseGroup, specID, err := s.getAtomicRolloutDatabaseGroup(ctx, task)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get atomic rollout database group")
+	}
+	if databaseGroup == "" {
+		return nil
+	}
+
+	// The tenants finishing during the enforcement wait for it, and then see the failure to revert themselves.
+	v, _ := s.atomicRolloutLocks.LoadOrStore(databaseGroup, &sync.Mutex{})
+	mu, ok := v.(*sync.Mutex)
+	if !ok {
+		return errors.Errorf("unexpected lock type %T", v)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+
+	stageTasks, err := s.store.ListTasks(ctx, &api.TaskFind{StageID: &task.StageID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to list tasks")
+	}
+	tenants, failedTask := getAtomicRolloutTenants(stageTasks, specID)
+	if failedTask == nil {
+		return nil
+	}
+
+	issue, err := s.store.GetIssueV2(ctx, &store.FindIssueMessage{PipelineID: &task.PipelineID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get issue")
+	}
+	pipeline, err := s.store.GetPipelineV2ByID(ctx, task.PipelineID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get pipeline")
+	}
+	if pipeline == nil {
+		return errors.Errorf("pipeline %d not found", task.PipelineID)
+	}
+
+	report := &storepb.IssueCommentPayload_AtomicRolloutRevert{
+		DatabaseGroup: databaseGroup,
+		FailedTask:    common.FormatTask(pipeline.ProjectID, failedTask.PipelineID, failedTask.StageID, failedTask.ID),
+	}
+	for _, tenant := range tenants {
+		taskName := common.FormatTask(pipeline.ProjectID, tenant.PipelineID, tenant.StageID, tenant.ID)
+		switch tenant.LatestTaskRunStatus {
+		case api.TaskRunPending, api.TaskRunRunning:
+			if err := s.cancelTenantTaskRuns(ctx, tenant); err != nil {
+				return errors.Wrapf(err, "failed to cancel task runs of task %d", tenant.ID)
+			}
+			report.Tenants = append(report.Tenants, &storepb.IssueCommentPayload_AtomicRolloutRevert_Tenant{
+				Task:   taskName,
+				Status: storepb.IssueCommentPayload_AtomicRolloutRevert_Tenant_CANCELED,
+			})
+		case api.TaskRunDone:
+			statement, err := s.revertTenant(ctx, tenant, databaseGroup)
+			t := &storepb.IssueCommentPayload_AtomicRolloutRevert_Tenant{
+				Task:      taskName,
+				Status:    storepb.IssueCommentPayload_AtomicRolloutRevert_Tenant_REVERTED,
+				Statement: statement,
+			}
+			if err != nil {
+				slog.Error("failed to revert tenant", slog.Int("task", tenant.ID), log.BBError(err))
+				t.Status = storepb.IssueCommentPayload_AtomicRolloutRevert_Tenant_REVERT_FAILED
+				t.Error = err.Error()
+			}
+			report.Tenants = append(report.Tenants, t)
+		default:
+		}
+	}
+
+	if len(report.Tenants) == 0 || issue == nil {
+		return nil
+	}
+	if _, err := s.store.CreateIssueComment(ctx, &store.IssueCommentMessage{
+		IssueUID: issue.UID,
+		Payload: &storepb.IssueCommentPayload{
+			Event: &storepb.IssueCommentPayload_AtomicRolloutRevert_{
+				AtomicRolloutRevert: report,
+			},
+		},
+	}, api.SystemBotID); err != nil {
+		return errors.Wrapf(err, "failed to create issue comment")
+	}
+	return nil
+}
+
+// getAtomicRolloutDatabaseGroup returns the database group name and the spec id if the task is created from an atomic multitenancy database group.
+func (s *SchedulerV2) getAtomicRolloutDatabaseGroup(ctx context.Context, task *store.TaskMessage) (string, string, error) {
+	if task.Type != api.TaskDatabaseSchemaUpdate && task.Type != api.TaskDatabaseDataUpdate {
+		return "", "", nil
+	}
+	specID := getTaskSpecID(task)
+	if specID == "" {
+		return "", "", nil
+	}
+	plan, err := s.store.GetPlan(ctx, &store.FindPlanMessage{PipelineID: &task.PipelineID})
+	if err != nil {
+		return "", "", errors.Wrapf(err, "failed to get plan")
+	}
+	if plan == nil {
+		return "", "", nil
+	}
+	var target string
+	for _, step := range plan.Config.GetSteps() {
+		for _, spec := range step.GetSpecs() {
+			if spec.GetId() == specID {
+				target = spec.GetChangeDatabaseConfig().GetTarget()
+			}
+		}
+	}
+	projectID, databaseGroupID, err := common.GetProjectIDDatabaseGroupID(target)
+	if err != nil {
+		// The target is not a database group.
+		return "", "", nil
+	}
+	project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{ResourceID: &projectID})
+	if err != nil {
+		return "", "", errors.Wrapf(err, "failed to get project %q", projectID)
+	}
+	if project == nil {
+		return "", "", nil
+	}
+	databaseGroup, err := s.store.GetDatabaseGroup(ctx, &store.FindDatabaseGroupMessage{ProjectUID: &project.UID, ResourceID: &databaseGroupID})
+	if err != nil {
+		return "", "", errors.Wrapf(err, "failed to get database group %q", databaseGroupID)
+	}
+	if databaseGroup == nil || !databaseGroup.Payload.GetMultitenancy() || !databaseGroup.Payload.GetAtomicRollout() {
+		return "", "", nil
+	}
+	return target, specID, nil
+}
+
+// getAtomicRolloutTenants returns the tenant tasks of the spec and the first failed one among them.
+func getAtomicRolloutTenants(stageTasks []*store.TaskMessage, specID string) ([]*store.TaskMessage, *store.TaskMessage) {
+	var tenants []*store.TaskMessage
+	var failedTask *store.TaskMessage
+	for _, stageTask := range stageTasks {
+		if getTaskSpecID(stageTask) != specID {
+			continue
+		}
+		tenants = append(tenants, stageTask)
+		if stageTask.LatestTaskRunStatus == api.TaskRunFailed && failedTask == nil {
+			failedTask = stageTask
+		}
+	}
+	return tenants, failedTask
+}
+
+func getTaskSpecID(task *store.TaskMessage) string {
+	payload := &storepb.TaskDatabaseUpdatePayload{}
+	if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(task.Payload), payload); err != nil {
+		return ""
+	}
+	return payload.SpecId
+}
+
+func (s *SchedulerV2) cancelTenantTaskRuns(ctx context.Context, task *store.TaskMessage) error {
+	taskRuns, err := s.store.ListTaskRunsV2(ctx, &store.FindTaskRunMessage{
+		TaskUID: &task.ID,
+		Status:  &[]api.TaskRunStatus{api.TaskRunPending, api.TaskRunRunning},
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to list task runs")
+	}
+	var pendingTaskRunIDs []int
+	for _, taskRun := range taskRuns {
+		if taskRun.Status == api.TaskRunPending {
+			pendingTaskRunIDs = append(pendingTaskRunIDs, taskRun.ID)
+			continue
+		}
+		// The executing task run is canceled via its context.
+		// If it finishes before the cancellation, it is reverted when it's done.
+		if cancelFunc, ok := s.stateCfg.RunningTaskRunsCancelFunc.Load(taskRun.ID); ok {
+			if cancel, ok := cancelFunc.(context.CancelFunc); ok {
+				cancel()
+			}
+		}
+	}
+	if len(pendingTaskRunIDs) > 0 {
+		if err := s.store.BatchCancelTaskRuns(ctx, pendingTaskRunIDs, api.SystemBotID); err != nil {
+			return errors.Wrapf(err, "failed to cancel pending task runs")
+		}
+	}
+	return nil
+}
+
+// revertTenant reverts the change applied by the latest done task run of the task and returns the revert statement.
+// The reverted task run is marked as canceled so that the task could be rolled out again.
+func (s *SchedulerV2) revertTenant(ctx context.Context, task *store.TaskMessage, databaseGroup string) (string, error) {
+	taskRuns, err := s.store.ListTaskRunsV2(ctx, &store.FindTaskRunMessage{
+		TaskUID: &task.ID,
+		Status:  &[]api.TaskRunStatus{api.TaskRunDone},
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to list task runs")
+	}
+	if len(taskRuns) == 0 {
+		return "", errors.Errorf("no done task run found for task %d", task.ID)
+	}
+	taskRun := taskRuns[len(taskRuns)-1]
+
+	instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &task.InstanceID})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get instance")
+	}
+	if instance == nil {
+		return "", errors.Errorf("instance %d not found", task.InstanceID)
+	}
+	database, err := s.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{UID: task.DatabaseID})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get database")
+	}
+	if database == nil {
+		return "", errors.Errorf("database not found for task %d", task.ID)
+	}
+
+	var statement string
+	switch task.Type {
+	case api.TaskDatabaseDataUpdate:
+		statement, err = s.getDataRevertStatement(ctx, instance, taskRun)
+	case api.TaskDatabaseSchemaUpdate:
+		statement, err = s.getSchemaRevertStatement(ctx, instance, taskRun)
+	default:
+		err = errors.Errorf("unsupported task type %s", task.Type)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if strings.TrimSpace(statement) != "" {
+		driver, err := s.dbFactory.GetAdminDatabaseDriver(ctx, instance, database, db.ConnectionContext{})
+		if err != nil {
+			return statement, errors.Wrapf(err, "failed to get driver")
+		}
+		defer driver.Close(ctx)
+		if _, err := driver.Execute(ctx, statement, db.ExecuteOptions{}); err != nil {
+			return statement, errors.Wrapf(err, "failed to execute revert statement")
+		}
+	}
+
+	// Remove the revision so that the version could be applied again.
+	if version := taskRun.ResultProto.GetVersion(); version != "" {
+		revisions, err := s.store.ListRevisions(ctx, &store.FindRevisionMessage{DatabaseUID: &database.UID, Version: &version})
+		if err != nil {
+			return statement, errors.Wrapf(err, "failed to list revisions")
+		}
+		for _, revision := range revisions {
+			if err := s.store.DeleteRevision(ctx, revision.UID, api.SystemBotID); err != nil {
+				return statement, errors.Wrapf(err, "failed to delete revision %d", revision.UID)
+			}
+		}
+	}
+
+	resultBytes, err := protojson.Marshal(&storepb.TaskRunResult{
+		Detail:            fmt.Sprintf("The change is reverted by the atomic rollout of %s", databaseGroup),
+		Changelog:         taskRun.ResultProto.GetChangelog(),
+		PriorBackupDetail: taskRun.ResultProto.GetPriorBackupDetail(),
+	})
+	if err != nil {
+		return statement, errors.Wrapf(err, "failed to marshal task run result")
+	}
+	code := common.Ok
+	result := string(resultBytes)
+	if _, err := s.store.UpdateTaskRunStatus(ctx, &store.TaskRunStatusPatch{
+		ID:        taskRun.ID,
+		UpdaterID: api.SystemBotID,
+		Status:    api.TaskRunCanceled,
+		Code:      &code,
+		Result:    &result,
+	}); err != nil {
+		return statement, errors.Wrapf(err, "failed to mark task run as canceled")
+	}
+	return statement, nil
+}
+
+// getDataRevertStatement generates the restore statement from the prior backup of the data change.
+func (s *SchedulerV2) getDataRevertStatement(ctx context.Context, instance *store.InstanceMessage, taskRun *store.TaskRunMessage) (string, error) {
+	backupDetail := taskRun.ResultProto.GetPriorBackupDetail()
+	if backupDetail == nil {
+		return "", errors.Errorf("the data change has no prior backup to restore from")
+	}
+	if taskRun.SheetUID == nil {
+		return "", errors.Errorf("task run %d has no sheet", taskRun.ID)
+	}
+	statement, err := s.store.GetSheetStatementByID(ctx, *taskRun.SheetUID)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get sheet statement")
+	}
+	var restores []string
+	for _, item := range backupDetail.Items {
+		restore, err := base.GenerateRestoreSQL(ctx, instance.Engine, base.RestoreContext{
+			InstanceID:              instance.ResourceID,
+			GetDatabaseMetadataFunc: BuildGetDatabaseMetadataFunc(s.store),
+			ListDatabaseNamesFunc:   BuildListDatabaseNamesFunc(s.store),
+			IgnoreCaseSensitive:     store.IgnoreDatabaseAndTableCaseSensitive(instance),
+		}, statement, item)
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to generate restore sql")
+		}
+		restores = append(restores, restore)
+	}
+	return strings.Join(restores, "\n"), nil
+}
+
+// getSchemaRevertStatement diffs the schema after the change against the schema dumped before the change.
+func (s *SchedulerV2) getSchemaRevertStatement(ctx context.Context, instance *store.InstanceMessage, taskRun *store.TaskRunMessage) (string, error) {
+	changelogName := taskRun.ResultProto.GetChangelog()
+	if changelogName == "" {
+		return "", errors.Errorf("the schema change has no changelog")
+	}
+	_, _, changelogUID, err := common.GetInstanceDatabaseChangelogUID(changelogName)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to parse changelog %q", changelogName)
+	}
+	changelog, err := s.store.GetChangelog(ctx, &store.FindChangelogMessage{UID: &changelogUID, ShowFull: true})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get changelog")
+	}
+	if changelog == nil || changelog.PrevSyncHistoryUID == nil || changelog.SyncHistoryUID == nil {
+		return "", errors.Errorf("the schema change has no schema dump to revert to")
+	}
+
+	source, target := changelog.Schema, changelog.PrevSchema
+	var engine storepb.Engine
+	switch instance.Engine {
+	case storepb.Engine_POSTGRES:
+		engine = storepb.Engine_POSTGRES
+		if source, err = pgparser.FilterBackupSchema(source); err != nil {
+			return "", errors.Wrapf(err, "failed to filter backup schema")
+		}
+		if target, err = pgparser.FilterBackupSchema(target); err != nil {
+			return "", errors.Wrapf(err, "failed to filter backup schema")
+		}
+	case storepb.Engine_MYSQL, storepb.Engine_MARIADB, storepb.Engine_OCEANBASE:
+		engine = storepb.Engine_MYSQL
+	case storepb.Engine_TIDB:
+		engine = storepb.Engine_TIDB
+	default:
+		return "", errors.Errorf("reverting schema changes is not supported for engine %s", instance.Engine)
+	}
+	diff, err := base.SchemaDiff(engine, base.DiffContext{
+		IgnoreCaseSensitive: store.IgnoreDatabaseAndTableCaseSensitive(instance),
+		StrictMode:          true,
+	}, source, target)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to compute schema diff")
+	}
+	return diff, nil
+}
@@ -0,0 +1,41 @@
+package taskrun
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+func TestGetAtomicRolloutTenants(t *testing.T) {
+	a := require.New(t)
+	newTask := func(id int, specID string, status api.TaskRunStatus) *store.TaskMessage {
+		return &store.TaskMessage{
+			ID:                  id,
+			Payload:             `{"specId": "` + specID + `"}`,
+			LatestTaskRunStatus: status,
+		}
+	}
+	stageTasks := []*store.TaskMessage{
+		newTask(1, "s1", api.TaskRunDone),
+		newTask(2, "s2", api.TaskRunFailed),
+		newTask(3, "s1", api.TaskRunRunning),
+		newTask(4, "s1", api.TaskRunFailed),
+		newTask(5, "s1", api.TaskRunFailed),
+	}
+
+	tenants, failedTask := getAtomicRolloutTenants(stageTasks, "s1")
+	a.Len(tenants, 4)
+	a.Equal(4, failedTask.ID)
+
+	// The failure of the other spec doesn't count.
+	tenants, failedTask = getAtomicRolloutTenants(stageTasks[:3], "s1")
+	a.Len(tenants, 2)
+	a.Nil(failedTask)
+
+	tenants, failedTask = getAtomicRolloutTenants(stageTasks, "s3")
+	a.Empty(tenants)
+	a.Nil(failedTask)
+}
@@ -0,0 +1,156 @@
+package taskrun
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// taskRunQuota tracks the task run concurrency quotas of instances and environments
+// during one scheduling round.
+type taskRunQuota struct {
+	store *store.Store
+
+	// stageEnvironment is the map from stage UID to environment UID.
+	stageEnvironment map[int]int
+	// environmentLimit is the map from environment UID to the maximum concurrent task runs.
+	environmentLimit map[int]int
+
+	runningByInstance    map[int]int
+	runningByEnvironment map[int]int
+	waitingByInstance    map[int]int
+	waitingByEnvironment map[int]int
+}
+
+func newTaskRunQuota(ctx context.Context, s *store.Store, runningTaskRuns []*store.TaskRunMessage) (*taskRunQuota, error) {
+	q := &taskRunQuota{
+		store:                s,
+		stageEnvironment:     map[int]int{},
+		environmentLimit:     map[int]int{},
+		runningByInstance:    map[int]int{},
+		runningByEnvironment: map[int]int{},
+		waitingByInstance:    map[int]int{},
+		waitingByEnvironment: map[int]int{},
+	}
+	for _, taskRun := range runningTaskRuns {
+		task, err := s.GetTaskV2ByID(ctx, taskRun.TaskUID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get task %v", taskRun.TaskUID)
+		}
+		environmentID, err := q.getEnvironmentID(ctx, taskRun)
+		if err != nil {
+			return nil, err
+		}
+		q.runningByInstance[task.InstanceID]++
+		q.runningByEnvironment[environmentID]++
+	}
+	return q, nil
+}
+
+func (q *taskRunQuota) getEnvironmentID(ctx context.Context, taskRun *store.TaskRunMessage) (int, error) {
+	if environmentID, ok := q.stageEnvironment[taskRun.StageUID]; ok {
+		return environmentID, nil
+	}
+	stages, err := q.store.ListStageV2(ctx, taskRun.PipelineUID)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to list stages for pipeline %v", taskRun.PipelineUID)
+	}
+	for _, stage := range stages {
+		q.stageEnvironment[stage.ID] = stage.EnvironmentID
+	}
+	environmentID, ok := q.stageEnvironment[taskRun.StageUID]
+	if !ok {
+		return 0, errors.Errorf("stage %v not found", taskRun.StageUID)
+	}
+	return environmentID, nil
+}
+
+func (q *taskRunQuota) getEnvironmentLimit(ctx context.Context, environmentID int) (int, error) {
+	if limit, ok := q.environmentLimit[environmentID]; ok {
+		return limit, nil
+	}
+	policy, err := q.store.GetTaskRunConcurrencyPolicy(ctx, environmentID)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to get task run concurrency policy for environment %v", environmentID)
+	}
+	limit := int(policy.GetMaximumConcurrentTaskRuns())
+	q.environmentLimit[environmentID] = limit
+	return limit, nil
+}
+
+// acquire takes a slot for the task run from the instance and environment quotas.
+// It returns the quota waiting cause if any quota is exhausted, or nil if the slot is taken.
+func (q *taskRunQuota) acquire(ctx context.Context, taskRun *store.TaskRunMessage, task *store.TaskMessage) (*storepb.SchedulerInfo_TaskRunQuota, error) {
+	instance, err := q.store.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &task.InstanceID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get instance %v", task.InstanceID)
+	}
+	environmentID, err := q.getEnvironmentID(ctx, taskRun)
+	if err != nil {
+		return nil, err
+	}
+	environmentLimit, err := q.getEnvironmentLimit(ctx, environmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if instance != nil {
+		if limit := int(instance.Options.GetMaximumConcurrentTaskRuns()); limit > 0 && q.runningByInstance[task.InstanceID] >= limit {
+			q.waitingByInstance[task.InstanceID]++
+			return &storepb.SchedulerInfo_TaskRunQuota{
+				Scope:         storepb.SchedulerInfo_TaskRunQuota_INSTANCE,
+				ResourceUid:   int32(task.InstanceID),
+				QueuePosition: int32(q.waitingByInstance[task.InstanceID]),
+			}, nil
+		}
+	}
+	if environmentLimit > 0 && q.runningByEnvironment[environmentID] >= environmentLimit {
+		q.waitingByEnvironment[environmentID]++
+		return &storepb.SchedulerInfo_TaskRunQuota{
+			Scope:         storepb.SchedulerInfo_TaskRunQuota_ENVIRONMENT,
+			ResourceUid:   int32(environmentID),
+			QueuePosition: int32(q.waitingByEnvironment[environmentID]),
+		}, nil
+	}
+
+	q.runningByInstance[task.InstanceID]++
+	q.runningByEnvironment[environmentID]++
+	return nil, nil
+}
+
+// fairQueue orders the pending task runs so that projects take turns.
+// Projects with fewer running task runs go first, and task runs within a project keep their creation order.
+func fairQueue(pendingTaskRuns, runningTaskRuns []*store.TaskRunMessage) []*store.TaskRunMessage {
+	running := map[string]int{}
+	for _, taskRun := range runningTaskRuns {
+		running[taskRun.ProjectID]++
+	}
+
+	var projects []string
+	queues := map[string][]*store.TaskRunMessage{}
+	for _, taskRun := range pendingTaskRuns {
+		if _, ok := queues[taskRun.ProjectID]; !ok {
+			projects = append(projects, taskRun.ProjectID)
+		}
+		queues[taskRun.ProjectID] = append(queues[taskRun.ProjectID], taskRun)
+	}
+	sort.SliceStable(projects, func(i, j int) bool {
+		return running[projects[i]] < running[projects[j]]
+	})
+
+	var ordered []*store.TaskRunMessage
+	for len(ordered) < len(pendingTaskRuns) {
+		for _, project := range projects {
+			if len(queues[project]) == 0 {
+				continue
+			}
+			ordered = append(ordered, queues[project][0])
+			queues[project] = queues[project][1:]
+		}
+	}
+	return ordered
+}
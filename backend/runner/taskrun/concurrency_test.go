@@ -0,0 +1,31 @@
+package taskrun
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+func TestFairQueue(t *testing.T) {
+	a := assert.New(t)
+
+	pending := []*store.TaskRunMessage{
+		{ID: 1, ProjectID: "p1"},
+		{ID: 2, ProjectID: "p1"},
+		{ID: 3, ProjectID: "p1"},
+		{ID: 4, ProjectID: "p2"},
+		{ID: 5, ProjectID: "p3"},
+		{ID: 6, ProjectID: "p3"},
+	}
+	running := []*store.TaskRunMessage{
+		{ID: 7, ProjectID: "p3"},
+	}
+
+	var got []int
+	for _, taskRun := range fairQueue(pending, running) {
+		got = append(got, taskRun.ID)
+	}
+	a.Equal([]int{1, 4, 5, 2, 6, 3}, got)
+}
@@ -0,0 +1,428 @@
+package taskrun
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/store/model"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// replicaLagCheckInterval is the interval to recheck the replica lag while throttling.
+const replicaLagCheckInterval = time.Second
+
+// runBatchMigration executes a single-table UPDATE/DELETE statement in primary key ranged chunks.
+// The progress is persisted to the task payload after each chunk commits, so that a rerun resumes from the last committed chunk.
+// The chunk is marked in flight before it's executed, and the mark stays if the task run stops while the chunk commits.
+// The progress is cleared after the final chunk.
+// Every chunk runs in its own session, so the pre-execute hook runs before every chunk, and the post-execute hook runs after the final chunk.
+func (exec *DataUpdateExecutor) runBatchMigration(ctx context.Context, driverCtx context.Context, task *store.TaskMessage, taskRunUID int, payload *storepb.TaskDatabaseUpdatePayload, statement string, version model.Version, sheetID *int, hook *migrationHook) (bool, *storepb.TaskRunResult, error) {
+	mi, mc, err := getMigrationInfo(ctx, exec.store, exec.profile, exec.schemaSyncer, task, db.Data, statement, version, sheetID, taskRunUID, exec.dbFactory)
+	if err != nil {
+		return true, nil, err
+	}
+
+	useDBOwner, err := getUseDatabaseOwner(ctx, exec.store, mc.instance, mc.database)
+	if err != nil {
+		return true, nil, errors.Wrapf(err, "failed to check if we should use database owner")
+	}
+	driver, err := exec.dbFactory.GetAdminDatabaseDriver(ctx, mc.instance, mc.database, db.ConnectionContext{
+		UseDatabaseOwner: useDBOwner,
+	})
+	if err != nil {
+		return true, nil, errors.Wrapf(err, "failed to get driver connection for instance %q", mc.instance.ResourceID)
+	}
+	defer driver.Close(ctx)
+
+	opts := db.ExecuteOptions{}
+	opts.SetConnectionID = func(id string) {
+		exec.stateCfg.TaskRunConnectionID.Store(taskRunUID, id)
+	}
+	opts.DeleteConnectionID = func() {
+		exec.stateCfg.TaskRunConnectionID.Delete(taskRunUID)
+	}
+	opts.CreateTaskRunLog = getCreateTaskRunLog(ctx, taskRunUID, exec.store, exec.profile)
+	if hook != nil {
+		hook.setExecuteOptions(&opts)
+	}
+
+	execFunc := func(execCtx context.Context, execStatement string) error {
+		b, err := exec.newDMLBatcher(ctx, task, payload, mc.instance, mc.database, driver, execStatement)
+		if err != nil {
+			return err
+		}
+		defer b.close(ctx)
+		return b.run(execCtx, opts)
+	}
+	skipped, err := executeMigrationWithFunc(ctx, driverCtx, exec.store, mi, mc, statement, execFunc, opts)
+	if err != nil {
+		return true, nil, err
+	}
+	return postMigration(ctx, exec.store, mi, mc, skipped)
+}
+
+type dmlBatcher struct {
+	exec       *DataUpdateExecutor
+	task       *store.TaskMessage
+	engine     storepb.Engine
+	driver     db.Driver
+	statement  *base.BatchDMLStatement
+	keys       []string
+	config     *storepb.DMLBatchConfig
+	checkpoint *storepb.DMLBatchCheckpoint
+	totalRows  int64
+	// replicaDrivers are the read-only data source drivers to check the replica lag for MySQL.
+	replicaDrivers []db.Driver
+}
+
+func (exec *DataUpdateExecutor) newDMLBatcher(ctx context.Context, task *store.TaskMessage, payload *storepb.TaskDatabaseUpdatePayload, instance *store.InstanceMessage, database *store.DatabaseMessage, driver db.Driver, statement string) (*dmlBatcher, error) {
+	stmt, err := base.ParseBatchDML(instance.Engine, statement)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse statement for batched execution")
+	}
+	if stmt.Database != "" && stmt.Database != database.DatabaseName {
+		return nil, errors.Errorf("table %q must be in the database %q", stmt.Database+"."+stmt.Table, database.DatabaseName)
+	}
+	schemaName := stmt.Schema
+	if instance.Engine == storepb.Engine_POSTGRES && schemaName == "" {
+		schemaName = "public"
+	}
+
+	dbSchema, err := exec.store.GetDBSchema(ctx, database.UID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get database schema")
+	}
+	if dbSchema == nil {
+		return nil, errors.Errorf("database schema not found for database %q", database.DatabaseName)
+	}
+	table := dbSchema.GetDatabaseMetadata().GetSchema(schemaName).GetTable(stmt.Table)
+	if table == nil {
+		return nil, errors.Errorf("table %q not found, please sync the database schema and retry", stmt.Table)
+	}
+	primaryKey := table.GetPrimaryKey()
+	if primaryKey == nil || len(primaryKey.GetProto().GetExpressions()) == 0 {
+		return nil, errors.Errorf("table %q has no primary key, cannot execute in batches", stmt.Table)
+	}
+
+	b := &dmlBatcher{
+		exec:       exec,
+		task:       task,
+		engine:     instance.Engine,
+		driver:     driver,
+		statement:  stmt,
+		keys:       primaryKey.GetProto().GetExpressions(),
+		config:     payload.DmlBatchConfig,
+		checkpoint: &storepb.DMLBatchCheckpoint{},
+		totalRows:  table.GetRowCount(),
+	}
+	if payload.DmlBatchCheckpoint != nil {
+		b.checkpoint = proto.Clone(payload.DmlBatchCheckpoint).(*storepb.DMLBatchCheckpoint)
+	}
+
+	if b.config.GetMaxReplicaLag().AsDuration() > 0 && instance.Engine != storepb.Engine_POSTGRES {
+		for _, ds := range instance.DataSources {
+			if ds.Type != api.RO {
+				continue
+			}
+			replicaDriver, err := exec.dbFactory.GetDataSourceDriver(ctx, instance, ds, "", false /* datashare */, true /* readOnly */, db.ConnectionContext{})
+			if err != nil {
+				b.close(ctx)
+				return nil, errors.Wrapf(err, "failed to get driver for read-only data source %q", ds.ID)
+			}
+			b.replicaDrivers = append(b.replicaDrivers, replicaDriver)
+		}
+		if len(b.replicaDrivers) == 0 {
+			return nil, errors.Errorf("max replica lag requires a read-only data source for the replica of instance %q", instance.ResourceID)
+		}
+	}
+	return b, nil
+}
+
+func (b *dmlBatcher) close(ctx context.Context) {
+	for _, driver := range b.replicaDrivers {
+		driver.Close(ctx)
+	}
+}
+
+func (b *dmlBatcher) run(ctx context.Context, opts db.ExecuteOptions) error {
+	if b.checkpoint.InFlight && !b.statement.Delete {
+		// Deleting the rows of the chunk again is harmless, but updating them again may not be.
+		return errors.Errorf("chunk %d was interrupted while committing, so it's unknown whether it's committed, please check the rows after the primary key %v, and update the statement to restart the batches from the beginning", b.checkpoint.CompletedChunks+1, b.checkpoint.LastKey)
+	}
+	createdTs := time.Now().Unix()
+	for {
+		if err := b.throttle(ctx); err != nil {
+			return err
+		}
+
+		upper, err := b.nextBoundary(ctx)
+		if err != nil {
+			return errors.Wrap(err, "failed to find the next chunk boundary")
+		}
+		var predicates []string
+		if len(b.checkpoint.LastKey) > 0 {
+			predicates = append(predicates, fmt.Sprintf("%s > %s", b.keyTuple(true), b.valueTuple(b.checkpoint.LastKey)))
+		}
+		if upper != nil {
+			predicates = append(predicates, fmt.Sprintf("%s <= %s", b.keyTuple(true), b.valueTuple(upper)))
+		}
+		chunkStatement := b.statement.Render("1 = 1")
+		if len(predicates) > 0 {
+			chunkStatement = b.statement.Render(strings.Join(predicates, " AND "))
+		}
+		// The checkpoint is in the metadata database, so it can't be saved atomically with the chunk.
+		// The chunk is marked in flight before it's executed, and the checkpoint is saved after the chunk commits.
+		inFlight := proto.Clone(b.checkpoint).(*storepb.DMLBatchCheckpoint)
+		inFlight.InFlight = true
+		if err := b.saveCheckpoint(ctx, inFlight, false /* final */); err != nil {
+			return errors.Wrap(err, "failed to save dml batch checkpoint")
+		}
+		committing := false
+		chunkOpts := opts
+		if upper != nil {
+			chunkOpts.PostExecuteStatement = ""
+		}
+		chunkOpts.BeforeCommit = func(int64) error {
+			committing = true
+			return nil
+		}
+		affectedRows, err := b.driver.Execute(ctx, chunkStatement, chunkOpts)
+		if err != nil {
+			if !committing {
+				// The chunk is rolled back before committing, so it's safe to rerun.
+				if err := b.saveCheckpoint(ctx, b.checkpoint, false /* final */); err != nil {
+					slog.Error("failed to restore dml batch checkpoint", slog.Int("task", b.task.ID), log.BBError(err))
+				}
+			}
+			return errors.Wrapf(err, "failed to execute chunk %d", b.checkpoint.CompletedChunks+1)
+		}
+		checkpoint := proto.Clone(b.checkpoint).(*storepb.DMLBatchCheckpoint)
+		checkpoint.InFlight = false
+		checkpoint.CompletedChunks++
+		checkpoint.AffectedRows += affectedRows
+		if upper == nil {
+			checkpoint.ScannedRows = max(checkpoint.ScannedRows, b.totalRows)
+		} else {
+			checkpoint.ScannedRows += int64(b.config.ChunkSize)
+			checkpoint.LastKey = upper
+		}
+		if err := b.saveCheckpoint(ctx, checkpoint, upper == nil); err != nil {
+			return errors.Wrap(err, "failed to save dml batch checkpoint")
+		}
+		b.checkpoint = checkpoint
+
+		b.exec.stateCfg.TaskProgress.Store(b.task.ID, api.Progress{
+			TotalUnit:     max(b.totalRows, b.checkpoint.ScannedRows),
+			CompletedUnit: b.checkpoint.ScannedRows,
+			CreatedTs:     createdTs,
+			UpdatedTs:     time.Now().Unix(),
+		})
+		if upper == nil {
+			return nil
+		}
+
+		if sleep := b.config.GetSleep().AsDuration(); sleep > 0 {
+			select {
+			case <-time.After(sleep):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}
+}
+
+// saveCheckpoint saves the checkpoint to the task payload, or clears it after the final chunk.
+func (b *dmlBatcher) saveCheckpoint(ctx context.Context, checkpoint *storepb.DMLBatchCheckpoint, final bool) error {
+	if final {
+		// The empty checkpoint removes the checkpoint from the task payload.
+		checkpoint = &storepb.DMLBatchCheckpoint{}
+	}
+	_, err := b.exec.store.UpdateTaskV2(ctx, &api.TaskPatch{
+		ID:                 b.task.ID,
+		UpdaterID:          api.SystemBotID,
+		DMLBatchCheckpoint: checkpoint,
+	})
+	return err
+}
+
+// nextBoundary returns the primary key of the last row in the next chunk, or nil if the remaining rows fit in one chunk.
+func (b *dmlBatcher) nextBoundary(ctx context.Context) ([]string, error) {
+	var buf strings.Builder
+	_, _ = fmt.Fprintf(&buf, "SELECT %s FROM %s", strings.Join(b.quotedKeys(false), ", "), b.quotedTable())
+	if len(b.checkpoint.LastKey) > 0 {
+		_, _ = fmt.Fprintf(&buf, " WHERE %s > %s", b.keyTuple(false), b.valueTuple(b.checkpoint.LastKey))
+	}
+	_, _ = fmt.Fprintf(&buf, " ORDER BY %s LIMIT 1 OFFSET %d", strings.Join(b.quotedKeys(false), ", "), b.config.ChunkSize-1)
+
+	rows, err := b.driver.GetDB().QueryContext(ctx, buf.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	if !rows.Next() {
+		return nil, rows.Err()
+	}
+	values := make([]sql.NullString, len(b.keys))
+	dest := make([]any, len(b.keys))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	if err := rows.Scan(dest...); err != nil {
+		return nil, err
+	}
+	var result []string
+	for _, v := range values {
+		result = append(result, v.String)
+	}
+	return result, rows.Err()
+}
+
+// throttle blocks until the replica lag falls below the threshold.
+func (b *dmlBatcher) throttle(ctx context.Context) error {
+	maxLag := b.config.GetMaxReplicaLag().AsDuration()
+	if maxLag <= 0 {
+		return nil
+	}
+	for {
+		lag, err := b.replicaLag(ctx)
+		if err != nil {
+			return errors.Wrap(err, "failed to get replica lag")
+		}
+		if lag <= maxLag {
+			return nil
+		}
+		slog.Debug("throttle dml batch execution for replica lag",
+			slog.Int("task", b.task.ID),
+			slog.Duration("lag", lag),
+		)
+		select {
+		case <-time.After(replicaLagCheckInterval):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (b *dmlBatcher) replicaLag(ctx context.Context) (time.Duration, error) {
+	if b.engine == storepb.Engine_POSTGRES {
+		var seconds float64
+		if err := b.driver.GetDB().QueryRowContext(ctx, "SELECT COALESCE(EXTRACT(EPOCH FROM MAX(replay_lag)), 0) FROM pg_stat_replication").Scan(&seconds); err != nil {
+			return 0, err
+		}
+		return time.Duration(seconds * float64(time.Second)), nil
+	}
+
+	var lag time.Duration
+	for _, driver := range b.replicaDrivers {
+		seconds, err := getMySQLReplicaLagSeconds(ctx, driver.GetDB())
+		if err != nil {
+			return 0, err
+		}
+		lag = max(lag, time.Duration(seconds)*time.Second)
+	}
+	return lag, nil
+}
+
+// getMySQLReplicaLagSeconds returns the replication lag of a MySQL replica, or 0 if it's not a replica.
+func getMySQLReplicaLagSeconds(ctx context.Context, sqlDB *sql.DB) (int64, error) {
+	rows, err := sqlDB.QueryContext(ctx, "SHOW REPLICA STATUS")
+	if err != nil {
+		// SHOW REPLICA STATUS is introduced in MySQL 8.0.22.
+		rows, err = sqlDB.QueryContext(ctx, "SHOW SLAVE STATUS")
+		if err != nil {
+			return 0, err
+		}
+	}
+	defer rows.Close()
+	columns, err := rows.Columns()
+	if err != nil {
+		return 0, err
+	}
+	var lag int64
+	for rows.Next() {
+		values := make([]sql.NullInt64, len(columns))
+		dest := make([]any, len(columns))
+		for i, column := range columns {
+			if column == "Seconds_Behind_Source" || column == "Seconds_Behind_Master" {
+				dest[i] = &values[i]
+			} else {
+				dest[i] = new(sql.RawBytes)
+			}
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return 0, err
+		}
+		for i, column := range columns {
+			if column != "Seconds_Behind_Source" && column != "Seconds_Behind_Master" {
+				continue
+			}
+			if !values[i].Valid {
+				// NULL means the replication is broken.
+				return 0, errors.Errorf("replication is not running, %s is NULL", column)
+			}
+			lag = max(lag, values[i].Int64)
+		}
+	}
+	return lag, rows.Err()
+}
+
+// quotedTable returns the table name qualified by the database and the schema in the statement.
+func (b *dmlBatcher) quotedTable() string {
+	var parts []string
+	for _, part := range []string{b.statement.Database, b.statement.Schema, b.statement.Table} {
+		if part != "" {
+			parts = append(parts, b.quoteIdentifier(part))
+		}
+	}
+	return strings.Join(parts, ".")
+}
+
+func (b *dmlBatcher) quotedKeys(qualified bool) []string {
+	var result []string
+	for _, key := range b.keys {
+		if qualified {
+			result = append(result, b.quoteIdentifier(b.statement.TableReference())+"."+b.quoteIdentifier(key))
+		} else {
+			result = append(result, b.quoteIdentifier(key))
+		}
+	}
+	return result
+}
+
+func (b *dmlBatcher) keyTuple(qualified bool) string {
+	return "(" + strings.Join(b.quotedKeys(qualified), ", ") + ")"
+}
+
+func (b *dmlBatcher) valueTuple(values []string) string {
+	var result []string
+	for _, v := range values {
+		result = append(result, b.quoteLiteral(v))
+	}
+	return "(" + strings.Join(result, ", ") + ")"
+}
+
+func (b *dmlBatcher) quoteIdentifier(s string) string {
+	if b.engine == storepb.Engine_POSTGRES {
+		return `"` + strings.ReplaceAll(s, `"`, `""`) + `"`
+	}
+	return "`" + strings.ReplaceAll(s, "`", "``") + "`"
+}
+
+func (b *dmlBatcher) quoteLiteral(s string) string {
+	if b.engine != storepb.Engine_POSTGRES {
+		s = strings.ReplaceAll(s, `\`, `\\`)
+	}
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
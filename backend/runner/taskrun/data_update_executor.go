@@ -14,6 +14,7 @@ import (
 	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/component/config"
 	"github.com/bytebase/bytebase/backend/component/dbfactory"
+	"github.com/bytebase/bytebase/backend/component/sheet"
 	"github.com/bytebase/bytebase/backend/component/state"
 	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
 	"github.com/bytebase/bytebase/backend/runner/schemasync"
@@ -29,9 +30,10 @@ import (
 )
 
 // NewDataUpdateExecutor creates a data update (DML) task executor.
-func NewDataUpdateExecutor(store *store.Store, dbFactory *dbfactory.DBFactory, license enterprise.LicenseService, stateCfg *state.State, schemaSyncer *schemasync.Syncer, profile *config.Profile) Executor {
+func NewDataUpdateExecutor(store *store.Store, sheetManager *sheet.Manager, dbFactory *dbfactory.DBFactory, license enterprise.LicenseService, stateCfg *state.State, schemaSyncer *schemasync.Syncer, profile *config.Profile) Executor {
 	return &DataUpdateExecutor{
 		store:        store,
+		sheetManager: sheetManager,
 		dbFactory:    dbFactory,
 		license:      license,
 		stateCfg:     stateCfg,
@@ -43,6 +45,7 @@ func NewDataUpdateExecutor(store *store.Store, dbFactory *dbfactory.DBFactory, l
 // DataUpdateExecutor is the data update (DML) task executor.
 type DataUpdateExecutor struct {
 	store        *store.Store
+	sheetManager *sheet.Manager
 	dbFactory    *dbfactory.DBFactory
 	license      enterprise.LicenseService
 	stateCfg     *state.State
@@ -128,11 +131,22 @@ func (exec *DataUpdateExecutor) RunOnce(ctx context.Context, driverCtx context.C
 		})
 	}
 	version := model.Version{Version: payload.SchemaVersion}
-	terminated, result, err := runMigration(ctx, driverCtx, exec.store, exec.dbFactory, exec.stateCfg, exec.schemaSyncer, exec.profile, task, taskRunUID, db.Data, statement, version, &sheetID)
+	var terminated bool
+	var result *storepb.TaskRunResult
+	hook, err := getMigrationHook(ctx, exec.store, exec.sheetManager, instance, database, statement)
+	if err != nil {
+		return true, nil, errors.Wrap(err, "failed to get migration hook")
+	}
+	if payload.DmlBatchConfig != nil {
+		terminated, result, err = exec.runBatchMigration(ctx, driverCtx, task, taskRunUID, payload, statement, version, &sheetID, hook)
+	} else {
+		terminated, result, err = runMigration(ctx, driverCtx, exec.store, exec.dbFactory, exec.stateCfg, exec.schemaSyncer, exec.profile, task, taskRunUID, db.Data, statement, version, &sheetID, hook)
+	}
 	if result != nil {
 		// Save prior backup detail to task run result.
 		result.PriorBackupDetail = priorBackupDetail
 	}
+	hook.reportPostError(result)
 	return terminated, result, err
 }
 
@@ -167,6 +181,10 @@ func (exec *DataUpdateExecutor) backupData(
 	if payload.PreUpdateBackupDetail == nil || payload.PreUpdateBackupDetail.Database == "" {
 		return nil, nil
 	}
+	// The rows have been backed up before the interrupted batched run, skip to avoid backing up the half-updated rows.
+	if payload.DmlBatchCheckpoint != nil {
+		return nil, nil
+	}
 
 	sourceDatabaseName := common.FormatDatabase(database.InstanceID, database.DatabaseName)
 	// Format: instances/{instance}/databases/{database}
@@ -83,11 +83,21 @@ type migrateContext struct {
 		// The file
 		// Format: projects/{project}/releases/{release}/files/{id}
 		file string
+		// The type of the file.
+		fileType storepb.ReleaseFileType
 	}
 
 	// mutable
 	// changelog uid
 	changelog int64
+	// The revisions of the repeatable or declarative file to be replaced by the new revision.
+	replacedRevisions []*store.RevisionMessage
+}
+
+// isReapplicable returns whether the migration applies a repeatable or declarative release file,
+// which is re-applied whenever its content changes.
+func (mc *migrateContext) isReapplicable() bool {
+	return mc.release.fileType == storepb.ReleaseFileType_REPEATABLE || mc.release.fileType == storepb.ReleaseFileType_DECLARATIVE
 }
 
 func getMigrationInfo(ctx context.Context, stores *store.Store, profile *config.Profile, syncer *schemasync.Syncer, task *store.TaskMessage, migrationType db.MigrationType, statement string, schemaVersion model.Version, sheetID *int, taskRunUID int, dbFactory *dbfactory.DBFactory) (*db.MigrationInfo, *migrateContext, error) {
@@ -159,12 +169,26 @@ func getMigrationInfo(ctx context.Context, stores *store.Store, profile *config.
 		}
 
 		if f := p.TaskReleaseSource.GetFile(); f != "" {
-			project, release, _, err := common.GetProjectReleaseUIDFile(f)
+			project, release, fileID, err := common.GetProjectReleaseUIDFile(f)
 			if err != nil {
 				return nil, nil, errors.Wrapf(err, "failed to parse file %s", f)
 			}
 			mc.release.release = common.FormatReleaseName(project, release)
 			mc.release.file = f
+
+			releaseMessage, err := stores.GetRelease(ctx, release)
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "failed to get release %s", mc.release.release)
+			}
+			if releaseMessage == nil {
+				return nil, nil, errors.Errorf("release %s not found", mc.release.release)
+			}
+			for _, file := range releaseMessage.Payload.Files {
+				if file.Id == fileID {
+					mc.release.fileType = file.Type
+					break
+				}
+			}
 		}
 	}
 
@@ -285,6 +309,7 @@ func doMigration(
 	statement string,
 	mi *db.MigrationInfo,
 	mc *migrateContext,
+	hook *migrationHook,
 ) (bool, error) {
 	instance := mc.instance
 	database := mc.database
@@ -318,7 +343,6 @@ func doMigration(
 	opts.DeleteConnectionID = func() {
 		stateCfg.TaskRunConnectionID.Delete(mc.taskRunUID)
 	}
-
 	if stateCfg != nil {
 		switch mc.task.Type {
 		case api.TaskDatabaseSchemaUpdate, api.TaskDatabaseDataUpdate:
@@ -334,6 +358,13 @@ func doMigration(
 			}
 		}
 	}
+	if hook != nil {
+		hook.setExecuteOptions(&opts)
+		// The hook executions are recorded in the task run log.
+		if opts.CreateTaskRunLog == nil {
+			opts.CreateTaskRunLog = getCreateTaskRunLog(ctx, mc.taskRunUID, stores, profile)
+		}
+	}
 
 	return executeMigrationDefault(ctx, driverCtx, stores, stateCfg, driver, mi, mc, statement, opts)
 }
@@ -377,13 +408,13 @@ func postMigration(ctx context.Context, stores *store.Store, mi *db.MigrationInf
 	}, nil
 }
 
-func runMigration(ctx context.Context, driverCtx context.Context, store *store.Store, dbFactory *dbfactory.DBFactory, stateCfg *state.State, syncer *schemasync.Syncer, profile *config.Profile, task *store.TaskMessage, taskRunUID int, migrationType db.MigrationType, statement string, schemaVersion model.Version, sheetID *int) (terminated bool, result *storepb.TaskRunResult, err error) {
+func runMigration(ctx context.Context, driverCtx context.Context, store *store.Store, dbFactory *dbfactory.DBFactory, stateCfg *state.State, syncer *schemasync.Syncer, profile *config.Profile, task *store.TaskMessage, taskRunUID int, migrationType db.MigrationType, statement string, schemaVersion model.Version, sheetID *int, hook *migrationHook) (terminated bool, result *storepb.TaskRunResult, err error) {
 	mi, mc, err := getMigrationInfo(ctx, store, profile, syncer, task, migrationType, statement, schemaVersion, sheetID, taskRunUID, dbFactory)
 	if err != nil {
 		return true, nil, err
 	}
 
-	skipped, err := doMigration(ctx, driverCtx, store, stateCfg, profile, statement, mi, mc)
+	skipped, err := doMigration(ctx, driverCtx, store, stateCfg, profile, statement, mi, mc, hook)
 	if err != nil {
 		return true, nil, err
 	}
@@ -476,9 +507,14 @@ func beginMigration(ctx context.Context, stores *store.Store, mi *db.MigrationIn
 			return false, errors.Wrapf(err, "failed to list revisions")
 		}
 		if len(list) > 0 {
-			// This version has been executed.
-			// skip execution.
-			return true, nil
+			// The repeatable and declarative files are re-applied when the content changes.
+			if mc.isReapplicable() && mc.sheet != nil && list[0].Payload.SheetSha256 != mc.sheet.GetSha256Hex() {
+				mc.replacedRevisions = list
+			} else {
+				// This version has been executed.
+				// skip execution.
+				return true, nil
+			}
 		}
 	}
 
@@ -544,6 +580,7 @@ func endMigration(ctx context.Context, storeInstance *store.Store, mi *db.Migrat
 					Sheet:       "",
 					SheetSha256: "",
 					TaskRun:     mc.taskRunName,
+					Type:        mc.release.fileType,
 				},
 			}
 			if mc.sheet != nil {
@@ -551,7 +588,11 @@ func endMigration(ctx context.Context, storeInstance *store.Store, mi *db.Migrat
 				r.Payload.SheetSha256 = mc.sheet.GetSha256Hex()
 			}
 
-			revision, err := storeInstance.CreateRevision(ctx, r, mi.CreatorID)
+			var replacedUIDs []int64
+			for _, replaced := range mc.replacedRevisions {
+				replacedUIDs = append(replacedUIDs, replaced.UID)
+			}
+			revision, err := storeInstance.ReplaceRevisions(ctx, r, replacedUIDs, mi.CreatorID)
 			if err != nil {
 				return errors.Wrapf(err, "failed to create revision")
 			}
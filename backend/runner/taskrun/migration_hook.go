@@ -0,0 +1,167 @@
+package taskrun
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/component/sheet"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	hookVariableDatabase      = "{{database}}"
+	hookVariableChangedTables = "{{changed_tables}}"
+)
+
+// migrationHookEngines are the engines whose drivers run the hook statements in the same session as the migration.
+var migrationHookEngines = map[storepb.Engine]bool{
+	storepb.Engine_POSTGRES:  true,
+	storepb.Engine_MYSQL:     true,
+	storepb.Engine_MARIADB:   true,
+	storepb.Engine_OCEANBASE: true,
+}
+
+// migrationHook is the rendered hook statements of a migration.
+type migrationHook struct {
+	preStatement  string
+	postStatement string
+	// postError is the failure of the post-execute statement, which runs after the changes are committed.
+	postError error
+}
+
+func (h *migrationHook) setExecuteOptions(opts *db.ExecuteOptions) {
+	opts.PreExecuteStatement = h.preStatement
+	opts.PostExecuteStatement = h.postStatement
+	opts.PostExecuteFailed = func(err error) {
+		h.postError = err
+	}
+}
+
+// reportPostError appends the failure of the post-execute statement to the task run result.
+func (h *migrationHook) reportPostError(result *storepb.TaskRunResult) {
+	if h == nil || h.postError == nil || result == nil {
+		return
+	}
+	result.Detail = fmt.Sprintf("%s The post-execute hook failed after the changes were committed: %v", result.Detail, h.postError)
+}
+
+// getMigrationHook renders the project migration hooks that apply to the database environment.
+func getMigrationHook(ctx context.Context, s *store.Store, sheetManager *sheet.Manager, instance *store.InstanceMessage, database *store.DatabaseMessage, statement string) (*migrationHook, error) {
+	project, err := s.GetProjectV2(ctx, &store.FindProjectMessage{ResourceID: &database.ProjectID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get project %s", database.ProjectID)
+	}
+	if project == nil {
+		return nil, errors.Errorf("project %s not found", database.ProjectID)
+	}
+
+	pre, post := getHookStatements(project.Setting.GetMigrationHooks(), database.EffectiveEnvironmentID)
+	if pre == "" && post == "" {
+		return nil, nil
+	}
+	if !migrationHookEngines[instance.Engine] {
+		slog.Warn("migration hooks are not supported for the engine, skip",
+			slog.String("instance", instance.ResourceID),
+			slog.String("engine", instance.Engine.String()),
+		)
+		return nil, nil
+	}
+
+	var changedTables string
+	if strings.Contains(pre, hookVariableChangedTables) || strings.Contains(post, hookVariableChangedTables) {
+		changedTables, err = getChangedTables(ctx, s, sheetManager, instance, database, statement)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get changed tables")
+		}
+	}
+	replacer := newHookReplacer(instance.Engine, database.DatabaseName, changedTables)
+	return &migrationHook{
+		preStatement:  replacer.Replace(pre),
+		postStatement: replacer.Replace(post),
+	}, nil
+}
+
+// getHookStatements returns the joined pre-execute and post-execute statements of the hooks applying to the environment.
+func getHookStatements(hooks []*storepb.MigrationHook, environmentID string) (string, string) {
+	var preStatements, postStatements []string
+	for _, hook := range hooks {
+		if len(hook.Environments) > 0 && !slices.Contains(hook.Environments, environmentID) {
+			continue
+		}
+		if hook.PreStatement != "" {
+			preStatements = append(preStatements, hook.PreStatement)
+		}
+		if hook.PostStatement != "" {
+			postStatements = append(postStatements, hook.PostStatement)
+		}
+	}
+	return strings.Join(preStatements, "\n"), strings.Join(postStatements, "\n")
+}
+
+// newHookReplacer returns the replacer of the hook variables.
+// The variables are replaced in a single pass, so the values are never expanded again.
+func newHookReplacer(engine storepb.Engine, database, changedTables string) *strings.Replacer {
+	return strings.NewReplacer(
+		hookVariableDatabase, quoteDatabaseName(engine, database),
+		hookVariableChangedTables, changedTables,
+	)
+}
+
+// getChangedTables returns the comma-separated quoted names of the tables changed by the statement.
+func getChangedTables(ctx context.Context, s *store.Store, sheetManager *sheet.Manager, instance *store.InstanceMessage, database *store.DatabaseMessage, statement string) (string, error) {
+	dbSchema, err := s.GetDBSchema(ctx, database.UID)
+	if err != nil {
+		return "", err
+	}
+	if dbSchema == nil {
+		return "", errors.Errorf("database schema not found: %d", database.UID)
+	}
+	asts, advices := sheetManager.GetASTsForChecks(instance.Engine, statement)
+	if len(advices) > 0 {
+		return "", errors.Errorf("failed to parse statement: %s", advices[0].Content)
+	}
+	changeSummary, err := base.ExtractChangedResources(instance.Engine, database.DatabaseName, "" /* defaultSchema */, dbSchema, asts, statement)
+	if err != nil {
+		return "", err
+	}
+
+	var tables []string
+	for _, changedDatabase := range changeSummary.ChangedResources.Build().GetDatabases() {
+		for _, changedSchema := range changedDatabase.GetSchemas() {
+			for _, changedTable := range changedSchema.GetTables() {
+				tables = append(tables, quoteTableName(instance.Engine, changedDatabase.GetName(), changedSchema.GetName(), changedTable.GetName()))
+			}
+		}
+	}
+	return strings.Join(tables, ", "), nil
+}
+
+func quoteTableName(engine storepb.Engine, database, schema, table string) string {
+	if engine == storepb.Engine_POSTGRES {
+		if schema == "" {
+			return quoteHookIdentifier(table, `"`)
+		}
+		return quoteHookIdentifier(schema, `"`) + "." + quoteHookIdentifier(table, `"`)
+	}
+	return quoteDatabaseName(engine, database) + "." + quoteHookIdentifier(table, "`")
+}
+
+func quoteDatabaseName(engine storepb.Engine, database string) string {
+	if engine == storepb.Engine_POSTGRES {
+		return quoteHookIdentifier(database, `"`)
+	}
+	return quoteHookIdentifier(database, "`")
+}
+
+// quoteHookIdentifier quotes the identifier, doubling the embedded quotes.
+func quoteHookIdentifier(identifier, quote string) string {
+	return quote + strings.ReplaceAll(identifier, quote, quote+quote) + quote
+}
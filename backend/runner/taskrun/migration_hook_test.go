@@ -0,0 +1,70 @@
+package taskrun
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestGetHookStatements(t *testing.T) {
+	a := require.New(t)
+	hooks := []*storepb.MigrationHook{
+		{PreStatement: "SET lock_timeout = '3s';"},
+		{Environments: []string{"prod"}, PreStatement: "SET statement_timeout = '1min';", PostStatement: "ANALYZE {{changed_tables}};"},
+		{Environments: []string{"test"}, PostStatement: "SELECT 1;"},
+	}
+
+	pre, post := getHookStatements(hooks, "prod")
+	a.Equal("SET lock_timeout = '3s';\nSET statement_timeout = '1min';", pre)
+	a.Equal("ANALYZE {{changed_tables}};", post)
+
+	pre, post = getHookStatements(hooks, "staging")
+	a.Equal("SET lock_timeout = '3s';", pre)
+	a.Equal("", post)
+
+	pre, post = getHookStatements(nil, "prod")
+	a.Equal("", pre)
+	a.Equal("", post)
+}
+
+func TestNewHookReplacer(t *testing.T) {
+	a := require.New(t)
+	replacer := newHookReplacer(storepb.Engine_POSTGRES, `my"db`, `"public"."t1", "public"."{{database}}"`)
+	got := replacer.Replace("ANALYZE {{changed_tables}}; -- {{database}}, {{database}}")
+	// The values are not expanded again.
+	a.Equal(`ANALYZE "public"."t1", "public"."{{database}}"; -- "my""db", "my""db"`, got)
+
+	replacer = newHookReplacer(storepb.Engine_MYSQL, "d`b", "")
+	a.Equal("USE `d``b`;", replacer.Replace("USE {{database}};"))
+}
+
+func TestReportPostError(t *testing.T) {
+	a := require.New(t)
+	result := &storepb.TaskRunResult{Detail: "Applied migration."}
+	hook := &migrationHook{postStatement: "ANALYZE;"}
+	hook.reportPostError(result)
+	a.Equal("Applied migration.", result.Detail)
+
+	opts := &db.ExecuteOptions{}
+	hook.setExecuteOptions(opts)
+	opts.PostExecuteFailed(errors.New("permission denied"))
+	hook.reportPostError(result)
+	a.Equal("Applied migration. The post-execute hook failed after the changes were committed: permission denied", result.Detail)
+
+	var nilHook *migrationHook
+	nilHook.reportPostError(result)
+}
+
+func TestQuoteTableName(t *testing.T) {
+	a := require.New(t)
+	a.Equal(`"public"."orders"`, quoteTableName(storepb.Engine_POSTGRES, "db", "public", "orders"))
+	a.Equal(`"orders"`, quoteTableName(storepb.Engine_POSTGRES, "db", "", "orders"))
+	a.Equal("`db`.`orders`", quoteTableName(storepb.Engine_MYSQL, "db", "", "orders"))
+	// The embedded quotes are escaped.
+	a.Equal(`"my""schema"."a""b"`, quoteTableName(storepb.Engine_POSTGRES, "db", `my"schema`, `a"b`))
+	a.Equal("`d``b`.`a``b`", quoteTableName(storepb.Engine_MYSQL, "d`b", "", "a`b"))
+}
@@ -0,0 +1,81 @@
+package taskrun
+
+import (
+	"context"
+	"slices"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// completeReleasePromotion moves the release pin of the environment promoted by the plan of the pipeline,
+// after the rollouts of all the promotion plans complete.
+func (s *SchedulerV2) completeReleasePromotion(ctx context.Context, pipelineUID int) error {
+	plan, err := s.store.GetPlan(ctx, &store.FindPlanMessage{PipelineID: &pipelineUID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get plan")
+	}
+	if plan == nil || plan.Config.GetReleaseSource().GetRelease() == "" {
+		return nil
+	}
+	// The pins belong to the project of the release, which may differ from the project of the plan.
+	projectID, _, err := common.GetProjectReleaseUID(plan.Config.GetReleaseSource().GetRelease())
+	if err != nil {
+		return errors.Wrapf(err, "failed to parse release")
+	}
+	project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{ResourceID: &projectID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get project")
+	}
+	if project == nil {
+		return nil
+	}
+	releasePins, ok := updateReleasePinsOnPlanDone(project.Setting.GetReleasePins(), plan.UID)
+	if !ok {
+		return nil
+	}
+	projectSettings := project.Setting
+	projectSettings.ReleasePins = releasePins
+	if _, err := s.store.UpdateProjectV2(ctx, &store.UpdateProjectMessage{
+		UpdaterID:  api.SystemBotID,
+		ResourceID: project.ResourceID,
+		Setting:    projectSettings,
+	}); err != nil {
+		return errors.Wrapf(err, "failed to update release pins")
+	}
+	return nil
+}
+
+// updateReleasePinsOnPlanDone removes the plan from the ongoing promotions,
+// and moves the pin to the promoting release if no promotion plan is left.
+// It returns false if the plan doesn't promote any pinned environment.
+func updateReleasePinsOnPlanDone(pins []*storepb.ReleasePin, planUID int64) ([]*storepb.ReleasePin, bool) {
+	var releasePins []*storepb.ReleasePin
+	updated := false
+	for _, pin := range pins {
+		if !slices.Contains(pin.PromotingPlanUids, planUID) {
+			releasePins = append(releasePins, pin)
+			continue
+		}
+		updated = true
+		planUIDs := slices.DeleteFunc(slices.Clone(pin.PromotingPlanUids), func(uid int64) bool { return uid == planUID })
+		if len(planUIDs) > 0 {
+			releasePins = append(releasePins, &storepb.ReleasePin{
+				Environment:         pin.Environment,
+				ReleaseUid:          pin.ReleaseUid,
+				PromotingReleaseUid: pin.PromotingReleaseUid,
+				PromotingPlanUids:   planUIDs,
+			})
+			continue
+		}
+		releasePins = append(releasePins, &storepb.ReleasePin{
+			Environment: pin.Environment,
+			ReleaseUid:  pin.PromotingReleaseUid,
+		})
+	}
+	return releasePins, updated
+}
@@ -0,0 +1,37 @@
+package taskrun
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestUpdateReleasePinsOnPlanDone(t *testing.T) {
+	a := require.New(t)
+	pins := []*storepb.ReleasePin{
+		{Environment: "staging", ReleaseUid: 41},
+		{Environment: "prod", ReleaseUid: 42, PromotingReleaseUid: 43, PromotingPlanUids: []int64{101, 102}},
+	}
+
+	_, updated := updateReleasePinsOnPlanDone(pins, 100)
+	a.False(updated)
+
+	// The pin is not moved until all the promotion plans are done.
+	got, updated := updateReleasePinsOnPlanDone(pins, 101)
+	a.True(updated)
+	a.Equal([]*storepb.ReleasePin{
+		{Environment: "staging", ReleaseUid: 41},
+		{Environment: "prod", ReleaseUid: 42, PromotingReleaseUid: 43, PromotingPlanUids: []int64{102}},
+	}, got)
+	// The original pins are not modified.
+	a.Equal([]int64{101, 102}, pins[1].PromotingPlanUids)
+
+	got, updated = updateReleasePinsOnPlanDone(got, 102)
+	a.True(updated)
+	a.Equal([]*storepb.ReleasePin{
+		{Environment: "staging", ReleaseUid: 41},
+		{Environment: "prod", ReleaseUid: 43},
+	}, got)
+}
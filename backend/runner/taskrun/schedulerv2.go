@@ -15,8 +15,10 @@ import (
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/component/config"
+	"github.com/bytebase/bytebase/backend/component/dbfactory"
 	"github.com/bytebase/bytebase/backend/component/state"
 	"github.com/bytebase/bytebase/backend/component/webhook"
+	"github.com/bytebase/bytebase/backend/component/writeback"
 	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/plugin/db"
@@ -31,12 +33,18 @@ const (
 
 // SchedulerV2 is the V2 scheduler for task run.
 type SchedulerV2 struct {
-	store          *store.Store
-	stateCfg       *state.State
-	webhookManager *webhook.Manager
-	executorMap    map[api.TaskType]Executor
-	profile        *config.Profile
-	licenseService enterprise.LicenseService
+	store            *store.Store
+	stateCfg         *state.State
+	webhookManager   *webhook.Manager
+	writeBackManager *writeback.Manager
+	executorMap      map[api.TaskType]Executor
+	profile          *config.Profile
+	licenseService   enterprise.LicenseService
+	dbFactory        *dbfactory.DBFactory
+
+	// atomicRolloutLocks maps the database groups to the mutexes serializing their atomic rollout enforcement,
+	// so that a tenant is reverted at most once.
+	atomicRolloutLocks sync.Map // map[string]*sync.Mutex
 }
 
 // NewSchedulerV2 will create a new scheduler.
@@ -44,16 +52,20 @@ func NewSchedulerV2(
 	store *store.Store,
 	stateCfg *state.State,
 	webhookManager *webhook.Manager,
+	writeBackManager *writeback.Manager,
 	profile *config.Profile,
 	licenseService enterprise.LicenseService,
+	dbFactory *dbfactory.DBFactory,
 ) *SchedulerV2 {
 	return &SchedulerV2{
-		store:          store,
-		stateCfg:       stateCfg,
-		webhookManager: webhookManager,
-		profile:        profile,
-		executorMap:    map[api.TaskType]Executor{},
-		licenseService: licenseService,
+		store:            store,
+		stateCfg:         stateCfg,
+		webhookManager:   webhookManager,
+		writeBackManager: writeBackManager,
+		profile:          profile,
+		executorMap:      map[api.TaskType]Executor{},
+		licenseService:   licenseService,
+		dbFactory:        dbFactory,
 	}
 }
 
@@ -286,8 +298,18 @@ func (s *SchedulerV2) schedulePendingTaskRuns(ctx context.Context) error {
 	if err != nil {
 		return errors.Wrapf(err, "failed to list pending tasks")
 	}
-	for _, taskRun := range taskRuns {
-		if err := s.schedulePendingTaskRun(ctx, taskRun); err != nil {
+	runningTaskRuns, err := s.store.ListTaskRunsV2(ctx, &store.FindTaskRunMessage{
+		Status: &[]api.TaskRunStatus{api.TaskRunRunning},
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to list running tasks")
+	}
+	quota, err := newTaskRunQuota(ctx, s.store, runningTaskRuns)
+	if err != nil {
+		return errors.Wrapf(err, "failed to build task run quota")
+	}
+	for _, taskRun := range fairQueue(taskRuns, runningTaskRuns) {
+		if err := s.schedulePendingTaskRun(ctx, taskRun, quota); err != nil {
 			slog.Error("failed to schedule pending task run", log.BBError(err))
 		}
 	}
@@ -295,13 +317,14 @@ func (s *SchedulerV2) schedulePendingTaskRuns(ctx context.Context) error {
 	return nil
 }
 
-func (s *SchedulerV2) schedulePendingTaskRun(ctx context.Context, taskRun *store.TaskRunMessage) error {
+func (s *SchedulerV2) schedulePendingTaskRun(ctx context.Context, taskRun *store.TaskRunMessage, quota *taskRunQuota) error {
 	// here, we move pending taskruns to running taskruns which means they are ready to be executed.
 	// pending taskruns remain pending if
 	// 1. earliestAllowedTs not met.
 	// 2. blocked by other tasks via TaskDAG
 	// 3. for versioned tasks, there are other versioned tasks on the same database with
 	// a smaller version not finished yet. we need to wait for those first.
+	// 4. the task run concurrency quota of the instance or the environment is exhausted.
 	task, err := s.store.GetTaskV2ByID(ctx, taskRun.TaskUID)
 	if err != nil {
 		return errors.Wrapf(err, "failed to get task")
@@ -370,6 +393,23 @@ func (s *SchedulerV2) schedulePendingTaskRun(ctx context.Context, taskRun *store
 		return nil
 	}
 
+	waitingQuota, err := quota.acquire(ctx, taskRun, task)
+	if err != nil {
+		return errors.Wrapf(err, "failed to acquire task run quota")
+	}
+	if waitingQuota != nil {
+		s.stateCfg.TaskRunSchedulerInfo.Store(taskRun.ID, &storepb.SchedulerInfo{
+			ReportTime: timestamppb.Now(),
+			WaitingCause: &storepb.SchedulerInfo_WaitingCause{
+				Cause: &storepb.SchedulerInfo_WaitingCause_TaskRunQuota{
+					TaskRunQuota: waitingQuota,
+				},
+			},
+		})
+		return nil
+	}
+	s.stateCfg.TaskRunSchedulerInfo.Delete(taskRun.ID)
+
 	if _, err := s.store.UpdateTaskRunStatus(ctx, &store.TaskRunStatusPatch{
 		ID:        taskRun.ID,
 		UpdaterID: api.SystemBotID,
@@ -644,6 +684,8 @@ func (s *SchedulerV2) runTaskRunOnce(ctx context.Context, taskRun *store.TaskRun
 		}
 
 		s.createActivityForTaskRunStatusUpdate(ctx, task, api.TaskRunFailed, taskRunResult.Detail)
+
+		s.startAtomicRolloutEnforcement(ctx, task)
 		return
 	}
 
@@ -692,6 +734,8 @@ func (s *SchedulerV2) runTaskRunOnce(ctx context.Context, taskRun *store.TaskRun
 		}
 
 		s.createActivityForTaskRunStatusUpdate(ctx, task, api.TaskRunDone, "")
+
+		s.startAtomicRolloutEnforcement(ctx, task)
 		s.stateCfg.TaskSkippedOrDoneChan <- task.ID
 		return
 	}
@@ -804,6 +848,9 @@ func (s *SchedulerV2) ListenTaskSkippedOrDone(ctx context.Context) {
 				}(); err != nil {
 					slog.Error("failed to create ActivityPipelineStageStatusUpdate activity", log.BBError(err))
 				}
+				// Queue the write backs of the deployed schema to the repositories,
+				// the write back runner makes them so that the slow VCS requests don't block the listener.
+				s.writeBackManager.WriteBackStage(ctx, issue, taskStage)
 				// create "notify pipeline rollout" activity.
 				if err := func() error {
 					if nextStage == nil {
@@ -829,6 +876,12 @@ func (s *SchedulerV2) ListenTaskSkippedOrDone(ctx context.Context) {
 					slog.Error("failed to create rollout release notification activity", log.BBError(err))
 				}
 
+				if pipelineDone {
+					if err := s.completeReleasePromotion(ctx, task.PipelineID); err != nil {
+						slog.Error("failed to complete release promotion", log.BBError(err))
+					}
+				}
+
 				// After all tasks in the pipeline are done, we will resolve the issue if the issue is auto-resolvable.
 				if issue.Project.Setting.AutoResolveIssue && pipelineDone {
 					if err := func() error {
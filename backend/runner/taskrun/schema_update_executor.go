@@ -11,6 +11,7 @@ import (
 	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/component/config"
 	"github.com/bytebase/bytebase/backend/component/dbfactory"
+	"github.com/bytebase/bytebase/backend/component/sheet"
 	"github.com/bytebase/bytebase/backend/component/state"
 	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
 	"github.com/bytebase/bytebase/backend/plugin/db"
@@ -21,9 +22,10 @@ import (
 )
 
 // NewSchemaUpdateExecutor creates a schema update (DDL) task executor.
-func NewSchemaUpdateExecutor(store *store.Store, dbFactory *dbfactory.DBFactory, license enterprise.LicenseService, stateCfg *state.State, schemaSyncer *schemasync.Syncer, profile *config.Profile) Executor {
+func NewSchemaUpdateExecutor(store *store.Store, sheetManager *sheet.Manager, dbFactory *dbfactory.DBFactory, license enterprise.LicenseService, stateCfg *state.State, schemaSyncer *schemasync.Syncer, profile *config.Profile) Executor {
 	return &SchemaUpdateExecutor{
 		store:        store,
+		sheetManager: sheetManager,
 		dbFactory:    dbFactory,
 		license:      license,
 		stateCfg:     stateCfg,
@@ -35,6 +37,7 @@ func NewSchemaUpdateExecutor(store *store.Store, dbFactory *dbfactory.DBFactory,
 // SchemaUpdateExecutor is the schema update (DDL) task executor.
 type SchemaUpdateExecutor struct {
 	store        *store.Store
+	sheetManager *sheet.Manager
 	dbFactory    *dbfactory.DBFactory
 	license      enterprise.LicenseService
 	stateCfg     *state.State
@@ -64,8 +67,14 @@ func (exec *SchemaUpdateExecutor) RunOnce(ctx context.Context, driverCtx context
 		return true, nil, err
 	}
 
+	hook, err := getMigrationHook(ctx, exec.store, exec.sheetManager, instance, database, statement)
+	if err != nil {
+		return true, nil, errors.Wrap(err, "failed to get migration hook")
+	}
+
 	version := model.Version{Version: payload.SchemaVersion}
-	terminated, result, err := runMigration(ctx, driverCtx, exec.store, exec.dbFactory, exec.stateCfg, exec.schemaSyncer, exec.profile, task, taskRunUID, db.Migrate, statement, version, &sheetID)
+	terminated, result, err := runMigration(ctx, driverCtx, exec.store, exec.dbFactory, exec.stateCfg, exec.schemaSyncer, exec.profile, task, taskRunUID, db.Migrate, statement, version, &sheetID, hook)
+	hook.reportPostError(result)
 	// sync database schema anyways
 	exec.store.CreateTaskRunLogS(ctx, taskRunUID, time.Now(), exec.profile.DeployID, &storepb.TaskRunLog{
 		Type:              storepb.TaskRunLog_DATABASE_SYNC_START,
@@ -17,7 +17,9 @@ import (
 
 	directorysync "github.com/bytebase/bytebase/backend/api/directory-sync"
 	"github.com/bytebase/bytebase/backend/api/gitops"
+	"github.com/bytebase/bytebase/backend/api/im"
 	"github.com/bytebase/bytebase/backend/api/lsp"
+	"github.com/bytebase/bytebase/backend/api/sso"
 	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/component/config"
 )
@@ -28,6 +30,8 @@ func configureEchoRouters(
 	lspServer *lsp.Server,
 	gitOpsServer *gitops.Service,
 	directorySyncServer *directorysync.Service,
+	ssoServer *sso.Service,
+	imServer *im.Service,
 	mux *grpcruntime.ServeMux,
 	profile *config.Profile,
 ) {
@@ -119,6 +123,14 @@ func configureEchoRouters(
 
 	scimGroup := webhookGroup.Group(scimAPIPrefix)
 	directorySyncServer.RegisterDirectorySyncRoutes(scimGroup)
+	directorySyncServer.RegisterSCIMRoutes(scimGroup.Group(scimV2APIPrefix))
+
+	// Interactive requests from the IM apps.
+	imServer.RegisterIMRoutes(webhookGroup.Group(imAPIPrefix))
+
+	// SAML service provider.
+	samlGroup := e.Group(samlAPIPrefix)
+	ssoServer.RegisterSAMLRoutes(samlGroup)
 }
 
 func recoverMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
@@ -25,7 +25,9 @@ import (
 	"github.com/bytebase/bytebase/backend/api/auth"
 	directorysync "github.com/bytebase/bytebase/backend/api/directory-sync"
 	"github.com/bytebase/bytebase/backend/api/gitops"
+	"github.com/bytebase/bytebase/backend/api/im"
 	"github.com/bytebase/bytebase/backend/api/lsp"
+	"github.com/bytebase/bytebase/backend/api/sso"
 	apiv1 "github.com/bytebase/bytebase/backend/api/v1"
 	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/common/stacktrace"
@@ -35,6 +37,7 @@ import (
 	"github.com/bytebase/bytebase/backend/component/sheet"
 	"github.com/bytebase/bytebase/backend/component/state"
 	"github.com/bytebase/bytebase/backend/component/webhook"
+	"github.com/bytebase/bytebase/backend/component/writeback"
 	"github.com/bytebase/bytebase/backend/demo"
 	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
 	enterprisesvc "github.com/bytebase/bytebase/backend/enterprise/service"
@@ -45,6 +48,9 @@ import (
 	"github.com/bytebase/bytebase/backend/resources/mysqlutil"
 	"github.com/bytebase/bytebase/backend/resources/postgres"
 	"github.com/bytebase/bytebase/backend/runner/approval"
+	"github.com/bytebase/bytebase/backend/runner/discovery"
+	"github.com/bytebase/bytebase/backend/runner/iamexpiry"
+	"github.com/bytebase/bytebase/backend/runner/jitaccess"
 	"github.com/bytebase/bytebase/backend/runner/mail"
 	"github.com/bytebase/bytebase/backend/runner/metricreport"
 	"github.com/bytebase/bytebase/backend/runner/plancheck"
@@ -59,6 +65,10 @@ const (
 	// webhookAPIPrefix is the API prefix for Bytebase webhook.
 	webhookAPIPrefix = "/hook"
 	scimAPIPrefix    = "/scim"
+	scimV2APIPrefix  = "/v2"
+	imAPIPrefix      = "/im"
+	// samlAPIPrefix is the API prefix for Bytebase as the SAML service provider.
+	samlAPIPrefix = "/saml"
 	// lspAPI is the API for Bytebase Language Server Protocol.
 	lspAPI                 = "/lsp"
 	maxStacksize           = 1024 * 10240
@@ -76,10 +86,14 @@ type Server struct {
 	mailSender         *mail.SlowQueryWeeklyMailSender
 	approvalRunner     *approval.Runner
 	relayRunner        *relay.Runner
+	iamExpiryRunner    *iamexpiry.Runner
+	discoveryRunner    *discovery.Runner
+	jitAccessRunner    *jitaccess.Runner
 	runnerWG           sync.WaitGroup
 
-	webhookManager *webhook.Manager
-	iamManager     *iam.Manager
+	webhookManager   *webhook.Manager
+	writeBackManager *writeback.Manager
+	iamManager       *iam.Manager
 
 	licenseService enterprise.LicenseService
 
@@ -247,6 +261,7 @@ func NewServer(ctx context.Context, profile *config.Profile) (*Server, error) {
 		return nil, errors.Wrapf(err, "failed to create iam manager")
 	}
 	s.webhookManager = webhook.NewManager(storeInstance, s.iamManager)
+	s.writeBackManager = writeback.NewManager(storeInstance)
 	s.dbFactory = dbfactory.New(
 		s.store,
 		s.mysqlBinDir,
@@ -280,20 +295,23 @@ func NewServer(ctx context.Context, profile *config.Profile) (*Server, error) {
 	)
 
 	s.metricReporter = metricreport.NewReporter(s.store, s.licenseService, s.profile, false)
-	s.schemaSyncer = schemasync.NewSyncer(storeInstance, s.dbFactory, s.stateCfg, profile, s.licenseService)
+	s.schemaSyncer = schemasync.NewSyncer(storeInstance, s.dbFactory, s.stateCfg, profile, s.licenseService, s.webhookManager)
 	if !profile.Readonly {
-		s.slowQuerySyncer = slowquerysync.NewSyncer(storeInstance, s.dbFactory, s.stateCfg, profile)
+		s.slowQuerySyncer = slowquerysync.NewSyncer(storeInstance, s.dbFactory, s.stateCfg, profile, s.webhookManager)
 		s.mailSender = mail.NewSender(s.store, s.stateCfg, s.iamManager)
 		s.relayRunner = relay.NewRunner(storeInstance, s.webhookManager, s.stateCfg)
 		s.approvalRunner = approval.NewRunner(storeInstance, s.sheetManager, s.dbFactory, s.stateCfg, s.webhookManager, s.relayRunner, s.licenseService)
+		s.iamExpiryRunner = iamexpiry.NewRunner(storeInstance, s.webhookManager)
+		s.discoveryRunner = discovery.NewRunner(storeInstance, s.dbFactory, s.licenseService)
+		s.jitAccessRunner = jitaccess.NewRunner(storeInstance, s.dbFactory, s.webhookManager, s.secret)
 
-		s.taskSchedulerV2 = taskrun.NewSchedulerV2(storeInstance, s.stateCfg, s.webhookManager, profile, s.licenseService)
+		s.taskSchedulerV2 = taskrun.NewSchedulerV2(storeInstance, s.stateCfg, s.webhookManager, s.writeBackManager, profile, s.licenseService, s.dbFactory)
 		s.taskSchedulerV2.Register(api.TaskGeneral, taskrun.NewDefaultExecutor())
 		s.taskSchedulerV2.Register(api.TaskDatabaseCreate, taskrun.NewDatabaseCreateExecutor(storeInstance, s.dbFactory, s.schemaSyncer, s.stateCfg, profile))
 		s.taskSchedulerV2.Register(api.TaskDatabaseSchemaBaseline, taskrun.NewSchemaBaselineExecutor(storeInstance, s.dbFactory, s.licenseService, s.stateCfg, s.schemaSyncer, profile))
-		s.taskSchedulerV2.Register(api.TaskDatabaseSchemaUpdate, taskrun.NewSchemaUpdateExecutor(storeInstance, s.dbFactory, s.licenseService, s.stateCfg, s.schemaSyncer, profile))
+		s.taskSchedulerV2.Register(api.TaskDatabaseSchemaUpdate, taskrun.NewSchemaUpdateExecutor(storeInstance, s.sheetManager, s.dbFactory, s.licenseService, s.stateCfg, s.schemaSyncer, profile))
 		s.taskSchedulerV2.Register(api.TaskDatabaseSchemaUpdateSDL, taskrun.NewSchemaUpdateSDLExecutor(storeInstance, s.dbFactory, s.licenseService, s.stateCfg, s.schemaSyncer, profile))
-		s.taskSchedulerV2.Register(api.TaskDatabaseDataUpdate, taskrun.NewDataUpdateExecutor(storeInstance, s.dbFactory, s.licenseService, s.stateCfg, s.schemaSyncer, profile))
+		s.taskSchedulerV2.Register(api.TaskDatabaseDataUpdate, taskrun.NewDataUpdateExecutor(storeInstance, s.sheetManager, s.dbFactory, s.licenseService, s.stateCfg, s.schemaSyncer, profile))
 		s.taskSchedulerV2.Register(api.TaskDatabaseDataExport, taskrun.NewDataExportExecutor(storeInstance, s.dbFactory, s.licenseService, s.stateCfg, s.schemaSyncer, profile))
 		s.taskSchedulerV2.Register(api.TaskDatabaseSchemaUpdateGhostSync, taskrun.NewSchemaUpdateGhostSyncExecutor(storeInstance, s.stateCfg, s.secret))
 		s.taskSchedulerV2.Register(api.TaskDatabaseSchemaUpdateGhostCutover, taskrun.NewSchemaUpdateGhostCutoverExecutor(storeInstance, s.dbFactory, s.licenseService, s.stateCfg, s.schemaSyncer, profile))
@@ -307,6 +325,8 @@ func NewServer(ctx context.Context, profile *config.Profile) (*Server, error) {
 		s.planCheckScheduler.Register(store.PlanCheckDatabaseGhostSync, ghostSyncExecutor)
 		statementReportExecutor := plancheck.NewStatementReportExecutor(storeInstance, s.sheetManager, s.dbFactory)
 		s.planCheckScheduler.Register(store.PlanCheckDatabaseStatementSummaryReport, statementReportExecutor)
+		statementDryRunExecutor := plancheck.NewStatementDryRunExecutor(storeInstance, s.sheetManager, s.dbFactory)
+		s.planCheckScheduler.Register(store.PlanCheckDatabaseStatementDryRun, statementDryRunExecutor)
 
 		// Metric reporter
 		s.initMetricReporter()
@@ -376,9 +396,11 @@ func NewServer(ctx context.Context, profile *config.Profile) (*Server, error) {
 	// GitOps webhook server.
 	gitOpsServer := gitops.NewService(s.store, s.licenseService, releaseService, planService, rolloutService, issueService, sqlService, s.sheetManager, profile)
 	directorySyncServer := directorysync.NewService(s.store, s.licenseService, s.iamManager)
+	ssoServer := sso.NewService(s.store)
+	imServer := im.NewService(s.store, issueService)
 
 	// Configure echo server routes.
-	configureEchoRouters(s.echoServer, s.grpcServer, s.lspServer, gitOpsServer, directorySyncServer, mux, profile)
+	configureEchoRouters(s.echoServer, s.grpcServer, s.lspServer, gitOpsServer, directorySyncServer, ssoServer, imServer, mux, profile)
 
 	serverStarted = true
 	return s, nil
@@ -402,6 +424,16 @@ func (s *Server) Run(ctx context.Context, port int) error {
 		go s.approvalRunner.Run(ctx, &s.runnerWG)
 		s.runnerWG.Add(1)
 		go s.relayRunner.Run(ctx, &s.runnerWG)
+		s.runnerWG.Add(1)
+		go s.iamExpiryRunner.Run(ctx, &s.runnerWG)
+		s.runnerWG.Add(1)
+		go s.discoveryRunner.Run(ctx, &s.runnerWG)
+		s.runnerWG.Add(1)
+		go s.jitAccessRunner.Run(ctx, &s.runnerWG)
+		s.runnerWG.Add(1)
+		go s.webhookManager.Run(ctx, &s.runnerWG)
+		s.runnerWG.Add(1)
+		go s.writeBackManager.Run(ctx, &s.runnerWG)
 
 		s.runnerWG.Add(1)
 		go s.metricReporter.Run(ctx, &s.runnerWG)
@@ -0,0 +1,236 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// AccessTokenMessage is the message for the personal access tokens and the service account tokens.
+type AccessTokenMessage struct {
+	PrincipalUID int
+	// TokenHash is the SHA-256 hash of the token secret. The secret itself is never stored.
+	TokenHash  string
+	ExpireTime *time.Time
+	Payload    *storepb.AccessTokenPayload
+
+	// output only
+	UID          int64
+	CreatorUID   int
+	CreatedTime  time.Time
+	LastUsedTime *time.Time
+	RevokeTime   *time.Time
+}
+
+// FindAccessTokenMessage is the message for finding access tokens.
+type FindAccessTokenMessage struct {
+	UID          *int64
+	PrincipalUID *int
+	TokenHash    *string
+	ShowRevoked  bool
+}
+
+// UpdateAccessTokenMessage is the message for updating an access token.
+type UpdateAccessTokenMessage struct {
+	UID int64
+
+	TokenHash    *string
+	LastUsedTime *time.Time
+	RevokeTime   *time.Time
+}
+
+// CreateAccessToken creates an access token.
+func (s *Store) CreateAccessToken(ctx context.Context, create *AccessTokenMessage, creatorUID int) (*AccessTokenMessage, error) {
+	query := `
+		INSERT INTO access_token (
+			principal_id,
+			creator_id,
+			expire_ts,
+			token_hash,
+			payload
+		) VALUES (
+			$1,
+			$2,
+			$3,
+			$4,
+			$5
+		) RETURNING id, created_ts
+	`
+
+	p, err := protojson.Marshal(create.Payload)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to marshal access token payload")
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to begin tx")
+	}
+	defer tx.Rollback()
+
+	var id int64
+	var createdTime time.Time
+	if err := tx.QueryRowContext(ctx, query,
+		create.PrincipalUID,
+		creatorUID,
+		create.ExpireTime,
+		create.TokenHash,
+		p,
+	).Scan(&id, &createdTime); err != nil {
+		return nil, errors.Wrapf(err, "failed to insert access token")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrapf(err, "failed to commit tx")
+	}
+
+	create.UID = id
+	create.CreatorUID = creatorUID
+	create.CreatedTime = createdTime
+	return create, nil
+}
+
+// GetAccessToken gets an access token.
+func (s *Store) GetAccessToken(ctx context.Context, find *FindAccessTokenMessage) (*AccessTokenMessage, error) {
+	tokens, err := s.ListAccessTokens(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return nil, nil
+	}
+	if len(tokens) > 1 {
+		return nil, errors.Errorf("found %d access tokens, expect 1", len(tokens))
+	}
+	return tokens[0], nil
+}
+
+// ListAccessTokens lists the access tokens.
+func (s *Store) ListAccessTokens(ctx context.Context, find *FindAccessTokenMessage) ([]*AccessTokenMessage, error) {
+	where, args := []string{"TRUE"}, []any{}
+	if v := find.UID; v != nil {
+		where, args = append(where, fmt.Sprintf("id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.PrincipalUID; v != nil {
+		where, args = append(where, fmt.Sprintf("principal_id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.TokenHash; v != nil {
+		where, args = append(where, fmt.Sprintf("token_hash = $%d", len(args)+1)), append(args, *v)
+	}
+	if !find.ShowRevoked {
+		where = append(where, "revoke_ts IS NULL")
+	}
+
+	query := fmt.Sprintf(`
+		SELECT
+			id,
+			principal_id,
+			creator_id,
+			created_ts,
+			expire_ts,
+			last_used_ts,
+			revoke_ts,
+			token_hash,
+			payload
+		FROM access_token
+		WHERE %s
+		ORDER BY id DESC
+	`, strings.Join(where, " AND "))
+
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to begin tx")
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to query rows")
+	}
+	defer rows.Close()
+
+	var tokens []*AccessTokenMessage
+	for rows.Next() {
+		token := &AccessTokenMessage{
+			Payload: &storepb.AccessTokenPayload{},
+		}
+		var expireTime, lastUsedTime, revokeTime sql.NullTime
+		var payload []byte
+		if err := rows.Scan(
+			&token.UID,
+			&token.PrincipalUID,
+			&token.CreatorUID,
+			&token.CreatedTime,
+			&expireTime,
+			&lastUsedTime,
+			&revokeTime,
+			&token.TokenHash,
+			&payload,
+		); err != nil {
+			return nil, errors.Wrapf(err, "failed to scan rows")
+		}
+		if expireTime.Valid {
+			token.ExpireTime = &expireTime.Time
+		}
+		if lastUsedTime.Valid {
+			token.LastUsedTime = &lastUsedTime.Time
+		}
+		if revokeTime.Valid {
+			token.RevokeTime = &revokeTime.Time
+		}
+		if err := common.ProtojsonUnmarshaler.Unmarshal(payload, token.Payload); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal payload")
+		}
+		tokens = append(tokens, token)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrapf(err, "rows err")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrapf(err, "failed to commit tx")
+	}
+	return tokens, nil
+}
+
+// UpdateAccessToken updates an access token.
+func (s *Store) UpdateAccessToken(ctx context.Context, update *UpdateAccessTokenMessage) (*AccessTokenMessage, error) {
+	set, args := []string{}, []any{}
+	if v := update.TokenHash; v != nil {
+		set, args = append(set, fmt.Sprintf("token_hash = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := update.LastUsedTime; v != nil {
+		set, args = append(set, fmt.Sprintf("last_used_ts = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := update.RevokeTime; v != nil {
+		set, args = append(set, fmt.Sprintf("revoke_ts = $%d", len(args)+1)), append(args, *v)
+	}
+	if len(set) == 0 {
+		return nil, errors.New("no update field provided")
+	}
+	args = append(args, update.UID)
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf(`UPDATE access_token SET %s WHERE id = $%d`, strings.Join(set, ", "), len(args)), args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to update access token")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrapf(err, "failed to commit tx")
+	}
+
+	return s.GetAccessToken(ctx, &FindAccessTokenMessage{UID: &update.UID, ShowRevoked: true})
+}
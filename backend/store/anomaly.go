@@ -9,6 +9,7 @@ import (
 
 	"github.com/pkg/errors"
 
+	"github.com/bytebase/bytebase/backend/common"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 )
 
@@ -48,13 +49,16 @@ type DeleteAnomalyMessage struct {
 }
 
 // UpsertActiveAnomalyV2 upserts an instance of anomaly.
-func (s *Store) UpsertActiveAnomalyV2(ctx context.Context, principalUID int, upsert *AnomalyMessage) (*AnomalyMessage, error) {
+// It returns true if the anomaly is newly opened.
+func (s *Store) UpsertActiveAnomalyV2(ctx context.Context, principalUID int, upsert *AnomalyMessage) (*AnomalyMessage, bool, error) {
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
 	defer tx.Rollback()
 
+	// The xmax of the inserted row is 0, while the updated row is locked by the upsert.
+	var opened bool
 	t := time.Now().Unix()
 	upsert.CreatedTs = t
 	upsert.UpdatedTs = t
@@ -72,8 +76,9 @@ func (s *Store) UpsertActiveAnomalyV2(ctx context.Context, principalUID int, ups
 	VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 	ON CONFLICT (project, database_id, type) DO UPDATE SET
 		updated_ts = EXCLUDED.updated_ts
+	RETURNING id, created_ts, (xmax = 0)
 `
-	if _, err := tx.ExecContext(ctx, query,
+	if err := tx.QueryRowContext(ctx, query,
 		principalUID,
 		principalUID,
 		t,
@@ -82,14 +87,14 @@ func (s *Store) UpsertActiveAnomalyV2(ctx context.Context, principalUID int, ups
 		upsert.InstanceUID,
 		upsert.DatabaseUID,
 		upsert.Type,
-	); err != nil {
-		return nil, err
+	).Scan(&upsert.UID, &upsert.CreatedTs, &opened); err != nil {
+		return nil, false, err
 	}
 	if err := tx.Commit(); err != nil {
-		return nil, err
+		return nil, false, err
 	}
 
-	return upsert, nil
+	return upsert, opened, nil
 }
 
 // ListAnomalyV2 lists anomalies, only return the normal ones.
@@ -114,6 +119,7 @@ func (s *Store) ListAnomalyV2(ctx context.Context, list *ListAnomalyMessage) ([]
 }
 
 // DeleteAnomalyV2 deletes an anomaly.
+// It returns a NotFound error if there is no such anomaly.
 func (s *Store) DeleteAnomalyV2(ctx context.Context, d *DeleteAnomalyMessage) error {
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
@@ -121,13 +127,21 @@ func (s *Store) DeleteAnomalyV2(ctx context.Context, d *DeleteAnomalyMessage) er
 	}
 	defer tx.Rollback()
 
-	if _, err := tx.ExecContext(ctx,
+	result, err := tx.ExecContext(ctx,
 		`DELETE FROM anomaly WHERE database_id = $1 AND type = $2`,
 		d.DatabaseUID,
 		d.Type,
-	); err != nil {
+	)
+	if err != nil {
 		return err
 	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return &common.Error{Code: common.NotFound, Err: errors.Errorf("anomaly not found")}
+	}
 
 	return tx.Commit()
 }
@@ -16,7 +16,19 @@ import (
 type AuditLogMethod string
 
 // The methods other than v1 api.
-const AuditLogMethodProjectRepositoryPush AuditLogMethod = "bb.project.repository.push"
+const (
+	AuditLogMethodProjectRepositoryPush AuditLogMethod = "bb.project.repository.push"
+	// AuditLogMethodIamBindingExpire is the method for removing the expired IAM bindings.
+	AuditLogMethodIamBindingExpire AuditLogMethod = "bb.iam.binding.expire"
+	// AuditLogMethodJITCredentialCreate is the method for creating the JIT access database user.
+	AuditLogMethodJITCredentialCreate AuditLogMethod = "bb.jit.credential.create"
+	// AuditLogMethodJITCredentialRevoke is the method for dropping the JIT access database user.
+	AuditLogMethodJITCredentialRevoke AuditLogMethod = "bb.jit.credential.revoke"
+	// AuditLogMethodIMIssueApprove is the method for approving the issue from the IM apps.
+	AuditLogMethodIMIssueApprove AuditLogMethod = "bb.im.issue.approve"
+	// AuditLogMethodIMIssueReject is the method for rejecting the issue from the IM apps.
+	AuditLogMethodIMIssueReject AuditLogMethod = "bb.im.issue.reject"
+)
 
 func (m AuditLogMethod) String() string {
 	return string(m)
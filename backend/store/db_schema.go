@@ -131,6 +131,35 @@ func (s *Store) UpsertDBSchema(ctx context.Context, databaseID int, dbSchema *mo
 	return nil
 }
 
+// UpdateDBSchemaConfigIfMatch updates the config of the database schema only if the config is still the given one, i.e. the etag,
+// so that the concurrent updates in between are not overwritten.
+// It returns false if the config has been changed.
+func (s *Store) UpdateDBSchemaConfigIfMatch(ctx context.Context, databaseID int, oldConfig, config *storepb.DatabaseConfig, updaterID int) (bool, error) {
+	oldBytes, err := protojson.Marshal(oldConfig)
+	if err != nil {
+		return false, err
+	}
+	bytes, err := protojson.Marshal(config)
+	if err != nil {
+		return false, err
+	}
+	result, err := s.db.db.ExecContext(ctx, `
+		UPDATE db_schema
+		SET config = $1, updater_id = $2, updated_ts = extract(epoch from now())
+		WHERE database_id = $3 AND config = $4::JSONB
+	`, bytes, updaterID, databaseID, oldBytes)
+	// The cached config may be stale if the update doesn't match.
+	s.dbSchemaCache.Remove(databaseID)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to update database config")
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to get affected rows")
+	}
+	return rows == 1, nil
+}
+
 func (s *Store) ListLegacyCatalog(ctx context.Context) ([]int, error) {
 	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
 	if err != nil {
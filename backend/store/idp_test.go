@@ -0,0 +1,50 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestIdentityProviderConfigRoundTrip(t *testing.T) {
+	a := require.New(t)
+	tests := []struct {
+		providerType storepb.IdentityProviderType
+		config       *storepb.IdentityProviderConfig
+	}{
+		{
+			providerType: storepb.IdentityProviderType_OIDC,
+			config: &storepb.IdentityProviderConfig{
+				Config: &storepb.IdentityProviderConfig_OidcConfig{
+					OidcConfig: &storepb.OIDCIdentityProviderConfig{Issuer: "https://idp.example.com", ClientId: "bytebase"},
+				},
+			},
+		},
+		{
+			providerType: storepb.IdentityProviderType_SAML,
+			config: &storepb.IdentityProviderConfig{
+				Config: &storepb.IdentityProviderConfig_SamlConfig{
+					SamlConfig: &storepb.SAMLIdentityProviderConfig{
+						IdpEntityId:    "https://idp.example.com/metadata",
+						SsoUrl:         "https://idp.example.com/sso",
+						IdpCertificate: "certificate",
+						FieldMapping:   &storepb.FieldMapping{Identifier: "email"},
+					},
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		configBytes, err := getConfigBytes(test.config)
+		a.NoError(err, test.providerType)
+		// The type is stored by name and read back from it.
+		providerType := convertIdentityProviderType(test.providerType.String())
+		a.Equal(test.providerType, providerType)
+		got := convertIdentityProviderConfigString(providerType, string(configBytes))
+		a.Empty(cmp.Diff(test.config, got, protocmp.Transform()), test.providerType)
+	}
+}
@@ -294,6 +294,41 @@ func (s *Store) UpdateIssueV2(ctx context.Context, uid int, patch *UpdateIssueMe
 	return s.GetIssueV2(ctx, &FindIssueMessage{UID: &uid})
 }
 
+// RevealJITAccessCredential marks the active JIT access credential of the issue as revealed and removes
+// the obfuscated password in one conditional update, so that the password is revealed at most once.
+// It returns the obfuscated password, or false if the credential is not active or has been revealed.
+func (s *Store) RevealJITAccessCredential(ctx context.Context, uid int, updaterID int) (string, bool, error) {
+	query := `
+		UPDATE issue
+		SET payload = jsonb_set(
+				payload #- '{jitAccessRequest,credential,obfuscatedPassword}',
+				'{jitAccessRequest,credential,passwordRevealed}',
+				'true'::JSONB
+			),
+			updater_id = $1,
+			updated_ts = $2
+		FROM (
+			SELECT id, COALESCE(payload->'jitAccessRequest'->'credential'->>'obfuscatedPassword', '') AS obfuscated_password
+			FROM issue
+			WHERE id = $3
+		) AS old
+		WHERE issue.id = old.id
+			AND issue.payload->'jitAccessRequest'->'credential'->>'state' = 'ACTIVE'
+			AND NOT COALESCE((issue.payload->'jitAccessRequest'->'credential'->>'passwordRevealed')::BOOLEAN, FALSE)
+		RETURNING old.obfuscated_password
+	`
+	var obfuscatedPassword string
+	if err := s.db.db.QueryRowContext(ctx, query, updaterID, time.Now().Unix(), uid).Scan(&obfuscatedPassword); err != nil {
+		if err == sql.ErrNoRows {
+			return "", false, nil
+		}
+		return "", false, errors.Wrapf(err, "failed to reveal JIT access credential")
+	}
+
+	s.issueCache.Remove(uid)
+	return obfuscatedPassword, true, nil
+}
+
 func setSubscribers(ctx context.Context, tx *Tx, issueUID int, subscribers []*UserMessage) error {
 	subscriberIDs := make(map[int]bool)
 	for _, subscriber := range subscribers {
@@ -231,10 +231,11 @@ func (d *DatabaseConfig) BuildDatabaseConfig() *storepb.DatabaseConfig {
 
 			for colName, colConfig := range tConfig.internal {
 				tableConfig.Columns = append(tableConfig.Columns, &storepb.ColumnCatalog{
-					Name:           colName,
-					SemanticType:   colConfig.SemanticType,
-					Labels:         colConfig.Labels,
-					Classification: colConfig.Classification,
+					Name:                   colName,
+					SemanticType:           colConfig.SemanticType,
+					Labels:                 colConfig.Labels,
+					Classification:         colConfig.Classification,
+					ClassificationProposal: colConfig.ClassificationProposal,
 				})
 			}
 			schemaConfig.Tables = append(schemaConfig.Tables, tableConfig)
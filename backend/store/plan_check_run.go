@@ -29,6 +29,8 @@ const (
 	PlanCheckDatabaseConnect PlanCheckRunType = "bb.plan-check.database.connect"
 	// PlanCheckDatabaseGhostSync is the plan check type for the gh-ost sync task.
 	PlanCheckDatabaseGhostSync PlanCheckRunType = "bb.plan-check.database.ghost.sync"
+	// PlanCheckDatabaseStatementDryRun is the plan check type for statement dry run.
+	PlanCheckDatabaseStatementDryRun PlanCheckRunType = "bb.plan-check.database.statement.dry-run"
 )
 
 // PlanCheckRunStatus is the status of a plan check run.
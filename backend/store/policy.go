@@ -153,6 +153,54 @@ func (s *Store) GetRolloutPolicy(ctx context.Context, environmentID int) (*store
 	return p, nil
 }
 
+// GetTaskRunConcurrencyPolicy gets the task run concurrency policy for the environment.
+func (s *Store) GetTaskRunConcurrencyPolicy(ctx context.Context, environmentID int) (*storepb.TaskRunConcurrencyPolicy, error) {
+	resourceType := api.PolicyResourceTypeEnvironment
+	pType := api.PolicyTypeTaskRunConcurrency
+	policy, err := s.GetPolicyV2(ctx, &FindPolicyMessage{
+		ResourceType: &resourceType,
+		ResourceUID:  &environmentID,
+		Type:         &pType,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get policy")
+	}
+	if policy == nil {
+		return &storepb.TaskRunConcurrencyPolicy{}, nil
+	}
+
+	p := &storepb.TaskRunConcurrencyPolicy{}
+	if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(policy.Payload), p); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal task run concurrency policy")
+	}
+
+	return p, nil
+}
+
+// GetDryRunPolicy gets the dry run policy for the environment.
+func (s *Store) GetDryRunPolicy(ctx context.Context, environmentID int) (*storepb.DryRunPolicy, error) {
+	resourceType := api.PolicyResourceTypeEnvironment
+	pType := api.PolicyTypeDryRun
+	policy, err := s.GetPolicyV2(ctx, &FindPolicyMessage{
+		ResourceType: &resourceType,
+		ResourceUID:  &environmentID,
+		Type:         &pType,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get policy")
+	}
+	if policy == nil {
+		return &storepb.DryRunPolicy{}, nil
+	}
+
+	p := &storepb.DryRunPolicy{}
+	if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(policy.Payload), p); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal dry run policy")
+	}
+
+	return p, nil
+}
+
 func (s *Store) GetDataExportPolicy(ctx context.Context) (*storepb.ExportDataPolicy, error) {
 	resourceType := api.PolicyResourceTypeWorkspace
 	pType := api.PolicyTypeExportData
@@ -325,6 +373,31 @@ func (s *Store) GetMaskingExceptionPolicyByProjectUID(ctx context.Context, proje
 	return p, nil
 }
 
+// GetRowFilterPolicyByProjectUID gets the row filter policy for a project.
+func (s *Store) GetRowFilterPolicyByProjectUID(ctx context.Context, projectUID int) (*storepb.RowFilterPolicy, error) {
+	resourceType := api.PolicyResourceTypeProject
+	pType := api.PolicyTypeRowFilter
+	policy, err := s.GetPolicyV2(ctx, &FindPolicyMessage{
+		ResourceType: &resourceType,
+		ResourceUID:  &projectUID,
+		Type:         &pType,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if policy == nil {
+		return &storepb.RowFilterPolicy{}, nil
+	}
+
+	p := new(storepb.RowFilterPolicy)
+	if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(policy.Payload), p); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
 // PolicyMessage is the mssage for policy.
 type PolicyMessage struct {
 	ResourceUID       int
@@ -445,6 +518,27 @@ func (s *Store) CreatePolicyV2(ctx context.Context, create *PolicyMessage, creat
 	return policy, nil
 }
 
+// UpdatePolicyPayloadIfMatch updates the payload of the policy only if the policy still has the update time, i.e. the etag,
+// and the payload of the given one, so that the concurrent updates in between are not overwritten.
+// It returns false if the policy has been changed.
+func (s *Store) UpdatePolicyPayloadIfMatch(ctx context.Context, policy *PolicyMessage, payload string, updaterID int) (bool, error) {
+	result, err := s.db.db.ExecContext(ctx, `
+		UPDATE policy
+		SET payload = $1, updater_id = $2, updated_ts = extract(epoch from now())
+		WHERE resource_type = $3 AND resource_id = $4 AND type = $5 AND updated_ts = $6 AND payload = $7::JSONB
+	`, payload, updaterID, policy.ResourceType, policy.ResourceUID, policy.Type, policy.UpdatedTime.Unix(), policy.Payload)
+	// The cached policy may be stale if the update doesn't match.
+	s.policyCache.Remove(getPolicyCacheKey(policy.ResourceType, policy.ResourceUID, policy.Type))
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to update policy")
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to get affected rows")
+	}
+	return rows == 1, nil
+}
+
 // UpdatePolicyV2 updates the policy.
 func (s *Store) UpdatePolicyV2(ctx context.Context, patch *UpdatePolicyMessage) (*PolicyMessage, error) {
 	set, args := []string{"updater_id = $1", "updated_ts = $2"}, []any{patch.UpdaterID, time.Now().Unix()}
@@ -31,6 +31,7 @@ type FindUserMessage struct {
 	ShowDeleted bool
 	Type        *api.PrincipalType
 	Limit       *int
+	Offset      *int
 }
 
 // UpdateUserMessage is the message to update a user.
@@ -182,11 +183,15 @@ func listUserImpl(ctx context.Context, tx *Tx, find *FindUserMessage) ([]*UserMe
 		principal.profile,
 		principal.created_ts
 	FROM principal
-	WHERE ` + strings.Join(where, " AND ")
+	WHERE ` + strings.Join(where, " AND ") + `
+	ORDER BY principal.id`
 
 	if v := find.Limit; v != nil {
 		query += fmt.Sprintf(" LIMIT %d", *v)
 	}
+	if v := find.Offset; v != nil {
+		query += fmt.Sprintf(" OFFSET %d", *v)
+	}
 
 	var userMessages []*UserMessage
 	rows, err := tx.QueryContext(ctx, query, args...)
@@ -146,6 +146,12 @@ func (s *Store) GetRevision(ctx context.Context, uid int64) (*RevisionMessage, e
 }
 
 func (s *Store) CreateRevision(ctx context.Context, revision *RevisionMessage, creatorUID int) (*RevisionMessage, error) {
+	return s.ReplaceRevisions(ctx, revision, nil, creatorUID)
+}
+
+// ReplaceRevisions deletes the replaced revisions and creates the revision in one transaction,
+// so that the database is never left without the revision of the version.
+func (s *Store) ReplaceRevisions(ctx context.Context, revision *RevisionMessage, replacedUIDs []int64, creatorUID int) (*RevisionMessage, error) {
 	query := `
 		INSERT INTO revision (
 			database_id,
@@ -172,6 +178,17 @@ func (s *Store) CreateRevision(ctx context.Context, revision *RevisionMessage, c
 	}
 	defer tx.Rollback()
 
+	if len(replacedUIDs) > 0 {
+		if _, err := tx.ExecContext(ctx, `
+			UPDATE revision
+			SET deleter_id = $1, deleted_ts = now()
+			WHERE id = ANY($2) AND deleted_ts IS NULL`,
+			creatorUID, replacedUIDs,
+		); err != nil {
+			return nil, errors.Wrapf(err, "failed to delete the replaced revisions")
+		}
+	}
+
 	var id int64
 	var createTime time.Time
 	if err := tx.QueryRowContext(ctx, query,
@@ -33,6 +33,8 @@ const (
 	RiskRequestQuery RiskSource = "bb.risk.request.query"
 	// RiskRequestExport is for requesting export grant.
 	RiskRequestExport RiskSource = "bb.risk.request.export"
+	// RiskRequestJITAccess is for requesting just-in-time database access.
+	RiskRequestJITAccess RiskSource = "bb.risk.request.jit-access"
 )
 
 // RiskMessage is the message for risks.
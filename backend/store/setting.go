@@ -171,6 +171,23 @@ func (s *Store) GetSemanticTypesSetting(ctx context.Context) (*storepb.SemanticT
 	return payload, nil
 }
 
+// GetMaskingKeySetting gets the masking key setting.
+func (s *Store) GetMaskingKeySetting(ctx context.Context) (*storepb.MaskingKeySetting, error) {
+	setting, err := s.GetSettingV2(ctx, api.SettingMaskingKeys)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get setting %v", api.SettingMaskingKeys)
+	}
+	if setting == nil {
+		return &storepb.MaskingKeySetting{}, nil
+	}
+
+	payload := new(storepb.MaskingKeySetting)
+	if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(setting.Value), payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
 // GetDataClassificationSetting gets the data classification setting.
 func (s *Store) GetDataClassificationSetting(ctx context.Context) (*storepb.DataClassificationSetting, error) {
 	setting, err := s.GetSettingV2(ctx, api.SettingDataClassification)
@@ -56,7 +56,91 @@ type slowQueryLogValue struct {
 	totalRowsExamined int64
 }
 
-func (*Store) listSlowQueryImpl(ctx context.Context, tx *Tx, list *ListSlowQueryMessage) ([]*v1pb.SlowQueryLog, error) {
+// ListDatabaseSlowQueryStatistics lists the slow query statistics of the fingerprints for each database, the samples are not included.
+// It returns the map from the database UID to the slow query logs of the database.
+func (s *Store) ListDatabaseSlowQueryStatistics(ctx context.Context, list *ListSlowQueryMessage) (map[int][]*v1pb.SlowQueryLog, error) {
+	where, args := getListSlowQueryWhere(list)
+	where = append(where, "database_id IS NOT NULL")
+	query := fmt.Sprintf(`
+		SELECT
+			database_id,
+			slow_query_statistics
+		FROM slow_query
+		WHERE (%s)
+	`, strings.Join(where, " AND "))
+
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	logMap := make(map[int]map[string]*slowQueryLogValue)
+	for rows.Next() {
+		var databaseUID int
+		var logBytes []byte
+		if err := rows.Scan(&databaseUID, &logBytes); err != nil {
+			return nil, err
+		}
+		var slowLog storepb.SlowQueryStatistics
+		if err := common.ProtojsonUnmarshaler.Unmarshal(logBytes, &slowLog); err != nil {
+			return nil, err
+		}
+		if _, ok := logMap[databaseUID]; !ok {
+			logMap[databaseUID] = make(map[string]*slowQueryLogValue)
+		}
+		for _, item := range slowLog.Items {
+			value, ok := logMap[databaseUID][item.SqlFingerprint]
+			if !ok {
+				value = &slowQueryLogValue{
+					log: &v1pb.SlowQueryLog{
+						Statistics: &v1pb.SlowQueryStatistics{
+							SqlFingerprint: item.SqlFingerprint,
+						},
+					},
+				}
+				logMap[databaseUID][item.SqlFingerprint] = value
+			}
+			statistics := value.log.Statistics
+			statistics.Count += item.Count
+			value.totalQueryTime += item.TotalQueryTime.AsDuration()
+			value.totalRowsSent += item.TotalRowsSent
+			value.totalRowsExamined += item.TotalRowsExamined
+			if statistics.MaximumQueryTime.AsDuration() < item.MaximumQueryTime.AsDuration() {
+				statistics.MaximumQueryTime = item.MaximumQueryTime
+			}
+			if statistics.LatestLogTime.AsTime().Before(item.LatestLogTime.AsTime()) {
+				statistics.LatestLogTime = item.LatestLogTime
+			}
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrapf(err, "failed to commit transaction")
+	}
+
+	result := make(map[int][]*v1pb.SlowQueryLog)
+	for databaseUID, values := range logMap {
+		for _, value := range values {
+			if value.log.Statistics.Count == 0 {
+				continue
+			}
+			result[databaseUID] = append(result[databaseUID], calculateStatistics(value))
+		}
+	}
+	return result, nil
+}
+
+func getListSlowQueryWhere(list *ListSlowQueryMessage) ([]string, []any) {
 	where, args := []string{"TRUE"}, []any{}
 	if v := list.InstanceUID; v != nil {
 		where, args = append(where, fmt.Sprintf("instance_id = $%d", len(args)+1)), append(args, *v)
@@ -70,6 +154,11 @@ func (*Store) listSlowQueryImpl(ctx context.Context, tx *Tx, list *ListSlowQuery
 	if v := list.EndLogDate; v != nil {
 		where, args = append(where, fmt.Sprintf("log_date_ts < $%d", len(args)+1)), append(args, v.Format("20060102"))
 	}
+	return where, args
+}
+
+func (*Store) listSlowQueryImpl(ctx context.Context, tx *Tx, list *ListSlowQueryMessage) ([]*v1pb.SlowQueryLog, error) {
+	where, args := getListSlowQueryWhere(list)
 
 	query := fmt.Sprintf(`
 		SELECT
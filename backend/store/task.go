@@ -12,6 +12,8 @@ import (
 
 	"github.com/jackc/pgtype"
 	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/bytebase/bytebase/backend/common"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
@@ -343,7 +345,7 @@ func (s *Store) UpdateTaskV2(ctx context.Context, patch *api.TaskPatch) (*TaskMe
 	if (patch.SchemaVersion != nil || patch.SheetID != nil) && patch.Payload != nil {
 		return nil, errors.Errorf("cannot set both sheetID/schemaVersion and payload for TaskPatch")
 	}
-	var payloadSet []string
+	var payloadSet, payloadRemove []string
 	if v := patch.SheetID; v != nil {
 		payloadSet, args = append(payloadSet, fmt.Sprintf(`jsonb_build_object('sheetId', $%d::INT)`, len(args)+1)), append(args, *v)
 	}
@@ -370,8 +372,40 @@ func (s *Store) UpdateTaskV2(ctx context.Context, patch *api.TaskPatch) (*TaskMe
 		}
 		payloadSet, args = append(payloadSet, fmt.Sprintf(`jsonb_build_object('flags', $%d::JSONB)`, len(args)+1)), append(args, jsonb)
 	}
-	if len(payloadSet) != 0 {
-		set = append(set, fmt.Sprintf(`payload = payload || %s`, strings.Join(payloadSet, "||")))
+	if v := patch.DMLBatchConfig; v != nil {
+		if proto.Size(v) == 0 {
+			payloadRemove = append(payloadRemove, "dmlBatchConfig")
+		} else {
+			jsonb, err := protojson.Marshal(v)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to marshal dmlBatchConfig")
+			}
+			payloadSet, args = append(payloadSet, fmt.Sprintf(`jsonb_build_object('dmlBatchConfig', $%d::JSONB)`, len(args)+1)), append(args, jsonb)
+		}
+	}
+	if v := patch.DMLBatchCheckpoint; v != nil {
+		if proto.Size(v) == 0 {
+			payloadRemove = append(payloadRemove, "dmlBatchCheckpoint")
+		} else {
+			jsonb, err := protojson.Marshal(v)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to marshal dmlBatchCheckpoint")
+			}
+			payloadSet, args = append(payloadSet, fmt.Sprintf(`jsonb_build_object('dmlBatchCheckpoint', $%d::JSONB)`, len(args)+1)), append(args, jsonb)
+		}
+	} else if patch.SheetID != nil {
+		// The checkpoint is bound to the statement, so it must not be used to resume a new sheet.
+		payloadRemove = append(payloadRemove, "dmlBatchCheckpoint")
+	}
+	if len(payloadSet) != 0 || len(payloadRemove) != 0 {
+		payloadExpr := "payload"
+		for _, key := range payloadRemove {
+			payloadExpr = fmt.Sprintf("(%s - '%s')", payloadExpr, key)
+		}
+		if len(payloadSet) != 0 {
+			payloadExpr = fmt.Sprintf("%s || %s", payloadExpr, strings.Join(payloadSet, "||"))
+		}
+		set = append(set, fmt.Sprintf(`payload = %s`, payloadExpr))
 	}
 	if v := patch.Payload; v != nil {
 		payload := "{}"
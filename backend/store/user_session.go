@@ -0,0 +1,242 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// UserSessionMessage is the message for the signed in sessions of the users.
+type UserSessionMessage struct {
+	PrincipalUID int
+	// SessionID is the ID carried by the access token of the session.
+	SessionID  string
+	ExpireTime time.Time
+	Payload    *storepb.UserSessionPayload
+
+	// output only
+	UID            int64
+	CreatedTime    time.Time
+	LastActiveTime time.Time
+	RevokeTime     *time.Time
+}
+
+// FindUserSessionMessage is the message for finding user sessions.
+type FindUserSessionMessage struct {
+	UID          *int64
+	PrincipalUID *int
+	SessionID    *string
+	// ShowInactive shows the revoked and expired sessions as well.
+	ShowInactive bool
+}
+
+// UpdateUserSessionMessage is the message for updating a user session.
+type UpdateUserSessionMessage struct {
+	UID int64
+
+	LastActiveTime *time.Time
+	RevokeTime     *time.Time
+}
+
+// CreateUserSession creates a user session.
+func (s *Store) CreateUserSession(ctx context.Context, create *UserSessionMessage) (*UserSessionMessage, error) {
+	query := `
+		INSERT INTO user_session (
+			principal_id,
+			session_id,
+			expire_ts,
+			payload
+		) VALUES (
+			$1,
+			$2,
+			$3,
+			$4
+		) RETURNING id, created_ts, last_active_ts
+	`
+
+	p, err := protojson.Marshal(create.Payload)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to marshal user session payload")
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to begin tx")
+	}
+	defer tx.Rollback()
+
+	if err := tx.QueryRowContext(ctx, query,
+		create.PrincipalUID,
+		create.SessionID,
+		create.ExpireTime,
+		p,
+	).Scan(&create.UID, &create.CreatedTime, &create.LastActiveTime); err != nil {
+		return nil, errors.Wrapf(err, "failed to insert user session")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrapf(err, "failed to commit tx")
+	}
+	return create, nil
+}
+
+// GetUserSession gets a user session.
+func (s *Store) GetUserSession(ctx context.Context, find *FindUserSessionMessage) (*UserSessionMessage, error) {
+	sessions, err := s.ListUserSessions(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(sessions) == 0 {
+		return nil, nil
+	}
+	if len(sessions) > 1 {
+		return nil, errors.Errorf("found %d user sessions, expect 1", len(sessions))
+	}
+	return sessions[0], nil
+}
+
+// ListUserSessions lists the user sessions ordered by the last active time descending.
+func (s *Store) ListUserSessions(ctx context.Context, find *FindUserSessionMessage) ([]*UserSessionMessage, error) {
+	where, args := []string{"TRUE"}, []any{}
+	if v := find.UID; v != nil {
+		where, args = append(where, fmt.Sprintf("id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.PrincipalUID; v != nil {
+		where, args = append(where, fmt.Sprintf("principal_id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.SessionID; v != nil {
+		where, args = append(where, fmt.Sprintf("session_id = $%d", len(args)+1)), append(args, *v)
+	}
+	if !find.ShowInactive {
+		where = append(where, "revoke_ts IS NULL", "expire_ts > now()")
+	}
+
+	query := fmt.Sprintf(`
+		SELECT
+			id,
+			principal_id,
+			session_id,
+			created_ts,
+			expire_ts,
+			last_active_ts,
+			revoke_ts,
+			payload
+		FROM user_session
+		WHERE %s
+		ORDER BY last_active_ts DESC, id DESC
+	`, strings.Join(where, " AND "))
+
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to begin tx")
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to query rows")
+	}
+	defer rows.Close()
+
+	var sessions []*UserSessionMessage
+	for rows.Next() {
+		session := &UserSessionMessage{
+			Payload: &storepb.UserSessionPayload{},
+		}
+		var revokeTime sql.NullTime
+		var payload []byte
+		if err := rows.Scan(
+			&session.UID,
+			&session.PrincipalUID,
+			&session.SessionID,
+			&session.CreatedTime,
+			&session.ExpireTime,
+			&session.LastActiveTime,
+			&revokeTime,
+			&payload,
+		); err != nil {
+			return nil, errors.Wrapf(err, "failed to scan rows")
+		}
+		if revokeTime.Valid {
+			session.RevokeTime = &revokeTime.Time
+		}
+		if err := common.ProtojsonUnmarshaler.Unmarshal(payload, session.Payload); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal payload")
+		}
+		sessions = append(sessions, session)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrapf(err, "rows err")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrapf(err, "failed to commit tx")
+	}
+	return sessions, nil
+}
+
+// UpdateUserSession updates a user session.
+func (s *Store) UpdateUserSession(ctx context.Context, update *UpdateUserSessionMessage) (*UserSessionMessage, error) {
+	set, args := []string{}, []any{}
+	if v := update.LastActiveTime; v != nil {
+		set, args = append(set, fmt.Sprintf("last_active_ts = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := update.RevokeTime; v != nil {
+		set, args = append(set, fmt.Sprintf("revoke_ts = $%d", len(args)+1)), append(args, *v)
+	}
+	if len(set) == 0 {
+		return nil, errors.New("no update field provided")
+	}
+	args = append(args, update.UID)
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf(`UPDATE user_session SET %s WHERE id = $%d`, strings.Join(set, ", "), len(args)), args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to update user session")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrapf(err, "failed to commit tx")
+	}
+
+	return s.GetUserSession(ctx, &FindUserSessionMessage{UID: &update.UID, ShowInactive: true})
+}
+
+// RevokeUserCredentials revokes all active sessions and access tokens of the user.
+func (s *Store) RevokeUserCredentials(ctx context.Context, principalUID int) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `UPDATE user_session SET revoke_ts = now() WHERE principal_id = $1 AND revoke_ts IS NULL`, principalUID); err != nil {
+		return errors.Wrapf(err, "failed to revoke user sessions")
+	}
+	if _, err := tx.ExecContext(ctx, `UPDATE access_token SET revoke_ts = now() WHERE principal_id = $1 AND revoke_ts IS NULL`, principalUID); err != nil {
+		return errors.Wrapf(err, "failed to revoke access tokens")
+	}
+
+	return tx.Commit()
+}
+
+// DeleteInactiveUserSessions deletes the revoked and expired sessions of the user.
+// The access tokens of the deleted sessions are rejected as the sessions are not found.
+func (s *Store) DeleteInactiveUserSessions(ctx context.Context, principalUID int) error {
+	if _, err := s.db.db.ExecContext(ctx, `DELETE FROM user_session WHERE principal_id = $1 AND (revoke_ts IS NOT NULL OR expire_ts <= now())`, principalUID); err != nil {
+		return errors.Wrapf(err, "failed to delete inactive user sessions")
+	}
+	return nil
+}
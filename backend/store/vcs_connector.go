@@ -49,6 +49,7 @@ type UpdateVCSConnectorMessage struct {
 	Branch        *string
 	BaseDirectory *string
 	DatabaseGroup *string
+	WriteBack     *storepb.VCSConnector_WriteBack
 }
 
 // GetVCSConnector gets a VCS connector.
@@ -212,6 +213,13 @@ func (s *Store) UpdateVCSConnector(ctx context.Context, update *UpdateVCSConnect
 	if v := update.DatabaseGroup; v != nil {
 		payloadSet, args = append(payloadSet, fmt.Sprintf("jsonb_build_object('databaseGroup', $%d::TEXT)", len(args)+1)), append(args, *v)
 	}
+	if v := update.WriteBack; v != nil {
+		writeBack, err := protojson.Marshal(v)
+		if err != nil {
+			return errors.Wrapf(err, "failed to marshal write back")
+		}
+		payloadSet, args = append(payloadSet, fmt.Sprintf("jsonb_build_object('writeBack', $%d::JSONB)", len(args)+1)), append(args, writeBack)
+	}
 	if len(payloadSet) != 0 {
 		set = append(set, fmt.Sprintf(`payload = payload || %s`, strings.Join(payloadSet, "||")))
 	}
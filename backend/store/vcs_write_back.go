@@ -0,0 +1,276 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// VCSWriteBackStatus is the status of a write back.
+type VCSWriteBackStatus string
+
+const (
+	// VCSWriteBackPending is the status of the write backs waiting for the next attempt.
+	VCSWriteBackPending VCSWriteBackStatus = "PENDING"
+	// VCSWriteBackSucceeded is the status of the succeeded write backs.
+	VCSWriteBackSucceeded VCSWriteBackStatus = "SUCCEEDED"
+	// VCSWriteBackFailed is the status of the write backs failed after all attempts.
+	VCSWriteBackFailed VCSWriteBackStatus = "FAILED"
+)
+
+// VCSWriteBackMessage is the message for writing the schema of a stage back to the repository of a VCS connector.
+type VCSWriteBackMessage struct {
+	IssueUID        int
+	StageUID        int
+	VCSConnectorUID int
+	Status          VCSWriteBackStatus
+	AttemptCount    int
+	NextAttemptTime time.Time
+	// Error is the error of the last failed attempt.
+	Error string
+
+	// output only
+	UID         int64
+	CreatedTime time.Time
+	UpdatedTime time.Time
+}
+
+// FindVCSWriteBackMessage is the message for finding write backs.
+type FindVCSWriteBackMessage struct {
+	UID      *int64
+	IssueUID *int
+	StageUID *int
+}
+
+// UpdateVCSWriteBackMessage is the message for updating a write back.
+type UpdateVCSWriteBackMessage struct {
+	UID int64
+
+	Status          *VCSWriteBackStatus
+	AttemptCount    *int
+	NextAttemptTime *time.Time
+	Error           *string
+}
+
+// UpsertVCSWriteBacks creates the pending write backs.
+// The write back of the same stage and VCS connector is reset to be attempted immediately with a fresh attempt budget.
+func (s *Store) UpsertVCSWriteBacks(ctx context.Context, creates []*VCSWriteBackMessage) error {
+	if len(creates) == 0 {
+		return nil
+	}
+
+	var values []string
+	var args []any
+	for _, create := range creates {
+		values = append(values, fmt.Sprintf("($%d, $%d, $%d, $%d)", len(args)+1, len(args)+2, len(args)+3, len(args)+4))
+		args = append(args, create.IssueUID, create.StageUID, create.VCSConnectorUID, VCSWriteBackPending)
+	}
+	query := fmt.Sprintf(`
+		INSERT INTO vcs_write_back (
+			issue_id,
+			stage_id,
+			vcs_connector_id,
+			status
+		) VALUES %s
+		ON CONFLICT (stage_id, vcs_connector_id) DO UPDATE SET
+			status = EXCLUDED.status,
+			attempt_count = 0,
+			next_attempt_ts = now(),
+			error = '',
+			updated_ts = now()
+	`, strings.Join(values, ", "))
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrapf(err, "failed to begin tx")
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return errors.Wrapf(err, "failed to upsert vcs write backs")
+	}
+
+	return tx.Commit()
+}
+
+// GetVCSWriteBack gets a write back.
+func (s *Store) GetVCSWriteBack(ctx context.Context, find *FindVCSWriteBackMessage) (*VCSWriteBackMessage, error) {
+	writeBacks, err := s.ListVCSWriteBacks(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(writeBacks) == 0 {
+		return nil, nil
+	}
+	if len(writeBacks) > 1 {
+		return nil, errors.Errorf("found %d vcs write backs, expect 1", len(writeBacks))
+	}
+	return writeBacks[0], nil
+}
+
+// ListVCSWriteBacks lists the write backs ordered by the id.
+func (s *Store) ListVCSWriteBacks(ctx context.Context, find *FindVCSWriteBackMessage) ([]*VCSWriteBackMessage, error) {
+	where, args := []string{"TRUE"}, []any{}
+	if v := find.UID; v != nil {
+		where, args = append(where, fmt.Sprintf("id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.IssueUID; v != nil {
+		where, args = append(where, fmt.Sprintf("issue_id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.StageUID; v != nil {
+		where, args = append(where, fmt.Sprintf("stage_id = $%d", len(args)+1)), append(args, *v)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT
+			id,
+			issue_id,
+			stage_id,
+			vcs_connector_id,
+			created_ts,
+			updated_ts,
+			status,
+			attempt_count,
+			next_attempt_ts,
+			error
+		FROM vcs_write_back
+		WHERE %s
+		ORDER BY id
+	`, strings.Join(where, " AND "))
+
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to begin tx")
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to query rows")
+	}
+	defer rows.Close()
+
+	writeBacks, err := scanVCSWriteBacks(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrapf(err, "failed to commit tx")
+	}
+	return writeBacks, nil
+}
+
+// ClaimVCSWriteBacks claims at most limit pending write backs whose next attempt is due.
+// The next attempt time of the claimed write backs is postponed to leaseUntil, so that they are
+// not claimed again before the attempt finishes, and are retried if the attempt never finishes.
+func (s *Store) ClaimVCSWriteBacks(ctx context.Context, limit int, leaseUntil time.Time) ([]*VCSWriteBackMessage, error) {
+	query := `
+		UPDATE vcs_write_back
+		SET next_attempt_ts = $1, updated_ts = now()
+		WHERE id IN (
+			SELECT id FROM vcs_write_back
+			WHERE status = $2 AND next_attempt_ts <= now()
+			ORDER BY next_attempt_ts
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING
+			id,
+			issue_id,
+			stage_id,
+			vcs_connector_id,
+			created_ts,
+			updated_ts,
+			status,
+			attempt_count,
+			next_attempt_ts,
+			error
+	`
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to begin tx")
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, query, leaseUntil, VCSWriteBackPending, limit)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to claim vcs write backs")
+	}
+	defer rows.Close()
+
+	writeBacks, err := scanVCSWriteBacks(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrapf(err, "failed to commit tx")
+	}
+	return writeBacks, nil
+}
+
+// UpdateVCSWriteBack updates a write back.
+func (s *Store) UpdateVCSWriteBack(ctx context.Context, update *UpdateVCSWriteBackMessage) (*VCSWriteBackMessage, error) {
+	set, args := []string{"updated_ts = now()"}, []any{}
+	if v := update.Status; v != nil {
+		set, args = append(set, fmt.Sprintf("status = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := update.AttemptCount; v != nil {
+		set, args = append(set, fmt.Sprintf("attempt_count = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := update.NextAttemptTime; v != nil {
+		set, args = append(set, fmt.Sprintf("next_attempt_ts = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := update.Error; v != nil {
+		set, args = append(set, fmt.Sprintf("error = $%d", len(args)+1)), append(args, *v)
+	}
+	args = append(args, update.UID)
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to begin tx")
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf(`UPDATE vcs_write_back SET %s WHERE id = $%d`, strings.Join(set, ", "), len(args)), args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to update vcs write back")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrapf(err, "failed to commit tx")
+	}
+
+	return s.GetVCSWriteBack(ctx, &FindVCSWriteBackMessage{UID: &update.UID})
+}
+
+func scanVCSWriteBacks(rows *sql.Rows) ([]*VCSWriteBackMessage, error) {
+	var writeBacks []*VCSWriteBackMessage
+	for rows.Next() {
+		writeBack := &VCSWriteBackMessage{}
+		if err := rows.Scan(
+			&writeBack.UID,
+			&writeBack.IssueUID,
+			&writeBack.StageUID,
+			&writeBack.VCSConnectorUID,
+			&writeBack.CreatedTime,
+			&writeBack.UpdatedTime,
+			&writeBack.Status,
+			&writeBack.AttemptCount,
+			&writeBack.NextAttemptTime,
+			&writeBack.Error,
+		); err != nil {
+			return nil, errors.Wrapf(err, "failed to scan rows")
+		}
+		writeBacks = append(writeBacks, writeBack)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrapf(err, "rows err")
+	}
+	return writeBacks, nil
+}
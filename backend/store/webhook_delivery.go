@@ -0,0 +1,355 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// WebhookDeliveryStatus is the status of a webhook delivery.
+type WebhookDeliveryStatus string
+
+const (
+	// WebhookDeliveryPending is the status of the deliveries waiting for the next attempt.
+	WebhookDeliveryPending WebhookDeliveryStatus = "PENDING"
+	// WebhookDeliverySucceeded is the status of the succeeded deliveries.
+	WebhookDeliverySucceeded WebhookDeliveryStatus = "SUCCEEDED"
+	// WebhookDeliveryDead is the status of the deliveries failed after all attempts.
+	WebhookDeliveryDead WebhookDeliveryStatus = "DEAD"
+)
+
+// WebhookDeliveryMessage is the message for the deliveries of the project webhooks.
+type WebhookDeliveryMessage struct {
+	ProjectWebhookUID int
+	Status            WebhookDeliveryStatus
+	AttemptCount      int
+	NextAttemptTime   time.Time
+	// DedupKey de-duplicates the deliveries of the same event.
+	DedupKey string
+	Payload  *storepb.WebhookDeliveryPayload
+
+	// output only
+	UID         int64
+	CreatedTime time.Time
+	UpdatedTime time.Time
+}
+
+// FindWebhookDeliveryMessage is the message for finding webhook deliveries.
+type FindWebhookDeliveryMessage struct {
+	UID               *int64
+	ProjectWebhookUID *int
+	DedupKey          *string
+	CreatedAfter      *time.Time
+
+	Limit  *int
+	Offset *int
+}
+
+// UpdateWebhookDeliveryMessage is the message for updating a webhook delivery.
+type UpdateWebhookDeliveryMessage struct {
+	UID int64
+
+	Status          *WebhookDeliveryStatus
+	AttemptCount    *int
+	NextAttemptTime *time.Time
+	Payload         *storepb.WebhookDeliveryPayload
+	// ReleaseLease releases the lease of the claimed delivery.
+	ReleaseLease bool
+}
+
+// CreateWebhookDeliveries creates the webhook deliveries.
+func (s *Store) CreateWebhookDeliveries(ctx context.Context, creates []*WebhookDeliveryMessage) error {
+	if len(creates) == 0 {
+		return nil
+	}
+
+	var values []string
+	var args []any
+	for _, create := range creates {
+		p, err := protojson.Marshal(create.Payload)
+		if err != nil {
+			return errors.Wrapf(err, "failed to marshal webhook delivery payload")
+		}
+		values = append(values, fmt.Sprintf("($%d, $%d, $%d, $%d, $%d)", len(args)+1, len(args)+2, len(args)+3, len(args)+4, len(args)+5))
+		args = append(args, create.ProjectWebhookUID, create.Status, create.NextAttemptTime, create.DedupKey, p)
+	}
+	query := fmt.Sprintf(`
+		INSERT INTO webhook_delivery (
+			project_webhook_id,
+			status,
+			next_attempt_ts,
+			dedup_key,
+			payload
+		) VALUES %s
+	`, strings.Join(values, ", "))
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrapf(err, "failed to begin tx")
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return errors.Wrapf(err, "failed to insert webhook deliveries")
+	}
+
+	return tx.Commit()
+}
+
+// GetWebhookDelivery gets a webhook delivery.
+func (s *Store) GetWebhookDelivery(ctx context.Context, find *FindWebhookDeliveryMessage) (*WebhookDeliveryMessage, error) {
+	deliveries, err := s.ListWebhookDeliveries(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(deliveries) == 0 {
+		return nil, nil
+	}
+	if len(deliveries) > 1 {
+		return nil, errors.Errorf("found %d webhook deliveries, expect 1", len(deliveries))
+	}
+	return deliveries[0], nil
+}
+
+// ListWebhookDeliveries lists the webhook deliveries ordered by the create time descending.
+func (s *Store) ListWebhookDeliveries(ctx context.Context, find *FindWebhookDeliveryMessage) ([]*WebhookDeliveryMessage, error) {
+	where, args := []string{"TRUE"}, []any{}
+	if v := find.UID; v != nil {
+		where, args = append(where, fmt.Sprintf("id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.ProjectWebhookUID; v != nil {
+		where, args = append(where, fmt.Sprintf("project_webhook_id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.DedupKey; v != nil {
+		where, args = append(where, fmt.Sprintf("dedup_key = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.CreatedAfter; v != nil {
+		where, args = append(where, fmt.Sprintf("created_ts > $%d", len(args)+1)), append(args, *v)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT
+			id,
+			project_webhook_id,
+			created_ts,
+			updated_ts,
+			status,
+			attempt_count,
+			next_attempt_ts,
+			dedup_key,
+			payload
+		FROM webhook_delivery
+		WHERE %s
+		ORDER BY id DESC
+	`, strings.Join(where, " AND "))
+	if v := find.Limit; v != nil {
+		query += fmt.Sprintf(" LIMIT %d", *v)
+	}
+	if v := find.Offset; v != nil {
+		query += fmt.Sprintf(" OFFSET %d", *v)
+	}
+
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to begin tx")
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to query rows")
+	}
+	defer rows.Close()
+
+	deliveries, err := scanWebhookDeliveries(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrapf(err, "failed to commit tx")
+	}
+	return deliveries, nil
+}
+
+// ClaimWebhookDeliveries claims at most limit pending deliveries whose next attempt is due.
+// The next attempt time of the claimed deliveries is postponed to leaseUntil, so that they are
+// not claimed again before the attempt finishes, and are retried if the attempt never finishes.
+func (s *Store) ClaimWebhookDeliveries(ctx context.Context, limit int, leaseUntil time.Time) ([]*WebhookDeliveryMessage, error) {
+	query := `
+		UPDATE webhook_delivery
+		SET next_attempt_ts = $1, lease_expire_ts = $1, updated_ts = now()
+		WHERE id IN (
+			SELECT id FROM webhook_delivery
+			WHERE status = $2 AND next_attempt_ts <= now()
+			ORDER BY next_attempt_ts
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING
+			id,
+			project_webhook_id,
+			created_ts,
+			updated_ts,
+			status,
+			attempt_count,
+			next_attempt_ts,
+			dedup_key,
+			payload
+	`
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to begin tx")
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, query, leaseUntil, WebhookDeliveryPending, limit)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to claim webhook deliveries")
+	}
+	defer rows.Close()
+
+	deliveries, err := scanWebhookDeliveries(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrapf(err, "failed to commit tx")
+	}
+	return deliveries, nil
+}
+
+// UpdateWebhookDelivery updates a webhook delivery.
+func (s *Store) UpdateWebhookDelivery(ctx context.Context, update *UpdateWebhookDeliveryMessage) (*WebhookDeliveryMessage, error) {
+	set, args := []string{"updated_ts = now()"}, []any{}
+	if v := update.Status; v != nil {
+		set, args = append(set, fmt.Sprintf("status = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := update.AttemptCount; v != nil {
+		set, args = append(set, fmt.Sprintf("attempt_count = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := update.NextAttemptTime; v != nil {
+		set, args = append(set, fmt.Sprintf("next_attempt_ts = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := update.Payload; v != nil {
+		p, err := protojson.Marshal(v)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to marshal webhook delivery payload")
+		}
+		set, args = append(set, fmt.Sprintf("payload = $%d", len(args)+1)), append(args, p)
+	}
+	if update.ReleaseLease {
+		set = append(set, "lease_expire_ts = NULL")
+	}
+	args = append(args, update.UID)
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to begin tx")
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf(`UPDATE webhook_delivery SET %s WHERE id = $%d`, strings.Join(set, ", "), len(args)), args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to update webhook delivery")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrapf(err, "failed to commit tx")
+	}
+
+	return s.GetWebhookDelivery(ctx, &FindWebhookDeliveryMessage{UID: &update.UID})
+}
+
+// ExtendWebhookDeliveryLease extends the lease of the claimed delivery to leaseUntil.
+func (s *Store) ExtendWebhookDeliveryLease(ctx context.Context, uid int64, leaseUntil time.Time) error {
+	if _, err := s.db.db.ExecContext(ctx, `
+		UPDATE webhook_delivery
+		SET next_attempt_ts = $1, lease_expire_ts = $1, updated_ts = now()
+		WHERE id = $2 AND status = $3 AND lease_expire_ts IS NOT NULL
+	`, leaseUntil, uid, WebhookDeliveryPending); err != nil {
+		return errors.Wrapf(err, "failed to extend webhook delivery lease")
+	}
+	return nil
+}
+
+// RedeliverWebhookDelivery resets the delivery to be posted immediately with a fresh attempt budget.
+// It returns false if the delivery is being posted.
+func (s *Store) RedeliverWebhookDelivery(ctx context.Context, uid int64) (*WebhookDeliveryMessage, bool, error) {
+	result, err := s.db.db.ExecContext(ctx, `
+		UPDATE webhook_delivery
+		SET status = $1, attempt_count = 0, next_attempt_ts = now(), updated_ts = now()
+		WHERE id = $2 AND (lease_expire_ts IS NULL OR lease_expire_ts <= now())
+	`, WebhookDeliveryPending, uid)
+	if err != nil {
+		return nil, false, errors.Wrapf(err, "failed to redeliver webhook delivery")
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, false, errors.Wrapf(err, "failed to get rows affected")
+	}
+	if rowsAffected == 0 {
+		return nil, false, nil
+	}
+	delivery, err := s.GetWebhookDelivery(ctx, &FindWebhookDeliveryMessage{UID: &uid})
+	if err != nil {
+		return nil, false, err
+	}
+	return delivery, true, nil
+}
+
+// DeleteFinishedWebhookDeliveries deletes the succeeded and dead deliveries last updated before the given time.
+func (s *Store) DeleteFinishedWebhookDeliveries(ctx context.Context, before time.Time) (int64, error) {
+	result, err := s.db.db.ExecContext(ctx, `
+		DELETE FROM webhook_delivery
+		WHERE status <> $1 AND updated_ts < $2
+	`, WebhookDeliveryPending, before)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to delete webhook deliveries")
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to get rows affected")
+	}
+	return rowsAffected, nil
+}
+
+func scanWebhookDeliveries(rows *sql.Rows) ([]*WebhookDeliveryMessage, error) {
+	var deliveries []*WebhookDeliveryMessage
+	for rows.Next() {
+		delivery := &WebhookDeliveryMessage{
+			Payload: &storepb.WebhookDeliveryPayload{},
+		}
+		var payload []byte
+		if err := rows.Scan(
+			&delivery.UID,
+			&delivery.ProjectWebhookUID,
+			&delivery.CreatedTime,
+			&delivery.UpdatedTime,
+			&delivery.Status,
+			&delivery.AttemptCount,
+			&delivery.NextAttemptTime,
+			&delivery.DedupKey,
+			&payload,
+		); err != nil {
+			return nil, errors.Wrapf(err, "failed to scan rows")
+		}
+		if err := common.ProtojsonUnmarshaler.Unmarshal(payload, delivery.Payload); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal payload")
+		}
+		deliveries = append(deliveries, delivery)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrapf(err, "rows err")
+	}
+	return deliveries, nil
+}
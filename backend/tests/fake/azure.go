@@ -65,6 +65,7 @@ func NewAzure(port int) VCSProvider {
 	repo.GET("/pullRequests/:pr/threads", az.listIssueComments)
 	repo.POST("/pullRequests/:pr/threads", az.createIssueComment)
 	repo.PATCH("/pullRequests/:pr/threads/:thread", az.updateIssueComment)
+	repo.POST("/pullRequests/:pr/statuses", az.createPullRequestStatus)
 	repo.GET("/commits/:commit/changes", az.getCommitChanges)
 	repo.GET("/items", az.readRepositoryFile)
 
@@ -235,6 +236,10 @@ func (*Azure) createIssueComment(echo.Context) error {
 	return nil
 }
 
+func (*Azure) createPullRequestStatus(c echo.Context) error {
+	return c.String(http.StatusOK, "{}")
+}
+
 func (*Azure) updateIssueComment(echo.Context) error {
 	return nil
 }
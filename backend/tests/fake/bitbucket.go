@@ -67,6 +67,7 @@ func NewBitbucket(port int) VCSProvider {
 	g.GET("/repositories/:owner/:repo/pullrequests/:prID/comments", bb.listPullRequestComment)
 	g.POST("/repositories/:owner/:repo/pullrequests/:prID/comments", bb.createPullRequestComment)
 	g.PUT("/repositories/:owner/:repo/pullrequests/:prID/comments/:comment", bb.updatePullRequestComment)
+	g.POST("/repositories/:owner/:repo/commit/:commitID/statuses/build", bb.createBuildStatus)
 	return bb
 }
 
@@ -348,6 +349,10 @@ func (*Bitbucket) createPullRequestComment(echo.Context) error {
 	return nil
 }
 
+func (*Bitbucket) createBuildStatus(c echo.Context) error {
+	return c.String(http.StatusCreated, "{}")
+}
+
 func (*Bitbucket) updatePullRequestComment(echo.Context) error {
 	return nil
 }
@@ -71,6 +71,8 @@ func NewGitHub(port int) VCSProvider {
 	g.GET("/repos/:owner/:repo/issues/:prID/comments", gh.listIssueComment)
 	g.POST("/repos/:owner/:repo/issues/:prID/comments", gh.createIssueComment)
 	g.PATCH("/repos/:owner/:repo/issues/:prID/comments", gh.updateIssueComment)
+	g.GET("/repos/:owner/:repo/commits/:commitID/check-runs", gh.listCheckRun)
+	g.POST("/repos/:owner/:repo/check-runs", gh.createCheckRun)
 	return gh
 }
 
@@ -218,6 +220,14 @@ func (*GitHub) updateIssueComment(echo.Context) error {
 	return nil
 }
 
+func (*GitHub) listCheckRun(c echo.Context) error {
+	return c.String(http.StatusOK, `{"total_count":0,"check_runs":[]}`)
+}
+
+func (*GitHub) createCheckRun(c echo.Context) error {
+	return c.String(http.StatusCreated, `{"id":1}`)
+}
+
 func (*GitHub) listIssueComment(c echo.Context) error {
 	comments := []github.Comment{}
 	buf, err := json.Marshal(comments)
@@ -72,6 +72,7 @@ func NewGitLab(port int) VCSProvider {
 	projectGroup.GET("/projects/:id/merge_requests/:mrID/notes", gl.listMergeRequestComment)
 	projectGroup.POST("/projects/:id/merge_requests/:mrID/notes", gl.createMergeRequestComment)
 	projectGroup.PUT("/projects/:id/merge_requests/:mrID/notes/:note", gl.updateMergeRequestComment)
+	projectGroup.POST("/projects/:id/statuses/:commitID", gl.createCommitStatus)
 
 	return gl
 }
@@ -355,6 +356,10 @@ func (*GitLab) createMergeRequestComment(echo.Context) error {
 	return nil
 }
 
+func (*GitLab) createCommitStatus(c echo.Context) error {
+	return c.String(http.StatusCreated, "{}")
+}
+
 func (*GitLab) updateMergeRequestComment(echo.Context) error {
 	return nil
 }
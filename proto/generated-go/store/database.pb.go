@@ -292,6 +292,56 @@ func (GenerationMetadata_Type) EnumDescriptor() ([]byte, []int) {
 	return file_store_database_proto_rawDescGZIP(), []int{15, 0}
 }
 
+type ClassificationProposal_State int32
+
+const (
+	ClassificationProposal_STATE_UNSPECIFIED ClassificationProposal_State = 0
+	ClassificationProposal_PENDING           ClassificationProposal_State = 1
+	// The rejected proposal is kept so that the discovery won't propose the same classification again.
+	ClassificationProposal_REJECTED ClassificationProposal_State = 2
+)
+
+// Enum value maps for ClassificationProposal_State.
+var (
+	ClassificationProposal_State_name = map[int32]string{
+		0: "STATE_UNSPECIFIED",
+		1: "PENDING",
+		2: "REJECTED",
+	}
+	ClassificationProposal_State_value = map[string]int32{
+		"STATE_UNSPECIFIED": 0,
+		"PENDING":           1,
+		"REJECTED":          2,
+	}
+)
+
+func (x ClassificationProposal_State) Enum() *ClassificationProposal_State {
+	p := new(ClassificationProposal_State)
+	*p = x
+	return p
+}
+
+func (x ClassificationProposal_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ClassificationProposal_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_database_proto_enumTypes[5].Descriptor()
+}
+
+func (ClassificationProposal_State) Type() protoreflect.EnumType {
+	return &file_store_database_proto_enumTypes[5]
+}
+
+func (x ClassificationProposal_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ClassificationProposal_State.Descriptor instead.
+func (ClassificationProposal_State) EnumDescriptor() ([]byte, []int) {
+	return file_store_database_proto_rawDescGZIP(), []int{33, 0}
+}
+
 type ObjectSchema_Type int32
 
 const (
@@ -334,11 +384,11 @@ func (x ObjectSchema_Type) String() string {
 }
 
 func (ObjectSchema_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_store_database_proto_enumTypes[5].Descriptor()
+	return file_store_database_proto_enumTypes[6].Descriptor()
 }
 
 func (ObjectSchema_Type) Type() protoreflect.EnumType {
-	return &file_store_database_proto_enumTypes[5]
+	return &file_store_database_proto_enumTypes[6]
 }
 
 func (x ObjectSchema_Type) Number() protoreflect.EnumNumber {
@@ -347,7 +397,7 @@ func (x ObjectSchema_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ObjectSchema_Type.Descriptor instead.
 func (ObjectSchema_Type) EnumDescriptor() ([]byte, []int) {
-	return file_store_database_proto_rawDescGZIP(), []int{33, 0}
+	return file_store_database_proto_rawDescGZIP(), []int{34, 0}
 }
 
 // DatabaseMetadata is the metadata for databases.
@@ -1729,11 +1779,10 @@ type TablePartitionMetadata struct {
 	// https://www.postgresql.org/docs/current/sql-createtable.html. For MySQL,
 	// the expression is the `expr` or `column_list` of the following syntax.
 	// PARTITION BY
-	//
-	//	{ [LINEAR] HASH(expr)
-	//	| [LINEAR] KEY [ALGORITHM={1 | 2}] (column_list)
-	//	| RANGE{(expr) | COLUMNS(column_list)}
-	//	| LIST{(expr) | COLUMNS(column_list)} }.
+	//    { [LINEAR] HASH(expr)
+	//    | [LINEAR] KEY [ALGORITHM={1 | 2}] (column_list)
+	//    | RANGE{(expr) | COLUMNS(column_list)}
+	//    | LIST{(expr) | COLUMNS(column_list)} }.
 	Expression string `protobuf:"bytes,3,opt,name=expression,proto3" json:"expression,omitempty"`
 	// The value is the value of a table partition.
 	// For MySQL, the value is for RANGE and LIST partition types,
@@ -3361,8 +3410,10 @@ type ColumnCatalog struct {
 	FullMaskingAlgorithmId string `protobuf:"bytes,7,opt,name=full_masking_algorithm_id,json=fullMaskingAlgorithmId,proto3" json:"full_masking_algorithm_id,omitempty"`
 	// Deprecated.
 	PartialMaskingAlgorithmId string `protobuf:"bytes,8,opt,name=partial_masking_algorithm_id,json=partialMaskingAlgorithmId,proto3" json:"partial_masking_algorithm_id,omitempty"`
-	unknownFields             protoimpl.UnknownFields
-	sizeCache                 protoimpl.SizeCache
+	// classification_proposal is proposed by the sensitive data discovery and waits for review.
+	ClassificationProposal *ClassificationProposal `protobuf:"bytes,9,opt,name=classification_proposal,json=classificationProposal,proto3" json:"classification_proposal,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
 func (x *ColumnCatalog) Reset() {
@@ -3451,6 +3502,108 @@ func (x *ColumnCatalog) GetPartialMaskingAlgorithmId() string {
 	return ""
 }
 
+func (x *ColumnCatalog) GetClassificationProposal() *ClassificationProposal {
+	if x != nil {
+		return x.ClassificationProposal
+	}
+	return nil
+}
+
+type ClassificationProposal struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Classification string                 `protobuf:"bytes,1,opt,name=classification,proto3" json:"classification,omitempty"`
+	SemanticType   string                 `protobuf:"bytes,2,opt,name=semantic_type,json=semanticType,proto3" json:"semantic_type,omitempty"`
+	// detector is the detector type matching the sampled values.
+	Detector string `protobuf:"bytes,3,opt,name=detector,proto3" json:"detector,omitempty"`
+	// match_ratio is the ratio of the sampled non-empty values matching the detector.
+	MatchRatio float64 `protobuf:"fixed64,4,opt,name=match_ratio,json=matchRatio,proto3" json:"match_ratio,omitempty"`
+	// sample_size is the count of the sampled non-empty values.
+	SampleSize    int32                        `protobuf:"varint,5,opt,name=sample_size,json=sampleSize,proto3" json:"sample_size,omitempty"`
+	ScanTime      *timestamppb.Timestamp       `protobuf:"bytes,6,opt,name=scan_time,json=scanTime,proto3" json:"scan_time,omitempty"`
+	State         ClassificationProposal_State `protobuf:"varint,7,opt,name=state,proto3,enum=bytebase.store.ClassificationProposal_State" json:"state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClassificationProposal) Reset() {
+	*x = ClassificationProposal{}
+	mi := &file_store_database_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClassificationProposal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClassificationProposal) ProtoMessage() {}
+
+func (x *ClassificationProposal) ProtoReflect() protoreflect.Message {
+	mi := &file_store_database_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClassificationProposal.ProtoReflect.Descriptor instead.
+func (*ClassificationProposal) Descriptor() ([]byte, []int) {
+	return file_store_database_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ClassificationProposal) GetClassification() string {
+	if x != nil {
+		return x.Classification
+	}
+	return ""
+}
+
+func (x *ClassificationProposal) GetSemanticType() string {
+	if x != nil {
+		return x.SemanticType
+	}
+	return ""
+}
+
+func (x *ClassificationProposal) GetDetector() string {
+	if x != nil {
+		return x.Detector
+	}
+	return ""
+}
+
+func (x *ClassificationProposal) GetMatchRatio() float64 {
+	if x != nil {
+		return x.MatchRatio
+	}
+	return 0
+}
+
+func (x *ClassificationProposal) GetSampleSize() int32 {
+	if x != nil {
+		return x.SampleSize
+	}
+	return 0
+}
+
+func (x *ClassificationProposal) GetScanTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScanTime
+	}
+	return nil
+}
+
+func (x *ClassificationProposal) GetState() ClassificationProposal_State {
+	if x != nil {
+		return x.State
+	}
+	return ClassificationProposal_STATE_UNSPECIFIED
+}
+
 type ObjectSchema struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	Type  ObjectSchema_Type      `protobuf:"varint,1,opt,name=type,proto3,enum=bytebase.store.ObjectSchema_Type" json:"type,omitempty"`
@@ -3466,7 +3619,7 @@ type ObjectSchema struct {
 
 func (x *ObjectSchema) Reset() {
 	*x = ObjectSchema{}
-	mi := &file_store_database_proto_msgTypes[33]
+	mi := &file_store_database_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3478,7 +3631,7 @@ func (x *ObjectSchema) String() string {
 func (*ObjectSchema) ProtoMessage() {}
 
 func (x *ObjectSchema) ProtoReflect() protoreflect.Message {
-	mi := &file_store_database_proto_msgTypes[33]
+	mi := &file_store_database_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3491,7 +3644,7 @@ func (x *ObjectSchema) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ObjectSchema.ProtoReflect.Descriptor instead.
 func (*ObjectSchema) Descriptor() ([]byte, []int) {
-	return file_store_database_proto_rawDescGZIP(), []int{33}
+	return file_store_database_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *ObjectSchema) GetType() ObjectSchema_Type {
@@ -3558,7 +3711,7 @@ type ObjectSchema_StructKind struct {
 
 func (x *ObjectSchema_StructKind) Reset() {
 	*x = ObjectSchema_StructKind{}
-	mi := &file_store_database_proto_msgTypes[36]
+	mi := &file_store_database_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3570,7 +3723,7 @@ func (x *ObjectSchema_StructKind) String() string {
 func (*ObjectSchema_StructKind) ProtoMessage() {}
 
 func (x *ObjectSchema_StructKind) ProtoReflect() protoreflect.Message {
-	mi := &file_store_database_proto_msgTypes[36]
+	mi := &file_store_database_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3583,7 +3736,7 @@ func (x *ObjectSchema_StructKind) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ObjectSchema_StructKind.ProtoReflect.Descriptor instead.
 func (*ObjectSchema_StructKind) Descriptor() ([]byte, []int) {
-	return file_store_database_proto_rawDescGZIP(), []int{33, 0}
+	return file_store_database_proto_rawDescGZIP(), []int{34, 0}
 }
 
 func (x *ObjectSchema_StructKind) GetProperties() map[string]*ObjectSchema {
@@ -3602,7 +3755,7 @@ type ObjectSchema_ArrayKind struct {
 
 func (x *ObjectSchema_ArrayKind) Reset() {
 	*x = ObjectSchema_ArrayKind{}
-	mi := &file_store_database_proto_msgTypes[37]
+	mi := &file_store_database_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3614,7 +3767,7 @@ func (x *ObjectSchema_ArrayKind) String() string {
 func (*ObjectSchema_ArrayKind) ProtoMessage() {}
 
 func (x *ObjectSchema_ArrayKind) ProtoReflect() protoreflect.Message {
-	mi := &file_store_database_proto_msgTypes[37]
+	mi := &file_store_database_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3627,7 +3780,7 @@ func (x *ObjectSchema_ArrayKind) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ObjectSchema_ArrayKind.ProtoReflect.Descriptor instead.
 func (*ObjectSchema_ArrayKind) Descriptor() ([]byte, []int) {
-	return file_store_database_proto_rawDescGZIP(), []int{33, 1}
+	return file_store_database_proto_rawDescGZIP(), []int{34, 1}
 }
 
 func (x *ObjectSchema_ArrayKind) GetKind() *ObjectSchema {
@@ -4183,7 +4336,7 @@ var file_store_database_proto_rawDesc = []byte{
 	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63,
 	0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x10, 0x0a,
 	0x0e, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x22,
-	0x87, 0x04, 0x0a, 0x0d, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f,
+	0xe8, 0x04, 0x0a, 0x0d, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f,
 	0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69,
 	0x63, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65,
@@ -4210,52 +4363,82 @@ var file_store_database_proto_rawDesc = []byte{
 	0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x61,
 	0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x19, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x4d, 0x61, 0x73, 0x6b, 0x69, 0x6e,
-	0x67, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x49, 0x64, 0x1a, 0x39, 0x0a, 0x0b,
-	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x6f, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x22, 0xe5, 0x04, 0x0a, 0x0c, 0x4f, 0x62,
-	0x6a, 0x65, 0x63, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x35, 0x0a, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62,
-	0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x12, 0x4a, 0x0a, 0x0b, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x5f, 0x6b, 0x69, 0x6e, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73,
-	0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x63,
-	0x68, 0x65, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4b, 0x69, 0x6e, 0x64, 0x48,
-	0x00, 0x52, 0x0a, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x47, 0x0a,
-	0x0a, 0x61, 0x72, 0x72, 0x61, 0x79, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x26, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f,
-	0x72, 0x65, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e,
-	0x41, 0x72, 0x72, 0x61, 0x79, 0x4b, 0x69, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x09, 0x61, 0x72, 0x72,
-	0x61, 0x79, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74,
-	0x69, 0x63, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73,
-	0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x54, 0x79, 0x70, 0x65, 0x1a, 0xc2, 0x01, 0x0a, 0x0a,
-	0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x57, 0x0a, 0x0a, 0x70, 0x72,
-	0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37,
-	0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e,
-	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x72,
-	0x75, 0x63, 0x74, 0x4b, 0x69, 0x6e, 0x64, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69,
-	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
-	0x69, 0x65, 0x73, 0x1a, 0x5b, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65,
-	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x32, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61,
+	0x67, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x49, 0x64, 0x12, 0x5f, 0x0a, 0x17,
+	0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x43,
+	0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x16, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x1a, 0x39, 0x0a,
+	0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x6f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x22, 0xfb, 0x02, 0x0a, 0x16, 0x43,
+	0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63,
+	0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a,
+	0x0d, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1f,
+	0x0a, 0x0b, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0a, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12,
+	0x1f, 0x0a, 0x0b, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x37, 0x0a, 0x09, 0x73, 0x63, 0x61, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x08, 0x73, 0x63, 0x61, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x42, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x39, 0x0a,
+	0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a,
+	0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45,
+	0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x02, 0x22, 0xe5, 0x04, 0x0a, 0x0c, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x35, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61,
 	0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53,
-	0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
-	0x1a, 0x3d, 0x0a, 0x09, 0x41, 0x72, 0x72, 0x61, 0x79, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x30, 0x0a,
-	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x79,
-	0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x4f, 0x62, 0x6a,
-	0x65, 0x63, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x22,
-	0x58, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x59, 0x50, 0x45, 0x5f,
-	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a,
-	0x06, 0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x55, 0x4d,
-	0x42, 0x45, 0x52, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x42, 0x4f, 0x4f, 0x4c, 0x45, 0x41, 0x4e,
-	0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x10, 0x04, 0x12, 0x09,
-	0x0a, 0x05, 0x41, 0x52, 0x52, 0x41, 0x59, 0x10, 0x05, 0x42, 0x06, 0x0a, 0x04, 0x6b, 0x69, 0x6e,
-	0x64, 0x42, 0x14, 0x5a, 0x12, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2d, 0x67,
-	0x6f, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x4a, 0x0a, 0x0b, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4b, 0x69, 0x6e, 0x64, 0x48, 0x00,
+	0x52, 0x0a, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x47, 0x0a, 0x0a,
+	0x61, 0x72, 0x72, 0x61, 0x79, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x26, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x41,
+	0x72, 0x72, 0x61, 0x79, 0x4b, 0x69, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x09, 0x61, 0x72, 0x72, 0x61,
+	0x79, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69,
+	0x63, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65,
+	0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x54, 0x79, 0x70, 0x65, 0x1a, 0xc2, 0x01, 0x0a, 0x0a, 0x53,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x57, 0x0a, 0x0a, 0x70, 0x72, 0x6f,
+	0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e,
+	0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x4f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x72, 0x75,
+	0x63, 0x74, 0x4b, 0x69, 0x6e, 0x64, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69,
+	0x65, 0x73, 0x1a, 0x5b, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x32, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a,
+	0x3d, 0x0a, 0x09, 0x41, 0x72, 0x72, 0x61, 0x79, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x30, 0x0a, 0x04,
+	0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x79, 0x74,
+	0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x4f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x22, 0x58,
+	0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06,
+	0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x55, 0x4d, 0x42,
+	0x45, 0x52, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x42, 0x4f, 0x4f, 0x4c, 0x45, 0x41, 0x4e, 0x10,
+	0x03, 0x12, 0x0a, 0x0a, 0x06, 0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x10, 0x04, 0x12, 0x09, 0x0a,
+	0x05, 0x41, 0x52, 0x52, 0x41, 0x59, 0x10, 0x05, 0x42, 0x06, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x42, 0x14, 0x5a, 0x12, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2d, 0x67, 0x6f,
+	0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -4270,118 +4453,123 @@ func file_store_database_proto_rawDescGZIP() []byte {
 	return file_store_database_proto_rawDescData
 }
 
-var file_store_database_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
-var file_store_database_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
+var file_store_database_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_store_database_proto_msgTypes = make([]protoimpl.MessageInfo, 40)
 var file_store_database_proto_goTypes = []any{
-	(TaskMetadata_State)(0),          // 0: bytebase.store.TaskMetadata.State
-	(StreamMetadata_Type)(0),         // 1: bytebase.store.StreamMetadata.Type
-	(StreamMetadata_Mode)(0),         // 2: bytebase.store.StreamMetadata.Mode
-	(TablePartitionMetadata_Type)(0), // 3: bytebase.store.TablePartitionMetadata.Type
-	(GenerationMetadata_Type)(0),     // 4: bytebase.store.GenerationMetadata.Type
-	(ObjectSchema_Type)(0),           // 5: bytebase.store.ObjectSchema.Type
-	(*DatabaseMetadata)(nil),         // 6: bytebase.store.DatabaseMetadata
-	(*DatabaseSchemaMetadata)(nil),   // 7: bytebase.store.DatabaseSchemaMetadata
-	(*LinkedDatabaseMetadata)(nil),   // 8: bytebase.store.LinkedDatabaseMetadata
-	(*SchemaMetadata)(nil),           // 9: bytebase.store.SchemaMetadata
-	(*EnumTypeMetadata)(nil),         // 10: bytebase.store.EnumTypeMetadata
-	(*EventMetadata)(nil),            // 11: bytebase.store.EventMetadata
-	(*SequenceMetadata)(nil),         // 12: bytebase.store.SequenceMetadata
-	(*TriggerMetadata)(nil),          // 13: bytebase.store.TriggerMetadata
-	(*TaskMetadata)(nil),             // 14: bytebase.store.TaskMetadata
-	(*StreamMetadata)(nil),           // 15: bytebase.store.StreamMetadata
-	(*TableMetadata)(nil),            // 16: bytebase.store.TableMetadata
-	(*CheckConstraintMetadata)(nil),  // 17: bytebase.store.CheckConstraintMetadata
-	(*ExternalTableMetadata)(nil),    // 18: bytebase.store.ExternalTableMetadata
-	(*TablePartitionMetadata)(nil),   // 19: bytebase.store.TablePartitionMetadata
-	(*ColumnMetadata)(nil),           // 20: bytebase.store.ColumnMetadata
-	(*GenerationMetadata)(nil),       // 21: bytebase.store.GenerationMetadata
-	(*ViewMetadata)(nil),             // 22: bytebase.store.ViewMetadata
-	(*DependencyColumn)(nil),         // 23: bytebase.store.DependencyColumn
-	(*MaterializedViewMetadata)(nil), // 24: bytebase.store.MaterializedViewMetadata
-	(*DependencyTable)(nil),          // 25: bytebase.store.DependencyTable
-	(*FunctionMetadata)(nil),         // 26: bytebase.store.FunctionMetadata
-	(*ProcedureMetadata)(nil),        // 27: bytebase.store.ProcedureMetadata
-	(*PackageMetadata)(nil),          // 28: bytebase.store.PackageMetadata
-	(*IndexMetadata)(nil),            // 29: bytebase.store.IndexMetadata
-	(*ExtensionMetadata)(nil),        // 30: bytebase.store.ExtensionMetadata
-	(*ForeignKeyMetadata)(nil),       // 31: bytebase.store.ForeignKeyMetadata
-	(*InstanceRoleMetadata)(nil),     // 32: bytebase.store.InstanceRoleMetadata
-	(*Secrets)(nil),                  // 33: bytebase.store.Secrets
-	(*SecretItem)(nil),               // 34: bytebase.store.SecretItem
-	(*DatabaseConfig)(nil),           // 35: bytebase.store.DatabaseConfig
-	(*SchemaCatalog)(nil),            // 36: bytebase.store.SchemaCatalog
-	(*TableCatalog)(nil),             // 37: bytebase.store.TableCatalog
-	(*ColumnCatalog)(nil),            // 38: bytebase.store.ColumnCatalog
-	(*ObjectSchema)(nil),             // 39: bytebase.store.ObjectSchema
-	nil,                              // 40: bytebase.store.DatabaseMetadata.LabelsEntry
-	nil,                              // 41: bytebase.store.ColumnCatalog.LabelsEntry
-	(*ObjectSchema_StructKind)(nil),  // 42: bytebase.store.ObjectSchema.StructKind
-	(*ObjectSchema_ArrayKind)(nil),   // 43: bytebase.store.ObjectSchema.ArrayKind
-	nil,                              // 44: bytebase.store.ObjectSchema.StructKind.PropertiesEntry
-	(*timestamppb.Timestamp)(nil),    // 45: google.protobuf.Timestamp
-	(*wrapperspb.StringValue)(nil),   // 46: google.protobuf.StringValue
-	(MaskingLevel)(0),                // 47: bytebase.store.MaskingLevel
+	(TaskMetadata_State)(0),           // 0: bytebase.store.TaskMetadata.State
+	(StreamMetadata_Type)(0),          // 1: bytebase.store.StreamMetadata.Type
+	(StreamMetadata_Mode)(0),          // 2: bytebase.store.StreamMetadata.Mode
+	(TablePartitionMetadata_Type)(0),  // 3: bytebase.store.TablePartitionMetadata.Type
+	(GenerationMetadata_Type)(0),      // 4: bytebase.store.GenerationMetadata.Type
+	(ClassificationProposal_State)(0), // 5: bytebase.store.ClassificationProposal.State
+	(ObjectSchema_Type)(0),            // 6: bytebase.store.ObjectSchema.Type
+	(*DatabaseMetadata)(nil),          // 7: bytebase.store.DatabaseMetadata
+	(*DatabaseSchemaMetadata)(nil),    // 8: bytebase.store.DatabaseSchemaMetadata
+	(*LinkedDatabaseMetadata)(nil),    // 9: bytebase.store.LinkedDatabaseMetadata
+	(*SchemaMetadata)(nil),            // 10: bytebase.store.SchemaMetadata
+	(*EnumTypeMetadata)(nil),          // 11: bytebase.store.EnumTypeMetadata
+	(*EventMetadata)(nil),             // 12: bytebase.store.EventMetadata
+	(*SequenceMetadata)(nil),          // 13: bytebase.store.SequenceMetadata
+	(*TriggerMetadata)(nil),           // 14: bytebase.store.TriggerMetadata
+	(*TaskMetadata)(nil),              // 15: bytebase.store.TaskMetadata
+	(*StreamMetadata)(nil),            // 16: bytebase.store.StreamMetadata
+	(*TableMetadata)(nil),             // 17: bytebase.store.TableMetadata
+	(*CheckConstraintMetadata)(nil),   // 18: bytebase.store.CheckConstraintMetadata
+	(*ExternalTableMetadata)(nil),     // 19: bytebase.store.ExternalTableMetadata
+	(*TablePartitionMetadata)(nil),    // 20: bytebase.store.TablePartitionMetadata
+	(*ColumnMetadata)(nil),            // 21: bytebase.store.ColumnMetadata
+	(*GenerationMetadata)(nil),        // 22: bytebase.store.GenerationMetadata
+	(*ViewMetadata)(nil),              // 23: bytebase.store.ViewMetadata
+	(*DependencyColumn)(nil),          // 24: bytebase.store.DependencyColumn
+	(*MaterializedViewMetadata)(nil),  // 25: bytebase.store.MaterializedViewMetadata
+	(*DependencyTable)(nil),           // 26: bytebase.store.DependencyTable
+	(*FunctionMetadata)(nil),          // 27: bytebase.store.FunctionMetadata
+	(*ProcedureMetadata)(nil),         // 28: bytebase.store.ProcedureMetadata
+	(*PackageMetadata)(nil),           // 29: bytebase.store.PackageMetadata
+	(*IndexMetadata)(nil),             // 30: bytebase.store.IndexMetadata
+	(*ExtensionMetadata)(nil),         // 31: bytebase.store.ExtensionMetadata
+	(*ForeignKeyMetadata)(nil),        // 32: bytebase.store.ForeignKeyMetadata
+	(*InstanceRoleMetadata)(nil),      // 33: bytebase.store.InstanceRoleMetadata
+	(*Secrets)(nil),                   // 34: bytebase.store.Secrets
+	(*SecretItem)(nil),                // 35: bytebase.store.SecretItem
+	(*DatabaseConfig)(nil),            // 36: bytebase.store.DatabaseConfig
+	(*SchemaCatalog)(nil),             // 37: bytebase.store.SchemaCatalog
+	(*TableCatalog)(nil),              // 38: bytebase.store.TableCatalog
+	(*ColumnCatalog)(nil),             // 39: bytebase.store.ColumnCatalog
+	(*ClassificationProposal)(nil),    // 40: bytebase.store.ClassificationProposal
+	(*ObjectSchema)(nil),              // 41: bytebase.store.ObjectSchema
+	nil,                               // 42: bytebase.store.DatabaseMetadata.LabelsEntry
+	nil,                               // 43: bytebase.store.ColumnCatalog.LabelsEntry
+	(*ObjectSchema_StructKind)(nil),   // 44: bytebase.store.ObjectSchema.StructKind
+	(*ObjectSchema_ArrayKind)(nil),    // 45: bytebase.store.ObjectSchema.ArrayKind
+	nil,                               // 46: bytebase.store.ObjectSchema.StructKind.PropertiesEntry
+	(*timestamppb.Timestamp)(nil),     // 47: google.protobuf.Timestamp
+	(*wrapperspb.StringValue)(nil),    // 48: google.protobuf.StringValue
+	(MaskingLevel)(0),                 // 49: bytebase.store.MaskingLevel
 }
 var file_store_database_proto_depIdxs = []int32{
-	40, // 0: bytebase.store.DatabaseMetadata.labels:type_name -> bytebase.store.DatabaseMetadata.LabelsEntry
-	45, // 1: bytebase.store.DatabaseMetadata.last_sync_time:type_name -> google.protobuf.Timestamp
-	9,  // 2: bytebase.store.DatabaseSchemaMetadata.schemas:type_name -> bytebase.store.SchemaMetadata
-	30, // 3: bytebase.store.DatabaseSchemaMetadata.extensions:type_name -> bytebase.store.ExtensionMetadata
-	8,  // 4: bytebase.store.DatabaseSchemaMetadata.linked_databases:type_name -> bytebase.store.LinkedDatabaseMetadata
-	16, // 5: bytebase.store.SchemaMetadata.tables:type_name -> bytebase.store.TableMetadata
-	18, // 6: bytebase.store.SchemaMetadata.external_tables:type_name -> bytebase.store.ExternalTableMetadata
-	22, // 7: bytebase.store.SchemaMetadata.views:type_name -> bytebase.store.ViewMetadata
-	26, // 8: bytebase.store.SchemaMetadata.functions:type_name -> bytebase.store.FunctionMetadata
-	27, // 9: bytebase.store.SchemaMetadata.procedures:type_name -> bytebase.store.ProcedureMetadata
-	15, // 10: bytebase.store.SchemaMetadata.streams:type_name -> bytebase.store.StreamMetadata
-	14, // 11: bytebase.store.SchemaMetadata.tasks:type_name -> bytebase.store.TaskMetadata
-	24, // 12: bytebase.store.SchemaMetadata.materialized_views:type_name -> bytebase.store.MaterializedViewMetadata
-	12, // 13: bytebase.store.SchemaMetadata.sequences:type_name -> bytebase.store.SequenceMetadata
-	28, // 14: bytebase.store.SchemaMetadata.packages:type_name -> bytebase.store.PackageMetadata
-	11, // 15: bytebase.store.SchemaMetadata.events:type_name -> bytebase.store.EventMetadata
-	10, // 16: bytebase.store.SchemaMetadata.enum_types:type_name -> bytebase.store.EnumTypeMetadata
+	42, // 0: bytebase.store.DatabaseMetadata.labels:type_name -> bytebase.store.DatabaseMetadata.LabelsEntry
+	47, // 1: bytebase.store.DatabaseMetadata.last_sync_time:type_name -> google.protobuf.Timestamp
+	10, // 2: bytebase.store.DatabaseSchemaMetadata.schemas:type_name -> bytebase.store.SchemaMetadata
+	31, // 3: bytebase.store.DatabaseSchemaMetadata.extensions:type_name -> bytebase.store.ExtensionMetadata
+	9,  // 4: bytebase.store.DatabaseSchemaMetadata.linked_databases:type_name -> bytebase.store.LinkedDatabaseMetadata
+	17, // 5: bytebase.store.SchemaMetadata.tables:type_name -> bytebase.store.TableMetadata
+	19, // 6: bytebase.store.SchemaMetadata.external_tables:type_name -> bytebase.store.ExternalTableMetadata
+	23, // 7: bytebase.store.SchemaMetadata.views:type_name -> bytebase.store.ViewMetadata
+	27, // 8: bytebase.store.SchemaMetadata.functions:type_name -> bytebase.store.FunctionMetadata
+	28, // 9: bytebase.store.SchemaMetadata.procedures:type_name -> bytebase.store.ProcedureMetadata
+	16, // 10: bytebase.store.SchemaMetadata.streams:type_name -> bytebase.store.StreamMetadata
+	15, // 11: bytebase.store.SchemaMetadata.tasks:type_name -> bytebase.store.TaskMetadata
+	25, // 12: bytebase.store.SchemaMetadata.materialized_views:type_name -> bytebase.store.MaterializedViewMetadata
+	13, // 13: bytebase.store.SchemaMetadata.sequences:type_name -> bytebase.store.SequenceMetadata
+	29, // 14: bytebase.store.SchemaMetadata.packages:type_name -> bytebase.store.PackageMetadata
+	12, // 15: bytebase.store.SchemaMetadata.events:type_name -> bytebase.store.EventMetadata
+	11, // 16: bytebase.store.SchemaMetadata.enum_types:type_name -> bytebase.store.EnumTypeMetadata
 	0,  // 17: bytebase.store.TaskMetadata.state:type_name -> bytebase.store.TaskMetadata.State
 	1,  // 18: bytebase.store.StreamMetadata.type:type_name -> bytebase.store.StreamMetadata.Type
 	2,  // 19: bytebase.store.StreamMetadata.mode:type_name -> bytebase.store.StreamMetadata.Mode
-	20, // 20: bytebase.store.TableMetadata.columns:type_name -> bytebase.store.ColumnMetadata
-	29, // 21: bytebase.store.TableMetadata.indexes:type_name -> bytebase.store.IndexMetadata
-	31, // 22: bytebase.store.TableMetadata.foreign_keys:type_name -> bytebase.store.ForeignKeyMetadata
-	19, // 23: bytebase.store.TableMetadata.partitions:type_name -> bytebase.store.TablePartitionMetadata
-	17, // 24: bytebase.store.TableMetadata.check_constraints:type_name -> bytebase.store.CheckConstraintMetadata
-	13, // 25: bytebase.store.TableMetadata.triggers:type_name -> bytebase.store.TriggerMetadata
-	20, // 26: bytebase.store.ExternalTableMetadata.columns:type_name -> bytebase.store.ColumnMetadata
+	21, // 20: bytebase.store.TableMetadata.columns:type_name -> bytebase.store.ColumnMetadata
+	30, // 21: bytebase.store.TableMetadata.indexes:type_name -> bytebase.store.IndexMetadata
+	32, // 22: bytebase.store.TableMetadata.foreign_keys:type_name -> bytebase.store.ForeignKeyMetadata
+	20, // 23: bytebase.store.TableMetadata.partitions:type_name -> bytebase.store.TablePartitionMetadata
+	18, // 24: bytebase.store.TableMetadata.check_constraints:type_name -> bytebase.store.CheckConstraintMetadata
+	14, // 25: bytebase.store.TableMetadata.triggers:type_name -> bytebase.store.TriggerMetadata
+	21, // 26: bytebase.store.ExternalTableMetadata.columns:type_name -> bytebase.store.ColumnMetadata
 	3,  // 27: bytebase.store.TablePartitionMetadata.type:type_name -> bytebase.store.TablePartitionMetadata.Type
-	19, // 28: bytebase.store.TablePartitionMetadata.subpartitions:type_name -> bytebase.store.TablePartitionMetadata
-	29, // 29: bytebase.store.TablePartitionMetadata.indexes:type_name -> bytebase.store.IndexMetadata
-	46, // 30: bytebase.store.ColumnMetadata.default:type_name -> google.protobuf.StringValue
-	21, // 31: bytebase.store.ColumnMetadata.generation:type_name -> bytebase.store.GenerationMetadata
+	20, // 28: bytebase.store.TablePartitionMetadata.subpartitions:type_name -> bytebase.store.TablePartitionMetadata
+	30, // 29: bytebase.store.TablePartitionMetadata.indexes:type_name -> bytebase.store.IndexMetadata
+	48, // 30: bytebase.store.ColumnMetadata.default:type_name -> google.protobuf.StringValue
+	22, // 31: bytebase.store.ColumnMetadata.generation:type_name -> bytebase.store.GenerationMetadata
 	4,  // 32: bytebase.store.GenerationMetadata.type:type_name -> bytebase.store.GenerationMetadata.Type
-	23, // 33: bytebase.store.ViewMetadata.dependency_columns:type_name -> bytebase.store.DependencyColumn
-	20, // 34: bytebase.store.ViewMetadata.columns:type_name -> bytebase.store.ColumnMetadata
-	13, // 35: bytebase.store.ViewMetadata.triggers:type_name -> bytebase.store.TriggerMetadata
-	23, // 36: bytebase.store.MaterializedViewMetadata.dependency_columns:type_name -> bytebase.store.DependencyColumn
-	13, // 37: bytebase.store.MaterializedViewMetadata.triggers:type_name -> bytebase.store.TriggerMetadata
-	29, // 38: bytebase.store.MaterializedViewMetadata.indexes:type_name -> bytebase.store.IndexMetadata
-	25, // 39: bytebase.store.FunctionMetadata.dependency_tables:type_name -> bytebase.store.DependencyTable
-	34, // 40: bytebase.store.Secrets.items:type_name -> bytebase.store.SecretItem
-	36, // 41: bytebase.store.DatabaseConfig.schemas:type_name -> bytebase.store.SchemaCatalog
-	37, // 42: bytebase.store.SchemaCatalog.tables:type_name -> bytebase.store.TableCatalog
-	38, // 43: bytebase.store.TableCatalog.columns:type_name -> bytebase.store.ColumnCatalog
-	39, // 44: bytebase.store.TableCatalog.object_schema:type_name -> bytebase.store.ObjectSchema
-	41, // 45: bytebase.store.ColumnCatalog.labels:type_name -> bytebase.store.ColumnCatalog.LabelsEntry
-	39, // 46: bytebase.store.ColumnCatalog.object_schema:type_name -> bytebase.store.ObjectSchema
-	47, // 47: bytebase.store.ColumnCatalog.masking_level:type_name -> bytebase.store.MaskingLevel
-	5,  // 48: bytebase.store.ObjectSchema.type:type_name -> bytebase.store.ObjectSchema.Type
-	42, // 49: bytebase.store.ObjectSchema.struct_kind:type_name -> bytebase.store.ObjectSchema.StructKind
-	43, // 50: bytebase.store.ObjectSchema.array_kind:type_name -> bytebase.store.ObjectSchema.ArrayKind
-	44, // 51: bytebase.store.ObjectSchema.StructKind.properties:type_name -> bytebase.store.ObjectSchema.StructKind.PropertiesEntry
-	39, // 52: bytebase.store.ObjectSchema.ArrayKind.kind:type_name -> bytebase.store.ObjectSchema
-	39, // 53: bytebase.store.ObjectSchema.StructKind.PropertiesEntry.value:type_name -> bytebase.store.ObjectSchema
-	54, // [54:54] is the sub-list for method output_type
-	54, // [54:54] is the sub-list for method input_type
-	54, // [54:54] is the sub-list for extension type_name
-	54, // [54:54] is the sub-list for extension extendee
-	0,  // [0:54] is the sub-list for field type_name
+	24, // 33: bytebase.store.ViewMetadata.dependency_columns:type_name -> bytebase.store.DependencyColumn
+	21, // 34: bytebase.store.ViewMetadata.columns:type_name -> bytebase.store.ColumnMetadata
+	14, // 35: bytebase.store.ViewMetadata.triggers:type_name -> bytebase.store.TriggerMetadata
+	24, // 36: bytebase.store.MaterializedViewMetadata.dependency_columns:type_name -> bytebase.store.DependencyColumn
+	14, // 37: bytebase.store.MaterializedViewMetadata.triggers:type_name -> bytebase.store.TriggerMetadata
+	30, // 38: bytebase.store.MaterializedViewMetadata.indexes:type_name -> bytebase.store.IndexMetadata
+	26, // 39: bytebase.store.FunctionMetadata.dependency_tables:type_name -> bytebase.store.DependencyTable
+	35, // 40: bytebase.store.Secrets.items:type_name -> bytebase.store.SecretItem
+	37, // 41: bytebase.store.DatabaseConfig.schemas:type_name -> bytebase.store.SchemaCatalog
+	38, // 42: bytebase.store.SchemaCatalog.tables:type_name -> bytebase.store.TableCatalog
+	39, // 43: bytebase.store.TableCatalog.columns:type_name -> bytebase.store.ColumnCatalog
+	41, // 44: bytebase.store.TableCatalog.object_schema:type_name -> bytebase.store.ObjectSchema
+	43, // 45: bytebase.store.ColumnCatalog.labels:type_name -> bytebase.store.ColumnCatalog.LabelsEntry
+	41, // 46: bytebase.store.ColumnCatalog.object_schema:type_name -> bytebase.store.ObjectSchema
+	49, // 47: bytebase.store.ColumnCatalog.masking_level:type_name -> bytebase.store.MaskingLevel
+	40, // 48: bytebase.store.ColumnCatalog.classification_proposal:type_name -> bytebase.store.ClassificationProposal
+	47, // 49: bytebase.store.ClassificationProposal.scan_time:type_name -> google.protobuf.Timestamp
+	5,  // 50: bytebase.store.ClassificationProposal.state:type_name -> bytebase.store.ClassificationProposal.State
+	6,  // 51: bytebase.store.ObjectSchema.type:type_name -> bytebase.store.ObjectSchema.Type
+	44, // 52: bytebase.store.ObjectSchema.struct_kind:type_name -> bytebase.store.ObjectSchema.StructKind
+	45, // 53: bytebase.store.ObjectSchema.array_kind:type_name -> bytebase.store.ObjectSchema.ArrayKind
+	46, // 54: bytebase.store.ObjectSchema.StructKind.properties:type_name -> bytebase.store.ObjectSchema.StructKind.PropertiesEntry
+	41, // 55: bytebase.store.ObjectSchema.ArrayKind.kind:type_name -> bytebase.store.ObjectSchema
+	41, // 56: bytebase.store.ObjectSchema.StructKind.PropertiesEntry.value:type_name -> bytebase.store.ObjectSchema
+	57, // [57:57] is the sub-list for method output_type
+	57, // [57:57] is the sub-list for method input_type
+	57, // [57:57] is the sub-list for extension type_name
+	57, // [57:57] is the sub-list for extension extendee
+	0,  // [0:57] is the sub-list for field type_name
 }
 
 func init() { file_store_database_proto_init() }
@@ -4397,7 +4585,7 @@ func file_store_database_proto_init() {
 	}
 	file_store_database_proto_msgTypes[31].OneofWrappers = []any{}
 	file_store_database_proto_msgTypes[32].OneofWrappers = []any{}
-	file_store_database_proto_msgTypes[33].OneofWrappers = []any{
+	file_store_database_proto_msgTypes[34].OneofWrappers = []any{
 		(*ObjectSchema_StructKind_)(nil),
 		(*ObjectSchema_ArrayKind_)(nil),
 	}
@@ -4406,8 +4594,8 @@ func file_store_database_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_store_database_proto_rawDesc,
-			NumEnums:      6,
-			NumMessages:   39,
+			NumEnums:      7,
+			NumMessages:   40,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -21,8 +21,12 @@ const (
 )
 
 type DatabaseGroupPayload struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Multitenancy  bool                   `protobuf:"varint,1,opt,name=multitenancy,proto3" json:"multitenancy,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Multitenancy bool                   `protobuf:"varint,1,opt,name=multitenancy,proto3" json:"multitenancy,omitempty"`
+	// Whether a stage rolls out to all tenants or none.
+	// If any tenant task in a stage fails, the tenants that succeeded are reverted.
+	// Only applicable to multitenancy database groups.
+	AtomicRollout bool `protobuf:"varint,2,opt,name=atomic_rollout,json=atomicRollout,proto3" json:"atomic_rollout,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -64,17 +68,27 @@ func (x *DatabaseGroupPayload) GetMultitenancy() bool {
 	return false
 }
 
+func (x *DatabaseGroupPayload) GetAtomicRollout() bool {
+	if x != nil {
+		return x.AtomicRollout
+	}
+	return false
+}
+
 var File_store_db_group_proto protoreflect.FileDescriptor
 
 var file_store_db_group_proto_rawDesc = []byte{
 	0x0a, 0x14, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x64, 0x62, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65,
-	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x22, 0x3a, 0x0a, 0x14, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x22, 0x61, 0x0a, 0x14, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61,
 	0x73, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x22,
 	0x0a, 0x0c, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x79, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x74, 0x65, 0x6e, 0x61, 0x6e,
-	0x63, 0x79, 0x42, 0x14, 0x5a, 0x12, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2d,
-	0x67, 0x6f, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x63, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x74, 0x6f, 0x6d, 0x69, 0x63, 0x5f, 0x72, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x61, 0x74, 0x6f, 0x6d,
+	0x69, 0x63, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x42, 0x14, 0x5a, 0x12, 0x67, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2d, 0x67, 0x6f, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -100,8 +100,13 @@ type Project struct {
 	PostgresDatabaseTenantMode bool `protobuf:"varint,9,opt,name=postgres_database_tenant_mode,json=postgresDatabaseTenantMode,proto3" json:"postgres_database_tenant_mode,omitempty"`
 	// Whether to allow the issue creator to self-approve the issue.
 	AllowSelfApproval bool `protobuf:"varint,10,opt,name=allow_self_approval,json=allowSelfApproval,proto3" json:"allow_self_approval,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// The hook statements run around every schema and data migration.
+	MigrationHooks []*MigrationHook `protobuf:"bytes,11,rep,name=migration_hooks,json=migrationHooks,proto3" json:"migration_hooks,omitempty"`
+	// The releases pinned to the environments.
+	// The environments without a pin track the latest release.
+	ReleasePins   []*ReleasePin `protobuf:"bytes,12,rep,name=release_pins,json=releasePins,proto3" json:"release_pins,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Project) Reset() {
@@ -197,6 +202,158 @@ func (x *Project) GetAllowSelfApproval() bool {
 	return false
 }
 
+func (x *Project) GetMigrationHooks() []*MigrationHook {
+	if x != nil {
+		return x.MigrationHooks
+	}
+	return nil
+}
+
+func (x *Project) GetReleasePins() []*ReleasePin {
+	if x != nil {
+		return x.ReleasePins
+	}
+	return nil
+}
+
+// MigrationHook is the statements run in the same session before and after the migration statement.
+// The statements support the {{database}} and {{changed_tables}} variables, which are replaced with the quoted names.
+type MigrationHook struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The environment resource IDs that the hook applies to.
+	// Empty means all environments.
+	Environments  []string `protobuf:"bytes,1,rep,name=environments,proto3" json:"environments,omitempty"`
+	PreStatement  string   `protobuf:"bytes,2,opt,name=pre_statement,json=preStatement,proto3" json:"pre_statement,omitempty"`
+	PostStatement string   `protobuf:"bytes,3,opt,name=post_statement,json=postStatement,proto3" json:"post_statement,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MigrationHook) Reset() {
+	*x = MigrationHook{}
+	mi := &file_store_project_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MigrationHook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MigrationHook) ProtoMessage() {}
+
+func (x *MigrationHook) ProtoReflect() protoreflect.Message {
+	mi := &file_store_project_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MigrationHook.ProtoReflect.Descriptor instead.
+func (*MigrationHook) Descriptor() ([]byte, []int) {
+	return file_store_project_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MigrationHook) GetEnvironments() []string {
+	if x != nil {
+		return x.Environments
+	}
+	return nil
+}
+
+func (x *MigrationHook) GetPreStatement() string {
+	if x != nil {
+		return x.PreStatement
+	}
+	return ""
+}
+
+func (x *MigrationHook) GetPostStatement() string {
+	if x != nil {
+		return x.PostStatement
+	}
+	return ""
+}
+
+// ReleasePin pins an environment to a release.
+type ReleasePin struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The environment resource ID.
+	Environment string `protobuf:"bytes,1,opt,name=environment,proto3" json:"environment,omitempty"`
+	// The release UID.
+	ReleaseUid int64 `protobuf:"varint,2,opt,name=release_uid,json=releaseUid,proto3" json:"release_uid,omitempty"`
+	// The release being promoted to the environment.
+	// The pin is moved to the release after the rollouts of all the promotion plans complete.
+	PromotingReleaseUid int64 `protobuf:"varint,3,opt,name=promoting_release_uid,json=promotingReleaseUid,proto3" json:"promoting_release_uid,omitempty"`
+	// The UIDs of the promotion plans whose rollouts are not completed yet.
+	PromotingPlanUids []int64 `protobuf:"varint,4,rep,packed,name=promoting_plan_uids,json=promotingPlanUids,proto3" json:"promoting_plan_uids,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ReleasePin) Reset() {
+	*x = ReleasePin{}
+	mi := &file_store_project_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleasePin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleasePin) ProtoMessage() {}
+
+func (x *ReleasePin) ProtoReflect() protoreflect.Message {
+	mi := &file_store_project_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleasePin.ProtoReflect.Descriptor instead.
+func (*ReleasePin) Descriptor() ([]byte, []int) {
+	return file_store_project_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ReleasePin) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *ReleasePin) GetReleaseUid() int64 {
+	if x != nil {
+		return x.ReleaseUid
+	}
+	return 0
+}
+
+func (x *ReleasePin) GetPromotingReleaseUid() int64 {
+	if x != nil {
+		return x.PromotingReleaseUid
+	}
+	return 0
+}
+
+func (x *ReleasePin) GetPromotingPlanUids() []int64 {
+	if x != nil {
+		return x.PromotingPlanUids
+	}
+	return nil
+}
+
 var File_store_project_proto protoreflect.FileDescriptor
 
 var file_store_project_proto_rawDesc = []byte{
@@ -207,7 +364,7 @@ var file_store_project_proto_rawDesc = []byte{
 	0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x02, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72,
 	0x6f, 0x75, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70,
-	0x22, 0xda, 0x03, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x38, 0x0a, 0x0c,
+	0x22, 0xe1, 0x04, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x38, 0x0a, 0x0c,
 	0x69, 0x73, 0x73, 0x75, 0x65, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03,
 	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74,
 	0x6f, 0x72, 0x65, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x0b, 0x69, 0x73, 0x73, 0x75, 0x65,
@@ -236,9 +393,37 @@ var file_store_project_proto_rawDesc = []byte{
 	0x61, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
 	0x5f, 0x73, 0x65, 0x6c, 0x66, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x18, 0x0a,
 	0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x65, 0x6c, 0x66, 0x41,
-	0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x42, 0x14, 0x5a,
-	0x12, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2d, 0x67, 0x6f, 0x2f, 0x73, 0x74,
-	0x6f, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x12, 0x46, 0x0a, 0x0f, 0x6d, 0x69, 0x67, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x2e, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x6f, 0x6f, 0x6b, 0x52,
+	0x0e, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x12,
+	0x3d, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x70, 0x69, 0x6e, 0x73, 0x18,
+	0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x50, 0x69,
+	0x6e, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x50, 0x69, 0x6e, 0x73, 0x4a, 0x04,
+	0x08, 0x01, 0x10, 0x02, 0x22, 0x7f, 0x0a, 0x0d, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x48, 0x6f, 0x6f, 0x6b, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x6e, 0x76,
+	0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x65,
+	0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x70, 0x72, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x25,
+	0x0a, 0x0e, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xb3, 0x01, 0x0a, 0x0a, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x50, 0x69, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72,
+	0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x72, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x55, 0x69, 0x64, 0x12, 0x32, 0x0a, 0x15, 0x70, 0x72, 0x6f, 0x6d, 0x6f,
+	0x74, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x75, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x55, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x70,
+	0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x75, 0x69,
+	0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x03, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74,
+	0x69, 0x6e, 0x67, 0x50, 0x6c, 0x61, 0x6e, 0x55, 0x69, 0x64, 0x73, 0x42, 0x14, 0x5a, 0x12, 0x67,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2d, 0x67, 0x6f, 0x2f, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -253,18 +438,22 @@ func file_store_project_proto_rawDescGZIP() []byte {
 	return file_store_project_proto_rawDescData
 }
 
-var file_store_project_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_store_project_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_store_project_proto_goTypes = []any{
-	(*Label)(nil),   // 0: bytebase.store.Label
-	(*Project)(nil), // 1: bytebase.store.Project
+	(*Label)(nil),         // 0: bytebase.store.Label
+	(*Project)(nil),       // 1: bytebase.store.Project
+	(*MigrationHook)(nil), // 2: bytebase.store.MigrationHook
+	(*ReleasePin)(nil),    // 3: bytebase.store.ReleasePin
 }
 var file_store_project_proto_depIdxs = []int32{
 	0, // 0: bytebase.store.Project.issue_labels:type_name -> bytebase.store.Label
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	2, // 1: bytebase.store.Project.migration_hooks:type_name -> bytebase.store.MigrationHook
+	3, // 2: bytebase.store.Project.release_pins:type_name -> bytebase.store.ReleasePin
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_store_project_proto_init() }
@@ -278,7 +467,7 @@ func file_store_project_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_store_project_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -25,7 +25,14 @@ type ReleaseFileType int32
 
 const (
 	ReleaseFileType_TYPE_UNSPECIFIED ReleaseFileType = 0
-	ReleaseFileType_VERSIONED        ReleaseFileType = 1
+	// The versioned file is applied once in the order of the version.
+	ReleaseFileType_VERSIONED ReleaseFileType = 1
+	// The repeatable file is re-applied whenever its content changes, e.g. views and stored procedures.
+	// The version identifies the file across releases. The repeatable files are applied after the versioned files.
+	ReleaseFileType_REPEATABLE ReleaseFileType = 2
+	// The declarative file holds the desired schema. The diff between the database schema and the file is applied.
+	// There is at most one declarative file in a release, and it is applied after the versioned files.
+	ReleaseFileType_DECLARATIVE ReleaseFileType = 3
 )
 
 // Enum value maps for ReleaseFileType.
@@ -33,10 +40,14 @@ var (
 	ReleaseFileType_name = map[int32]string{
 		0: "TYPE_UNSPECIFIED",
 		1: "VERSIONED",
+		2: "REPEATABLE",
+		3: "DECLARATIVE",
 	}
 	ReleaseFileType_value = map[string]int32{
 		"TYPE_UNSPECIFIED": 0,
 		"VERSIONED":        1,
+		"REPEATABLE":       2,
+		"DECLARATIVE":      3,
 	}
 )
 
@@ -305,12 +316,14 @@ var file_store_release_proto_rawDesc = []byte{
 	0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e,
 	0x56, 0x43, 0x53, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x76, 0x63, 0x73, 0x54, 0x79, 0x70, 0x65,
 	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
-	0x72, 0x6c, 0x2a, 0x36, 0x0a, 0x0f, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x46, 0x69, 0x6c,
+	0x72, 0x6c, 0x2a, 0x57, 0x0a, 0x0f, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x46, 0x69, 0x6c,
 	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e,
 	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x56,
-	0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x45, 0x44, 0x10, 0x01, 0x42, 0x14, 0x5a, 0x12, 0x67, 0x65,
-	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2d, 0x67, 0x6f, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x45,
+	0x50, 0x45, 0x41, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x44, 0x45,
+	0x43, 0x4c, 0x41, 0x52, 0x41, 0x54, 0x49, 0x56, 0x45, 0x10, 0x03, 0x42, 0x14, 0x5a, 0x12, 0x67,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2d, 0x67, 0x6f, 0x2f, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
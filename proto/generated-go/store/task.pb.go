@@ -159,8 +159,12 @@ type TaskDatabaseUpdatePayload struct {
 	// flags is used for ghost sync
 	Flags             map[string]string  `protobuf:"bytes,7,rep,name=flags,proto3" json:"flags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	TaskReleaseSource *TaskReleaseSource `protobuf:"bytes,8,opt,name=task_release_source,json=taskReleaseSource,proto3" json:"task_release_source,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	DmlBatchConfig    *DMLBatchConfig    `protobuf:"bytes,9,opt,name=dml_batch_config,json=dmlBatchConfig,proto3" json:"dml_batch_config,omitempty"`
+	// dml_batch_checkpoint is the progress of the batched DML execution.
+	// It's used to resume the execution after a failure.
+	DmlBatchCheckpoint *DMLBatchCheckpoint `protobuf:"bytes,10,opt,name=dml_batch_checkpoint,json=dmlBatchCheckpoint,proto3" json:"dml_batch_checkpoint,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *TaskDatabaseUpdatePayload) Reset() {
@@ -249,6 +253,99 @@ func (x *TaskDatabaseUpdatePayload) GetTaskReleaseSource() *TaskReleaseSource {
 	return nil
 }
 
+func (x *TaskDatabaseUpdatePayload) GetDmlBatchConfig() *DMLBatchConfig {
+	if x != nil {
+		return x.DmlBatchConfig
+	}
+	return nil
+}
+
+func (x *TaskDatabaseUpdatePayload) GetDmlBatchCheckpoint() *DMLBatchCheckpoint {
+	if x != nil {
+		return x.DmlBatchCheckpoint
+	}
+	return nil
+}
+
+type DMLBatchCheckpoint struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The primary key values of the last row covered by the completed chunks.
+	LastKey         []string `protobuf:"bytes,1,rep,name=last_key,json=lastKey,proto3" json:"last_key,omitempty"`
+	CompletedChunks int64    `protobuf:"varint,2,opt,name=completed_chunks,json=completedChunks,proto3" json:"completed_chunks,omitempty"`
+	ScannedRows     int64    `protobuf:"varint,3,opt,name=scanned_rows,json=scannedRows,proto3" json:"scanned_rows,omitempty"`
+	AffectedRows    int64    `protobuf:"varint,4,opt,name=affected_rows,json=affectedRows,proto3" json:"affected_rows,omitempty"`
+	// The chunk after last_key is being executed.
+	// It stays set if the task run stops while the chunk commits, in which case whether the chunk is committed is unknown.
+	InFlight      bool `protobuf:"varint,5,opt,name=in_flight,json=inFlight,proto3" json:"in_flight,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DMLBatchCheckpoint) Reset() {
+	*x = DMLBatchCheckpoint{}
+	mi := &file_store_task_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DMLBatchCheckpoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DMLBatchCheckpoint) ProtoMessage() {}
+
+func (x *DMLBatchCheckpoint) ProtoReflect() protoreflect.Message {
+	mi := &file_store_task_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DMLBatchCheckpoint.ProtoReflect.Descriptor instead.
+func (*DMLBatchCheckpoint) Descriptor() ([]byte, []int) {
+	return file_store_task_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DMLBatchCheckpoint) GetLastKey() []string {
+	if x != nil {
+		return x.LastKey
+	}
+	return nil
+}
+
+func (x *DMLBatchCheckpoint) GetCompletedChunks() int64 {
+	if x != nil {
+		return x.CompletedChunks
+	}
+	return 0
+}
+
+func (x *DMLBatchCheckpoint) GetScannedRows() int64 {
+	if x != nil {
+		return x.ScannedRows
+	}
+	return 0
+}
+
+func (x *DMLBatchCheckpoint) GetAffectedRows() int64 {
+	if x != nil {
+		return x.AffectedRows
+	}
+	return 0
+}
+
+func (x *DMLBatchCheckpoint) GetInFlight() bool {
+	if x != nil {
+		return x.InFlight
+	}
+	return false
+}
+
 // TaskDatabaseDataExportPayload is the task payload for database data export.
 type TaskDatabaseDataExportPayload struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -263,7 +360,7 @@ type TaskDatabaseDataExportPayload struct {
 
 func (x *TaskDatabaseDataExportPayload) Reset() {
 	*x = TaskDatabaseDataExportPayload{}
-	mi := &file_store_task_proto_msgTypes[2]
+	mi := &file_store_task_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -275,7 +372,7 @@ func (x *TaskDatabaseDataExportPayload) String() string {
 func (*TaskDatabaseDataExportPayload) ProtoMessage() {}
 
 func (x *TaskDatabaseDataExportPayload) ProtoReflect() protoreflect.Message {
-	mi := &file_store_task_proto_msgTypes[2]
+	mi := &file_store_task_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -288,7 +385,7 @@ func (x *TaskDatabaseDataExportPayload) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TaskDatabaseDataExportPayload.ProtoReflect.Descriptor instead.
 func (*TaskDatabaseDataExportPayload) Descriptor() ([]byte, []int) {
-	return file_store_task_proto_rawDescGZIP(), []int{2}
+	return file_store_task_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *TaskDatabaseDataExportPayload) GetSpecId() string {
@@ -329,7 +426,7 @@ type TaskReleaseSource struct {
 
 func (x *TaskReleaseSource) Reset() {
 	*x = TaskReleaseSource{}
-	mi := &file_store_task_proto_msgTypes[3]
+	mi := &file_store_task_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -341,7 +438,7 @@ func (x *TaskReleaseSource) String() string {
 func (*TaskReleaseSource) ProtoMessage() {}
 
 func (x *TaskReleaseSource) ProtoReflect() protoreflect.Message {
-	mi := &file_store_task_proto_msgTypes[3]
+	mi := &file_store_task_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -354,7 +451,7 @@ func (x *TaskReleaseSource) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TaskReleaseSource.ProtoReflect.Descriptor instead.
 func (*TaskReleaseSource) Descriptor() ([]byte, []int) {
-	return file_store_task_proto_rawDescGZIP(), []int{3}
+	return file_store_task_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *TaskReleaseSource) GetFile() string {
@@ -395,7 +492,7 @@ var file_store_task_proto_rawDesc = []byte{
 	0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
 	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x0b, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x22, 0xf0, 0x03, 0x0a, 0x19, 0x54,
+	0x28, 0x09, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x22, 0x90, 0x05, 0x0a, 0x19, 0x54,
 	0x61, 0x73, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74,
 	0x65, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6b, 0x69, 0x70,
 	0x70, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70,
@@ -423,25 +520,47 @@ var file_store_task_proto_rawDesc = []byte{
 	0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x54,
 	0x61, 0x73, 0x6b, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
 	0x52, 0x11, 0x74, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x53, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x1a, 0x38, 0x0a, 0x0a, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x72, 0x63, 0x65, 0x12, 0x48, 0x0a, 0x10, 0x64, 0x6d, 0x6c, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x44,
+	0x4d, 0x4c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0e, 0x64,
+	0x6d, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x54, 0x0a,
+	0x14, 0x64, 0x6d, 0x6c, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x62, 0x79,
+	0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x44, 0x4d, 0x4c,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52,
+	0x12, 0x64, 0x6d, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x1a, 0x38, 0x0a, 0x0a, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72,
 	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
 	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa5, 0x01,
-	0x0a, 0x1d, 0x54, 0x61, 0x73, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x44, 0x61,
-	0x74, 0x61, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12,
-	0x17, 0x0a, 0x07, 0x73, 0x70, 0x65, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x73, 0x70, 0x65, 0x63, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x68, 0x65, 0x65,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x73, 0x68, 0x65, 0x65,
-	0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12,
-	0x34, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x1c, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65,
-	0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66,
-	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x27, 0x0a, 0x11, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x6c,
-	0x65, 0x61, 0x73, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x69,
-	0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x42, 0x14,
-	0x5a, 0x12, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2d, 0x67, 0x6f, 0x2f, 0x73,
-	0x74, 0x6f, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xbf, 0x01,
+	0x0a, 0x12, 0x44, 0x4d, 0x4c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x61, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x12,
+	0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x75,
+	0x6e, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x63,
+	0x61, 0x6e, 0x6e, 0x65, 0x64, 0x5f, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x52, 0x6f, 0x77, 0x73, 0x12, 0x23, 0x0a,
+	0x0d, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x6f,
+	0x77, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6e, 0x5f, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x22,
+	0xa5, 0x01, 0x0a, 0x1d, 0x54, 0x61, 0x73, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
+	0x44, 0x61, 0x74, 0x61, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x70, 0x65, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x70, 0x65, 0x63, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x68,
+	0x65, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x73, 0x68,
+	0x65, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x12, 0x34, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1c, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52,
+	0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x27, 0x0a, 0x11, 0x54, 0x61, 0x73, 0x6b, 0x52,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65,
+	0x42, 0x14, 0x5a, 0x12, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2d, 0x67, 0x6f,
+	0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -456,26 +575,30 @@ func file_store_task_proto_rawDescGZIP() []byte {
 	return file_store_task_proto_rawDescData
 }
 
-var file_store_task_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_store_task_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_store_task_proto_goTypes = []any{
 	(*TaskDatabaseCreatePayload)(nil),     // 0: bytebase.store.TaskDatabaseCreatePayload
 	(*TaskDatabaseUpdatePayload)(nil),     // 1: bytebase.store.TaskDatabaseUpdatePayload
-	(*TaskDatabaseDataExportPayload)(nil), // 2: bytebase.store.TaskDatabaseDataExportPayload
-	(*TaskReleaseSource)(nil),             // 3: bytebase.store.TaskReleaseSource
-	nil,                                   // 4: bytebase.store.TaskDatabaseUpdatePayload.FlagsEntry
-	(*PreUpdateBackupDetail)(nil),         // 5: bytebase.store.PreUpdateBackupDetail
-	(ExportFormat)(0),                     // 6: bytebase.store.ExportFormat
+	(*DMLBatchCheckpoint)(nil),            // 2: bytebase.store.DMLBatchCheckpoint
+	(*TaskDatabaseDataExportPayload)(nil), // 3: bytebase.store.TaskDatabaseDataExportPayload
+	(*TaskReleaseSource)(nil),             // 4: bytebase.store.TaskReleaseSource
+	nil,                                   // 5: bytebase.store.TaskDatabaseUpdatePayload.FlagsEntry
+	(*PreUpdateBackupDetail)(nil),         // 6: bytebase.store.PreUpdateBackupDetail
+	(*DMLBatchConfig)(nil),                // 7: bytebase.store.DMLBatchConfig
+	(ExportFormat)(0),                     // 8: bytebase.store.ExportFormat
 }
 var file_store_task_proto_depIdxs = []int32{
-	5, // 0: bytebase.store.TaskDatabaseUpdatePayload.pre_update_backup_detail:type_name -> bytebase.store.PreUpdateBackupDetail
-	4, // 1: bytebase.store.TaskDatabaseUpdatePayload.flags:type_name -> bytebase.store.TaskDatabaseUpdatePayload.FlagsEntry
-	3, // 2: bytebase.store.TaskDatabaseUpdatePayload.task_release_source:type_name -> bytebase.store.TaskReleaseSource
-	6, // 3: bytebase.store.TaskDatabaseDataExportPayload.format:type_name -> bytebase.store.ExportFormat
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	6, // 0: bytebase.store.TaskDatabaseUpdatePayload.pre_update_backup_detail:type_name -> bytebase.store.PreUpdateBackupDetail
+	5, // 1: bytebase.store.TaskDatabaseUpdatePayload.flags:type_name -> bytebase.store.TaskDatabaseUpdatePayload.FlagsEntry
+	4, // 2: bytebase.store.TaskDatabaseUpdatePayload.task_release_source:type_name -> bytebase.store.TaskReleaseSource
+	7, // 3: bytebase.store.TaskDatabaseUpdatePayload.dml_batch_config:type_name -> bytebase.store.DMLBatchConfig
+	2, // 4: bytebase.store.TaskDatabaseUpdatePayload.dml_batch_checkpoint:type_name -> bytebase.store.DMLBatchCheckpoint
+	8, // 5: bytebase.store.TaskDatabaseDataExportPayload.format:type_name -> bytebase.store.ExportFormat
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_store_task_proto_init() }
@@ -491,7 +614,7 @@ func file_store_task_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_store_task_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
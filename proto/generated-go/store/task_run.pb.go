@@ -21,6 +21,55 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type SchedulerInfo_TaskRunQuota_Scope int32
+
+const (
+	SchedulerInfo_TaskRunQuota_SCOPE_UNSPECIFIED SchedulerInfo_TaskRunQuota_Scope = 0
+	SchedulerInfo_TaskRunQuota_INSTANCE          SchedulerInfo_TaskRunQuota_Scope = 1
+	SchedulerInfo_TaskRunQuota_ENVIRONMENT       SchedulerInfo_TaskRunQuota_Scope = 2
+)
+
+// Enum value maps for SchedulerInfo_TaskRunQuota_Scope.
+var (
+	SchedulerInfo_TaskRunQuota_Scope_name = map[int32]string{
+		0: "SCOPE_UNSPECIFIED",
+		1: "INSTANCE",
+		2: "ENVIRONMENT",
+	}
+	SchedulerInfo_TaskRunQuota_Scope_value = map[string]int32{
+		"SCOPE_UNSPECIFIED": 0,
+		"INSTANCE":          1,
+		"ENVIRONMENT":       2,
+	}
+)
+
+func (x SchedulerInfo_TaskRunQuota_Scope) Enum() *SchedulerInfo_TaskRunQuota_Scope {
+	p := new(SchedulerInfo_TaskRunQuota_Scope)
+	*p = x
+	return p
+}
+
+func (x SchedulerInfo_TaskRunQuota_Scope) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SchedulerInfo_TaskRunQuota_Scope) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_task_run_proto_enumTypes[0].Descriptor()
+}
+
+func (SchedulerInfo_TaskRunQuota_Scope) Type() protoreflect.EnumType {
+	return &file_store_task_run_proto_enumTypes[0]
+}
+
+func (x SchedulerInfo_TaskRunQuota_Scope) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SchedulerInfo_TaskRunQuota_Scope.Descriptor instead.
+func (SchedulerInfo_TaskRunQuota_Scope) EnumDescriptor() ([]byte, []int) {
+	return file_store_task_run_proto_rawDescGZIP(), []int{2, 1, 0}
+}
+
 type TaskRunResult struct {
 	state  protoimpl.MessageState `protogen:"open.v1"`
 	Detail string                 `protobuf:"bytes,1,opt,name=detail,proto3" json:"detail,omitempty"`
@@ -403,6 +452,7 @@ type SchedulerInfo_WaitingCause struct {
 	//
 	//	*SchedulerInfo_WaitingCause_ConnectionLimit
 	//	*SchedulerInfo_WaitingCause_TaskUid
+	//	*SchedulerInfo_WaitingCause_TaskRunQuota
 	Cause         isSchedulerInfo_WaitingCause_Cause `protobuf_oneof:"cause"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -463,6 +513,15 @@ func (x *SchedulerInfo_WaitingCause) GetTaskUid() int32 {
 	return 0
 }
 
+func (x *SchedulerInfo_WaitingCause) GetTaskRunQuota() *SchedulerInfo_TaskRunQuota {
+	if x != nil {
+		if x, ok := x.Cause.(*SchedulerInfo_WaitingCause_TaskRunQuota); ok {
+			return x.TaskRunQuota
+		}
+	}
+	return nil
+}
+
 type isSchedulerInfo_WaitingCause_Cause interface {
 	isSchedulerInfo_WaitingCause_Cause()
 }
@@ -475,10 +534,79 @@ type SchedulerInfo_WaitingCause_TaskUid struct {
 	TaskUid int32 `protobuf:"varint,2,opt,name=task_uid,json=taskUid,proto3,oneof"`
 }
 
+type SchedulerInfo_WaitingCause_TaskRunQuota struct {
+	TaskRunQuota *SchedulerInfo_TaskRunQuota `protobuf:"bytes,3,opt,name=task_run_quota,json=taskRunQuota,proto3,oneof"`
+}
+
 func (*SchedulerInfo_WaitingCause_ConnectionLimit) isSchedulerInfo_WaitingCause_Cause() {}
 
 func (*SchedulerInfo_WaitingCause_TaskUid) isSchedulerInfo_WaitingCause_Cause() {}
 
+func (*SchedulerInfo_WaitingCause_TaskRunQuota) isSchedulerInfo_WaitingCause_Cause() {}
+
+type SchedulerInfo_TaskRunQuota struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The scope whose task run concurrency quota is exhausted.
+	Scope SchedulerInfo_TaskRunQuota_Scope `protobuf:"varint,1,opt,name=scope,proto3,enum=bytebase.store.SchedulerInfo_TaskRunQuota_Scope" json:"scope,omitempty"`
+	// The UID of the instance or environment.
+	ResourceUid int32 `protobuf:"varint,2,opt,name=resource_uid,json=resourceUid,proto3" json:"resource_uid,omitempty"`
+	// The 1-based position of the task run in the queue of the scope.
+	QueuePosition int32 `protobuf:"varint,3,opt,name=queue_position,json=queuePosition,proto3" json:"queue_position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchedulerInfo_TaskRunQuota) Reset() {
+	*x = SchedulerInfo_TaskRunQuota{}
+	mi := &file_store_task_run_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchedulerInfo_TaskRunQuota) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchedulerInfo_TaskRunQuota) ProtoMessage() {}
+
+func (x *SchedulerInfo_TaskRunQuota) ProtoReflect() protoreflect.Message {
+	mi := &file_store_task_run_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchedulerInfo_TaskRunQuota.ProtoReflect.Descriptor instead.
+func (*SchedulerInfo_TaskRunQuota) Descriptor() ([]byte, []int) {
+	return file_store_task_run_proto_rawDescGZIP(), []int{2, 1}
+}
+
+func (x *SchedulerInfo_TaskRunQuota) GetScope() SchedulerInfo_TaskRunQuota_Scope {
+	if x != nil {
+		return x.Scope
+	}
+	return SchedulerInfo_TaskRunQuota_SCOPE_UNSPECIFIED
+}
+
+func (x *SchedulerInfo_TaskRunQuota) GetResourceUid() int32 {
+	if x != nil {
+		return x.ResourceUid
+	}
+	return 0
+}
+
+func (x *SchedulerInfo_TaskRunQuota) GetQueuePosition() int32 {
+	if x != nil {
+		return x.QueuePosition
+	}
+	return 0
+}
+
 var File_store_task_run_proto protoreflect.FileDescriptor
 
 var file_store_task_run_proto_rawDesc = []byte{
@@ -544,7 +672,7 @@ var file_store_task_run_proto_rawDesc = []byte{
 	0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x02, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x61,
 	0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65,
-	0x22, 0x80, 0x02, 0x0a, 0x0d, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x49, 0x6e,
+	0x22, 0xb7, 0x04, 0x0a, 0x0d, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x49, 0x6e,
 	0x66, 0x6f, 0x12, 0x3b, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d,
 	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
@@ -554,15 +682,34 @@ var file_store_task_run_proto_rawDesc = []byte{
 	0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
 	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x57, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x61, 0x75,
 	0x73, 0x65, 0x52, 0x0c, 0x77, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x61, 0x75, 0x73, 0x65,
-	0x1a, 0x61, 0x0a, 0x0c, 0x57, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x61, 0x75, 0x73, 0x65,
-	0x12, 0x2b, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6c,
-	0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x0f, 0x63, 0x6f,
-	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1b, 0x0a,
-	0x08, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48,
-	0x00, 0x52, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x55, 0x69, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x63, 0x61,
-	0x75, 0x73, 0x65, 0x42, 0x14, 0x5a, 0x12, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64,
-	0x2d, 0x67, 0x6f, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x1a, 0xb5, 0x01, 0x0a, 0x0c, 0x57, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x61, 0x75, 0x73,
+	0x65, 0x12, 0x2b, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x0f, 0x63,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1b,
+	0x0a, 0x08, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x48, 0x00, 0x52, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x55, 0x69, 0x64, 0x12, 0x52, 0x0a, 0x0e, 0x74,
+	0x61, 0x73, 0x6b, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x49, 0x6e,
+	0x66, 0x6f, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x48,
+	0x00, 0x52, 0x0c, 0x74, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x42,
+	0x07, 0x0a, 0x05, 0x63, 0x61, 0x75, 0x73, 0x65, 0x1a, 0xdf, 0x01, 0x0a, 0x0c, 0x54, 0x61, 0x73,
+	0x6b, 0x52, 0x75, 0x6e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x46, 0x0a, 0x05, 0x73, 0x63, 0x6f,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x30, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x51,
+	0x75, 0x6f, 0x74, 0x61, 0x2e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x75, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x55, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3d, 0x0a, 0x05, 0x53,
+	0x63, 0x6f, 0x70, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x43, 0x4f, 0x50, 0x45, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x49,
+	0x4e, 0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x45, 0x4e, 0x56,
+	0x49, 0x52, 0x4f, 0x4e, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x02, 0x42, 0x14, 0x5a, 0x12, 0x67, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2d, 0x67, 0x6f, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -577,34 +724,39 @@ func file_store_task_run_proto_rawDescGZIP() []byte {
 	return file_store_task_run_proto_rawDescData
 }
 
-var file_store_task_run_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_store_task_run_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_store_task_run_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_store_task_run_proto_goTypes = []any{
-	(*TaskRunResult)(nil),                // 0: bytebase.store.TaskRunResult
-	(*PriorBackupDetail)(nil),            // 1: bytebase.store.PriorBackupDetail
-	(*SchedulerInfo)(nil),                // 2: bytebase.store.SchedulerInfo
-	(*TaskRunResult_Position)(nil),       // 3: bytebase.store.TaskRunResult.Position
-	(*PriorBackupDetail_Item)(nil),       // 4: bytebase.store.PriorBackupDetail.Item
-	(*PriorBackupDetail_Item_Table)(nil), // 5: bytebase.store.PriorBackupDetail.Item.Table
-	(*SchedulerInfo_WaitingCause)(nil),   // 6: bytebase.store.SchedulerInfo.WaitingCause
-	(*timestamppb.Timestamp)(nil),        // 7: google.protobuf.Timestamp
-	(*Position)(nil),                     // 8: bytebase.store.Position
+	(SchedulerInfo_TaskRunQuota_Scope)(0), // 0: bytebase.store.SchedulerInfo.TaskRunQuota.Scope
+	(*TaskRunResult)(nil),                 // 1: bytebase.store.TaskRunResult
+	(*PriorBackupDetail)(nil),             // 2: bytebase.store.PriorBackupDetail
+	(*SchedulerInfo)(nil),                 // 3: bytebase.store.SchedulerInfo
+	(*TaskRunResult_Position)(nil),        // 4: bytebase.store.TaskRunResult.Position
+	(*PriorBackupDetail_Item)(nil),        // 5: bytebase.store.PriorBackupDetail.Item
+	(*PriorBackupDetail_Item_Table)(nil),  // 6: bytebase.store.PriorBackupDetail.Item.Table
+	(*SchedulerInfo_WaitingCause)(nil),    // 7: bytebase.store.SchedulerInfo.WaitingCause
+	(*SchedulerInfo_TaskRunQuota)(nil),    // 8: bytebase.store.SchedulerInfo.TaskRunQuota
+	(*timestamppb.Timestamp)(nil),         // 9: google.protobuf.Timestamp
+	(*Position)(nil),                      // 10: bytebase.store.Position
 }
 var file_store_task_run_proto_depIdxs = []int32{
-	3,  // 0: bytebase.store.TaskRunResult.start_position:type_name -> bytebase.store.TaskRunResult.Position
-	3,  // 1: bytebase.store.TaskRunResult.end_position:type_name -> bytebase.store.TaskRunResult.Position
-	1,  // 2: bytebase.store.TaskRunResult.prior_backup_detail:type_name -> bytebase.store.PriorBackupDetail
-	4,  // 3: bytebase.store.PriorBackupDetail.items:type_name -> bytebase.store.PriorBackupDetail.Item
-	7,  // 4: bytebase.store.SchedulerInfo.report_time:type_name -> google.protobuf.Timestamp
-	6,  // 5: bytebase.store.SchedulerInfo.waiting_cause:type_name -> bytebase.store.SchedulerInfo.WaitingCause
-	5,  // 6: bytebase.store.PriorBackupDetail.Item.source_table:type_name -> bytebase.store.PriorBackupDetail.Item.Table
-	5,  // 7: bytebase.store.PriorBackupDetail.Item.target_table:type_name -> bytebase.store.PriorBackupDetail.Item.Table
-	8,  // 8: bytebase.store.PriorBackupDetail.Item.start_position:type_name -> bytebase.store.Position
-	8,  // 9: bytebase.store.PriorBackupDetail.Item.end_position:type_name -> bytebase.store.Position
-	10, // [10:10] is the sub-list for method output_type
-	10, // [10:10] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	4,  // 0: bytebase.store.TaskRunResult.start_position:type_name -> bytebase.store.TaskRunResult.Position
+	4,  // 1: bytebase.store.TaskRunResult.end_position:type_name -> bytebase.store.TaskRunResult.Position
+	2,  // 2: bytebase.store.TaskRunResult.prior_backup_detail:type_name -> bytebase.store.PriorBackupDetail
+	5,  // 3: bytebase.store.PriorBackupDetail.items:type_name -> bytebase.store.PriorBackupDetail.Item
+	9,  // 4: bytebase.store.SchedulerInfo.report_time:type_name -> google.protobuf.Timestamp
+	7,  // 5: bytebase.store.SchedulerInfo.waiting_cause:type_name -> bytebase.store.SchedulerInfo.WaitingCause
+	6,  // 6: bytebase.store.PriorBackupDetail.Item.source_table:type_name -> bytebase.store.PriorBackupDetail.Item.Table
+	6,  // 7: bytebase.store.PriorBackupDetail.Item.target_table:type_name -> bytebase.store.PriorBackupDetail.Item.Table
+	10, // 8: bytebase.store.PriorBackupDetail.Item.start_position:type_name -> bytebase.store.Position
+	10, // 9: bytebase.store.PriorBackupDetail.Item.end_position:type_name -> bytebase.store.Position
+	8,  // 10: bytebase.store.SchedulerInfo.WaitingCause.task_run_quota:type_name -> bytebase.store.SchedulerInfo.TaskRunQuota
+	0,  // 11: bytebase.store.SchedulerInfo.TaskRunQuota.scope:type_name -> bytebase.store.SchedulerInfo.TaskRunQuota.Scope
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_store_task_run_proto_init() }
@@ -616,19 +768,21 @@ func file_store_task_run_proto_init() {
 	file_store_task_run_proto_msgTypes[6].OneofWrappers = []any{
 		(*SchedulerInfo_WaitingCause_ConnectionLimit)(nil),
 		(*SchedulerInfo_WaitingCause_TaskUid)(nil),
+		(*SchedulerInfo_WaitingCause_TaskRunQuota)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_store_task_run_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   7,
+			NumEnums:      1,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_store_task_run_proto_goTypes,
 		DependencyIndexes: file_store_task_run_proto_depIdxs,
+		EnumInfos:         file_store_task_run_proto_enumTypes,
 		MessageInfos:      file_store_task_run_proto_msgTypes,
 	}.Build()
 	File_store_task_run_proto = out.File
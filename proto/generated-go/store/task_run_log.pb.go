@@ -34,6 +34,8 @@ const (
 	TaskRunLog_TRANSACTION_CONTROL    TaskRunLog_Type = 8
 	TaskRunLog_PRIOR_BACKUP_START     TaskRunLog_Type = 9
 	TaskRunLog_PRIOR_BACKUP_END       TaskRunLog_Type = 10
+	TaskRunLog_HOOK_EXECUTE_START     TaskRunLog_Type = 11
+	TaskRunLog_HOOK_EXECUTE_END       TaskRunLog_Type = 12
 )
 
 // Enum value maps for TaskRunLog_Type.
@@ -50,6 +52,8 @@ var (
 		8:  "TRANSACTION_CONTROL",
 		9:  "PRIOR_BACKUP_START",
 		10: "PRIOR_BACKUP_END",
+		11: "HOOK_EXECUTE_START",
+		12: "HOOK_EXECUTE_END",
 	}
 	TaskRunLog_Type_value = map[string]int32{
 		"TYPE_UNSPECIFIED":       0,
@@ -63,6 +67,8 @@ var (
 		"TRANSACTION_CONTROL":    8,
 		"PRIOR_BACKUP_START":     9,
 		"PRIOR_BACKUP_END":       10,
+		"HOOK_EXECUTE_START":     11,
+		"HOOK_EXECUTE_END":       12,
 	}
 )
 
@@ -196,6 +202,55 @@ func (TaskRunLog_TransactionControl_Type) EnumDescriptor() ([]byte, []int) {
 	return file_store_task_run_log_proto_rawDescGZIP(), []int{0, 7, 0}
 }
 
+type TaskRunLog_HookExecuteStart_Phase int32
+
+const (
+	TaskRunLog_HookExecuteStart_PHASE_UNSPECIFIED TaskRunLog_HookExecuteStart_Phase = 0
+	TaskRunLog_HookExecuteStart_PRE               TaskRunLog_HookExecuteStart_Phase = 1
+	TaskRunLog_HookExecuteStart_POST              TaskRunLog_HookExecuteStart_Phase = 2
+)
+
+// Enum value maps for TaskRunLog_HookExecuteStart_Phase.
+var (
+	TaskRunLog_HookExecuteStart_Phase_name = map[int32]string{
+		0: "PHASE_UNSPECIFIED",
+		1: "PRE",
+		2: "POST",
+	}
+	TaskRunLog_HookExecuteStart_Phase_value = map[string]int32{
+		"PHASE_UNSPECIFIED": 0,
+		"PRE":               1,
+		"POST":              2,
+	}
+)
+
+func (x TaskRunLog_HookExecuteStart_Phase) Enum() *TaskRunLog_HookExecuteStart_Phase {
+	p := new(TaskRunLog_HookExecuteStart_Phase)
+	*p = x
+	return p
+}
+
+func (x TaskRunLog_HookExecuteStart_Phase) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TaskRunLog_HookExecuteStart_Phase) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_task_run_log_proto_enumTypes[3].Descriptor()
+}
+
+func (TaskRunLog_HookExecuteStart_Phase) Type() protoreflect.EnumType {
+	return &file_store_task_run_log_proto_enumTypes[3]
+}
+
+func (x TaskRunLog_HookExecuteStart_Phase) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TaskRunLog_HookExecuteStart_Phase.Descriptor instead.
+func (TaskRunLog_HookExecuteStart_Phase) EnumDescriptor() ([]byte, []int) {
+	return file_store_task_run_log_proto_rawDescGZIP(), []int{0, 10, 0}
+}
+
 type TaskRunLog struct {
 	state               protoimpl.MessageState          `protogen:"open.v1"`
 	Type                TaskRunLog_Type                 `protobuf:"varint,1,opt,name=type,proto3,enum=bytebase.store.TaskRunLog_Type" json:"type,omitempty"`
@@ -210,6 +265,8 @@ type TaskRunLog struct {
 	TransactionControl  *TaskRunLog_TransactionControl  `protobuf:"bytes,9,opt,name=transaction_control,json=transactionControl,proto3" json:"transaction_control,omitempty"`
 	PriorBackupStart    *TaskRunLog_PriorBackupStart    `protobuf:"bytes,10,opt,name=prior_backup_start,json=priorBackupStart,proto3" json:"prior_backup_start,omitempty"`
 	PriorBackupEnd      *TaskRunLog_PriorBackupEnd      `protobuf:"bytes,11,opt,name=prior_backup_end,json=priorBackupEnd,proto3" json:"prior_backup_end,omitempty"`
+	HookExecuteStart    *TaskRunLog_HookExecuteStart    `protobuf:"bytes,13,opt,name=hook_execute_start,json=hookExecuteStart,proto3" json:"hook_execute_start,omitempty"`
+	HookExecuteEnd      *TaskRunLog_HookExecuteEnd      `protobuf:"bytes,14,opt,name=hook_execute_end,json=hookExecuteEnd,proto3" json:"hook_execute_end,omitempty"`
 	unknownFields       protoimpl.UnknownFields
 	sizeCache           protoimpl.SizeCache
 }
@@ -328,6 +385,20 @@ func (x *TaskRunLog) GetPriorBackupEnd() *TaskRunLog_PriorBackupEnd {
 	return nil
 }
 
+func (x *TaskRunLog) GetHookExecuteStart() *TaskRunLog_HookExecuteStart {
+	if x != nil {
+		return x.HookExecuteStart
+	}
+	return nil
+}
+
+func (x *TaskRunLog) GetHookExecuteEnd() *TaskRunLog_HookExecuteEnd {
+	if x != nil {
+		return x.HookExecuteEnd
+	}
+	return nil
+}
+
 type TaskRunLog_SchemaDumpStart struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -788,6 +859,103 @@ func (x *TaskRunLog_PriorBackupEnd) GetError() string {
 	return ""
 }
 
+type TaskRunLog_HookExecuteStart struct {
+	state protoimpl.MessageState            `protogen:"open.v1"`
+	Phase TaskRunLog_HookExecuteStart_Phase `protobuf:"varint,1,opt,name=phase,proto3,enum=bytebase.store.TaskRunLog_HookExecuteStart_Phase" json:"phase,omitempty"`
+	// The rendered hook statement.
+	Statement     string `protobuf:"bytes,2,opt,name=statement,proto3" json:"statement,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskRunLog_HookExecuteStart) Reset() {
+	*x = TaskRunLog_HookExecuteStart{}
+	mi := &file_store_task_run_log_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskRunLog_HookExecuteStart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskRunLog_HookExecuteStart) ProtoMessage() {}
+
+func (x *TaskRunLog_HookExecuteStart) ProtoReflect() protoreflect.Message {
+	mi := &file_store_task_run_log_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskRunLog_HookExecuteStart.ProtoReflect.Descriptor instead.
+func (*TaskRunLog_HookExecuteStart) Descriptor() ([]byte, []int) {
+	return file_store_task_run_log_proto_rawDescGZIP(), []int{0, 10}
+}
+
+func (x *TaskRunLog_HookExecuteStart) GetPhase() TaskRunLog_HookExecuteStart_Phase {
+	if x != nil {
+		return x.Phase
+	}
+	return TaskRunLog_HookExecuteStart_PHASE_UNSPECIFIED
+}
+
+func (x *TaskRunLog_HookExecuteStart) GetStatement() string {
+	if x != nil {
+		return x.Statement
+	}
+	return ""
+}
+
+type TaskRunLog_HookExecuteEnd struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Error         string                 `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskRunLog_HookExecuteEnd) Reset() {
+	*x = TaskRunLog_HookExecuteEnd{}
+	mi := &file_store_task_run_log_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskRunLog_HookExecuteEnd) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskRunLog_HookExecuteEnd) ProtoMessage() {}
+
+func (x *TaskRunLog_HookExecuteEnd) ProtoReflect() protoreflect.Message {
+	mi := &file_store_task_run_log_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskRunLog_HookExecuteEnd.ProtoReflect.Descriptor instead.
+func (*TaskRunLog_HookExecuteEnd) Descriptor() ([]byte, []int) {
+	return file_store_task_run_log_proto_rawDescGZIP(), []int{0, 11}
+}
+
+func (x *TaskRunLog_HookExecuteEnd) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
 var File_store_task_run_log_proto protoreflect.FileDescriptor
 
 var file_store_task_run_log_proto_rawDesc = []byte{
@@ -795,7 +963,7 @@ var file_store_task_run_log_proto_rawDesc = []byte{
 	0x5f, 0x6c, 0x6f, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x62, 0x79, 0x74, 0x65,
 	0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x1a, 0x14, 0x73, 0x74, 0x6f, 0x72,
 	0x65, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x72, 0x75, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0xb8, 0x10, 0x0a, 0x0a, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x4c, 0x6f, 0x67, 0x12,
+	0x22, 0xed, 0x13, 0x0a, 0x0a, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x4c, 0x6f, 0x67, 0x12,
 	0x33, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e,
 	0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x54,
 	0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x4c, 0x6f, 0x67, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
@@ -857,6 +1025,17 @@ var file_store_task_run_log_proto_rawDesc = []byte{
 	0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x4c,
 	0x6f, 0x67, 0x2e, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x45, 0x6e,
 	0x64, 0x52, 0x0e, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x45, 0x6e,
+	0x64, 0x12, 0x59, 0x0a, 0x12, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x65, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e,
+	0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x54,
+	0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x4c, 0x6f, 0x67, 0x2e, 0x48, 0x6f, 0x6f, 0x6b, 0x45, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x10, 0x68, 0x6f, 0x6f, 0x6b,
+	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x53, 0x0a, 0x10,
+	0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x5f, 0x65, 0x6e, 0x64,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x4c,
+	0x6f, 0x67, 0x2e, 0x48, 0x6f, 0x6f, 0x6b, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x45, 0x6e,
+	0x64, 0x52, 0x0e, 0x68, 0x6f, 0x6f, 0x6b, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x45, 0x6e,
 	0x64, 0x1a, 0x11, 0x0a, 0x0f, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x44, 0x75, 0x6d, 0x70, 0x53,
 	0x74, 0x61, 0x72, 0x74, 0x1a, 0x25, 0x0a, 0x0d, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x44, 0x75,
 	0x6d, 0x70, 0x45, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01,
@@ -910,25 +1089,41 @@ var file_store_task_run_log_proto_rawDesc = []byte{
 	0x6b, 0x75, 0x70, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x11, 0x70, 0x72, 0x69, 0x6f, 0x72,
 	0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x14, 0x0a, 0x05,
 	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x22, 0x86, 0x02, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x54,
-	0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
-	0x00, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x41, 0x5f, 0x44, 0x55, 0x4d, 0x50,
-	0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x43, 0x48, 0x45,
-	0x4d, 0x41, 0x5f, 0x44, 0x55, 0x4d, 0x50, 0x5f, 0x45, 0x4e, 0x44, 0x10, 0x02, 0x12, 0x13, 0x0a,
-	0x0f, 0x43, 0x4f, 0x4d, 0x4d, 0x41, 0x4e, 0x44, 0x5f, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x45,
-	0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x4f, 0x4d, 0x4d, 0x41, 0x4e, 0x44, 0x5f, 0x52, 0x45,
-	0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x10, 0x04, 0x12, 0x17, 0x0a, 0x13, 0x44, 0x41, 0x54, 0x41,
-	0x42, 0x41, 0x53, 0x45, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x10,
-	0x05, 0x12, 0x15, 0x0a, 0x11, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x5f, 0x53, 0x59,
-	0x4e, 0x43, 0x5f, 0x45, 0x4e, 0x44, 0x10, 0x06, 0x12, 0x1a, 0x0a, 0x16, 0x54, 0x41, 0x53, 0x4b,
-	0x5f, 0x52, 0x55, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x50, 0x44, 0x41,
-	0x54, 0x45, 0x10, 0x07, 0x12, 0x17, 0x0a, 0x13, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54,
-	0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x10, 0x08, 0x12, 0x16, 0x0a,
-	0x12, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x55, 0x50, 0x5f, 0x53, 0x54,
-	0x41, 0x52, 0x54, 0x10, 0x09, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x5f, 0x42,
-	0x41, 0x43, 0x4b, 0x55, 0x50, 0x5f, 0x45, 0x4e, 0x44, 0x10, 0x0a, 0x42, 0x14, 0x5a, 0x12, 0x67,
-	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2d, 0x67, 0x6f, 0x2f, 0x73, 0x74, 0x6f, 0x72,
-	0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x72, 0x1a, 0xac, 0x01, 0x0a, 0x10, 0x48, 0x6f, 0x6f, 0x6b, 0x45, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x47, 0x0a, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x31, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x4c,
+	0x6f, 0x67, 0x2e, 0x48, 0x6f, 0x6f, 0x6b, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x2e, 0x50, 0x68, 0x61, 0x73, 0x65, 0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x31,
+	0x0a, 0x05, 0x50, 0x68, 0x61, 0x73, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x48, 0x41, 0x53, 0x45,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x07,
+	0x0a, 0x03, 0x50, 0x52, 0x45, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x4f, 0x53, 0x54, 0x10,
+	0x02, 0x1a, 0x26, 0x0a, 0x0e, 0x48, 0x6f, 0x6f, 0x6b, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65,
+	0x45, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xb4, 0x02, 0x0a, 0x04, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x43, 0x48, 0x45,
+	0x4d, 0x41, 0x5f, 0x44, 0x55, 0x4d, 0x50, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x10, 0x01, 0x12,
+	0x13, 0x0a, 0x0f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x41, 0x5f, 0x44, 0x55, 0x4d, 0x50, 0x5f, 0x45,
+	0x4e, 0x44, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x43, 0x4f, 0x4d, 0x4d, 0x41, 0x4e, 0x44, 0x5f,
+	0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x45, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x4f, 0x4d,
+	0x4d, 0x41, 0x4e, 0x44, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x10, 0x04, 0x12,
+	0x17, 0x0a, 0x13, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x5f, 0x53, 0x59, 0x4e, 0x43,
+	0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x10, 0x05, 0x12, 0x15, 0x0a, 0x11, 0x44, 0x41, 0x54, 0x41,
+	0x42, 0x41, 0x53, 0x45, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x45, 0x4e, 0x44, 0x10, 0x06, 0x12,
+	0x1a, 0x0a, 0x16, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x52, 0x55, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x07, 0x12, 0x17, 0x0a, 0x13, 0x54,
+	0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52,
+	0x4f, 0x4c, 0x10, 0x08, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x5f, 0x42, 0x41,
+	0x43, 0x4b, 0x55, 0x50, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x10, 0x09, 0x12, 0x14, 0x0a, 0x10,
+	0x50, 0x52, 0x49, 0x4f, 0x52, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x55, 0x50, 0x5f, 0x45, 0x4e, 0x44,
+	0x10, 0x0a, 0x12, 0x16, 0x0a, 0x12, 0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x45, 0x58, 0x45, 0x43, 0x55,
+	0x54, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x10, 0x0b, 0x12, 0x14, 0x0a, 0x10, 0x48, 0x4f,
+	0x4f, 0x4b, 0x5f, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x45, 0x5f, 0x45, 0x4e, 0x44, 0x10, 0x0c,
+	0x42, 0x14, 0x5a, 0x12, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2d, 0x67, 0x6f,
+	0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -943,45 +1138,51 @@ func file_store_task_run_log_proto_rawDescGZIP() []byte {
 	return file_store_task_run_log_proto_rawDescData
 }
 
-var file_store_task_run_log_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_store_task_run_log_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_store_task_run_log_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_store_task_run_log_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_store_task_run_log_proto_goTypes = []any{
 	(TaskRunLog_Type)(0),                       // 0: bytebase.store.TaskRunLog.Type
 	(TaskRunLog_TaskRunStatusUpdate_Status)(0), // 1: bytebase.store.TaskRunLog.TaskRunStatusUpdate.Status
 	(TaskRunLog_TransactionControl_Type)(0),    // 2: bytebase.store.TaskRunLog.TransactionControl.Type
-	(*TaskRunLog)(nil),                         // 3: bytebase.store.TaskRunLog
-	(*TaskRunLog_SchemaDumpStart)(nil),         // 4: bytebase.store.TaskRunLog.SchemaDumpStart
-	(*TaskRunLog_SchemaDumpEnd)(nil),           // 5: bytebase.store.TaskRunLog.SchemaDumpEnd
-	(*TaskRunLog_CommandExecute)(nil),          // 6: bytebase.store.TaskRunLog.CommandExecute
-	(*TaskRunLog_CommandResponse)(nil),         // 7: bytebase.store.TaskRunLog.CommandResponse
-	(*TaskRunLog_DatabaseSyncStart)(nil),       // 8: bytebase.store.TaskRunLog.DatabaseSyncStart
-	(*TaskRunLog_DatabaseSyncEnd)(nil),         // 9: bytebase.store.TaskRunLog.DatabaseSyncEnd
-	(*TaskRunLog_TaskRunStatusUpdate)(nil),     // 10: bytebase.store.TaskRunLog.TaskRunStatusUpdate
-	(*TaskRunLog_TransactionControl)(nil),      // 11: bytebase.store.TaskRunLog.TransactionControl
-	(*TaskRunLog_PriorBackupStart)(nil),        // 12: bytebase.store.TaskRunLog.PriorBackupStart
-	(*TaskRunLog_PriorBackupEnd)(nil),          // 13: bytebase.store.TaskRunLog.PriorBackupEnd
-	(*PriorBackupDetail)(nil),                  // 14: bytebase.store.PriorBackupDetail
+	(TaskRunLog_HookExecuteStart_Phase)(0),     // 3: bytebase.store.TaskRunLog.HookExecuteStart.Phase
+	(*TaskRunLog)(nil),                         // 4: bytebase.store.TaskRunLog
+	(*TaskRunLog_SchemaDumpStart)(nil),         // 5: bytebase.store.TaskRunLog.SchemaDumpStart
+	(*TaskRunLog_SchemaDumpEnd)(nil),           // 6: bytebase.store.TaskRunLog.SchemaDumpEnd
+	(*TaskRunLog_CommandExecute)(nil),          // 7: bytebase.store.TaskRunLog.CommandExecute
+	(*TaskRunLog_CommandResponse)(nil),         // 8: bytebase.store.TaskRunLog.CommandResponse
+	(*TaskRunLog_DatabaseSyncStart)(nil),       // 9: bytebase.store.TaskRunLog.DatabaseSyncStart
+	(*TaskRunLog_DatabaseSyncEnd)(nil),         // 10: bytebase.store.TaskRunLog.DatabaseSyncEnd
+	(*TaskRunLog_TaskRunStatusUpdate)(nil),     // 11: bytebase.store.TaskRunLog.TaskRunStatusUpdate
+	(*TaskRunLog_TransactionControl)(nil),      // 12: bytebase.store.TaskRunLog.TransactionControl
+	(*TaskRunLog_PriorBackupStart)(nil),        // 13: bytebase.store.TaskRunLog.PriorBackupStart
+	(*TaskRunLog_PriorBackupEnd)(nil),          // 14: bytebase.store.TaskRunLog.PriorBackupEnd
+	(*TaskRunLog_HookExecuteStart)(nil),        // 15: bytebase.store.TaskRunLog.HookExecuteStart
+	(*TaskRunLog_HookExecuteEnd)(nil),          // 16: bytebase.store.TaskRunLog.HookExecuteEnd
+	(*PriorBackupDetail)(nil),                  // 17: bytebase.store.PriorBackupDetail
 }
 var file_store_task_run_log_proto_depIdxs = []int32{
 	0,  // 0: bytebase.store.TaskRunLog.type:type_name -> bytebase.store.TaskRunLog.Type
-	4,  // 1: bytebase.store.TaskRunLog.schema_dump_start:type_name -> bytebase.store.TaskRunLog.SchemaDumpStart
-	5,  // 2: bytebase.store.TaskRunLog.schema_dump_end:type_name -> bytebase.store.TaskRunLog.SchemaDumpEnd
-	6,  // 3: bytebase.store.TaskRunLog.command_execute:type_name -> bytebase.store.TaskRunLog.CommandExecute
-	7,  // 4: bytebase.store.TaskRunLog.command_response:type_name -> bytebase.store.TaskRunLog.CommandResponse
-	8,  // 5: bytebase.store.TaskRunLog.database_sync_start:type_name -> bytebase.store.TaskRunLog.DatabaseSyncStart
-	9,  // 6: bytebase.store.TaskRunLog.database_sync_end:type_name -> bytebase.store.TaskRunLog.DatabaseSyncEnd
-	10, // 7: bytebase.store.TaskRunLog.task_run_status_update:type_name -> bytebase.store.TaskRunLog.TaskRunStatusUpdate
-	11, // 8: bytebase.store.TaskRunLog.transaction_control:type_name -> bytebase.store.TaskRunLog.TransactionControl
-	12, // 9: bytebase.store.TaskRunLog.prior_backup_start:type_name -> bytebase.store.TaskRunLog.PriorBackupStart
-	13, // 10: bytebase.store.TaskRunLog.prior_backup_end:type_name -> bytebase.store.TaskRunLog.PriorBackupEnd
-	1,  // 11: bytebase.store.TaskRunLog.TaskRunStatusUpdate.status:type_name -> bytebase.store.TaskRunLog.TaskRunStatusUpdate.Status
-	2,  // 12: bytebase.store.TaskRunLog.TransactionControl.type:type_name -> bytebase.store.TaskRunLog.TransactionControl.Type
-	14, // 13: bytebase.store.TaskRunLog.PriorBackupEnd.prior_backup_detail:type_name -> bytebase.store.PriorBackupDetail
-	14, // [14:14] is the sub-list for method output_type
-	14, // [14:14] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	5,  // 1: bytebase.store.TaskRunLog.schema_dump_start:type_name -> bytebase.store.TaskRunLog.SchemaDumpStart
+	6,  // 2: bytebase.store.TaskRunLog.schema_dump_end:type_name -> bytebase.store.TaskRunLog.SchemaDumpEnd
+	7,  // 3: bytebase.store.TaskRunLog.command_execute:type_name -> bytebase.store.TaskRunLog.CommandExecute
+	8,  // 4: bytebase.store.TaskRunLog.command_response:type_name -> bytebase.store.TaskRunLog.CommandResponse
+	9,  // 5: bytebase.store.TaskRunLog.database_sync_start:type_name -> bytebase.store.TaskRunLog.DatabaseSyncStart
+	10, // 6: bytebase.store.TaskRunLog.database_sync_end:type_name -> bytebase.store.TaskRunLog.DatabaseSyncEnd
+	11, // 7: bytebase.store.TaskRunLog.task_run_status_update:type_name -> bytebase.store.TaskRunLog.TaskRunStatusUpdate
+	12, // 8: bytebase.store.TaskRunLog.transaction_control:type_name -> bytebase.store.TaskRunLog.TransactionControl
+	13, // 9: bytebase.store.TaskRunLog.prior_backup_start:type_name -> bytebase.store.TaskRunLog.PriorBackupStart
+	14, // 10: bytebase.store.TaskRunLog.prior_backup_end:type_name -> bytebase.store.TaskRunLog.PriorBackupEnd
+	15, // 11: bytebase.store.TaskRunLog.hook_execute_start:type_name -> bytebase.store.TaskRunLog.HookExecuteStart
+	16, // 12: bytebase.store.TaskRunLog.hook_execute_end:type_name -> bytebase.store.TaskRunLog.HookExecuteEnd
+	1,  // 13: bytebase.store.TaskRunLog.TaskRunStatusUpdate.status:type_name -> bytebase.store.TaskRunLog.TaskRunStatusUpdate.Status
+	2,  // 14: bytebase.store.TaskRunLog.TransactionControl.type:type_name -> bytebase.store.TaskRunLog.TransactionControl.Type
+	17, // 15: bytebase.store.TaskRunLog.PriorBackupEnd.prior_backup_detail:type_name -> bytebase.store.PriorBackupDetail
+	3,  // 16: bytebase.store.TaskRunLog.HookExecuteStart.phase:type_name -> bytebase.store.TaskRunLog.HookExecuteStart.Phase
+	17, // [17:17] is the sub-list for method output_type
+	17, // [17:17] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_store_task_run_log_proto_init() }
@@ -995,8 +1196,8 @@ func file_store_task_run_log_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_store_task_run_log_proto_rawDesc,
-			NumEnums:      3,
-			NumMessages:   11,
+			NumEnums:      4,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
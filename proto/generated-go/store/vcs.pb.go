@@ -45,6 +45,8 @@ type VCSConnector struct {
 	// Apply changes to the database group. Optional, if not set, will apply changes to all databases in the project.
 	// Format: projects/{project}/databaseGroups/{databaseGroup}
 	DatabaseGroup string `protobuf:"bytes,9,opt,name=database_group,json=databaseGroup,proto3" json:"database_group,omitempty"`
+	// Write the deployed schema and the changelog back to the repository after each rollout stage.
+	WriteBack     *VCSConnector_WriteBack `protobuf:"bytes,10,opt,name=write_back,json=writeBack,proto3" json:"write_back,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -142,12 +144,92 @@ func (x *VCSConnector) GetDatabaseGroup() string {
 	return ""
 }
 
+func (x *VCSConnector) GetWriteBack() *VCSConnector_WriteBack {
+	if x != nil {
+		return x.WriteBack
+	}
+	return nil
+}
+
+type VCSConnector_WriteBack struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether to write the schema snapshots and the changelog back to the repository after the rollout.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// The branch to write back to. If empty, the connector branch is used.
+	Branch string `protobuf:"bytes,2,opt,name=branch,proto3" json:"branch,omitempty"`
+	// The directory to write the files to. e.g. /bytebase
+	// The files are written to {directory}/{environment}/{instance}/{database}.sql and {database}.changelog.json.
+	Directory string `protobuf:"bytes,3,opt,name=directory,proto3" json:"directory,omitempty"`
+	// Whether to open a pull request against the branch instead of pushing to the branch directly.
+	CreatePullRequest bool `protobuf:"varint,4,opt,name=create_pull_request,json=createPullRequest,proto3" json:"create_pull_request,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *VCSConnector_WriteBack) Reset() {
+	*x = VCSConnector_WriteBack{}
+	mi := &file_store_vcs_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VCSConnector_WriteBack) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VCSConnector_WriteBack) ProtoMessage() {}
+
+func (x *VCSConnector_WriteBack) ProtoReflect() protoreflect.Message {
+	mi := &file_store_vcs_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VCSConnector_WriteBack.ProtoReflect.Descriptor instead.
+func (*VCSConnector_WriteBack) Descriptor() ([]byte, []int) {
+	return file_store_vcs_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *VCSConnector_WriteBack) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *VCSConnector_WriteBack) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+func (x *VCSConnector_WriteBack) GetDirectory() string {
+	if x != nil {
+		return x.Directory
+	}
+	return ""
+}
+
+func (x *VCSConnector_WriteBack) GetCreatePullRequest() bool {
+	if x != nil {
+		return x.CreatePullRequest
+	}
+	return false
+}
+
 var File_store_vcs_proto protoreflect.FileDescriptor
 
 var file_store_vcs_proto_rawDesc = []byte{
 	0x0a, 0x0f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x76, 0x63, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x12, 0x0e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72,
-	0x65, 0x22, 0xc3, 0x02, 0x0a, 0x0c, 0x56, 0x43, 0x53, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x65, 0x22, 0x98, 0x04, 0x0a, 0x0c, 0x56, 0x43, 0x53, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
 	0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x75, 0x6c, 0x6c,
 	0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x75, 0x6c,
@@ -167,9 +249,22 @@ var file_store_vcs_proto_rawDesc = []byte{
 	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
 	0x12, 0x25, 0x0a, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x67, 0x72, 0x6f,
 	0x75, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61,
-	0x73, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x14, 0x5a, 0x12, 0x67, 0x65, 0x6e, 0x65, 0x72,
-	0x61, 0x74, 0x65, 0x64, 0x2d, 0x67, 0x6f, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x45, 0x0a, 0x0a, 0x77, 0x72, 0x69, 0x74, 0x65,
+	0x5f, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x62, 0x79,
+	0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x56, 0x43, 0x53,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x42,
+	0x61, 0x63, 0x6b, 0x52, 0x09, 0x77, 0x72, 0x69, 0x74, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x1a, 0x8b,
+	0x01, 0x0a, 0x09, 0x57, 0x72, 0x69, 0x74, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x12, 0x18, 0x0a, 0x07,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x1c,
+	0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x2e, 0x0a, 0x13,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x75, 0x6c, 0x6c, 0x5f, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x50, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x14, 0x5a, 0x12,
+	0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2d, 0x67, 0x6f, 0x2f, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -184,16 +279,18 @@ func file_store_vcs_proto_rawDescGZIP() []byte {
 	return file_store_vcs_proto_rawDescData
 }
 
-var file_store_vcs_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_store_vcs_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_store_vcs_proto_goTypes = []any{
-	(*VCSConnector)(nil), // 0: bytebase.store.VCSConnector
+	(*VCSConnector)(nil),           // 0: bytebase.store.VCSConnector
+	(*VCSConnector_WriteBack)(nil), // 1: bytebase.store.VCSConnector.WriteBack
 }
 var file_store_vcs_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	1, // 0: bytebase.store.VCSConnector.write_back:type_name -> bytebase.store.VCSConnector.WriteBack
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_store_vcs_proto_init() }
@@ -207,7 +304,7 @@ func file_store_vcs_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_store_vcs_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -203,6 +203,8 @@ const (
 	VCSType_BITBUCKET VCSType = 3
 	// Azure DevOps. Using for Azure DevOps GitOps workflow.
 	VCSType_AZURE_DEVOPS VCSType = 4
+	// Gitea. Using for Gitea and self-hosted Forgejo.
+	VCSType_GITEA VCSType = 5
 )
 
 // Enum value maps for VCSType.
@@ -213,6 +215,7 @@ var (
 		2: "GITLAB",
 		3: "BITBUCKET",
 		4: "AZURE_DEVOPS",
+		5: "GITEA",
 	}
 	VCSType_value = map[string]int32{
 		"VCS_TYPE_UNSPECIFIED": 0,
@@ -220,6 +223,7 @@ var (
 		"GITLAB":               2,
 		"BITBUCKET":            3,
 		"AZURE_DEVOPS":         4,
+		"GITEA":                5,
 	}
 )
 
@@ -448,19 +452,20 @@ var file_v1_common_proto_rawDesc = []byte{
 	0x4e, 0x41, 0x4d, 0x4f, 0x44, 0x42, 0x10, 0x17, 0x12, 0x0e, 0x0a, 0x0a, 0x44, 0x41, 0x54, 0x41,
 	0x42, 0x52, 0x49, 0x43, 0x4b, 0x53, 0x10, 0x18, 0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x4f, 0x43, 0x4b,
 	0x52, 0x4f, 0x41, 0x43, 0x48, 0x44, 0x42, 0x10, 0x19, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x4f, 0x53,
-	0x4d, 0x4f, 0x53, 0x44, 0x42, 0x10, 0x1a, 0x2a, 0x5c, 0x0a, 0x07, 0x56, 0x43, 0x53, 0x54, 0x79,
+	0x4d, 0x4f, 0x53, 0x44, 0x42, 0x10, 0x1a, 0x2a, 0x67, 0x0a, 0x07, 0x56, 0x43, 0x53, 0x54, 0x79,
 	0x70, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x56, 0x43, 0x53, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55,
 	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06,
 	0x47, 0x49, 0x54, 0x48, 0x55, 0x42, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x47, 0x49, 0x54, 0x4c,
 	0x41, 0x42, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x42, 0x49, 0x54, 0x42, 0x55, 0x43, 0x4b, 0x45,
 	0x54, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x41, 0x5a, 0x55, 0x52, 0x45, 0x5f, 0x44, 0x45, 0x56,
-	0x4f, 0x50, 0x53, 0x10, 0x04, 0x2a, 0x4c, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46,
-	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x12, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f,
-	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x07, 0x0a,
-	0x03, 0x43, 0x53, 0x56, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x02,
-	0x12, 0x07, 0x0a, 0x03, 0x53, 0x51, 0x4c, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x58, 0x4c, 0x53,
-	0x58, 0x10, 0x04, 0x42, 0x11, 0x5a, 0x0f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64,
-	0x2d, 0x67, 0x6f, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x4f, 0x50, 0x53, 0x10, 0x04, 0x12, 0x09, 0x0a, 0x05, 0x47, 0x49, 0x54, 0x45, 0x41, 0x10, 0x05,
+	0x2a, 0x4c, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x12, 0x16, 0x0a, 0x12, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x43, 0x53, 0x56, 0x10,
+	0x01, 0x12, 0x08, 0x0a, 0x04, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x53,
+	0x51, 0x4c, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x58, 0x4c, 0x53, 0x58, 0x10, 0x04, 0x42, 0x11,
+	0x5a, 0x0f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2d, 0x67, 0x6f, 0x2f, 0x76,
+	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -149,6 +149,48 @@ func local_request_DatabaseCatalogService_UpdateDatabaseCatalog_0(ctx context.Co
 	return msg, metadata, err
 }
 
+func request_DatabaseCatalogService_ReviewClassificationProposals_0(ctx context.Context, marshaler runtime.Marshaler, client DatabaseCatalogServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq ReviewClassificationProposalsRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq); err != nil && !errors.Is(err, io.EOF) {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	val, ok := pathParams["name"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "name")
+	}
+	protoReq.Name, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "name", err)
+	}
+	msg, err := client.ReviewClassificationProposals(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_DatabaseCatalogService_ReviewClassificationProposals_0(ctx context.Context, marshaler runtime.Marshaler, server DatabaseCatalogServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq ReviewClassificationProposalsRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq); err != nil && !errors.Is(err, io.EOF) {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	val, ok := pathParams["name"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "name")
+	}
+	protoReq.Name, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "name", err)
+	}
+	msg, err := server.ReviewClassificationProposals(ctx, &protoReq)
+	return msg, metadata, err
+}
+
 // RegisterDatabaseCatalogServiceHandlerServer registers the http handlers for service DatabaseCatalogService to "mux".
 // UnaryRPC     :call DatabaseCatalogServiceServer directly.
 // StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
@@ -195,6 +237,26 @@ func RegisterDatabaseCatalogServiceHandlerServer(ctx context.Context, mux *runti
 		}
 		forward_DatabaseCatalogService_UpdateDatabaseCatalog_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
+	mux.Handle(http.MethodPost, pattern_DatabaseCatalogService_ReviewClassificationProposals_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/bytebase.v1.DatabaseCatalogService/ReviewClassificationProposals", runtime.WithHTTPPathPattern("/v1/{name=instances/*/databases/*/catalog}:reviewClassificationProposals"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_DatabaseCatalogService_ReviewClassificationProposals_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_DatabaseCatalogService_ReviewClassificationProposals_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
 
 	return nil
 }
@@ -269,15 +331,34 @@ func RegisterDatabaseCatalogServiceHandlerClient(ctx context.Context, mux *runti
 		}
 		forward_DatabaseCatalogService_UpdateDatabaseCatalog_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
+	mux.Handle(http.MethodPost, pattern_DatabaseCatalogService_ReviewClassificationProposals_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/bytebase.v1.DatabaseCatalogService/ReviewClassificationProposals", runtime.WithHTTPPathPattern("/v1/{name=instances/*/databases/*/catalog}:reviewClassificationProposals"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_DatabaseCatalogService_ReviewClassificationProposals_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_DatabaseCatalogService_ReviewClassificationProposals_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
 	return nil
 }
 
 var (
-	pattern_DatabaseCatalogService_GetDatabaseCatalog_0    = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 1, 0, 2, 2, 1, 0, 2, 3, 4, 5, 5, 4}, []string{"v1", "instances", "databases", "catalog", "name"}, ""))
-	pattern_DatabaseCatalogService_UpdateDatabaseCatalog_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 1, 0, 2, 2, 1, 0, 2, 3, 4, 5, 5, 4}, []string{"v1", "instances", "databases", "catalog", "catalog.name"}, ""))
+	pattern_DatabaseCatalogService_GetDatabaseCatalog_0            = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 1, 0, 2, 2, 1, 0, 2, 3, 4, 5, 5, 4}, []string{"v1", "instances", "databases", "catalog", "name"}, ""))
+	pattern_DatabaseCatalogService_UpdateDatabaseCatalog_0         = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 1, 0, 2, 2, 1, 0, 2, 3, 4, 5, 5, 4}, []string{"v1", "instances", "databases", "catalog", "catalog.name"}, ""))
+	pattern_DatabaseCatalogService_ReviewClassificationProposals_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 1, 0, 2, 2, 1, 0, 2, 3, 4, 5, 5, 4}, []string{"v1", "instances", "databases", "catalog", "name"}, "reviewClassificationProposals"))
 )
 
 var (
-	forward_DatabaseCatalogService_GetDatabaseCatalog_0    = runtime.ForwardResponseMessage
-	forward_DatabaseCatalogService_UpdateDatabaseCatalog_0 = runtime.ForwardResponseMessage
+	forward_DatabaseCatalogService_GetDatabaseCatalog_0            = runtime.ForwardResponseMessage
+	forward_DatabaseCatalogService_UpdateDatabaseCatalog_0         = runtime.ForwardResponseMessage
+	forward_DatabaseCatalogService_ReviewClassificationProposals_0 = runtime.ForwardResponseMessage
 )
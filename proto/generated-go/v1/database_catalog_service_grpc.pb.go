@@ -19,8 +19,9 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	DatabaseCatalogService_GetDatabaseCatalog_FullMethodName    = "/bytebase.v1.DatabaseCatalogService/GetDatabaseCatalog"
-	DatabaseCatalogService_UpdateDatabaseCatalog_FullMethodName = "/bytebase.v1.DatabaseCatalogService/UpdateDatabaseCatalog"
+	DatabaseCatalogService_GetDatabaseCatalog_FullMethodName            = "/bytebase.v1.DatabaseCatalogService/GetDatabaseCatalog"
+	DatabaseCatalogService_UpdateDatabaseCatalog_FullMethodName         = "/bytebase.v1.DatabaseCatalogService/UpdateDatabaseCatalog"
+	DatabaseCatalogService_ReviewClassificationProposals_FullMethodName = "/bytebase.v1.DatabaseCatalogService/ReviewClassificationProposals"
 )
 
 // DatabaseCatalogServiceClient is the client API for DatabaseCatalogService service.
@@ -29,6 +30,8 @@ const (
 type DatabaseCatalogServiceClient interface {
 	GetDatabaseCatalog(ctx context.Context, in *GetDatabaseCatalogRequest, opts ...grpc.CallOption) (*DatabaseCatalog, error)
 	UpdateDatabaseCatalog(ctx context.Context, in *UpdateDatabaseCatalogRequest, opts ...grpc.CallOption) (*DatabaseCatalog, error)
+	// ReviewClassificationProposals accepts or rejects the classification proposals of the sensitive data discovery.
+	ReviewClassificationProposals(ctx context.Context, in *ReviewClassificationProposalsRequest, opts ...grpc.CallOption) (*DatabaseCatalog, error)
 }
 
 type databaseCatalogServiceClient struct {
@@ -59,12 +62,24 @@ func (c *databaseCatalogServiceClient) UpdateDatabaseCatalog(ctx context.Context
 	return out, nil
 }
 
+func (c *databaseCatalogServiceClient) ReviewClassificationProposals(ctx context.Context, in *ReviewClassificationProposalsRequest, opts ...grpc.CallOption) (*DatabaseCatalog, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DatabaseCatalog)
+	err := c.cc.Invoke(ctx, DatabaseCatalogService_ReviewClassificationProposals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DatabaseCatalogServiceServer is the server API for DatabaseCatalogService service.
 // All implementations must embed UnimplementedDatabaseCatalogServiceServer
 // for forward compatibility.
 type DatabaseCatalogServiceServer interface {
 	GetDatabaseCatalog(context.Context, *GetDatabaseCatalogRequest) (*DatabaseCatalog, error)
 	UpdateDatabaseCatalog(context.Context, *UpdateDatabaseCatalogRequest) (*DatabaseCatalog, error)
+	// ReviewClassificationProposals accepts or rejects the classification proposals of the sensitive data discovery.
+	ReviewClassificationProposals(context.Context, *ReviewClassificationProposalsRequest) (*DatabaseCatalog, error)
 	mustEmbedUnimplementedDatabaseCatalogServiceServer()
 }
 
@@ -81,6 +96,9 @@ func (UnimplementedDatabaseCatalogServiceServer) GetDatabaseCatalog(context.Cont
 func (UnimplementedDatabaseCatalogServiceServer) UpdateDatabaseCatalog(context.Context, *UpdateDatabaseCatalogRequest) (*DatabaseCatalog, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateDatabaseCatalog not implemented")
 }
+func (UnimplementedDatabaseCatalogServiceServer) ReviewClassificationProposals(context.Context, *ReviewClassificationProposalsRequest) (*DatabaseCatalog, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewClassificationProposals not implemented")
+}
 func (UnimplementedDatabaseCatalogServiceServer) mustEmbedUnimplementedDatabaseCatalogServiceServer() {
 }
 func (UnimplementedDatabaseCatalogServiceServer) testEmbeddedByValue() {}
@@ -139,6 +157,24 @@ func _DatabaseCatalogService_UpdateDatabaseCatalog_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DatabaseCatalogService_ReviewClassificationProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewClassificationProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseCatalogServiceServer).ReviewClassificationProposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DatabaseCatalogService_ReviewClassificationProposals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseCatalogServiceServer).ReviewClassificationProposals(ctx, req.(*ReviewClassificationProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DatabaseCatalogService_ServiceDesc is the grpc.ServiceDesc for DatabaseCatalogService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -154,6 +190,10 @@ var DatabaseCatalogService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateDatabaseCatalog",
 			Handler:    _DatabaseCatalogService_UpdateDatabaseCatalog_Handler,
 		},
+		{
+			MethodName: "ReviewClassificationProposals",
+			Handler:    _DatabaseCatalogService_ReviewClassificationProposals_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/database_catalog_service.proto",
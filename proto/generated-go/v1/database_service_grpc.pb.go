@@ -37,6 +37,7 @@ const (
 	DatabaseService_ListRevisions_FullMethodName         = "/bytebase.v1.DatabaseService/ListRevisions"
 	DatabaseService_GetRevision_FullMethodName           = "/bytebase.v1.DatabaseService/GetRevision"
 	DatabaseService_CreateRevision_FullMethodName        = "/bytebase.v1.DatabaseService/CreateRevision"
+	DatabaseService_ImportRevisions_FullMethodName       = "/bytebase.v1.DatabaseService/ImportRevisions"
 	DatabaseService_DeleteRevision_FullMethodName        = "/bytebase.v1.DatabaseService/DeleteRevision"
 	DatabaseService_ListChangelogs_FullMethodName        = "/bytebase.v1.DatabaseService/ListChangelogs"
 	DatabaseService_GetChangelog_FullMethodName          = "/bytebase.v1.DatabaseService/GetChangelog"
@@ -63,6 +64,11 @@ type DatabaseServiceClient interface {
 	ListRevisions(ctx context.Context, in *ListRevisionsRequest, opts ...grpc.CallOption) (*ListRevisionsResponse, error)
 	GetRevision(ctx context.Context, in *GetRevisionRequest, opts ...grpc.CallOption) (*Revision, error)
 	CreateRevision(ctx context.Context, in *CreateRevisionRequest, opts ...grpc.CallOption) (*Revision, error)
+	// ImportRevisions imports the migration history of Flyway or Liquibase as revisions.
+	// The revision versions are parsed from the migration file names the same way as the release files,
+	// e.g. 3 for V3__add_table.sql of Flyway without the prefix, and for changelog/3_add_index.sql of Liquibase.
+	// The versions already recorded as revisions are skipped.
+	ImportRevisions(ctx context.Context, in *ImportRevisionsRequest, opts ...grpc.CallOption) (*ImportRevisionsResponse, error)
 	DeleteRevision(ctx context.Context, in *DeleteRevisionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ListChangelogs(ctx context.Context, in *ListChangelogsRequest, opts ...grpc.CallOption) (*ListChangelogsResponse, error)
 	GetChangelog(ctx context.Context, in *GetChangelogRequest, opts ...grpc.CallOption) (*Changelog, error)
@@ -246,6 +252,16 @@ func (c *databaseServiceClient) CreateRevision(ctx context.Context, in *CreateRe
 	return out, nil
 }
 
+func (c *databaseServiceClient) ImportRevisions(ctx context.Context, in *ImportRevisionsRequest, opts ...grpc.CallOption) (*ImportRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportRevisionsResponse)
+	err := c.cc.Invoke(ctx, DatabaseService_ImportRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *databaseServiceClient) DeleteRevision(ctx context.Context, in *DeleteRevisionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -297,6 +313,11 @@ type DatabaseServiceServer interface {
 	ListRevisions(context.Context, *ListRevisionsRequest) (*ListRevisionsResponse, error)
 	GetRevision(context.Context, *GetRevisionRequest) (*Revision, error)
 	CreateRevision(context.Context, *CreateRevisionRequest) (*Revision, error)
+	// ImportRevisions imports the migration history of Flyway or Liquibase as revisions.
+	// The revision versions are parsed from the migration file names the same way as the release files,
+	// e.g. 3 for V3__add_table.sql of Flyway without the prefix, and for changelog/3_add_index.sql of Liquibase.
+	// The versions already recorded as revisions are skipped.
+	ImportRevisions(context.Context, *ImportRevisionsRequest) (*ImportRevisionsResponse, error)
 	DeleteRevision(context.Context, *DeleteRevisionRequest) (*emptypb.Empty, error)
 	ListChangelogs(context.Context, *ListChangelogsRequest) (*ListChangelogsResponse, error)
 	GetChangelog(context.Context, *GetChangelogRequest) (*Changelog, error)
@@ -361,6 +382,9 @@ func (UnimplementedDatabaseServiceServer) GetRevision(context.Context, *GetRevis
 func (UnimplementedDatabaseServiceServer) CreateRevision(context.Context, *CreateRevisionRequest) (*Revision, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateRevision not implemented")
 }
+func (UnimplementedDatabaseServiceServer) ImportRevisions(context.Context, *ImportRevisionsRequest) (*ImportRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportRevisions not implemented")
+}
 func (UnimplementedDatabaseServiceServer) DeleteRevision(context.Context, *DeleteRevisionRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteRevision not implemented")
 }
@@ -697,6 +721,24 @@ func _DatabaseService_CreateRevision_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DatabaseService_ImportRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).ImportRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DatabaseService_ImportRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).ImportRevisions(ctx, req.(*ImportRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DatabaseService_DeleteRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteRevisionRequest)
 	if err := dec(in); err != nil {
@@ -826,6 +868,10 @@ var DatabaseService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateRevision",
 			Handler:    _DatabaseService_CreateRevision_Handler,
 		},
+		{
+			MethodName: "ImportRevisions",
+			Handler:    _DatabaseService_ImportRevisions_Handler,
+		},
 		{
 			MethodName: "DeleteRevision",
 			Handler:    _DatabaseService_DeleteRevision_Handler,
@@ -19,18 +19,19 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	IssueService_GetIssue_FullMethodName                = "/bytebase.v1.IssueService/GetIssue"
-	IssueService_CreateIssue_FullMethodName             = "/bytebase.v1.IssueService/CreateIssue"
-	IssueService_ListIssues_FullMethodName              = "/bytebase.v1.IssueService/ListIssues"
-	IssueService_SearchIssues_FullMethodName            = "/bytebase.v1.IssueService/SearchIssues"
-	IssueService_UpdateIssue_FullMethodName             = "/bytebase.v1.IssueService/UpdateIssue"
-	IssueService_ListIssueComments_FullMethodName       = "/bytebase.v1.IssueService/ListIssueComments"
-	IssueService_CreateIssueComment_FullMethodName      = "/bytebase.v1.IssueService/CreateIssueComment"
-	IssueService_UpdateIssueComment_FullMethodName      = "/bytebase.v1.IssueService/UpdateIssueComment"
-	IssueService_BatchUpdateIssuesStatus_FullMethodName = "/bytebase.v1.IssueService/BatchUpdateIssuesStatus"
-	IssueService_ApproveIssue_FullMethodName            = "/bytebase.v1.IssueService/ApproveIssue"
-	IssueService_RejectIssue_FullMethodName             = "/bytebase.v1.IssueService/RejectIssue"
-	IssueService_RequestIssue_FullMethodName            = "/bytebase.v1.IssueService/RequestIssue"
+	IssueService_GetIssue_FullMethodName                  = "/bytebase.v1.IssueService/GetIssue"
+	IssueService_CreateIssue_FullMethodName               = "/bytebase.v1.IssueService/CreateIssue"
+	IssueService_ListIssues_FullMethodName                = "/bytebase.v1.IssueService/ListIssues"
+	IssueService_SearchIssues_FullMethodName              = "/bytebase.v1.IssueService/SearchIssues"
+	IssueService_UpdateIssue_FullMethodName               = "/bytebase.v1.IssueService/UpdateIssue"
+	IssueService_ListIssueComments_FullMethodName         = "/bytebase.v1.IssueService/ListIssueComments"
+	IssueService_CreateIssueComment_FullMethodName        = "/bytebase.v1.IssueService/CreateIssueComment"
+	IssueService_UpdateIssueComment_FullMethodName        = "/bytebase.v1.IssueService/UpdateIssueComment"
+	IssueService_BatchUpdateIssuesStatus_FullMethodName   = "/bytebase.v1.IssueService/BatchUpdateIssuesStatus"
+	IssueService_ApproveIssue_FullMethodName              = "/bytebase.v1.IssueService/ApproveIssue"
+	IssueService_RejectIssue_FullMethodName               = "/bytebase.v1.IssueService/RejectIssue"
+	IssueService_RequestIssue_FullMethodName              = "/bytebase.v1.IssueService/RequestIssue"
+	IssueService_RevealJITAccessCredential_FullMethodName = "/bytebase.v1.IssueService/RevealJITAccessCredential"
 )
 
 // IssueServiceClient is the client API for IssueService service.
@@ -56,6 +57,9 @@ type IssueServiceClient interface {
 	// RequestIssue requests the issue.
 	// The access is based on approval flow.
 	RequestIssue(ctx context.Context, in *RequestIssueRequest, opts ...grpc.CallOption) (*Issue, error)
+	// RevealJITAccessCredential returns the database credential vended for the JIT access issue.
+	// The password can only be revealed once, and only to the requester.
+	RevealJITAccessCredential(ctx context.Context, in *RevealJITAccessCredentialRequest, opts ...grpc.CallOption) (*RevealJITAccessCredentialResponse, error)
 }
 
 type issueServiceClient struct {
@@ -186,6 +190,16 @@ func (c *issueServiceClient) RequestIssue(ctx context.Context, in *RequestIssueR
 	return out, nil
 }
 
+func (c *issueServiceClient) RevealJITAccessCredential(ctx context.Context, in *RevealJITAccessCredentialRequest, opts ...grpc.CallOption) (*RevealJITAccessCredentialResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevealJITAccessCredentialResponse)
+	err := c.cc.Invoke(ctx, IssueService_RevealJITAccessCredential_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // IssueServiceServer is the server API for IssueService service.
 // All implementations must embed UnimplementedIssueServiceServer
 // for forward compatibility.
@@ -209,6 +223,9 @@ type IssueServiceServer interface {
 	// RequestIssue requests the issue.
 	// The access is based on approval flow.
 	RequestIssue(context.Context, *RequestIssueRequest) (*Issue, error)
+	// RevealJITAccessCredential returns the database credential vended for the JIT access issue.
+	// The password can only be revealed once, and only to the requester.
+	RevealJITAccessCredential(context.Context, *RevealJITAccessCredentialRequest) (*RevealJITAccessCredentialResponse, error)
 	mustEmbedUnimplementedIssueServiceServer()
 }
 
@@ -255,6 +272,9 @@ func (UnimplementedIssueServiceServer) RejectIssue(context.Context, *RejectIssue
 func (UnimplementedIssueServiceServer) RequestIssue(context.Context, *RequestIssueRequest) (*Issue, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RequestIssue not implemented")
 }
+func (UnimplementedIssueServiceServer) RevealJITAccessCredential(context.Context, *RevealJITAccessCredentialRequest) (*RevealJITAccessCredentialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealJITAccessCredential not implemented")
+}
 func (UnimplementedIssueServiceServer) mustEmbedUnimplementedIssueServiceServer() {}
 func (UnimplementedIssueServiceServer) testEmbeddedByValue()                      {}
 
@@ -492,6 +512,24 @@ func _IssueService_RequestIssue_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IssueService_RevealJITAccessCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevealJITAccessCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).RevealJITAccessCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_RevealJITAccessCredential_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).RevealJITAccessCredential(ctx, req.(*RevealJITAccessCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // IssueService_ServiceDesc is the grpc.ServiceDesc for IssueService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -547,6 +585,10 @@ var IssueService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RequestIssue",
 			Handler:    _IssueService_RequestIssue_Handler,
 		},
+		{
+			MethodName: "RevealJITAccessCredential",
+			Handler:    _IssueService_RevealJITAccessCredential_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/issue_service.proto",
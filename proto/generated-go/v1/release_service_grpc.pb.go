@@ -20,13 +20,15 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ReleaseService_GetRelease_FullMethodName      = "/bytebase.v1.ReleaseService/GetRelease"
-	ReleaseService_ListReleases_FullMethodName    = "/bytebase.v1.ReleaseService/ListReleases"
-	ReleaseService_CreateRelease_FullMethodName   = "/bytebase.v1.ReleaseService/CreateRelease"
-	ReleaseService_UpdateRelease_FullMethodName   = "/bytebase.v1.ReleaseService/UpdateRelease"
-	ReleaseService_DeleteRelease_FullMethodName   = "/bytebase.v1.ReleaseService/DeleteRelease"
-	ReleaseService_UndeleteRelease_FullMethodName = "/bytebase.v1.ReleaseService/UndeleteRelease"
-	ReleaseService_CheckRelease_FullMethodName    = "/bytebase.v1.ReleaseService/CheckRelease"
+	ReleaseService_GetRelease_FullMethodName       = "/bytebase.v1.ReleaseService/GetRelease"
+	ReleaseService_ListReleases_FullMethodName     = "/bytebase.v1.ReleaseService/ListReleases"
+	ReleaseService_CreateRelease_FullMethodName    = "/bytebase.v1.ReleaseService/CreateRelease"
+	ReleaseService_UpdateRelease_FullMethodName    = "/bytebase.v1.ReleaseService/UpdateRelease"
+	ReleaseService_DeleteRelease_FullMethodName    = "/bytebase.v1.ReleaseService/DeleteRelease"
+	ReleaseService_UndeleteRelease_FullMethodName  = "/bytebase.v1.ReleaseService/UndeleteRelease"
+	ReleaseService_CheckRelease_FullMethodName     = "/bytebase.v1.ReleaseService/CheckRelease"
+	ReleaseService_GetReleaseMatrix_FullMethodName = "/bytebase.v1.ReleaseService/GetReleaseMatrix"
+	ReleaseService_PromoteRelease_FullMethodName   = "/bytebase.v1.ReleaseService/PromoteRelease"
 )
 
 // ReleaseServiceClient is the client API for ReleaseService service.
@@ -40,6 +42,11 @@ type ReleaseServiceClient interface {
 	DeleteRelease(ctx context.Context, in *DeleteReleaseRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	UndeleteRelease(ctx context.Context, in *UndeleteReleaseRequest, opts ...grpc.CallOption) (*Release, error)
 	CheckRelease(ctx context.Context, in *CheckReleaseRequest, opts ...grpc.CallOption) (*CheckReleaseResponse, error)
+	// GetReleaseMatrix gets the release deployed to each environment and the pending files of the databases.
+	GetReleaseMatrix(ctx context.Context, in *GetReleaseMatrixRequest, opts ...grpc.CallOption) (*ReleaseMatrix, error)
+	// PromoteRelease creates the plan, issue and rollout applying the pending files of the release
+	// to the databases of an environment.
+	PromoteRelease(ctx context.Context, in *PromoteReleaseRequest, opts ...grpc.CallOption) (*PromoteReleaseResponse, error)
 }
 
 type releaseServiceClient struct {
@@ -120,6 +127,26 @@ func (c *releaseServiceClient) CheckRelease(ctx context.Context, in *CheckReleas
 	return out, nil
 }
 
+func (c *releaseServiceClient) GetReleaseMatrix(ctx context.Context, in *GetReleaseMatrixRequest, opts ...grpc.CallOption) (*ReleaseMatrix, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseMatrix)
+	err := c.cc.Invoke(ctx, ReleaseService_GetReleaseMatrix_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *releaseServiceClient) PromoteRelease(ctx context.Context, in *PromoteReleaseRequest, opts ...grpc.CallOption) (*PromoteReleaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PromoteReleaseResponse)
+	err := c.cc.Invoke(ctx, ReleaseService_PromoteRelease_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ReleaseServiceServer is the server API for ReleaseService service.
 // All implementations must embed UnimplementedReleaseServiceServer
 // for forward compatibility.
@@ -131,6 +158,11 @@ type ReleaseServiceServer interface {
 	DeleteRelease(context.Context, *DeleteReleaseRequest) (*emptypb.Empty, error)
 	UndeleteRelease(context.Context, *UndeleteReleaseRequest) (*Release, error)
 	CheckRelease(context.Context, *CheckReleaseRequest) (*CheckReleaseResponse, error)
+	// GetReleaseMatrix gets the release deployed to each environment and the pending files of the databases.
+	GetReleaseMatrix(context.Context, *GetReleaseMatrixRequest) (*ReleaseMatrix, error)
+	// PromoteRelease creates the plan, issue and rollout applying the pending files of the release
+	// to the databases of an environment.
+	PromoteRelease(context.Context, *PromoteReleaseRequest) (*PromoteReleaseResponse, error)
 	mustEmbedUnimplementedReleaseServiceServer()
 }
 
@@ -162,6 +194,12 @@ func (UnimplementedReleaseServiceServer) UndeleteRelease(context.Context, *Undel
 func (UnimplementedReleaseServiceServer) CheckRelease(context.Context, *CheckReleaseRequest) (*CheckReleaseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckRelease not implemented")
 }
+func (UnimplementedReleaseServiceServer) GetReleaseMatrix(context.Context, *GetReleaseMatrixRequest) (*ReleaseMatrix, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReleaseMatrix not implemented")
+}
+func (UnimplementedReleaseServiceServer) PromoteRelease(context.Context, *PromoteReleaseRequest) (*PromoteReleaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PromoteRelease not implemented")
+}
 func (UnimplementedReleaseServiceServer) mustEmbedUnimplementedReleaseServiceServer() {}
 func (UnimplementedReleaseServiceServer) testEmbeddedByValue()                        {}
 
@@ -309,6 +347,42 @@ func _ReleaseService_CheckRelease_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ReleaseService_GetReleaseMatrix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReleaseMatrixRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReleaseServiceServer).GetReleaseMatrix(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReleaseService_GetReleaseMatrix_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReleaseServiceServer).GetReleaseMatrix(ctx, req.(*GetReleaseMatrixRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReleaseService_PromoteRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromoteReleaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReleaseServiceServer).PromoteRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReleaseService_PromoteRelease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReleaseServiceServer).PromoteRelease(ctx, req.(*PromoteReleaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ReleaseService_ServiceDesc is the grpc.ServiceDesc for ReleaseService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -344,6 +418,14 @@ var ReleaseService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CheckRelease",
 			Handler:    _ReleaseService_CheckRelease_Handler,
 		},
+		{
+			MethodName: "GetReleaseMatrix",
+			Handler:    _ReleaseService_GetReleaseMatrix_Handler,
+		},
+		{
+			MethodName: "PromoteRelease",
+			Handler:    _ReleaseService_PromoteRelease_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/release_service.proto",
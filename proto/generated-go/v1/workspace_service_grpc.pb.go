@@ -19,8 +19,9 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	WorkspaceService_GetIamPolicy_FullMethodName = "/bytebase.v1.WorkspaceService/GetIamPolicy"
-	WorkspaceService_SetIamPolicy_FullMethodName = "/bytebase.v1.WorkspaceService/SetIamPolicy"
+	WorkspaceService_GetIamPolicy_FullMethodName     = "/bytebase.v1.WorkspaceService/GetIamPolicy"
+	WorkspaceService_SetIamPolicy_FullMethodName     = "/bytebase.v1.WorkspaceService/SetIamPolicy"
+	WorkspaceService_AnalyzeIamPolicy_FullMethodName = "/bytebase.v1.WorkspaceService/AnalyzeIamPolicy"
 )
 
 // WorkspaceServiceClient is the client API for WorkspaceService service.
@@ -29,6 +30,9 @@ const (
 type WorkspaceServiceClient interface {
 	GetIamPolicy(ctx context.Context, in *GetIamPolicyRequest, opts ...grpc.CallOption) (*IamPolicy, error)
 	SetIamPolicy(ctx context.Context, in *SetIamPolicyRequest, opts ...grpc.CallOption) (*IamPolicy, error)
+	// AnalyzeIamPolicy explains why a principal has or doesn't have a permission on a resource,
+	// or lists all principals holding a permission on a resource.
+	AnalyzeIamPolicy(ctx context.Context, in *AnalyzeIamPolicyRequest, opts ...grpc.CallOption) (*AnalyzeIamPolicyResponse, error)
 }
 
 type workspaceServiceClient struct {
@@ -59,12 +63,25 @@ func (c *workspaceServiceClient) SetIamPolicy(ctx context.Context, in *SetIamPol
 	return out, nil
 }
 
+func (c *workspaceServiceClient) AnalyzeIamPolicy(ctx context.Context, in *AnalyzeIamPolicyRequest, opts ...grpc.CallOption) (*AnalyzeIamPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AnalyzeIamPolicyResponse)
+	err := c.cc.Invoke(ctx, WorkspaceService_AnalyzeIamPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WorkspaceServiceServer is the server API for WorkspaceService service.
 // All implementations must embed UnimplementedWorkspaceServiceServer
 // for forward compatibility.
 type WorkspaceServiceServer interface {
 	GetIamPolicy(context.Context, *GetIamPolicyRequest) (*IamPolicy, error)
 	SetIamPolicy(context.Context, *SetIamPolicyRequest) (*IamPolicy, error)
+	// AnalyzeIamPolicy explains why a principal has or doesn't have a permission on a resource,
+	// or lists all principals holding a permission on a resource.
+	AnalyzeIamPolicy(context.Context, *AnalyzeIamPolicyRequest) (*AnalyzeIamPolicyResponse, error)
 	mustEmbedUnimplementedWorkspaceServiceServer()
 }
 
@@ -81,6 +98,9 @@ func (UnimplementedWorkspaceServiceServer) GetIamPolicy(context.Context, *GetIam
 func (UnimplementedWorkspaceServiceServer) SetIamPolicy(context.Context, *SetIamPolicyRequest) (*IamPolicy, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetIamPolicy not implemented")
 }
+func (UnimplementedWorkspaceServiceServer) AnalyzeIamPolicy(context.Context, *AnalyzeIamPolicyRequest) (*AnalyzeIamPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnalyzeIamPolicy not implemented")
+}
 func (UnimplementedWorkspaceServiceServer) mustEmbedUnimplementedWorkspaceServiceServer() {}
 func (UnimplementedWorkspaceServiceServer) testEmbeddedByValue()                          {}
 
@@ -138,6 +158,24 @@ func _WorkspaceService_SetIamPolicy_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WorkspaceService_AnalyzeIamPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnalyzeIamPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).AnalyzeIamPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_AnalyzeIamPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).AnalyzeIamPolicy(ctx, req.(*AnalyzeIamPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // WorkspaceService_ServiceDesc is the grpc.ServiceDesc for WorkspaceService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -153,6 +191,10 @@ var WorkspaceService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetIamPolicy",
 			Handler:    _WorkspaceService_SetIamPolicy_Handler,
 		},
+		{
+			MethodName: "AnalyzeIamPolicy",
+			Handler:    _WorkspaceService_AnalyzeIamPolicy_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/workspace_service.proto",